@@ -103,7 +103,13 @@ import (
 	_ "github.com/tendermint/farming/client/docs/statik"
 )
 
-const appName = "FarmingApp"
+const (
+	appName = "FarmingApp"
+
+	// UpgradeName is the name of the upgrade that runs the in-place store
+	// migrations of the farming module.
+	UpgradeName = "v2.0.0"
+)
 
 var (
 	// DefaultNodeHome default home directories for the application daemon
@@ -123,7 +129,7 @@ var (
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler,
 			upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
-			farmingclient.ProposalHandler,
+			farmingclient.ProposalHandler, farmingclient.StakingDenomsProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -308,9 +314,16 @@ func NewFarmingApp(
 		app.BankKeeper, app.ModuleAccountAddrs(),
 	)
 
-	app.FarmingKeeper = farmingkeeper.NewKeeper(
+	farmingKeeper := farmingkeeper.NewKeeper(
 		appCodec, keys[farmingtypes.StoreKey], app.GetSubspace(farmingtypes.ModuleName), app.AccountKeeper,
-		app.BankKeeper, app.ModuleAccountAddrs(),
+		app.BankKeeper, app.BudgetKeeper, app.LiquidityKeeper, app.ModuleAccountAddrs(),
+	)
+
+	// NOTE: farmingKeeper above is passed by reference, so that it will contain these hooks
+	app.FarmingKeeper = *farmingKeeper.SetHooks(
+		farmingtypes.NewMultiFarmingHooks(
+		// register the farming hooks
+		),
 	)
 
 	// register the proposal types
@@ -370,7 +383,7 @@ func NewFarmingApp(
 		params.NewAppModule(app.ParamsKeeper),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		liquidity.NewAppModule(appCodec, app.LiquidityKeeper, app.AccountKeeper, app.BankKeeper, app.DistrKeeper),
-		farming.NewAppModule(appCodec, app.FarmingKeeper, app.AccountKeeper, app.BankKeeper),
+		farming.NewAppModule(appCodec, app.FarmingKeeper, app.AccountKeeper, app.BankKeeper, keys[paramstypes.StoreKey]),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -429,6 +442,10 @@ func NewFarmingApp(
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
 
+	app.UpgradeKeeper.SetUpgradeHandler(UpgradeName, func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
+
 	// add test gRPC service for testing gRPC queries in isolation
 	testdata.RegisterQueryServer(app.GRPCQueryRouter(), testdata.QueryImpl{})
 
@@ -445,7 +462,7 @@ func NewFarmingApp(
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper),
 		budget.NewAppModule(appCodec, app.BudgetKeeper, app.AccountKeeper, app.BankKeeper),
-		farming.NewAppModule(appCodec, app.FarmingKeeper, app.AccountKeeper, app.BankKeeper),
+		farming.NewAppModule(appCodec, app.FarmingKeeper, app.AccountKeeper, app.BankKeeper, keys[paramstypes.StoreKey]),
 		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
 		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
@@ -4,9 +4,13 @@ const (
 	// farming module simulation operation weights for messages
 	DefaultWeightMsgCreateFixedAmountPlan int = 10
 	DefaultWeightMsgCreateRatioPlan       int = 10
+	DefaultWeightMsgCreateDecayingPlan    int = 10
 	DefaultWeightMsgStake                 int = 85
 	DefaultWeightMsgUnstake               int = 30
 	DefaultWeightMsgHarvest               int = 30
+	DefaultWeightMsgModifyPrivatePlan     int = 10
+	DefaultWeightMsgTerminatePrivatePlan  int = 5
+	DefaultWeightMsgFundPrivatePlan       int = 10
 
 	DefaultWeightAddPublicPlanProposal    int = 5
 	DefaultWeightUpdatePublicPlanProposal int = 5
@@ -15,34 +15,52 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 
 	logger := k.Logger(ctx)
 
-	for _, plan := range k.GetPlans(ctx) {
-		if !plan.GetTerminated() && ctx.BlockTime().After(plan.GetEndTime()) {
-			if err := k.TerminatePlan(ctx, plan); err != nil {
-				logger.Error("failed to terminate plan", "plan_id", plan.GetId())
-			}
-		}
-	}
-
-	// CurrentEpochDays is initialized with the value of NextEpochDays in genesis, and
-	// it is used here to prevent from affecting the epoch days for farming rewards allocation.
-	// Suppose NextEpochDays is 7 days, and it is proposed to change the value to 1 day through governance proposal.
+	// CurrentEpochDuration is initialized with the value of NextEpochDuration in genesis, and
+	// it is used here to prevent from affecting the epoch duration for farming rewards allocation.
+	// Suppose NextEpochDuration is 7 days, and it is proposed to change the value to 1 hour through governance proposal.
 	// Although the proposal is passed, farming rewards allocation should continue to proceed with 7 days,
 	// and then it gets updated.
-	currentEpochDays := k.GetCurrentEpochDays(ctx)
+	currentEpochDuration := k.GetCurrentEpochDuration(ctx)
 
-	lastEpochTime, found := k.GetLastEpochTime(ctx)
+	nextEpochTime, found := k.GetNextEpochTime(ctx)
 	if !found {
 		k.SetLastEpochTime(ctx, ctx.BlockTime())
-	} else {
-		y, m, d := lastEpochTime.AddDate(0, 0, int(currentEpochDays)).Date()
-		y2, m2, d2 := ctx.BlockTime().Date()
-		if !time.Date(y2, m2, d2, 0, 0, 0, 0, time.UTC).Before(time.Date(y, m, d, 0, 0, 0, 0, time.UTC)) {
+	} else if !ctx.BlockTime().Before(nextEpochTime) {
+		if maxCatchUpEpochs := k.GetParams(ctx).MaxCatchUpEpochs; maxCatchUpEpochs > 0 {
+			// Epochs missed while the chain was down are ended one by one,
+			// before plans that have ended in the meantime get terminated.
+			if err := k.CatchUpEpochs(ctx, maxCatchUpEpochs); err != nil {
+				panic(err)
+			}
+		} else {
 			if err := k.AdvanceEpoch(ctx); err != nil {
 				panic(err)
 			}
-			if params := k.GetParams(ctx); params.NextEpochDays != currentEpochDays {
-				k.SetCurrentEpochDays(ctx, params.NextEpochDays)
+			if params := k.GetParams(ctx); params.NextEpochDuration != currentEpochDuration {
+				k.SetCurrentEpochDuration(ctx, params.NextEpochDuration)
 			}
 		}
 	}
+
+	// While missed epochs are still being caught up, plans are terminated only
+	// if they have ended by the last epoch, so that they can allocate rewards
+	// for the remaining missed epochs in the following blocks.
+	terminationTime := ctx.BlockTime()
+	if nextEpochTime, found := k.GetNextEpochTime(ctx); found && !ctx.BlockTime().Before(nextEpochTime) {
+		terminationTime, _ = k.GetLastEpochTime(ctx)
+	}
+
+	for _, plan := range k.GetPlansEndedBefore(ctx, terminationTime) {
+		if err := k.TerminatePlan(ctx, plan); err != nil {
+			logger.Error("failed to terminate plan", "plan_id", plan.GetId())
+		}
+	}
+
+	// Unbondings mature at every block, regardless of epochs.
+	k.ProcessMaturedUnbondings(ctx)
+
+	// The liquidity module executes pool batches in its end blocker, which
+	// runs before this one, so the pool coins minted by deposits made on
+	// behalf of farmers are staked in the same block.
+	k.ProcessDepositRequests(ctx)
 }
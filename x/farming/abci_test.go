@@ -3,20 +3,25 @@ package farming_test
 import (
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gravity-devs/liquidity/x/liquidity"
+	liquiditytypes "github.com/gravity-devs/liquidity/x/liquidity/types"
+
 	"github.com/tendermint/farming/x/farming"
 	"github.com/tendermint/farming/x/farming/types"
 
 	_ "github.com/stretchr/testify/suite"
 )
 
-func (suite *ModuleTestSuite) TestEndBlockerEpochDaysTest() {
-	epochDaysTest := func(formerEpochDays, targetNextEpochDays uint32) {
+func (suite *ModuleTestSuite) TestEndBlockerEpochDurationTest() {
+	epochDurationTest := func(formerEpochDuration, targetNextEpochDuration time.Duration) {
 		suite.SetupTest()
 
 		params := suite.keeper.GetParams(suite.ctx)
-		params.NextEpochDays = formerEpochDays
+		params.NextEpochDuration = formerEpochDuration
 		suite.keeper.SetParams(suite.ctx, params)
-		suite.keeper.SetCurrentEpochDays(suite.ctx, formerEpochDays)
+		suite.keeper.SetCurrentEpochDuration(suite.ctx, formerEpochDuration)
 
 		t := types.ParseTime("2021-08-01T00:00:00Z")
 		suite.ctx = suite.ctx.WithBlockTime(t)
@@ -29,24 +34,24 @@ func (suite *ModuleTestSuite) TestEndBlockerEpochDaysTest() {
 			suite.ctx = suite.ctx.WithBlockTime(t)
 			farming.EndBlocker(suite.ctx, suite.keeper)
 
-			if i == 10 { // 10 hours passed
+			if i == 1 { // 1 hour passed
 				params := suite.keeper.GetParams(suite.ctx)
-				params.NextEpochDays = targetNextEpochDays
+				params.NextEpochDuration = targetNextEpochDuration
 				suite.keeper.SetParams(suite.ctx, params)
 			}
 
-			currentEpochDays := suite.keeper.GetCurrentEpochDays(suite.ctx)
+			currentEpochDuration := suite.keeper.GetCurrentEpochDuration(suite.ctx)
 			t2, _ := suite.keeper.GetLastEpochTime(suite.ctx)
 
-			if uint32(i) == formerEpochDays*24 {
+			if time.Duration(i)*time.Hour == formerEpochDuration {
 				suite.Require().True(t2.After(lastEpochTime))
-				suite.Require().Equal(t2.Sub(lastEpochTime).Hours(), float64(formerEpochDays*24))
-				suite.Require().Equal(targetNextEpochDays, currentEpochDays)
+				suite.Require().Equal(formerEpochDuration, t2.Sub(lastEpochTime))
+				suite.Require().Equal(targetNextEpochDuration, currentEpochDuration)
 			}
 
-			if uint32(i) == formerEpochDays*24+targetNextEpochDays*24 {
-				suite.Require().Equal(t2.Sub(lastEpochTime).Hours(), float64(currentEpochDays*24))
-				suite.Require().Equal(targetNextEpochDays, currentEpochDays)
+			if time.Duration(i)*time.Hour == formerEpochDuration+targetNextEpochDuration {
+				suite.Require().Equal(currentEpochDuration, t2.Sub(lastEpochTime))
+				suite.Require().Equal(targetNextEpochDuration, currentEpochDuration)
 			}
 
 			lastEpochTime = t2
@@ -54,11 +59,62 @@ func (suite *ModuleTestSuite) TestEndBlockerEpochDaysTest() {
 	}
 
 	// increasing case
-	epochDaysTest(1, 7)
+	epochDurationTest(24*time.Hour, 7*24*time.Hour)
 
 	// decreasing case
-	epochDaysTest(7, 1)
+	epochDurationTest(7*24*time.Hour, 24*time.Hour)
 
 	// stay case
-	epochDaysTest(1, 1)
+	epochDurationTest(24*time.Hour, 24*time.Hour)
+
+	// sub-day cases
+	epochDurationTest(24*time.Hour, 2*time.Hour)
+	epochDurationTest(2*time.Hour, time.Hour)
+	epochDurationTest(time.Hour, time.Hour)
+}
+
+func (suite *ModuleTestSuite) TestEndBlockerMaturedUnbondings() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.UnbondingPeriod = 12 * time.Hour
+	suite.keeper.SetParams(suite.ctx, params)
+
+	t := types.ParseTime("2021-08-01T00:00:00Z")
+	suite.ctx = suite.ctx.WithBlockTime(t)
+	farming.EndBlocker(suite.ctx, suite.keeper)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	_, err := suite.keeper.Unstake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.Require().NoError(err)
+
+	// Unbondings mature in the middle of an epoch.
+	for i := 1; i <= 12; i++ {
+		suite.ctx = suite.ctx.WithBlockTime(t.Add(time.Duration(i) * time.Hour))
+		farming.EndBlocker(suite.ctx, suite.keeper)
+
+		_, found := suite.keeper.GetUnbonding(suite.ctx, 1)
+		suite.Require().Equal(i < 12, found)
+	}
+
+	balancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(balancesBefore.Add(sdk.NewInt64Coin(denom1, 1_000_000)), balancesAfter))
+}
+
+func (suite *ModuleTestSuite) TestEndBlockerDepositRequests() {
+	pool, err := suite.app.LiquidityKeeper.CreatePool(suite.ctx, liquiditytypes.NewMsgCreatePool(
+		suite.addrs[5], liquiditytypes.DefaultPoolTypeID, sdk.NewCoins(sdk.NewInt64Coin(denom1, 100_000_000), sdk.NewInt64Coin(denom2, 100_000_000))))
+	suite.Require().NoError(err)
+
+	id, err := suite.keeper.DepositAndStake(suite.ctx, suite.addrs[0], pool.Id, sdk.NewCoins(sdk.NewInt64Coin(denom1, 10_000_000), sdk.NewInt64Coin(denom2, 10_000_000)))
+	suite.Require().NoError(err)
+
+	// The liquidity module's end blocker runs before the farming module's.
+	liquidity.EndBlocker(suite.ctx, suite.app.LiquidityKeeper)
+	farming.EndBlocker(suite.ctx, suite.keeper)
+
+	_, found := suite.keeper.GetDepositRequest(suite.ctx, id)
+	suite.Require().False(found)
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(pool.PoolCoinDenom, 100_000)),
+		suite.keeper.GetAllQueuedCoinsByFarmer(suite.ctx, suite.addrs[0])))
 }
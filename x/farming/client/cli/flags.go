@@ -12,6 +12,12 @@ const (
 	FlagTerminationAddr  = "termination-addr"
 	FlagStakingCoinDenom = "staking-coin-denom"
 	FlagAll              = "all"
+	FlagStartingEpoch    = "starting-epoch"
+	FlagEndingEpoch      = "ending-epoch"
+	FlagPlanId           = "plan-id"
+	FlagStakeRewards     = "stake-rewards"
+	FlagLockDuration     = "lock-duration"
+	FlagFarmer           = "farmer"
 )
 
 // flagSetPlans returns the FlagSet used for farming plan related opertations.
@@ -44,11 +50,42 @@ func flagSetRewards() *flag.FlagSet {
 	return fs
 }
 
+// flagSetHistoricalRewards returns the FlagSet used for the epoch range of historical rewards.
+func flagSetHistoricalRewards() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Uint64(FlagStartingEpoch, 0, "The first epoch of the range, inclusive")
+	fs.Uint64(FlagEndingEpoch, 0, "The last epoch of the range, inclusive; 0 means no upper bound")
+
+	return fs
+}
+
+// flagSetAnnualRewards returns the FlagSet used for estimated annual rewards.
+func flagSetAnnualRewards() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagStakingCoinDenom, "", "The staking coin denom")
+	fs.Uint64(FlagPlanId, 0, "The plan id")
+
+	return fs
+}
+
 // flagSetHarvest returns the FlagSet used for harvest all staking coin denoms.
 func flagSetHarvest() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
 	fs.Bool(FlagAll, false, "Harvest for all staking coin denoms")
+	fs.Bool(FlagStakeRewards, false, "Stake the harvested rewards whose denoms are staking coin denoms of the plans")
+
+	return fs
+}
+
+// flagSetStake returns the FlagSet used for staking coins.
+func flagSetStake() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Duration(FlagLockDuration, 0, "The duration of one of the lock tiers to lock the staking coins for; 0 means no lock")
+	fs.String(FlagFarmer, "", "The bech32 address of the farmer to stake for; the staking coins are paid by the signer")
 
 	return fs
 }
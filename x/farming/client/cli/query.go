@@ -35,9 +35,24 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryPlans(),
 		GetCmdQueryPlan(),
 		GetCmdQueryStakings(),
+		GetCmdQueryLockedStakings(),
+		GetCmdQueryUnbondings(),
+		GetCmdQueryStakingsDetail(),
+		GetCmdQueryQueuedStakings(),
+		GetCmdQueryStakingsByDenom(),
+		GetCmdQueryQueuedStakingsByDenom(),
 		GetCmdQueryTotalStakings(),
 		GetCmdQueryRewards(),
-		GetCmdQueryCurrentEpochDays(),
+		GetCmdQueryRewardsWithdrawAddress(),
+		GetCmdQueryCurrentEpochDuration(),
+		GetCmdQueryHistoricalRewards(),
+		GetCmdQueryCurrentEpoch(),
+		GetCmdQueryOutstandingRewards(),
+		GetCmdQueryAnnualRewards(),
+		GetCmdQueryAllocationPreview(),
+		GetCmdQueryEpochInfo(),
+		GetCmdQueryPlanFunding(),
+		GetCmdQueryAllowedStakingDenoms(),
 	)
 	return farmingQueryCmd
 }
@@ -245,19 +260,26 @@ $ %s query %s stakings %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --staking-coin-
 	return cmd
 }
 
-// GetCmdQueryTotalStakings implements the query total staking amounts for a staking coin denom command.
-func GetCmdQueryTotalStakings() *cobra.Command {
+// GetCmdQueryLockedStakings implements the query locked stakings command.
+func GetCmdQueryLockedStakings() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
 	cmd := &cobra.Command{
-		Use:   "total-stakings [staking-coin-denom]",
+		Use:   "locked-stakings [farmer]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Query total staking amount for a staking coin denom",
+		Short: "Query locked stakings by a farmer",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query total staking amount for a staking coin denom.
+			fmt.Sprintf(`Query all locked stakings by a farmer, with their multipliers and unlock times.
+Locked stakings made in the current epoch are returned as queued locked stakings.
+
+Optionally restrict locked stakings for a staking coin denom.
 
 Example:
-$ %s query %s total-stakings poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+$ %s query %s locked-stakings %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+$ %s query %s locked-stakings %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --staking-coin-denom poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
 `,
-				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -267,12 +289,15 @@ $ %s query %s total-stakings poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			stakingCoinDenom := args[0]
-			if err := sdk.ValidateDenom(stakingCoinDenom); err != nil {
+			farmerAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
 				return err
 			}
 
-			resp, err := queryClient.TotalStakings(cmd.Context(), &types.QueryTotalStakingsRequest{
+			stakingCoinDenom, _ := cmd.Flags().GetString(FlagStakingCoinDenom)
+
+			resp, err := queryClient.LockedStakings(cmd.Context(), &types.QueryLockedStakingsRequest{
+				Farmer:           farmerAcc.String(),
 				StakingCoinDenom: stakingCoinDenom,
 			})
 			if err != nil {
@@ -283,27 +308,28 @@ $ %s query %s total-stakings poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D
 		},
 	}
 
+	cmd.Flags().AddFlagSet(flagSetStakings())
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
 
-// GetCmdQueryRewards implements the query all rewards for a farmer command.
-func GetCmdQueryRewards() *cobra.Command {
+// GetCmdQueryUnbondings implements the query unbondings command.
+func GetCmdQueryUnbondings() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
 
 	cmd := &cobra.Command{
-		Use:   "rewards [farmer]",
+		Use:   "unbondings [farmer]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Query rewards for a farmer",
+		Short: "Query unbondings by a farmer",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query all rewards for a farmer.
+			fmt.Sprintf(`Query all unbondings by a farmer, with their completion times.
 
-Optionally restrict rewards for a staking coin denom.
+Optionally restrict unbondings for a staking coin denom.
 
 Example:
-$ %s query %s rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
-$ %s query %s rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --staking-coin-denom poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+$ %s query %s unbondings %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+$ %s query %s unbondings %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --staking-coin-denom poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
 `,
 				version.AppName, types.ModuleName, bech32PrefixAccAddr,
 				version.AppName, types.ModuleName, bech32PrefixAccAddr,
@@ -323,7 +349,7 @@ $ %s query %s rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --staking-coin-d
 
 			stakingCoinDenom, _ := cmd.Flags().GetString(FlagStakingCoinDenom)
 
-			resp, err := queryClient.Rewards(cmd.Context(), &types.QueryRewardsRequest{
+			resp, err := queryClient.Unbondings(cmd.Context(), &types.QueryUnbondingsRequest{
 				Farmer:           farmerAcc.String(),
 				StakingCoinDenom: stakingCoinDenom,
 			})
@@ -335,23 +361,742 @@ $ %s query %s rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --staking-coin-d
 		},
 	}
 
-	cmd.Flags().AddFlagSet(flagSetRewards())
+	cmd.Flags().AddFlagSet(flagSetStakings())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryStakingsDetail implements the query stakings detail command.
+func GetCmdQueryStakingsDetail() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "stakings-detail [farmer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query staking records by a farmer with their epoch information",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all staking and queued staking records by a farmer.
+Each staking record comes with its starting epoch and the current epoch of the staking coin denom,
+and the expected time queued stakings become staked is also shown.
+
+Optionally restrict records for a staking coin denom.
+
+Example:
+$ %s query %s stakings-detail %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+$ %s query %s stakings-detail %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --staking-coin-denom poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			farmerAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			stakingCoinDenom, _ := cmd.Flags().GetString(FlagStakingCoinDenom)
+
+			resp, err := queryClient.StakingsDetail(cmd.Context(), &types.QueryStakingsDetailRequest{
+				Farmer:           farmerAcc.String(),
+				StakingCoinDenom: stakingCoinDenom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetStakings())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryQueuedStakings implements the query queued stakings command.
+func GetCmdQueryQueuedStakings() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "queued-stakings [farmer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query queued stakings by a farmer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all queued stakings by a farmer, with the expected time they become staked.
+
+Optionally restrict queued stakings for a staking coin denom.
+
+Example:
+$ %s query %s queued-stakings %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+$ %s query %s queued-stakings %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --staking-coin-denom poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			farmerAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			stakingCoinDenom, _ := cmd.Flags().GetString(FlagStakingCoinDenom)
+
+			resp, err := queryClient.QueuedStakings(cmd.Context(), &types.QueryQueuedStakingsRequest{
+				Farmer:           farmerAcc.String(),
+				StakingCoinDenom: stakingCoinDenom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetStakings())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryStakingsByDenom implements the query stakings by denom command.
+func GetCmdQueryStakingsByDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stakings-by-denom [staking-coin-denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query stakings of all farmers for a staking coin denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query stakings of all farmers for a staking coin denom.
+Each record has the farmer, the staked amount and the starting epoch of the staking.
+Locked stakings and queued locked stakings for the denom are listed separately, and are not paginated.
+
+Example:
+$ %s query %s stakings-by-denom poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+$ %s query %s stakings-by-denom poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --limit 100
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			stakingCoinDenom := args[0]
+			if err := sdk.ValidateDenom(stakingCoinDenom); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			resp, err := queryClient.StakingsByDenom(cmd.Context(), &types.QueryStakingsByDenomRequest{
+				StakingCoinDenom: stakingCoinDenom,
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "stakings-by-denom")
+
+	return cmd
+}
+
+// GetCmdQueryQueuedStakingsByDenom implements the query queued stakings by denom command.
+func GetCmdQueryQueuedStakingsByDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queued-stakings-by-denom [staking-coin-denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query queued stakings of all farmers for a staking coin denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query queued stakings of all farmers for a staking coin denom.
+Each record has the farmer and the queued amount.
+
+Example:
+$ %s query %s queued-stakings-by-denom poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+$ %s query %s queued-stakings-by-denom poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --limit 100
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			stakingCoinDenom := args[0]
+			if err := sdk.ValidateDenom(stakingCoinDenom); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			resp, err := queryClient.QueuedStakingsByDenom(cmd.Context(), &types.QueryQueuedStakingsByDenomRequest{
+				StakingCoinDenom: stakingCoinDenom,
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "queued-stakings-by-denom")
+
+	return cmd
+}
+
+// GetCmdQueryTotalStakings implements the query total staking amounts for a staking coin denom command.
+func GetCmdQueryTotalStakings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-stakings [staking-coin-denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query total staking amount for a staking coin denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query total staking amount for a staking coin denom.
+
+Example:
+$ %s query %s total-stakings poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			stakingCoinDenom := args[0]
+			if err := sdk.ValidateDenom(stakingCoinDenom); err != nil {
+				return err
+			}
+
+			resp, err := queryClient.TotalStakings(cmd.Context(), &types.QueryTotalStakingsRequest{
+				StakingCoinDenom: stakingCoinDenom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRewards implements the query all rewards for a farmer command.
+func GetCmdQueryRewards() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "rewards [farmer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query rewards for a farmer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all rewards for a farmer.
+
+Optionally restrict rewards for a staking coin denom.
+
+Example:
+$ %s query %s rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+$ %s query %s rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --staking-coin-denom poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			farmerAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			stakingCoinDenom, _ := cmd.Flags().GetString(FlagStakingCoinDenom)
+
+			resp, err := queryClient.Rewards(cmd.Context(), &types.QueryRewardsRequest{
+				Farmer:           farmerAcc.String(),
+				StakingCoinDenom: stakingCoinDenom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetRewards())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRewardsWithdrawAddress implements the query rewards withdraw address command.
+func GetCmdQueryRewardsWithdrawAddress() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "rewards-withdraw-address [farmer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the rewards withdraw address of a farmer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the address that the rewards of a farmer are withdrawn to.
+
+If the farmer hasn't set a rewards withdraw address, the farmer's own address is returned.
+
+Example:
+$ %s query %s rewards-withdraw-address %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			farmerAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			resp, err := queryClient.RewardsWithdrawAddress(cmd.Context(), &types.QueryRewardsWithdrawAddressRequest{
+				Farmer: farmerAcc.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCurrentEpochDuration implements the query current epoch duration command.
+func GetCmdQueryCurrentEpochDuration() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "current-epoch-duration",
+		Args:  cobra.NoArgs,
+		Short: "Query the value of current epoch duration",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the value set as current epoch duration.
+
+Example:
+$ %s query %s current-epoch-duration
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.CurrentEpochDuration(context.Background(), &types.QueryCurrentEpochDurationRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryHistoricalRewards implements the query historical rewards command.
+func GetCmdQueryHistoricalRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "historical-rewards [staking-coin-denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query historical rewards for a staking coin denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query historical rewards for a staking coin denom.
+Each record has the cumulative unit rewards of the staking coin denom at the epoch.
+
+Optionally restrict records for an epoch range.
+
+Example:
+$ %s query %s historical-rewards poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+$ %s query %s historical-rewards poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --starting-epoch 10 --ending-epoch 20
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			stakingCoinDenom := args[0]
+			if err := sdk.ValidateDenom(stakingCoinDenom); err != nil {
+				return err
+			}
+
+			startingEpoch, _ := cmd.Flags().GetUint64(FlagStartingEpoch)
+			endingEpoch, _ := cmd.Flags().GetUint64(FlagEndingEpoch)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			resp, err := queryClient.HistoricalRewards(cmd.Context(), &types.QueryHistoricalRewardsRequest{
+				StakingCoinDenom: stakingCoinDenom,
+				StartingEpoch:    startingEpoch,
+				EndingEpoch:      endingEpoch,
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetHistoricalRewards())
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "historical-rewards")
+
+	return cmd
+}
+
+// GetCmdQueryCurrentEpoch implements the query current epoch command.
+func GetCmdQueryCurrentEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "current-epoch [staking-coin-denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the current epoch for a staking coin denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current epoch for a staking coin denom.
+
+Example:
+$ %s query %s current-epoch poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			stakingCoinDenom := args[0]
+			if err := sdk.ValidateDenom(stakingCoinDenom); err != nil {
+				return err
+			}
+
+			resp, err := queryClient.CurrentEpoch(cmd.Context(), &types.QueryCurrentEpochRequest{
+				StakingCoinDenom: stakingCoinDenom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryOutstandingRewards implements the query outstanding rewards command.
+func GetCmdQueryOutstandingRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outstanding-rewards [staking-coin-denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query outstanding rewards for a staking coin denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query outstanding rewards for a staking coin denom.
+Outstanding rewards are the rewards allocated for the staking coin denom that are not withdrawn yet.
+
+Example:
+$ %s query %s outstanding-rewards poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			stakingCoinDenom := args[0]
+			if err := sdk.ValidateDenom(stakingCoinDenom); err != nil {
+				return err
+			}
+
+			resp, err := queryClient.OutstandingRewards(cmd.Context(), &types.QueryOutstandingRewardsRequest{
+				StakingCoinDenom: stakingCoinDenom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAnnualRewards implements the query estimated annual rewards command.
+func GetCmdQueryAnnualRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "annual-rewards [optional flags]",
+		Args:  cobra.NoArgs,
+		Short: "Query estimated annual rewards per unit of staking coin",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query estimated annual rewards per unit of staking coin.
+The estimation assumes that the rewards allocated by the current active plans
+at the end of the current epoch are repeated for a year.
+
+Optionally restrict the estimation for a staking coin denom or for a plan.
+
+Example:
+$ %s query %s annual-rewards
+$ %s query %s annual-rewards --staking-coin-denom poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+$ %s query %s annual-rewards --plan-id 1
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			stakingCoinDenom, _ := cmd.Flags().GetString(FlagStakingCoinDenom)
+			planId, _ := cmd.Flags().GetUint64(FlagPlanId)
+
+			resp, err := queryClient.AnnualRewards(cmd.Context(), &types.QueryAnnualRewardsRequest{
+				StakingCoinDenom: stakingCoinDenom,
+				PlanId:           planId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetAnnualRewards())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAllocationPreview implements the query allocation preview command.
+func GetCmdQueryAllocationPreview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allocation-preview",
+		Args:  cobra.NoArgs,
+		Short: "Query the rewards allocation that will happen at the end of the current epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rewards allocation that will happen at the end of the current epoch.
+The result includes the amount each plan will allocate, the increase of unit rewards
+for each staking coin denom and the plans that will be skipped with the reason.
+
+Example:
+$ %s query %s allocation-preview
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.AllocationPreview(cmd.Context(), &types.QueryAllocationPreviewRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryEpochInfo implements the query epoch info command.
+func GetCmdQueryEpochInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-info",
+		Args:  cobra.NoArgs,
+		Short: "Query the global epoch number and the times of the last and next epochs",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the global epoch number, which is the number of epochs that have ended so far,
+along with the last epoch time, the current epoch duration, the next epoch duration
+that takes effect after the current epoch and the time the current epoch is expected to end.
+
+Example:
+$ %s query %s epoch-info
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.EpochInfo(context.Background(), &types.QueryEpochInfoRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPlanFunding implements the query plan funding command.
+func GetCmdQueryPlanFunding() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plan-funding [plan-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the budgets funding a plan and its projected inflow and outflow per epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the budgets of the budget module that fund the farming pool of a plan.
+The projected inflow per epoch is the average amount of coins the budgets have collected
+per epoch since their start times. The outflow per epoch is the amount of coins the plan
+allocates per epoch, and the total outflow includes all active plans that share the farming pool.
+
+Example:
+$ %s query %s plan-funding 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			planId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "plan-id %s is not valid", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.PlanFunding(cmd.Context(), &types.QueryPlanFundingRequest{
+				PlanId: planId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
 
-// GetCmdQueryCurrentEpochDays implements the query current epoch days command.
-func GetCmdQueryCurrentEpochDays() *cobra.Command {
+// GetCmdQueryAllowedStakingDenoms implements the query allowed staking denoms command.
+func GetCmdQueryAllowedStakingDenoms() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "current-epoch-days",
+		Use:   "allowed-staking-denoms",
 		Args:  cobra.NoArgs,
-		Short: "Query the value of current epoch days",
+		Short: "Query the denoms allowed to be staked",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query the value set as current epoch days.
+			fmt.Sprintf(`Query the denoms allowed to be staked, which are managed by governance.
+Any denom can be staked when no denom is allowed.
 
 Example:
-$ %s query %s current-epoch-days
+$ %s query %s allowed-staking-denoms
 `,
 				version.AppName, types.ModuleName,
 			),
@@ -364,7 +1109,7 @@ $ %s query %s current-epoch-days
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			resp, err := queryClient.CurrentEpochDays(context.Background(), &types.QueryCurrentEpochDaysRequest{})
+			resp, err := queryClient.AllowedStakingDenoms(context.Background(), &types.QueryAllowedStakingDenomsRequest{})
 			if err != nil {
 				return err
 			}
@@ -5,6 +5,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -35,9 +36,17 @@ func GetTxCmd() *cobra.Command {
 	farmingTxCmd.AddCommand(
 		NewCreateFixedAmountPlanCmd(),
 		NewCreateRatioPlanCmd(),
+		NewCreateDecayingPlanCmd(),
 		NewStakeCmd(),
 		NewUnstakeCmd(),
+		NewCancelUnbondingCmd(),
+		NewSetRewardsWithdrawAddressCmd(),
+		NewDepositAndStakeCmd(),
+		NewUnstakeAndWithdrawCmd(),
 		NewHarvestCmd(),
+		NewModifyPrivatePlanCmd(),
+		NewTerminatePrivatePlanCmd(),
+		NewFundPrivatePlanCmd(),
 	)
 	if keeper.EnableAdvanceEpoch {
 		farmingTxCmd.AddCommand(NewAdvanceEpochCmd())
@@ -187,6 +196,89 @@ Description for the parameters:
 	return cmd
 }
 
+// NewCreateDecayingPlanCmd implements the create a decaying plan command handler.
+func NewCreateDecayingPlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-private-decaying-plan [plan-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create private decaying farming plan",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create private decaying farming plan.
+The plan details must be provided through a JSON file. 
+The epoch amount decays by the decay factor every decay_epochs epochs, or at each of decay_times.
+Exactly one of decay_epochs or decay_times must be provided.
+		
+Example:
+$ %s tx %s create-private-decaying-plan <path/to/plan.json> --from mykey 
+
+Where plan.json contains:
+
+{
+  "name": "This plan intends to provide incentives for Cosmonauts!",
+  "staking_coin_weights": [
+    {
+      "denom": "poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4",
+      "amount": "1.000000000000000000"
+    }
+  ],
+  "start_time": "2021-08-06T09:00:00Z",
+  "end_time": "2022-08-13T09:00:00Z",
+  "epoch_amount": [
+    {
+      "denom": "uatom",
+      "amount": "1000"
+    }
+  ],
+  "decay_factor": "0.500000000000000000",
+  "decay_epochs": 30
+}
+
+Description for the parameters:
+
+[name]: specifies the name for the plan 
+[staking_coin_weights]: specifies coin weights for the plan
+[start_time]: specifies the time for the plan to start 
+[end_time]: specifies the time for the plan to end
+[epoch_amount]: specifies an initial amount to distribute for every epoch
+[decay_factor]: specifies a factor the epoch amount is multiplied by on each decay. 0.500000000000000000 means halving
+[decay_epochs]: specifies the number of epochs between decays
+[decay_times]: specifies the times at which the epoch amount decays
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			plan, err := ParsePrivateDecayingPlan(args[0])
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse %s file due to %v", args[0], err)
+			}
+
+			msg := types.NewMsgCreateDecayingPlan(
+				plan.Name,
+				clientCtx.GetFromAddress(),
+				plan.StakingCoinWeights,
+				plan.StartTime,
+				plan.EndTime,
+				plan.EpochAmount,
+				plan.DecayFactor,
+				plan.DecayEpochs,
+				plan.DecayTimes,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewStakeCmd implements the stake coin(s) command handler.
 func NewStakeCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -198,12 +290,25 @@ func NewStakeCmd() *cobra.Command {
 			
 To get farming rewards, you must stake coins that are defined in available plans on a network. 
 
+With the --lock-duration flag, the coins are locked for the duration of one of the lock tiers
+defined in the params. Locked coins are staked immediately and earn rewards boosted by the
+multiplier of the lock tier, but they can't be unstaked until they unlock.
+
+With the --farmer flag, the coins are paid by the signer and staked on behalf of the farmer.
+The staked coins and the rewards belong to the farmer. Coins can't be locked on behalf of
+another farmer, and the stake fails if it makes the farmer have more staking coin denoms
+than the max_third_party_staking_denoms param allows.
+
 Example:
 $ %s tx %s stake 1000poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --from mykey
 $ %s tx %s stake 500poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4,500pool93E069B333B5ECEBFE24C6E1437E814003248E0DD7FF8B9F82119F4587449BA5 --from mykey
+$ %s tx %s stake 1000poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --lock-duration 720h --from mykey
+$ %s tx %s stake 1000poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --farmer cosmos1... --from mykey
 `,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -220,11 +325,21 @@ $ %s tx %s stake 500poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2
 			}
 
 			msg := types.NewMsgStake(farmer, stakingCoins)
+			farmerStr, _ := cmd.Flags().GetString(FlagFarmer)
+			if farmerStr != "" {
+				farmerAcc, err := sdk.AccAddressFromBech32(farmerStr)
+				if err != nil {
+					return err
+				}
+				msg = types.NewMsgStakeFor(farmer, farmerAcc, stakingCoins)
+			}
+			msg.LockDuration, _ = cmd.Flags().GetDuration(FlagLockDuration)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().AddFlagSet(flagSetStake())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -239,7 +354,8 @@ func NewUnstakeCmd() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Unstake coins. 
 			
-Note that unstaking doesn't require any period and your accumulated rewards are automatically withdrawn to your wallet.
+Note that your accumulated rewards are automatically withdrawn to your wallet.
+If the unbonding period param is set, the unstaked coins are released after the period.
 
 Example:
 $ %s tx %s unstake 500poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --from mykey
@@ -273,6 +389,173 @@ $ %s tx %s unstake 500poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70
 	return cmd
 }
 
+// NewCancelUnbondingCmd implements the cancel unbonding command handler.
+func NewCancelUnbondingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-unbonding [unbonding-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel an unbonding and stake the coins again",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel an unbonding and stake the coins again.
+The unbonding coins are queued and staked at the end of the current epoch.
+
+Example:
+$ %s tx %s cancel-unbonding 1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			unbondingId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelUnbonding(clientCtx.GetFromAddress(), unbondingId)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewDepositAndStakeCmd implements the deposit and stake command handler.
+func NewDepositAndStakeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-and-stake [pool-id] [deposit-coins]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Deposit coins to a liquidity pool and stake the pool coins",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Deposit coins to a liquidity pool and stake the pool coins minted by the deposit.
+The pool coins are staked for you once the deposit batch is executed, and the deposit coins
+that are not accepted by the pool are refunded.
+
+Example:
+$ %s tx %s deposit-and-stake 1 100000000uatom,5000000000uusd --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			depositCoins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositAndStake(clientCtx.GetFromAddress(), poolId, depositCoins)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUnstakeAndWithdrawCmd implements the unstake and withdraw command handler.
+func NewUnstakeAndWithdrawCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unstake-and-withdraw [pool-id] [pool-coin]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Unstake pool coins and withdraw them from the liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unstake pool coins and withdraw them from the liquidity pool.
+The reserve coins of the pool are sent to you once the withdrawal batch is executed.
+It is not available while the unbonding period is set.
+
+Example:
+$ %s tx %s unstake-and-withdraw 1 1000poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			poolCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnstakeAndWithdraw(clientCtx.GetFromAddress(), poolId, poolCoin)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetRewardsWithdrawAddressCmd implements the set rewards withdraw address command handler.
+func NewSetRewardsWithdrawAddressCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-rewards-withdraw-address [withdraw-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Change the address that your farming rewards are withdrawn to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Change the address that your farming rewards are withdrawn to.
+All rewards are withdrawn to the address, including the rewards withdrawn while staking and unstaking.
+Set your own address to withdraw the rewards to your wallet again.
+
+Example:
+$ %s tx %s set-rewards-withdraw-address %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			withdrawAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetRewardsWithdrawAddress(clientCtx.GetFromAddress(), withdrawAddr)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewHarvestCmd implements the harvest rewards command handler.
 func NewHarvestCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -286,10 +569,15 @@ Example:
 $ %s tx %s harvest poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4 --from mykey
 $ %s tx %s harvest poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4,pool93E069B333B5ECEBFE24C6E1437E814003248E0DD7FF8B9F82119F4587449BA5 --from mykey
 $ %s tx %s harvest --all --from mykey
+$ %s tx %s harvest --all --stake-rewards --from mykey
+
+With --stake-rewards flag, the harvested rewards whose denoms are staking coin denoms of the plans
+are staked as queued coins instead of being sent to your wallet.
 `,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -335,6 +623,7 @@ $ %s tx %s harvest --all --from mykey
 			}
 
 			msg := types.NewMsgHarvest(farmer, denoms)
+			msg.StakeRewards, _ = cmd.Flags().GetBool(FlagStakeRewards)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -346,6 +635,171 @@ $ %s tx %s harvest --all --from mykey
 	return cmd
 }
 
+// NewModifyPrivatePlanCmd implements the modify a private plan command handler.
+func NewModifyPrivatePlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "modify-private-plan [plan-id] [plan-file]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Modify private farming plan",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Modify private farming plan.
+The transaction must be signed by the termination address of the plan.
+The fields to update must be provided through a JSON file and omitted fields are left unchanged.
+Providing either epoch_amount or epoch_ratio changes the plan to a fixed amount plan or a ratio plan respectively.
+Providing decay_factor along with epoch_amount and a decay schedule changes the plan to a decaying plan.
+
+Example:
+$ %s tx %s modify-private-plan 1 <path/to/plan.json> --from mykey
+
+Where plan.json contains:
+
+{
+  "name": "This plan intends to provide more incentives for Cosmonauts!",
+  "end_time": "2023-08-13T09:00:00Z",
+  "epoch_amount": [
+    {
+      "denom": "uatom",
+      "amount": "2"
+    }
+  ]
+}
+
+Description for the parameters:
+
+[name]: specifies the new name for the plan
+[staking_coin_weights]: specifies new coin weights for the plan
+[start_time]: specifies the new time for the plan to start
+[end_time]: specifies the new time for the plan to end
+[epoch_amount]: specifies a new amount to distribute for every epoch
+[epoch_ratio]: specifies a new ratio to distribute for every epoch
+[decay_factor]: specifies a new factor the epoch amount is multiplied by on each decay
+[decay_epochs]: specifies a new number of epochs between decays
+[decay_times]: specifies new times at which the epoch amount decays
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			planId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			plan, err := ParsePrivateModifyPlan(args[1])
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to parse %s file due to %v", args[1], err)
+			}
+
+			msg := types.NewMsgModifyPrivatePlan(
+				planId,
+				clientCtx.GetFromAddress(),
+				plan.Name,
+				plan.StakingCoinWeights,
+				plan.StartTime,
+				plan.EndTime,
+				plan.EpochAmount,
+				plan.EpochRatio,
+			)
+			msg.DecayFactor = plan.DecayFactor
+			msg.DecayEpochs = plan.DecayEpochs
+			msg.DecayTimes = plan.DecayTimes
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewTerminatePrivatePlanCmd implements the terminate a private plan command handler.
+func NewTerminatePrivatePlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "terminate-private-plan [plan-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Terminate private farming plan",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Terminate private farming plan.
+The transaction must be signed by the termination address of the plan.
+All remaining coins in the farming pool are sent to the termination address.
+
+Example:
+$ %s tx %s terminate-private-plan 1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			planId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTerminatePrivatePlan(planId, clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewFundPrivatePlanCmd implements the fund a private plan command handler.
+func NewFundPrivatePlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-private-plan [plan-id] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Fund the farming pool of private farming plan",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Send coins from the termination address to the farming pool of private farming plan.
+The transaction must be signed by the termination address of the plan.
+
+Example:
+$ %s tx %s fund-private-plan 1 1000000uatom --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			planId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundPrivatePlan(planId, clientCtx.GetFromAddress(), amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewAdvanceEpochCmd implements the advance epoch by 1 command handler.
 func NewAdvanceEpochCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -478,3 +932,75 @@ Where proposal.json contains:
 
 	return cmd
 }
+
+// GetCmdSubmitStakingDenomsProposal implements the add/remove allowed staking denoms command handler.
+func GetCmdSubmitStakingDenomsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staking-denoms [proposal-file] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to add or remove allowed staking denoms",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to add denoms to or remove denoms from the allowed staking denoms along with an initial deposit.
+Once any denom is allowed, only the allowed denoms can be staked and used in the staking coin weights of plans.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal staking-denoms <path/to/proposal.json> --from=<key_or_address> --deposit=<deposit_amount>
+
+Where proposal.json contains:
+
+{
+  "title": "Allowed Staking Denoms",
+  "description": "Allow only the pool coin of the ATOM/STAKE pool to be staked",
+  "add_denoms": [
+    "poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4"
+  ],
+  "remove_denoms": []
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseStakingDenomsProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewStakingDenomsProposal(
+				proposal.Title,
+				proposal.Description,
+				proposal.AddDenoms,
+				proposal.RemoveDenoms,
+			)
+
+			from := clientCtx.GetFromAddress()
+
+			msg, err := gov.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
@@ -29,6 +29,32 @@ type PrivateRatioPlanRequest struct {
 	EpochRatio         sdk.Dec      `json:"epoch_ratio"`
 }
 
+// PrivateDecayingPlanRequest defines CLI request for a private decaying plan.
+type PrivateDecayingPlanRequest struct {
+	Name               string       `json:"name"`
+	StakingCoinWeights sdk.DecCoins `json:"staking_coin_weights"`
+	StartTime          time.Time    `json:"start_time"`
+	EndTime            time.Time    `json:"end_time"`
+	EpochAmount        sdk.Coins    `json:"epoch_amount"`
+	DecayFactor        sdk.Dec      `json:"decay_factor"`
+	DecayEpochs        uint32       `json:"decay_epochs"`
+	DecayTimes         []time.Time  `json:"decay_times"`
+}
+
+// PrivateModifyPlanRequest defines CLI request for modifying a private plan.
+// Fields that are omitted are left unchanged.
+type PrivateModifyPlanRequest struct {
+	Name               string       `json:"name"`
+	StakingCoinWeights sdk.DecCoins `json:"staking_coin_weights"`
+	StartTime          *time.Time   `json:"start_time"`
+	EndTime            *time.Time   `json:"end_time"`
+	EpochAmount        sdk.Coins    `json:"epoch_amount"`
+	EpochRatio         sdk.Dec      `json:"epoch_ratio"`
+	DecayFactor        sdk.Dec      `json:"decay_factor"`
+	DecayEpochs        uint32       `json:"decay_epochs"`
+	DecayTimes         []time.Time  `json:"decay_times"`
+}
+
 // ParsePrivateFixedPlan reads and parses a PrivateFixedPlanRequest from a file.
 func ParsePrivateFixedPlan(file string) (PrivateFixedPlanRequest, error) {
 	plan := PrivateFixedPlanRequest{}
@@ -61,6 +87,38 @@ func ParsePrivateRatioPlan(file string) (PrivateRatioPlanRequest, error) {
 	return plan, nil
 }
 
+// ParsePrivateDecayingPlan reads and parses a PrivateDecayingPlanRequest from a file.
+func ParsePrivateDecayingPlan(file string) (PrivateDecayingPlanRequest, error) {
+	plan := PrivateDecayingPlanRequest{}
+
+	contents, err := ioutil.ReadFile(file)
+	if err != nil {
+		return plan, err
+	}
+
+	if err = json.Unmarshal(contents, &plan); err != nil {
+		return plan, err
+	}
+
+	return plan, nil
+}
+
+// ParsePrivateModifyPlan reads and parses a PrivateModifyPlanRequest from a file.
+func ParsePrivateModifyPlan(file string) (PrivateModifyPlanRequest, error) {
+	plan := PrivateModifyPlanRequest{}
+
+	contents, err := ioutil.ReadFile(file)
+	if err != nil {
+		return plan, err
+	}
+
+	if err = json.Unmarshal(contents, &plan); err != nil {
+		return plan, err
+	}
+
+	return plan, nil
+}
+
 // ParsePublicPlanProposal reads and parses a PublicPlanProposal from a file.
 func ParsePublicPlanProposal(cdc codec.JSONCodec, proposalFile string) (types.PublicPlanProposal, error) {
 	proposal := types.PublicPlanProposal{}
@@ -77,6 +135,22 @@ func ParsePublicPlanProposal(cdc codec.JSONCodec, proposalFile string) (types.Pu
 	return proposal, nil
 }
 
+// ParseStakingDenomsProposal reads and parses a StakingDenomsProposal from a file.
+func ParseStakingDenomsProposal(cdc codec.JSONCodec, proposalFile string) (types.StakingDenomsProposal, error) {
+	proposal := types.StakingDenomsProposal{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
 // String returns a human readable string representation of the request.
 func (req PrivateFixedPlanRequest) String() string {
 	result, err := json.Marshal(&req)
@@ -94,3 +168,21 @@ func (req PrivateRatioPlanRequest) String() string {
 	}
 	return string(result)
 }
+
+// String returns a human readable string representation of the request.
+func (req PrivateDecayingPlanRequest) String() string {
+	result, err := json.Marshal(&req)
+	if err != nil {
+		panic(err)
+	}
+	return string(result)
+}
+
+// String returns a human readable string representation of the request.
+func (req PrivateModifyPlanRequest) String() string {
+	result, err := json.Marshal(&req)
+	if err != nil {
+		panic(err)
+	}
+	return string(result)
+}
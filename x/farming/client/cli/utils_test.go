@@ -71,6 +71,65 @@ func TestParsePrivateRatioPlan(t *testing.T) {
 	require.Equal(t, "1.000000000000000000", plan.EpochRatio.String())
 }
 
+func TestParsePrivateDecayingPlan(t *testing.T) {
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "name": "This plan intends to provide incentives for Cosmonauts!",
+  "staking_coin_weights": [
+    {
+      "denom": "PoolCoinDenom",
+      "amount": "1.000000000000000000"
+    }
+  ],
+  "start_time": "2021-07-15T08:41:21Z",
+  "end_time": "2022-07-16T08:41:21Z",
+  "epoch_amount": [
+    {
+      "denom": "uatom",
+      "amount": "1000"
+    }
+  ],
+  "decay_factor": "0.500000000000000000",
+  "decay_times": ["2021-10-15T08:41:21Z", "2022-01-15T08:41:21Z"]
+}
+`)
+
+	plan, err := cli.ParsePrivateDecayingPlan(okJSON.Name())
+	require.NoError(t, err)
+	require.NotEmpty(t, plan.String())
+
+	require.Equal(t, "This plan intends to provide incentives for Cosmonauts!", plan.Name)
+	require.Equal(t, "1.000000000000000000PoolCoinDenom", plan.StakingCoinWeights.String())
+	require.Equal(t, "2021-07-15T08:41:21Z", plan.StartTime.Format(time.RFC3339))
+	require.Equal(t, "2022-07-16T08:41:21Z", plan.EndTime.Format(time.RFC3339))
+	require.Equal(t, "1000uatom", plan.EpochAmount.String())
+	require.Equal(t, "0.500000000000000000", plan.DecayFactor.String())
+	require.Equal(t, uint32(0), plan.DecayEpochs)
+	require.Len(t, plan.DecayTimes, 2)
+	require.Equal(t, "2022-01-15T08:41:21Z", plan.DecayTimes[1].Format(time.RFC3339))
+}
+
+func TestParsePrivateModifyPlan(t *testing.T) {
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "name": "This plan intends to provide more incentives for Cosmonauts!",
+  "end_time": "2023-07-16T08:41:21Z",
+  "epoch_ratio": "0.500000000000000000"
+}
+`)
+
+	plan, err := cli.ParsePrivateModifyPlan(okJSON.Name())
+	require.NoError(t, err)
+	require.NotEmpty(t, plan.String())
+
+	require.Equal(t, "This plan intends to provide more incentives for Cosmonauts!", plan.Name)
+	require.Nil(t, plan.StakingCoinWeights)
+	require.Nil(t, plan.StartTime)
+	require.Equal(t, "2023-07-16T08:41:21Z", plan.EndTime.Format(time.RFC3339))
+	require.True(t, plan.EpochAmount.Empty())
+	require.Equal(t, "0.500000000000000000", plan.EpochRatio.String())
+}
+
 func TestParsePublicPlanProposal(t *testing.T) {
 	encodingConfig := params.MakeTestEncodingConfig()
 
@@ -108,3 +167,28 @@ func TestParsePublicPlanProposal(t *testing.T) {
 	require.Equal(t, "Public Farming Plan", proposal.Title)
 	require.Equal(t, "Are you ready to farm?", proposal.Description)
 }
+
+func TestParseStakingDenomsProposal(t *testing.T) {
+	encodingConfig := params.MakeTestEncodingConfig()
+
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "title": "Allowed Staking Denoms",
+  "description": "Allow only the pool coin to be staked",
+  "add_denoms": [
+    "PoolCoinDenom"
+  ],
+  "remove_denoms": [
+    "uatom"
+  ]
+}
+`)
+
+	proposal, err := cli.ParseStakingDenomsProposal(encodingConfig.Marshaler, okJSON.Name())
+	require.NoError(t, err)
+
+	require.Equal(t, "Allowed Staking Denoms", proposal.Title)
+	require.Equal(t, "Allow only the pool coin to be staked", proposal.Description)
+	require.Equal(t, []string{"PoolCoinDenom"}, proposal.AddDenoms)
+	require.Equal(t, []string{"uatom"}, proposal.RemoveDenoms)
+}
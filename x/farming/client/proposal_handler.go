@@ -7,8 +7,10 @@ import (
 	"github.com/tendermint/farming/x/farming/client/rest"
 )
 
-// ProposalHandler is the public plan command handler.
+// ProposalHandler is the public plan command handler and
+// StakingDenomsProposalHandler is the allowed staking denoms command handler.
 // Note that rest.ProposalRESTHandler will be deprecated in the future.
 var (
-	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitPublicPlanProposal, rest.ProposalRESTHandler)
+	ProposalHandler              = govclient.NewProposalHandler(cli.GetCmdSubmitPublicPlanProposal, rest.ProposalRESTHandler)
+	StakingDenomsProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitStakingDenomsProposal, rest.StakingDenomsProposalRESTHandler)
 )
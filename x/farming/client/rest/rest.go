@@ -15,6 +15,14 @@ func ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
 	}
 }
 
+// StakingDenomsProposalRESTHandler returns a ProposalRESTHandler that exposes the allowed staking denoms proposal REST handler with a given sub-route.
+func StakingDenomsProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "farming_staking_denoms",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
 func postProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 	}
@@ -3,6 +3,7 @@ package testutil
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
@@ -622,6 +623,18 @@ func (s *IntegrationTestSuite) TestNewHarvestCmd() {
 			},
 			false, &sdk.TxResponse{}, 0,
 		},
+		{
+			"valid transaction case #4",
+			[]string{
+				fmt.Sprintf("--%s", cli.FlagAll),
+				fmt.Sprintf("--%s", cli.FlagStakeRewards),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
 		{
 			"invalid staking coin denoms case #1",
 			[]string{
@@ -657,6 +670,279 @@ func (s *IntegrationTestSuite) TestNewHarvestCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewModifyPrivatePlanCmd() {
+	val := s.network.Validators[0]
+
+	req := cli.PrivateFixedPlanRequest{
+		Name:               "test",
+		StakingCoinWeights: sdk.NewDecCoins(sdk.NewDecCoin("stake", sdk.NewInt(1))),
+		StartTime:          types.ParseTime("0001-01-01T00:00:00Z"),
+		EndTime:            types.ParseTime("9999-01-01T00:00:00Z"),
+		EpochAmount:        sdk.NewCoins(sdk.NewInt64Coin("node0token", 100_000_000)),
+	}
+
+	// create a fixed amount plan
+	_, err := MsgCreateFixedAmountPlanExec(
+		val.ClientCtx,
+		val.Address.String(),
+		testutil.WriteToNewTempFile(s.T(), req.String()).Name(),
+	)
+	s.Require().NoError(err)
+
+	modifyReq := cli.PrivateModifyPlanRequest{
+		Name:        "new-name",
+		EpochAmount: sdk.NewCoins(sdk.NewInt64Coin("node0token", 200_000_000)),
+	}
+	modifyFile := testutil.WriteToNewTempFile(s.T(), modifyReq.String()).Name()
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"valid transaction case #1",
+			[]string{
+				"1",
+				modifyFile,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"plan not found case #1",
+			[]string{
+				"10",
+				modifyFile,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			false, &sdk.TxResponse{}, 38,
+		},
+		{
+			"invalid plan id case #1",
+			[]string{
+				"0",
+				modifyFile,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"invalid file case #1",
+			[]string{
+				"1",
+				"invalid-file.json",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewModifyPrivatePlanCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err, out.String())
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTerminatePrivatePlanCmd() {
+	val := s.network.Validators[0]
+
+	req := cli.PrivateFixedPlanRequest{
+		Name:               "test",
+		StakingCoinWeights: sdk.NewDecCoins(sdk.NewDecCoin("stake", sdk.NewInt(1))),
+		StartTime:          types.ParseTime("0001-01-01T00:00:00Z"),
+		EndTime:            types.ParseTime("9999-01-01T00:00:00Z"),
+		EpochAmount:        sdk.NewCoins(sdk.NewInt64Coin("node0token", 100_000_000)),
+	}
+
+	// create a fixed amount plan
+	_, err := MsgCreateFixedAmountPlanExec(
+		val.ClientCtx,
+		val.Address.String(),
+		testutil.WriteToNewTempFile(s.T(), req.String()).Name(),
+	)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"valid transaction case #1",
+			[]string{
+				"1",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"already terminated case #1",
+			[]string{
+				"1",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			false, &sdk.TxResponse{}, 12,
+		},
+		{
+			"invalid plan id case #1",
+			[]string{
+				"0",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewTerminatePrivatePlanCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err, out.String())
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewFundPrivatePlanCmd() {
+	val := s.network.Validators[0]
+
+	req := cli.PrivateFixedPlanRequest{
+		Name:               "test",
+		StakingCoinWeights: sdk.NewDecCoins(sdk.NewDecCoin("stake", sdk.NewInt(1))),
+		StartTime:          types.ParseTime("0001-01-01T00:00:00Z"),
+		EndTime:            types.ParseTime("9999-01-01T00:00:00Z"),
+		EpochAmount:        sdk.NewCoins(sdk.NewInt64Coin("node0token", 100_000_000)),
+	}
+
+	// create a fixed amount plan
+	_, err := MsgCreateFixedAmountPlanExec(
+		val.ClientCtx,
+		val.Address.String(),
+		testutil.WriteToNewTempFile(s.T(), req.String()).Name(),
+	)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"valid transaction case #1",
+			[]string{
+				"1",
+				sdk.NewInt64Coin("node0token", 1_000_000_000).String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"insufficient funds case #1",
+			[]string{
+				"1",
+				sdk.NewInt64Coin("node0token", 1_000_000_000_000).String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			false, &sdk.TxResponse{}, 5,
+		},
+		{
+			"invalid amount case #1",
+			[]string{
+				"1",
+				sdk.NewInt64Coin("node0token", 0).String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewFundPrivatePlanCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err, out.String())
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
 type QueryCmdTestSuite struct {
 	suite.Suite
 
@@ -949,7 +1235,7 @@ func (s *QueryCmdTestSuite) TestCmdQueryStakings() {
 	}
 }
 
-func (s *QueryCmdTestSuite) TestCmdQueryTotalStakings() {
+func (s *QueryCmdTestSuite) TestCmdQueryStakingsDetail() {
 	val := s.network.Validators[0]
 	clientCtx := val.ClientCtx
 
@@ -957,23 +1243,310 @@ func (s *QueryCmdTestSuite) TestCmdQueryTotalStakings() {
 		name      string
 		args      []string
 		expectErr bool
-		postRun   func(*types.QueryTotalStakingsResponse)
+		postRun   func(*types.QueryStakingsDetailResponse)
 	}{
 		{
 			"happy case",
 			[]string{
-				sdk.DefaultBondDenom,
+				val.Address.String(),
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			false,
-			func(resp *farmingtypes.QueryTotalStakingsResponse) {
-				s.Require().True(intEq(sdk.NewInt(1000000), resp.Amount))
+			func(resp *farmingtypes.QueryStakingsDetailResponse) {
+				s.Require().Len(resp.Stakings, 1)
+				s.Require().Equal(sdk.DefaultBondDenom, resp.Stakings[0].StakingCoinDenom)
+				s.Require().True(intEq(sdk.NewInt(1000000), resp.Stakings[0].Amount))
+				s.Require().NotNil(resp.NextEpochTime)
 			},
 		},
 		{
-			"invalid staking coin denom",
+			"invalid farmer addr",
 			[]string{
-				"!",
+				"invalid",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryStakingsDetail()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryStakingsDetailResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryQueuedStakings() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryQueuedStakingsResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryQueuedStakingsResponse) {
+				s.Require().Empty(resp.QueuedStakings)
+			},
+		},
+		{
+			"invalid farmer addr",
+			[]string{
+				"invalid",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryQueuedStakings()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryQueuedStakingsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryStakingsByDenom() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryStakingsByDenomResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				sdk.DefaultBondDenom,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryStakingsByDenomResponse) {
+				s.Require().Len(resp.Stakings, 1)
+				s.Require().Equal(val.Address.String(), resp.Stakings[0].Farmer)
+				s.Require().True(intEq(sdk.NewInt(1000000), resp.Stakings[0].Amount))
+				s.Require().Empty(resp.LockedStakings)
+				s.Require().Empty(resp.QueuedLockedStakings)
+			},
+		},
+		{
+			"no stakings",
+			[]string{
+				"node0token",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryStakingsByDenomResponse) {
+				s.Require().Empty(resp.Stakings)
+			},
+		},
+		{
+			"invalid staking coin denom",
+			[]string{
+				"!",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryStakingsByDenom()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryStakingsByDenomResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryQueuedStakingsByDenom() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryQueuedStakingsByDenomResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				sdk.DefaultBondDenom,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryQueuedStakingsByDenomResponse) {
+				s.Require().Empty(resp.QueuedStakings)
+			},
+		},
+		{
+			"invalid staking coin denom",
+			[]string{
+				"!",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryQueuedStakingsByDenom()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryQueuedStakingsByDenomResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryLockedStakings() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryLockedStakingsResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryLockedStakingsResponse) {
+				s.Require().Empty(resp.LockedStakings)
+				s.Require().Empty(resp.QueuedLockedStakings)
+			},
+		},
+		{
+			"with staking coin denom",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagStakingCoinDenom, sdk.DefaultBondDenom),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryLockedStakingsResponse) {
+				s.Require().Empty(resp.LockedStakings)
+				s.Require().Empty(resp.QueuedLockedStakings)
+			},
+		},
+		{
+			"invalid farmer addr",
+			[]string{
+				"invalid",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+		{
+			"invalid staking coin denom",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=!", cli.FlagStakingCoinDenom),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryLockedStakings()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryLockedStakingsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryTotalStakings() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryTotalStakingsResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				sdk.DefaultBondDenom,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryTotalStakingsResponse) {
+				s.Require().True(intEq(sdk.NewInt(1000000), resp.Amount))
+			},
+		},
+		{
+			"invalid staking coin denom",
+			[]string{
+				"!",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			true,
@@ -990,7 +1563,139 @@ func (s *QueryCmdTestSuite) TestCmdQueryTotalStakings() {
 				s.Require().Error(err)
 			} else {
 				s.Require().NoError(err)
-				var resp types.QueryTotalStakingsResponse
+				var resp types.QueryTotalStakingsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryRewards() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryRewardsResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryRewardsResponse) {
+				s.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin("node0token", 100_000_000)), resp.Rewards))
+			},
+		},
+		{
+			"invalid farmer addr",
+			[]string{
+				"invalid",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryRewards()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryRewardsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryCurrentEpochDuration() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryCurrentEpochDurationResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryCurrentEpochDurationResponse) {
+				s.Require().Equal(24*time.Hour, resp.CurrentEpochDuration)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryCurrentEpochDuration()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryCurrentEpochDurationResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryEpochInfo() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryEpochInfoResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryEpochInfoResponse) {
+				s.Require().Equal(uint64(2), resp.GlobalEpoch)
+				s.Require().NotNil(resp.LastEpochTime)
+				s.Require().Equal(24*time.Hour, resp.CurrentEpochDuration)
+				s.Require().Equal(24*time.Hour, resp.NextEpochDuration)
+				s.Require().NotNil(resp.NextEpochTime)
+				s.Require().True(resp.NextEpochTime.After(*resp.LastEpochTime))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryEpochInfo()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryEpochInfoResponse
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
 				tc.postRun(&resp)
 			}
@@ -998,7 +1703,7 @@ func (s *QueryCmdTestSuite) TestCmdQueryTotalStakings() {
 	}
 }
 
-func (s *QueryCmdTestSuite) TestCmdQueryRewards() {
+func (s *QueryCmdTestSuite) TestCmdQueryPlanFunding() {
 	val := s.network.Validators[0]
 	clientCtx := val.ClientCtx
 
@@ -1006,23 +1711,34 @@ func (s *QueryCmdTestSuite) TestCmdQueryRewards() {
 		name      string
 		args      []string
 		expectErr bool
-		postRun   func(*types.QueryRewardsResponse)
+		postRun   func(*types.QueryPlanFundingResponse)
 	}{
 		{
 			"happy case",
 			[]string{
-				val.Address.String(),
+				strconv.Itoa(1),
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			false,
-			func(resp *farmingtypes.QueryRewardsResponse) {
-				s.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin("node0token", 100_000_000)), resp.Rewards))
+			func(resp *farmingtypes.QueryPlanFundingResponse) {
+				s.Require().Equal(uint64(1), resp.PlanId)
+				s.Require().NotEmpty(resp.FarmingPoolAddress)
+				s.Require().False(resp.EpochOutflow.IsZero())
 			},
 		},
 		{
-			"invalid farmer addr",
+			"id not found",
 			[]string{
-				"invalid",
+				strconv.Itoa(10),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+		{
+			"invalid plan id",
+			[]string{
+				"a",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			true,
@@ -1032,14 +1748,233 @@ func (s *QueryCmdTestSuite) TestCmdQueryRewards() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdQueryRewards()
+			cmd := cli.GetCmdQueryPlanFunding()
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
 			if tc.expectErr {
 				s.Require().Error(err)
 			} else {
 				s.Require().NoError(err)
-				var resp types.QueryRewardsResponse
+				var resp types.QueryPlanFundingResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryHistoricalRewards() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryHistoricalRewardsResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				sdk.DefaultBondDenom,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryHistoricalRewardsResponse) {
+				s.Require().Len(resp.HistoricalRewards, 2)
+				s.Require().Equal(uint64(1), resp.HistoricalRewards[1].Epoch)
+			},
+		},
+		{
+			"invalid staking coin denom",
+			[]string{
+				"!",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryHistoricalRewards()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryHistoricalRewardsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryCurrentEpoch() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryCurrentEpochResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				sdk.DefaultBondDenom,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryCurrentEpochResponse) {
+				s.Require().Equal(uint64(2), resp.CurrentEpoch)
+			},
+		},
+		{
+			"staking coin denom without stakings",
+			[]string{
+				"denom2",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+		{
+			"invalid staking coin denom",
+			[]string{
+				"!",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryCurrentEpoch()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryCurrentEpochResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryOutstandingRewards() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryOutstandingRewardsResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				sdk.DefaultBondDenom,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryOutstandingRewardsResponse) {
+				s.Require().True(resp.Rewards.IsEqual(sdk.NewDecCoins(sdk.NewInt64DecCoin("node0token", 100_000_000))))
+			},
+		},
+		{
+			"invalid staking coin denom",
+			[]string{
+				"!",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryOutstandingRewards()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryOutstandingRewardsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
+func (s *QueryCmdTestSuite) TestCmdQueryAnnualRewards() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryAnnualRewardsResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryAnnualRewardsResponse) {
+				s.Require().Len(resp.AnnualRewards, 1)
+				s.Require().Equal(sdk.DefaultBondDenom, resp.AnnualRewards[0].StakingCoinDenom)
+				s.Require().True(resp.AnnualRewards[0].AnnualUnitRewards.IsEqual(sdk.NewDecCoins(sdk.NewInt64DecCoin("node0token", 36500))))
+			},
+		},
+		{
+			"with plan id",
+			[]string{
+				fmt.Sprintf("--%s=%d", cli.FlagPlanId, 1),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryAnnualRewardsResponse) {
+				s.Require().Len(resp.AnnualRewards, 1)
+				s.Require().Len(resp.AnnualRewards[0].Plans, 1)
+			},
+		},
+		{
+			"plan not found",
+			[]string{
+				fmt.Sprintf("--%s=%d", cli.FlagPlanId, 10),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryAnnualRewards()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryAnnualRewardsResponse
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
 				tc.postRun(&resp)
 			}
@@ -1047,7 +1982,7 @@ func (s *QueryCmdTestSuite) TestCmdQueryRewards() {
 	}
 }
 
-func (s *QueryCmdTestSuite) TestCmdQueryCurrentEpochDays() {
+func (s *QueryCmdTestSuite) TestCmdQueryAllocationPreview() {
 	val := s.network.Validators[0]
 	clientCtx := val.ClientCtx
 
@@ -1055,7 +1990,7 @@ func (s *QueryCmdTestSuite) TestCmdQueryCurrentEpochDays() {
 		name      string
 		args      []string
 		expectErr bool
-		postRun   func(*types.QueryCurrentEpochDaysResponse)
+		postRun   func(*types.QueryAllocationPreviewResponse)
 	}{
 		{
 			"happy case",
@@ -1063,22 +1998,25 @@ func (s *QueryCmdTestSuite) TestCmdQueryCurrentEpochDays() {
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			false,
-			func(resp *farmingtypes.QueryCurrentEpochDaysResponse) {
-				s.Require().Equal(uint32(1), resp.CurrentEpochDays)
+			func(resp *farmingtypes.QueryAllocationPreviewResponse) {
+				s.Require().Len(resp.Allocations, 1)
+				s.Require().Equal(uint64(1), resp.Allocations[0].PlanId)
+				s.Require().Len(resp.UnitRewards, 1)
+				s.Require().Empty(resp.SkippedPlans)
 			},
 		},
 	}
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdQueryCurrentEpochDays()
+			cmd := cli.GetCmdQueryAllocationPreview()
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
 			if tc.expectErr {
 				s.Require().Error(err)
 			} else {
 				s.Require().NoError(err)
-				var resp types.QueryCurrentEpochDaysResponse
+				var resp types.QueryAllocationPreviewResponse
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
 				tc.postRun(&resp)
 			}
@@ -1118,6 +2056,54 @@ func intEq(exp, got sdk.Int) (bool, string, string, string) {
 	return exp.Equal(got), "expected:\t%v\ngot:\t\t%v", exp.String(), got.String()
 }
 
+func (s *QueryCmdTestSuite) TestCmdQueryAllowedStakingDenoms() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		postRun   func(*types.QueryAllowedStakingDenomsResponse)
+	}{
+		{
+			"happy case",
+			[]string{
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			func(resp *farmingtypes.QueryAllowedStakingDenomsResponse) {
+				s.Require().Empty(resp.Denoms)
+			},
+		},
+		{
+			"invalid args",
+			[]string{
+				"extra",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryAllowedStakingDenoms()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryAllowedStakingDenomsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+				tc.postRun(&resp)
+			}
+		})
+	}
+}
+
 func coinsEq(exp, got sdk.Coins) (bool, string, string, string) {
 	return exp.IsEqual(got), "expected:\t%v\ngot:\t\t%v", exp.String(), got.String()
 }
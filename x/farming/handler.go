@@ -24,6 +24,10 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.CreateRatioPlan(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgCreateDecayingPlan:
+			res, err := msgServer.CreateDecayingPlan(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgStake:
 			res, err := msgServer.Stake(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -36,6 +40,34 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.Harvest(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgModifyPrivatePlan:
+			res, err := msgServer.ModifyPrivatePlan(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgTerminatePrivatePlan:
+			res, err := msgServer.TerminatePrivatePlan(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgFundPrivatePlan:
+			res, err := msgServer.FundPrivatePlan(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCancelUnbonding:
+			res, err := msgServer.CancelUnbonding(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetRewardsWithdrawAddress:
+			res, err := msgServer.SetRewardsWithdrawAddress(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgDepositAndStake:
+			res, err := msgServer.DepositAndStake(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgUnstakeAndWithdraw:
+			res, err := msgServer.UnstakeAndWithdraw(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -43,13 +75,17 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 }
 
 // NewPublicPlanProposalHandler creates a governance handler to manage new proposal types.
-// It enables PublicPlanProposal to propose a plan creation / modification / deletion.
+// It enables PublicPlanProposal to propose a plan creation / modification / deletion,
+// and StakingDenomsProposal to propose changes to the allowed staking denoms.
 func NewPublicPlanProposalHandler(k keeper.Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch c := content.(type) {
 		case *types.PublicPlanProposal:
 			return keeper.HandlePublicPlanProposal(ctx, k, c)
 
+		case *types.StakingDenomsProposal:
+			return keeper.HandleStakingDenomsProposal(ctx, k, c)
+
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized farming proposal content type: %T", c)
 		}
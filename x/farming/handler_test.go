@@ -1,7 +1,12 @@
 package farming_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	liquiditytypes "github.com/gravity-devs/liquidity/x/liquidity/types"
 
 	"github.com/tendermint/farming/x/farming"
 	"github.com/tendermint/farming/x/farming/types"
@@ -23,9 +28,14 @@ func (suite *ModuleTestSuite) TestMsgCreateFixedAmountPlan() {
 	)
 
 	handler := farming.NewHandler(suite.keeper)
-	_, err := handler(suite.ctx, msg)
+	res, err := handler(suite.ctx, msg)
 	suite.Require().NoError(err)
 
+	var resp types.MsgCreateFixedAmountPlanResponse
+	suite.Require().NoError(resp.Unmarshal(res.Data))
+	suite.Require().Equal(uint64(1), resp.PlanId)
+	suite.Require().Equal(types.PrivatePlanFarmingPoolAcc(msg.Name, 1).String(), resp.FarmingPoolAddress)
+
 	plan, found := suite.keeper.GetPlan(suite.ctx, 1)
 	suite.Require().Equal(true, found)
 
@@ -52,9 +62,14 @@ func (suite *ModuleTestSuite) TestMsgCreateRatioPlan() {
 	)
 
 	handler := farming.NewHandler(suite.keeper)
-	_, err := handler(suite.ctx, msg)
+	res, err := handler(suite.ctx, msg)
 	suite.Require().NoError(err)
 
+	var resp types.MsgCreateRatioPlanResponse
+	suite.Require().NoError(resp.Unmarshal(res.Data))
+	suite.Require().Equal(uint64(1), resp.PlanId)
+	suite.Require().Equal(types.PrivatePlanFarmingPoolAcc(msg.Name, 1).String(), resp.FarmingPoolAddress)
+
 	plan, found := suite.keeper.GetPlan(suite.ctx, 1)
 	suite.Require().Equal(true, found)
 
@@ -67,6 +82,45 @@ func (suite *ModuleTestSuite) TestMsgCreateRatioPlan() {
 	suite.Require().Equal(msg.EpochRatio, plan.(*types.RatioPlan).EpochRatio)
 }
 
+func (suite *ModuleTestSuite) TestMsgCreateDecayingPlan() {
+	msg := types.NewMsgCreateDecayingPlan(
+		"handlerTestPlan3",
+		suite.addrs[0],
+		sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec(denom1, sdk.NewDecWithPrec(3, 1)), // 30%
+			sdk.NewDecCoinFromDec(denom2, sdk.NewDecWithPrec(7, 1)), // 70%
+		),
+		types.ParseTime("2021-08-02T00:00:00Z"),
+		types.ParseTime("2021-08-10T00:00:00Z"),
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 10_000_000)),
+		sdk.NewDecWithPrec(5, 1), // halving
+		3,
+		nil,
+	)
+
+	handler := farming.NewHandler(suite.keeper)
+	res, err := handler(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	var resp types.MsgCreateDecayingPlanResponse
+	suite.Require().NoError(resp.Unmarshal(res.Data))
+	suite.Require().Equal(uint64(1), resp.PlanId)
+	suite.Require().Equal(types.PrivatePlanFarmingPoolAcc(msg.Name, 1).String(), resp.FarmingPoolAddress)
+
+	plan, found := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().Equal(true, found)
+
+	suite.Require().Equal(msg.Name, plan.GetName())
+	suite.Require().Equal(msg.Creator, plan.GetTerminationAddress().String())
+	suite.Require().Equal(msg.StakingCoinWeights, plan.GetStakingCoinWeights())
+	suite.Require().Equal(types.PrivatePlanFarmingPoolAcc(msg.Name, 1), plan.GetFarmingPoolAddress())
+	suite.Require().Equal(types.ParseTime("2021-08-02T00:00:00Z"), plan.GetStartTime())
+	suite.Require().Equal(types.ParseTime("2021-08-10T00:00:00Z"), plan.GetEndTime())
+	suite.Require().Equal(msg.EpochAmount, plan.(*types.DecayingPlan).EpochAmount)
+	suite.Require().Equal(msg.DecayFactor, plan.(*types.DecayingPlan).DecayFactor)
+	suite.Require().Equal(msg.DecayEpochs, plan.(*types.DecayingPlan).DecayEpochs)
+}
+
 func (suite *ModuleTestSuite) TestMsgStake() {
 	msg := types.NewMsgStake(
 		suite.addrs[0],
@@ -90,6 +144,59 @@ func (suite *ModuleTestSuite) TestMsgStake() {
 	suite.Require().Equal(msg.StakingCoins, queuedCoins)
 }
 
+func (suite *ModuleTestSuite) TestMsgStakeFor() {
+	msg := types.NewMsgStakeFor(
+		suite.addrs[0],
+		suite.addrs[1],
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 10_000_000)),
+	)
+
+	payerBalancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	farmerBalancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[1])
+
+	handler := farming.NewHandler(suite.keeper)
+	_, err := handler(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	_, found := suite.keeper.GetQueuedStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().False(found)
+	queuedStaking, found := suite.keeper.GetQueuedStaking(suite.ctx, denom1, suite.addrs[1])
+	suite.Require().True(found)
+	suite.Require().True(queuedStaking.Amount.Equal(sdk.NewInt(10_000_000)))
+
+	payerBalancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(msg.StakingCoins, payerBalancesBefore.Sub(payerBalancesAfter)))
+	suite.Require().True(coinsEq(farmerBalancesBefore, suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[1])))
+}
+
+func (suite *ModuleTestSuite) TestMsgStakeLockedFor() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.LockTiers = []types.LockTier{{Duration: 30 * 24 * time.Hour, Multiplier: sdk.NewDec(2)}}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	handler := farming.NewHandler(suite.keeper)
+
+	// An unrelated payer can't open locked stakings under the farmer's name.
+	for i := 0; i < 3; i++ {
+		msg := types.NewMsgStakeFor(suite.addrs[0], suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1)))
+		msg.LockDuration = 30 * 24 * time.Hour
+		suite.Require().Error(msg.ValidateBasic())
+		_, err := handler(suite.ctx, msg)
+		suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	}
+	suite.Require().Empty(suite.keeper.GetQueuedLockedStakingsByFarmer(suite.ctx, suite.addrs[1]))
+	suite.keeper.ProcessQueuedCoins(suite.ctx)
+	suite.Require().Empty(suite.keeper.GetLockedStakingsByFarmerAndDenom(suite.ctx, suite.addrs[1], denom1))
+
+	// The farmer can lock its own staking coins.
+	msg := types.NewMsgStakeFor(suite.addrs[1], suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1)))
+	msg.LockDuration = 30 * 24 * time.Hour
+	suite.Require().NoError(msg.ValidateBasic())
+	_, err := handler(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Len(suite.keeper.GetQueuedLockedStakingsByFarmer(suite.ctx, suite.addrs[1]), 1)
+}
+
 func (suite *ModuleTestSuite) TestMsgUnstake() {
 	stakeCoin := sdk.NewInt64Coin(denom1, 10_000_000)
 	suite.Stake(suite.addrs[0], sdk.NewCoins(stakeCoin))
@@ -129,11 +236,152 @@ func (suite *ModuleTestSuite) TestMsgHarvest() {
 	msg := types.NewMsgHarvest(suite.addrs[0], []string{denom2})
 
 	handler := farming.NewHandler(suite.keeper)
-	_, err = handler(suite.ctx, msg)
+	res, err := handler(suite.ctx, msg)
 	suite.Require().NoError(err)
 
+	var resp types.MsgHarvestResponse
+	suite.Require().NoError(resp.Unmarshal(res.Data))
+	suite.Require().True(coinsEq(rewards, resp.HarvestedRewards))
+
 	balancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
 	suite.Require().True(coinsEq(balancesBefore.Add(rewards...), balancesAfter))
 	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, types.RewardsReserveAcc).IsZero())
 	suite.Require().True(suite.Rewards(suite.addrs[0]).IsZero())
 }
+
+func (suite *ModuleTestSuite) TestMsgUnstakeWithdrawnRewards() {
+	for _, plan := range suite.samplePlans {
+		suite.keeper.SetPlan(suite.ctx, plan)
+	}
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom2, 10_000_000)))
+	suite.keeper.ProcessQueuedCoins(suite.ctx)
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-05T00:00:00Z"))
+	err := suite.keeper.AllocateRewards(suite.ctx)
+	suite.Require().NoError(err)
+
+	rewards := suite.Rewards(suite.addrs[0])
+	suite.Require().False(rewards.IsZero())
+
+	msg := types.NewMsgUnstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom2, 5_000_000)))
+
+	handler := farming.NewHandler(suite.keeper)
+	res, err := handler(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	var resp types.MsgUnstakeResponse
+	suite.Require().NoError(resp.Unmarshal(res.Data))
+	suite.Require().True(coinsEq(rewards, resp.WithdrawnRewards))
+	suite.Require().True(suite.Rewards(suite.addrs[0]).IsZero())
+}
+
+func (suite *ModuleTestSuite) TestMsgHarvestStakeRewards() {
+	for _, plan := range suite.samplePlans {
+		suite.keeper.SetPlan(suite.ctx, plan)
+	}
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom2, 10_000_000)))
+	suite.keeper.ProcessQueuedCoins(suite.ctx)
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-05T00:00:00Z"))
+	err := suite.keeper.AllocateRewards(suite.ctx)
+	suite.Require().NoError(err)
+
+	rewards := suite.Rewards(suite.addrs[0])
+
+	msg := types.NewMsgHarvest(suite.addrs[0], []string{denom2})
+	msg.StakeRewards = true
+
+	handler := farming.NewHandler(suite.keeper)
+	res, err := handler(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	var resp types.MsgHarvestResponse
+	suite.Require().NoError(resp.Unmarshal(res.Data))
+	suite.Require().True(coinsEq(rewards, resp.HarvestedRewards))
+	suite.Require().False(resp.StakedRewards.IsZero())
+
+	// the rewards in staking coin denoms of the plans are queued
+	queuedCoins := sdk.NewCoins()
+	suite.keeper.IterateQueuedStakingsByFarmer(suite.ctx, suite.addrs[0],
+		func(stakingCoinDenom string, queuedStaking types.QueuedStaking) (stop bool) {
+			queuedCoins = queuedCoins.Add(sdk.NewCoin(stakingCoinDenom, queuedStaking.Amount))
+			return false
+		},
+	)
+	suite.Require().True(coinsEq(resp.StakedRewards, queuedCoins))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, types.RewardsReserveAcc).IsZero())
+}
+
+func (suite *ModuleTestSuite) TestMsgCancelUnbonding() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.UnbondingPeriod = 7 * 24 * time.Hour
+	suite.keeper.SetParams(suite.ctx, params)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 10_000_000)))
+	suite.keeper.ProcessQueuedCoins(suite.ctx)
+
+	handler := farming.NewHandler(suite.keeper)
+	_, err := handler(suite.ctx, types.NewMsgUnstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 5_000_000))))
+	suite.Require().NoError(err)
+
+	_, err = handler(suite.ctx, types.NewMsgCancelUnbonding(suite.addrs[1], 1))
+	suite.Require().ErrorIs(err, types.ErrUnbondingNotExists)
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	_, err = handler(suite.ctx, types.NewMsgCancelUnbonding(suite.addrs[0], 1))
+	suite.Require().NoError(err)
+	balancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(balancesBefore, balancesAfter))
+
+	queuedStaking, found := suite.keeper.GetQueuedStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().True(queuedStaking.Amount.Equal(sdk.NewInt(5_000_000)))
+}
+
+func (suite *ModuleTestSuite) TestMsgSetRewardsWithdrawAddress() {
+	handler := farming.NewHandler(suite.keeper)
+	_, err := handler(suite.ctx, types.NewMsgSetRewardsWithdrawAddress(suite.addrs[0], suite.addrs[1]))
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.addrs[1], suite.keeper.GetRewardsWithdrawAddress(suite.ctx, suite.addrs[0]))
+
+	_, err = handler(suite.ctx, types.NewMsgSetRewardsWithdrawAddress(suite.addrs[0], suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+func (suite *ModuleTestSuite) TestMsgDepositAndStake() {
+	pool, err := suite.app.LiquidityKeeper.CreatePool(suite.ctx, liquiditytypes.NewMsgCreatePool(
+		suite.addrs[5], liquiditytypes.DefaultPoolTypeID, sdk.NewCoins(sdk.NewInt64Coin(denom1, 100_000_000), sdk.NewInt64Coin(denom2, 100_000_000))))
+	suite.Require().NoError(err)
+
+	msg := types.NewMsgDepositAndStake(suite.addrs[0], pool.Id, sdk.NewCoins(sdk.NewInt64Coin(denom1, 10_000_000), sdk.NewInt64Coin(denom2, 10_000_000)))
+
+	handler := farming.NewHandler(suite.keeper)
+	_, err = handler(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	req, found := suite.keeper.GetDepositRequest(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(msg.Farmer, req.Farmer)
+	suite.Require().True(coinsEq(msg.DepositCoins, req.DepositCoins))
+}
+
+func (suite *ModuleTestSuite) TestMsgUnstakeAndWithdraw() {
+	pool, err := suite.app.LiquidityKeeper.CreatePool(suite.ctx, liquiditytypes.NewMsgCreatePool(
+		suite.addrs[0], liquiditytypes.DefaultPoolTypeID, sdk.NewCoins(sdk.NewInt64Coin(denom1, 100_000_000), sdk.NewInt64Coin(denom2, 100_000_000))))
+	suite.Require().NoError(err)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(pool.PoolCoinDenom, 1_000_000)))
+
+	msg := types.NewMsgUnstakeAndWithdraw(suite.addrs[0], pool.Id, sdk.NewInt64Coin(pool.PoolCoinDenom, 1_000_000))
+
+	handler := farming.NewHandler(suite.keeper)
+	_, err = handler(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	suite.Require().True(suite.keeper.GetAllQueuedCoinsByFarmer(suite.ctx, suite.addrs[0]).IsZero())
+	state, found := suite.app.LiquidityKeeper.GetPoolBatchWithdrawMsgState(suite.ctx, pool.Id, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(msg.PoolCoin, state.Msg.PoolCoin)
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// SetAllowedStakingDenom adds a denom to the allowed staking denoms.
+func (k Keeper) SetAllowedStakingDenom(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAllowedStakingDenomKey(denom), []byte{})
+}
+
+// DeleteAllowedStakingDenom removes a denom from the allowed staking denoms.
+func (k Keeper) DeleteAllowedStakingDenom(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAllowedStakingDenomKey(denom))
+}
+
+// HasAllowedStakingDenom returns true if the denom is one of the allowed
+// staking denoms.
+func (k Keeper) HasAllowedStakingDenom(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAllowedStakingDenomKey(denom))
+}
+
+// IterateAllowedStakingDenoms iterates through all the allowed staking denoms
+// in ascending order and invokes callback function for each denom.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateAllowedStakingDenoms(ctx sdk.Context, cb func(denom string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AllowedStakingDenomKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(types.ParseAllowedStakingDenomKey(iter.Key())) {
+			break
+		}
+	}
+}
+
+// GetAllAllowedStakingDenoms returns all the allowed staking denoms.
+func (k Keeper) GetAllAllowedStakingDenoms(ctx sdk.Context) []string {
+	denoms := []string{}
+	k.IterateAllowedStakingDenoms(ctx, func(denom string) (stop bool) {
+		denoms = append(denoms, denom)
+		return false
+	})
+	return denoms
+}
+
+// IsStakingDenomRestricted returns true if there is any allowed staking
+// denom, which restricts the staking coin denoms to the allowed ones.
+func (k Keeper) IsStakingDenomRestricted(ctx sdk.Context) bool {
+	restricted := false
+	k.IterateAllowedStakingDenoms(ctx, func(string) (stop bool) {
+		restricted = true
+		return true
+	})
+	return restricted
+}
+
+// IsStakingDenomAllowed returns true if the denom can be staked.
+// Any denom can be staked while there are no allowed staking denoms.
+// Once restricted, the allowed staking denoms can't become empty again.
+func (k Keeper) IsStakingDenomAllowed(ctx sdk.Context, denom string) bool {
+	if k.HasAllowedStakingDenom(ctx, denom) {
+		return true
+	}
+	return !k.IsStakingDenomRestricted(ctx)
+}
+
+// validateStakingCoinsAllowed returns an error if any of the coins can't be staked.
+func (k Keeper) validateStakingCoinsAllowed(ctx sdk.Context, amount sdk.Coins) error {
+	for _, coin := range amount {
+		if !k.IsStakingDenomAllowed(ctx, coin.Denom) {
+			return sdkerrors.Wrapf(types.ErrStakingDenomNotAllowed, "%s is not allowed to be staked", coin.Denom)
+		}
+	}
+	return nil
+}
+
+// ValidateStakingCoinWeightsAllowed returns an error if any of the denoms of
+// the staking coin weights can't be staked.
+func (k Keeper) ValidateStakingCoinWeightsAllowed(ctx sdk.Context, weights sdk.DecCoins) error {
+	for _, weight := range weights {
+		if !k.IsStakingDenomAllowed(ctx, weight.Denom) {
+			return sdkerrors.Wrapf(types.ErrStakingDenomNotAllowed, "%s is not allowed to be staked", weight.Denom)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/keeper"
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+func (suite *KeeperTestSuite) TestIsStakingDenomAllowed() {
+	// Any denom can be staked while there are no allowed staking denoms.
+	suite.Require().True(suite.keeper.IsStakingDenomAllowed(suite.ctx, denom1))
+	suite.Require().True(suite.keeper.IsStakingDenomAllowed(suite.ctx, denom2))
+
+	suite.keeper.SetAllowedStakingDenom(suite.ctx, denom1)
+	suite.Require().True(suite.keeper.IsStakingDenomAllowed(suite.ctx, denom1))
+	suite.Require().False(suite.keeper.IsStakingDenomAllowed(suite.ctx, denom2))
+	suite.Require().Equal([]string{denom1}, suite.keeper.GetAllAllowedStakingDenoms(suite.ctx))
+
+	suite.keeper.DeleteAllowedStakingDenom(suite.ctx, denom1)
+	suite.Require().True(suite.keeper.IsStakingDenomAllowed(suite.ctx, denom2))
+	suite.Require().Empty(suite.keeper.GetAllAllowedStakingDenoms(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestStakeNotAllowedDenom() {
+	suite.keeper.SetAllowedStakingDenom(suite.ctx, denom1)
+
+	err := suite.keeper.Stake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom2, 1_000_000)))
+	suite.Require().ErrorIs(err, types.ErrStakingDenomNotAllowed)
+	suite.Require().True(suite.keeper.GetAllQueuedCoinsByFarmer(suite.ctx, suite.addrs[0]).IsZero())
+
+	suite.setLockTiers(sdk.NewDec(2))
+	_, err = suite.keeper.StakeLocked(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom2, 1_000_000)), lockDuration)
+	suite.Require().ErrorIs(err, types.ErrStakingDenomNotAllowed)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)),
+		suite.keeper.GetAllQueuedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+}
+
+func (suite *KeeperTestSuite) TestCreatePlanNotAllowedDenom() {
+	suite.keeper.SetAllowedStakingDenom(suite.ctx, denom1)
+
+	msg := types.NewMsgCreateFixedAmountPlan(
+		"plan1",
+		suite.addrs[4],
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom1, sdk.NewDecWithPrec(5, 1)), sdk.NewDecCoinFromDec(denom2, sdk.NewDecWithPrec(5, 1))),
+		types.ParseTime("0001-01-01T00:00:00Z"),
+		types.ParseTime("9999-12-31T00:00:00Z"),
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)),
+	)
+	_, err := suite.keeper.CreateFixedAmountPlan(suite.ctx, msg, suite.addrs[4], suite.addrs[4], types.PlanTypePublic)
+	suite.Require().ErrorIs(err, types.ErrStakingDenomNotAllowed)
+	suite.Require().Empty(suite.keeper.GetPlans(suite.ctx))
+
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1_000_000})
+
+	err = suite.keeper.ModifyPublicPlanProposal(suite.ctx, []types.ModifyPlanRequest{
+		{
+			PlanId:             1,
+			StakingCoinWeights: sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom2, sdk.OneDec())),
+		},
+	})
+	suite.Require().ErrorIs(err, types.ErrStakingDenomNotAllowed)
+}
+
+func (suite *KeeperTestSuite) TestHarvestAndStakeNotAllowedDenom() {
+	suite.keeper.SetAllowedStakingDenom(suite.ctx, denom1)
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom1: 1_000_000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	err := keeper.HandleStakingDenomsProposal(suite.ctx, suite.keeper,
+		types.NewStakingDenomsProposal("title", "description", []string{denom2}, []string{denom1}))
+	suite.Require().NoError(err)
+
+	// The rewards are no longer staked once their denom is not allowed.
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	harvested, staked, err := suite.keeper.HarvestAndStake(suite.ctx, suite.addrs[0], []string{denom1})
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), harvested))
+	suite.Require().True(staked.IsZero())
+	balancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(harvested, balancesAfter.Sub(balancesBefore)))
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// FundingBudgets returns the budgets of the budget module that have
+// the farming pool as their destination and have not ended yet.
+// The projected inflow of each budget is the average amount of coins the budget
+// has collected per epoch since its start time, based on the current epoch duration.
+func (k Keeper) FundingBudgets(ctx sdk.Context, farmingPoolAcc sdk.AccAddress) []types.FundingBudget {
+	epochDuration := k.GetCurrentEpochDuration(ctx)
+
+	budgets := []types.FundingBudget{}
+	for _, budget := range k.budgetKeeper.GetParams(ctx).Budgets {
+		if budget.DestinationAddress != farmingPoolAcc.String() || !budget.EndTime.After(ctx.BlockTime()) {
+			continue
+		}
+
+		totalCollectedCoins := k.budgetKeeper.GetTotalCollectedCoins(ctx, budget.Name)
+		budgets = append(budgets, types.FundingBudget{
+			Name:                budget.Name,
+			Rate:                budget.Rate,
+			SourceAddress:       budget.SourceAddress,
+			TotalCollectedCoins: totalCollectedCoins,
+			ProjectedInflow:     projectedInflow(totalCollectedCoins, ctx.BlockTime().Sub(budget.StartTime), epochDuration),
+		})
+	}
+
+	return budgets
+}
+
+// projectedInflow returns the amount of coins collected during elapsed,
+// scaled to an epoch of epochDuration.
+// It returns nil if no time has elapsed.
+func projectedInflow(collectedCoins sdk.Coins, elapsed, epochDuration time.Duration) sdk.Coins {
+	if elapsed <= 0 {
+		return nil
+	}
+	inflow, _ := sdk.NewDecCoinsFromCoins(collectedCoins...).
+		MulDecTruncate(sdk.NewDec(int64(epochDuration))).
+		QuoDecTruncate(sdk.NewDec(int64(elapsed))).
+		TruncateDecimal()
+	return inflow
+}
+
+// EpochOutflows returns the amount of coins the plan allocates from its
+// farming pool per epoch, and the amount of coins all active plans that share
+// the farming pool allocate per epoch.
+func (k Keeper) EpochOutflows(ctx sdk.Context, plan types.PlanI) (outflow, totalOutflow sdk.Coins) {
+	farmingPoolAcc := plan.GetFarmingPoolAddress()
+	balances := k.bankKeeper.GetAllBalances(ctx, farmingPoolAcc)
+
+	outflow = PlanEpochAmount(plan, balances, ctx.BlockTime())
+	totalOutflow = outflow
+	for _, p := range k.GetActivePlans(ctx) {
+		if p.GetId() == plan.GetId() || !types.IsPlanActiveAt(p, ctx.BlockTime()) ||
+			!p.GetFarmingPoolAddress().Equals(farmingPoolAcc) {
+			continue
+		}
+		totalOutflow = totalOutflow.Add(PlanEpochAmount(p, balances, ctx.BlockTime())...)
+	}
+
+	return outflow, totalOutflow
+}
+
+// PlanFunding returns the funding status of the plan's farming pool.
+func (k Keeper) PlanFunding(ctx sdk.Context, plan types.PlanI) types.QueryPlanFundingResponse {
+	budgets := k.FundingBudgets(ctx, plan.GetFarmingPoolAddress())
+
+	inflow := sdk.NewCoins()
+	for _, budget := range budgets {
+		inflow = inflow.Add(budget.ProjectedInflow...)
+	}
+
+	outflow, totalOutflow := k.EpochOutflows(ctx, plan)
+
+	return types.QueryPlanFundingResponse{
+		PlanId:             plan.GetId(),
+		FarmingPoolAddress: plan.GetFarmingPoolAddress().String(),
+		Budgets:            budgets,
+		ProjectedInflow:    inflow,
+		EpochOutflow:       outflow,
+		TotalEpochOutflow:  totalOutflow,
+		Sufficient:         inflow.IsAllGTE(totalOutflow),
+	}
+}
+
+// warnInsufficientFunding emits an event and logs a warning when the plan's
+// per-epoch demand, together with the other plans sharing the farming pool,
+// exceeds the projected inflow from the budgets funding the farming pool.
+// It does not fail, since the farming pool may also be funded by other means.
+func (k Keeper) warnInsufficientFunding(ctx sdk.Context, plan types.PlanI) {
+	funding := k.PlanFunding(ctx, plan)
+	if funding.Sufficient {
+		return
+	}
+
+	k.Logger(ctx).Info(
+		"plan demand exceeds the projected inflow from funding budgets",
+		"plan_id", funding.PlanId,
+		"farming_pool_address", funding.FarmingPoolAddress,
+		"total_epoch_outflow", funding.TotalEpochOutflow,
+		"projected_inflow", funding.ProjectedInflow,
+	)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePlanUnderfunded,
+			sdk.NewAttribute(types.AttributeKeyPlanId, strconv.FormatUint(funding.PlanId, 10)),
+			sdk.NewAttribute(types.AttributeKeyFarmingPoolAddress, funding.FarmingPoolAddress),
+			sdk.NewAttribute(types.AttributeKeyEpochOutflow, funding.TotalEpochOutflow.String()),
+			sdk.NewAttribute(types.AttributeKeyProjectedInflow, funding.ProjectedInflow.String()),
+		),
+	})
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	budgettypes "github.com/tendermint/budget/x/budget/types"
+
+	simapp "github.com/tendermint/farming/app"
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// setBudgets sets the budgets of the budget module.
+func (suite *KeeperTestSuite) setBudgets(budgets ...budgettypes.Budget) {
+	params := suite.app.BudgetKeeper.GetParams(suite.ctx)
+	params.Budgets = budgets
+	suite.app.BudgetKeeper.SetParams(suite.ctx, params)
+}
+
+func (suite *KeeperTestSuite) TestFundingBudgets() {
+	farmingPoolAcc := simapp.AddTestAddrs(suite.app, suite.ctx, 1, sdk.ZeroInt())[0]
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-09-01T00:00:00Z"))
+
+	suite.setBudgets(
+		budgettypes.Budget{
+			Name:               "budget1",
+			Rate:               sdk.NewDecWithPrec(1, 1),
+			SourceAddress:      suite.addrs[0].String(),
+			DestinationAddress: farmingPoolAcc.String(),
+			StartTime:          types.ParseTime("2021-08-01T00:00:00Z"),
+			EndTime:            types.ParseTime("2022-08-01T00:00:00Z"),
+		},
+		// Ended budget.
+		budgettypes.Budget{
+			Name:               "budget2",
+			Rate:               sdk.NewDecWithPrec(1, 1),
+			SourceAddress:      suite.addrs[0].String(),
+			DestinationAddress: farmingPoolAcc.String(),
+			StartTime:          types.ParseTime("2021-08-01T00:00:00Z"),
+			EndTime:            types.ParseTime("2021-08-15T00:00:00Z"),
+		},
+		// Budget for another destination.
+		budgettypes.Budget{
+			Name:               "budget3",
+			Rate:               sdk.NewDecWithPrec(1, 1),
+			SourceAddress:      suite.addrs[0].String(),
+			DestinationAddress: suite.addrs[1].String(),
+			StartTime:          types.ParseTime("2021-08-01T00:00:00Z"),
+			EndTime:            types.ParseTime("2022-08-01T00:00:00Z"),
+		},
+		// Budget that has not started yet.
+		budgettypes.Budget{
+			Name:               "budget4",
+			Rate:               sdk.NewDecWithPrec(1, 1),
+			SourceAddress:      suite.addrs[1].String(),
+			DestinationAddress: farmingPoolAcc.String(),
+			StartTime:          types.ParseTime("2021-10-01T00:00:00Z"),
+			EndTime:            types.ParseTime("2022-08-01T00:00:00Z"),
+		},
+	)
+	// 31 days have passed since the start time of budget1.
+	suite.app.BudgetKeeper.SetTotalCollectedCoins(suite.ctx, "budget1", sdk.NewCoins(sdk.NewInt64Coin(denom3, 31_000_000)))
+
+	budgets := suite.keeper.FundingBudgets(suite.ctx, farmingPoolAcc)
+	suite.Require().Len(budgets, 2)
+	suite.Require().Equal("budget1", budgets[0].Name)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 31_000_000)), budgets[0].TotalCollectedCoins))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)), budgets[0].ProjectedInflow))
+	suite.Require().Equal("budget4", budgets[1].Name)
+	suite.Require().True(budgets[1].ProjectedInflow.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestPlanFunding() {
+	farmingPoolAcc := simapp.AddTestAddrs(suite.app, suite.ctx, 1, sdk.ZeroInt())[0]
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-09-01T00:00:00Z"))
+
+	suite.setBudgets(budgettypes.Budget{
+		Name:               "budget1",
+		Rate:               sdk.NewDecWithPrec(1, 1),
+		SourceAddress:      suite.addrs[0].String(),
+		DestinationAddress: farmingPoolAcc.String(),
+		StartTime:          types.ParseTime("2021-08-01T00:00:00Z"),
+		EndTime:            types.ParseTime("2022-08-01T00:00:00Z"),
+	})
+	suite.app.BudgetKeeper.SetTotalCollectedCoins(suite.ctx, "budget1", sdk.NewCoins(sdk.NewInt64Coin(denom3, 31_000_000)))
+
+	suite.CreateFixedAmountPlan(farmingPoolAcc, map[string]string{denom1: "1"}, map[string]int64{denom3: 700_000})
+	plan, _ := suite.keeper.GetPlan(suite.ctx, 1)
+
+	funding := suite.keeper.PlanFunding(suite.ctx, plan)
+	suite.Require().Equal(uint64(1), funding.PlanId)
+	suite.Require().Equal(farmingPoolAcc.String(), funding.FarmingPoolAddress)
+	suite.Require().Len(funding.Budgets, 1)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)), funding.ProjectedInflow))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 700_000)), funding.EpochOutflow))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 700_000)), funding.TotalEpochOutflow))
+	suite.Require().True(funding.Sufficient)
+
+	// Another plan shares the farming pool, so the total outflow exceeds the inflow.
+	suite.CreateFixedAmountPlan(farmingPoolAcc, map[string]string{denom2: "1"}, map[string]int64{denom3: 400_000})
+	// A plan with another farming pool doesn't affect the funding.
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom2: "1"}, map[string]int64{denom3: 400_000})
+
+	funding = suite.keeper.PlanFunding(suite.ctx, plan)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 700_000)), funding.EpochOutflow))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_100_000)), funding.TotalEpochOutflow))
+	suite.Require().False(funding.Sufficient)
+}
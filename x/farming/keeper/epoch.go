@@ -39,39 +39,113 @@ func (k Keeper) SetLastEpochTime(ctx sdk.Context, t time.Time) {
 	store.Set(types.LastEpochTimeKey, bz)
 }
 
+// GetNextEpochTime returns the time the current epoch is expected to end.
+// Epochs end at the first block after the current epoch duration has passed
+// since the last epoch, where the last epoch time is truncated to a multiple
+// of the epoch duration, or to the start of the day(UTC) for epochs that are
+// a day or longer. This keeps epoch boundaries aligned to the same times
+// regardless of block times.
+// It returns false when the first epoch has not started yet.
+func (k Keeper) GetNextEpochTime(ctx sdk.Context) (t time.Time, found bool) {
+	lastEpochTime, found := k.GetLastEpochTime(ctx)
+	if !found {
+		return
+	}
+	epochDuration := k.GetCurrentEpochDuration(ctx)
+	truncation := epochDuration
+	if truncation > 24*time.Hour {
+		truncation = 24 * time.Hour
+	}
+	return lastEpochTime.Truncate(truncation).Add(epochDuration), true
+}
+
 // AdvanceEpoch ends the current epoch. When an epoch ends, rewards
-// are distributed and queued staking coins become staked.
+// are distributed, queued staking coins become staked and locked
+// stakings whose unlock time has passed become normal stakings.
 func (k Keeper) AdvanceEpoch(ctx sdk.Context) error {
 	if err := k.AllocateRewards(ctx); err != nil {
 		return err
 	}
 	k.ProcessQueuedCoins(ctx)
+	k.ProcessUnlockedStakings(ctx)
 	k.SetLastEpochTime(ctx, ctx.BlockTime())
+	k.SetGlobalEpoch(ctx, k.GetGlobalEpoch(ctx)+1)
+
+	k.AfterEpochAdvanced(ctx)
 
 	return nil
 }
 
-// GetCurrentEpochDays returns the current epoch days(period).
-func (k Keeper) GetCurrentEpochDays(ctx sdk.Context) uint32 {
-	var epochDays uint32
+// CatchUpEpochs ends the epochs that have ended by the current block time,
+// up to maxEpochs epochs.
+// Each epoch is ended as if the block time were the time the epoch was
+// expected to end, so that plans allocate rewards for the epochs missed while
+// the chain was down as they would have if the chain had been running.
+// The remaining missed epochs are caught up in the following blocks.
+func (k Keeper) CatchUpEpochs(ctx sdk.Context, maxEpochs uint32) error {
+	for i := uint32(0); i < maxEpochs; i++ {
+		nextEpochTime, found := k.GetNextEpochTime(ctx)
+		if !found || ctx.BlockTime().Before(nextEpochTime) {
+			break
+		}
+
+		if err := k.AdvanceEpoch(ctx.WithBlockTime(nextEpochTime)); err != nil {
+			return err
+		}
+		if params := k.GetParams(ctx); params.NextEpochDuration != k.GetCurrentEpochDuration(ctx) {
+			k.SetCurrentEpochDuration(ctx, params.NextEpochDuration)
+		}
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeEpochCaughtUp,
+				sdk.NewAttribute(types.AttributeKeyEpochEndTime, nextEpochTime.Format(time.RFC3339)),
+			),
+		})
+	}
+	return nil
+}
+
+// GetCurrentEpochDuration returns the current epoch duration(period).
+func (k Keeper) GetCurrentEpochDuration(ctx sdk.Context) time.Duration {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.CurrentEpochDaysKey)
+	bz := store.Get(types.CurrentEpochDurationKey)
 	if bz == nil {
-		// initialize with next epoch days
-		epochDays = k.GetParams(ctx).NextEpochDays
-	} else {
-		val := gogotypes.UInt32Value{}
-		if err := k.cdc.Unmarshal(bz, &val); err != nil {
-			panic(err)
-		}
-		epochDays = val.GetValue()
+		// initialize with next epoch duration
+		return k.GetParams(ctx).NextEpochDuration
+	}
+	var val gogotypes.Duration
+	k.cdc.MustUnmarshal(bz, &val)
+	epochDuration, err := gogotypes.DurationFromProto(&val)
+	if err != nil {
+		panic(err)
+	}
+	return epochDuration
+}
+
+// SetCurrentEpochDuration sets the current epoch duration(period).
+func (k Keeper) SetCurrentEpochDuration(ctx sdk.Context, epochDuration time.Duration) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(gogotypes.DurationProto(epochDuration))
+	store.Set(types.CurrentEpochDurationKey, bz)
+}
+
+// GetGlobalEpoch returns the global epoch number, which is the number of
+// epochs that have ended so far.
+func (k Keeper) GetGlobalEpoch(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GlobalEpochKey)
+	if bz == nil {
+		return 0
 	}
-	return epochDays
+	var val gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &val)
+	return val.GetValue()
 }
 
-// SetCurrentEpochDays sets the current epoch days(period).
-func (k Keeper) SetCurrentEpochDays(ctx sdk.Context, epochDays uint32) {
+// SetGlobalEpoch sets the global epoch number.
+func (k Keeper) SetGlobalEpoch(ctx sdk.Context, epoch uint64) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&gogotypes.UInt32Value{Value: epochDays})
-	store.Set(types.CurrentEpochDaysKey, bz)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: epoch})
+	store.Set(types.GlobalEpochKey, bz)
 }
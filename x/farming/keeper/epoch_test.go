@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/tendermint/farming/x/farming"
 	"github.com/tendermint/farming/x/farming/types"
 
@@ -26,9 +28,9 @@ func (suite *KeeperTestSuite) TestFirstEpoch() {
 	// The first epoch may run very quickly depending on when
 	// the farming module was activated,
 	// meaning that (block time) - (last epoch time) may be smaller
-	// than the current epoch_days for the first epoch.
+	// than the current epoch duration for the first epoch.
 
-	suite.Require().Equal(uint32(1), suite.keeper.GetCurrentEpochDays(suite.ctx))
+	suite.Require().Equal(24*time.Hour, suite.keeper.GetCurrentEpochDuration(suite.ctx))
 
 	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-11T23:59:59Z"))
 	farming.EndBlocker(suite.ctx, suite.keeper)
@@ -41,13 +43,15 @@ func (suite *KeeperTestSuite) TestFirstEpoch() {
 	suite.Require().True(t.After(lastEpochTime)) // Indicating that the epoch ended.
 }
 
-func (suite *KeeperTestSuite) TestEpochDays() {
-	for _, nextEpochDays := range []uint32{1, 2, 3} {
-		suite.Run(fmt.Sprintf("next epoch days = %d", nextEpochDays), func() {
+func (suite *KeeperTestSuite) TestEpochDuration() {
+	for _, nextEpochDuration := range []time.Duration{
+		30 * time.Minute, time.Hour, 6 * time.Hour, 24 * time.Hour, 2 * 24 * time.Hour, 3 * 24 * time.Hour,
+	} {
+		suite.Run(fmt.Sprintf("next epoch duration = %s", nextEpochDuration), func() {
 			suite.SetupTest()
 
 			params := suite.keeper.GetParams(suite.ctx)
-			params.NextEpochDays = nextEpochDays
+			params.NextEpochDuration = nextEpochDuration
 			suite.keeper.SetParams(suite.ctx, params)
 
 			t := types.ParseTime("2021-08-11T00:00:00Z")
@@ -55,7 +59,7 @@ func (suite *KeeperTestSuite) TestEpochDays() {
 			farming.EndBlocker(suite.ctx, suite.keeper)
 
 			lastEpochTime, _ := suite.keeper.GetLastEpochTime(suite.ctx)
-			currentEpochDays := suite.keeper.GetCurrentEpochDays(suite.ctx)
+			currentEpochDuration := suite.keeper.GetCurrentEpochDuration(suite.ctx)
 
 			for i := 0; i < 10000; i++ {
 				t = t.Add(5 * time.Minute)
@@ -64,8 +68,11 @@ func (suite *KeeperTestSuite) TestEpochDays() {
 
 				t2, _ := suite.keeper.GetLastEpochTime(suite.ctx)
 				if t2.After(lastEpochTime) {
-					suite.Require().GreaterOrEqual(t2.Sub(lastEpochTime).Hours(), float64(currentEpochDays*24))
+					suite.Require().GreaterOrEqual(t2.Sub(lastEpochTime), currentEpochDuration)
 					lastEpochTime = t2
+					// The new epoch duration takes effect after the current epoch.
+					currentEpochDuration = suite.keeper.GetCurrentEpochDuration(suite.ctx)
+					suite.Require().Equal(nextEpochDuration, currentEpochDuration)
 				}
 			}
 		})
@@ -85,13 +92,117 @@ func (suite *KeeperTestSuite) TestDelayedBlockTime() {
 	suite.Require().Equal(t, lastEpochTime)
 }
 
-func (suite *KeeperTestSuite) TestCurrentEpochDays() {
-	currentEpochDays := suite.keeper.GetCurrentEpochDays(suite.ctx)
-	suite.Require().Equal(uint32(1), currentEpochDays)
+func (suite *KeeperTestSuite) TestCatchUpEpochs() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.MaxCatchUpEpochs = 3
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// The plan ends while the chain is down.
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+	_, err := suite.keeper.CreateFixedAmountPlan(suite.ctx, types.NewMsgCreateFixedAmountPlan(
+		"plan1",
+		suite.addrs[4],
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom1, sdk.OneDec())),
+		types.ParseTime("2021-08-01T00:00:00Z"),
+		types.ParseTime("2021-08-06T12:00:00Z"),
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)),
+	), suite.addrs[4], suite.addrs[4], types.PlanTypePrivate)
+	suite.Require().NoError(err)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-02T00:00:00Z"))
+	farming.EndBlocker(suite.ctx, suite.keeper) // Queued staking coins have now staked.
+
+	caughtUpEpochEndTimes := func() []string {
+		var endTimes []string
+		for _, ev := range suite.ctx.EventManager().Events() {
+			if ev.Type == types.EventTypeEpochCaughtUp {
+				for _, attr := range ev.Attributes {
+					if string(attr.Key) == types.AttributeKeyEpochEndTime {
+						endTimes = append(endTimes, string(attr.Value))
+					}
+				}
+			}
+		}
+		return endTimes
+	}
+
+	// The chain has been down for 5 days, and only 3 epochs are caught up in a block.
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-07T00:00:00Z")).WithEventManager(sdk.NewEventManager())
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	endTimes := caughtUpEpochEndTimes()
+	suite.Require().Len(endTimes, 3)
+	suite.Require().Equal([]string{"2021-08-03T00:00:00Z", "2021-08-04T00:00:00Z", "2021-08-05T00:00:00Z"}, endTimes)
+	lastEpochTime, _ := suite.keeper.GetLastEpochTime(suite.ctx)
+	suite.Require().Equal(types.ParseTime("2021-08-05T00:00:00Z"), lastEpochTime)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 3_000_000)), suite.keeper.Rewards(suite.ctx, suite.addrs[0], denom1)))
+	plan, _ := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().False(plan.GetTerminated())
+
+	// The plan allocates rewards for the last epoch before it has ended, then gets terminated.
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-07T00:00:05Z")).WithEventManager(sdk.NewEventManager())
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	endTimes = caughtUpEpochEndTimes()
+	suite.Require().Len(endTimes, 2)
+	suite.Require().Equal([]string{"2021-08-06T00:00:00Z", "2021-08-07T00:00:00Z"}, endTimes)
+	lastEpochTime, _ = suite.keeper.GetLastEpochTime(suite.ctx)
+	suite.Require().Equal(types.ParseTime("2021-08-07T00:00:00Z"), lastEpochTime)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 4_000_000)), suite.keeper.Rewards(suite.ctx, suite.addrs[0], denom1)))
+	plan, _ = suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().True(plan.GetTerminated())
+}
+
+func (suite *KeeperTestSuite) TestGlobalEpoch() {
+	suite.Require().Equal(uint64(0), suite.keeper.GetGlobalEpoch(suite.ctx))
+
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+	suite.Require().Equal(uint64(2), suite.keeper.GetGlobalEpoch(suite.ctx))
+
+	// Each caught up epoch is counted.
+	params := suite.keeper.GetParams(suite.ctx)
+	params.MaxCatchUpEpochs = 10
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.keeper.SetLastEpochTime(suite.ctx, types.ParseTime("2021-08-01T00:00:00Z"))
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-04T00:00:00Z"))
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	suite.Require().Equal(uint64(5), suite.keeper.GetGlobalEpoch(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestCurrentEpochDuration() {
+	currentEpochDuration := suite.keeper.GetCurrentEpochDuration(suite.ctx)
+	suite.Require().Equal(24*time.Hour, currentEpochDuration)
+
+	suite.keeper.SetCurrentEpochDuration(suite.ctx, 3*time.Hour)
+
+	currentEpochDuration = suite.keeper.GetCurrentEpochDuration(suite.ctx)
+	suite.Require().Equal(3*time.Hour, currentEpochDuration)
+}
+
+func (suite *KeeperTestSuite) TestNextEpochTime() {
+	_, found := suite.keeper.GetNextEpochTime(suite.ctx)
+	suite.Require().False(found)
+
+	suite.keeper.SetLastEpochTime(suite.ctx, types.ParseTime("2021-08-11T12:34:56Z"))
+	t, found := suite.keeper.GetNextEpochTime(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(types.ParseTime("2021-08-12T00:00:00Z"), t)
+
+	suite.keeper.SetCurrentEpochDuration(suite.ctx, 3*24*time.Hour)
+	t, _ = suite.keeper.GetNextEpochTime(suite.ctx)
+	suite.Require().Equal(types.ParseTime("2021-08-14T00:00:00Z"), t)
+
+	suite.keeper.SetCurrentEpochDuration(suite.ctx, 36*time.Hour)
+	t, _ = suite.keeper.GetNextEpochTime(suite.ctx)
+	suite.Require().Equal(types.ParseTime("2021-08-12T12:00:00Z"), t)
 
-	nextEpochDays := uint32(3)
-	suite.keeper.SetCurrentEpochDays(suite.ctx, nextEpochDays)
+	suite.keeper.SetCurrentEpochDuration(suite.ctx, time.Hour)
+	t, _ = suite.keeper.GetNextEpochTime(suite.ctx)
+	suite.Require().Equal(types.ParseTime("2021-08-11T13:00:00Z"), t)
 
-	currentEpochDays = suite.keeper.GetCurrentEpochDays(suite.ctx)
-	suite.Require().Equal(uint32(3), currentEpochDays)
+	suite.keeper.SetCurrentEpochDuration(suite.ctx, 15*time.Minute)
+	t, _ = suite.keeper.GetNextEpochTime(suite.ctx)
+	suite.Require().Equal(types.ParseTime("2021-08-11T12:45:00Z"), t)
 }
@@ -18,7 +18,8 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	ctx, writeCache := ctx.CacheContext()
 
 	k.SetParams(ctx, genState.Params)
-	k.SetCurrentEpochDays(ctx, genState.CurrentEpochDays)
+	k.SetCurrentEpochDuration(ctx, genState.CurrentEpochDuration)
+	k.SetGlobalEpoch(ctx, genState.GlobalEpoch)
 	if addr := k.accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
@@ -51,6 +52,30 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		totalStakings[record.StakingCoinDenom] = amt
 	}
 
+	totalLockedStakings := map[string]types.TotalLockedStakings{} // (staking coin denom) => (total locked stakings)
+
+	for i, lockedStaking := range genState.LockedStakings {
+		k.SetLockedStaking(ctx, lockedStaking)
+		if i == len(genState.LockedStakings)-1 {
+			k.SetGlobalLockedStakingId(ctx, lockedStaking.Id)
+		}
+
+		total, ok := totalLockedStakings[lockedStaking.StakingCoinDenom]
+		if !ok {
+			total = types.TotalLockedStakings{Amount: sdk.ZeroInt(), WeightedAmount: sdk.ZeroDec()}
+		}
+		total.Amount = total.Amount.Add(lockedStaking.Amount)
+		total.WeightedAmount = total.WeightedAmount.Add(lockedStaking.Weight())
+		totalLockedStakings[lockedStaking.StakingCoinDenom] = total
+
+		amt, ok := totalStakings[lockedStaking.StakingCoinDenom]
+		if !ok {
+			amt = sdk.ZeroInt()
+		}
+		amt = amt.Add(lockedStaking.Amount)
+		totalStakings[lockedStaking.StakingCoinDenom] = amt
+	}
+
 	for _, record := range genState.TotalStakingsRecords {
 		if !record.Amount.Equal(totalStakings[record.StakingCoinDenom]) {
 			panic(fmt.Sprintf("TotalStaking for %s differs from the actual value; have %s, want %s",
@@ -72,6 +97,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		k.SetTotalStakings(ctx, record.StakingCoinDenom, types.TotalStakings{Amount: record.Amount})
 	}
 
+	for denom, total := range totalLockedStakings {
+		k.SetTotalLockedStakings(ctx, denom, total)
+	}
+
 	for _, record := range genState.QueuedStakingRecords {
 		farmerAcc, err := sdk.AccAddressFromBech32(record.Farmer)
 		if err != nil {
@@ -80,6 +109,27 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		k.SetQueuedStaking(ctx, record.StakingCoinDenom, farmerAcc, record.QueuedStaking)
 	}
 
+	for _, lockedStaking := range genState.QueuedLockedStakings {
+		k.SetQueuedLockedStaking(ctx, lockedStaking)
+		if lockedStaking.Id > k.GetGlobalLockedStakingId(ctx) {
+			k.SetGlobalLockedStakingId(ctx, lockedStaking.Id)
+		}
+	}
+
+	for i, unbonding := range genState.Unbondings {
+		k.SetUnbonding(ctx, unbonding)
+		if i == len(genState.Unbondings)-1 {
+			k.SetGlobalUnbondingId(ctx, unbonding.Id)
+		}
+	}
+
+	for i, req := range genState.DepositRequests {
+		k.SetDepositRequest(ctx, req)
+		if i == len(genState.DepositRequests)-1 {
+			k.SetGlobalDepositRequestId(ctx, req.Id)
+		}
+	}
+
 	for _, record := range genState.HistoricalRewardsRecords {
 		k.SetHistoricalRewards(ctx, record.StakingCoinDenom, record.Epoch, record.HistoricalRewards)
 	}
@@ -92,6 +142,22 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		k.SetCurrentEpoch(ctx, record.StakingCoinDenom, record.CurrentEpoch)
 	}
 
+	for _, record := range genState.RewardsWithdrawAddressRecords {
+		farmerAcc, err := sdk.AccAddressFromBech32(record.Farmer)
+		if err != nil {
+			panic(err)
+		}
+		withdrawAddr, err := sdk.AccAddressFromBech32(record.WithdrawAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.setRewardsWithdrawAddress(ctx, farmerAcc, withdrawAddr)
+	}
+
+	for _, denom := range genState.AllowedStakingDenoms {
+		k.SetAllowedStakingDenom(ctx, denom)
+	}
+
 	if genState.LastEpochTime != nil {
 		k.SetLastEpochTime(ctx, *genState.LastEpochTime)
 	}
@@ -121,6 +187,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 // ExportGenesis returns the farming module's genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	params := k.GetParams(ctx)
+	if params.LockTiers == nil { // The param store decodes empty lock tiers as nil.
+		params.LockTiers = []types.LockTier{}
+	}
 
 	plans := []types.PlanRecord{}
 	for _, plan := range k.GetPlans(ctx) {
@@ -192,6 +261,33 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
+	lockedStakings := []types.LockedStaking{}
+	k.IterateLockedStakings(ctx, func(lockedStaking types.LockedStaking) (stop bool) {
+		lockedStakings = append(lockedStakings, lockedStaking)
+		return false
+	})
+
+	queuedLockedStakings := []types.LockedStaking{}
+	k.IterateQueuedLockedStakings(ctx, func(lockedStaking types.LockedStaking) (stop bool) {
+		queuedLockedStakings = append(queuedLockedStakings, lockedStaking)
+		return false
+	})
+
+	unbondings := []types.Unbonding{}
+	k.IterateUnbondings(ctx, func(unbonding types.Unbonding) (stop bool) {
+		unbondings = append(unbondings, unbonding)
+		return false
+	})
+
+	rewardsWithdrawAddresses := []types.RewardsWithdrawAddressRecord{}
+	k.IterateRewardsWithdrawAddresses(ctx, func(farmerAcc, withdrawAddr sdk.AccAddress) (stop bool) {
+		rewardsWithdrawAddresses = append(rewardsWithdrawAddresses, types.RewardsWithdrawAddressRecord{
+			Farmer:          farmerAcc.String(),
+			WithdrawAddress: withdrawAddr.String(),
+		})
+		return false
+	})
+
 	var epochTime *time.Time
 	tempEpochTime, found := k.GetLastEpochTime(ctx)
 	if found {
@@ -209,6 +305,13 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		currentEpochs,
 		k.bankKeeper.GetAllBalances(ctx, types.RewardsReserveAcc),
 		epochTime,
-		k.GetCurrentEpochDays(ctx),
+		k.GetCurrentEpochDuration(ctx),
+		k.GetGlobalEpoch(ctx),
+		lockedStakings,
+		unbondings,
+		rewardsWithdrawAddresses,
+		k.GetAllDepositRequests(ctx),
+		k.GetAllAllowedStakingDenoms(ctx),
+		queuedLockedStakings,
 	)
 }
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
@@ -78,6 +79,91 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().Equal(genState, suite.keeper.ExportGenesis(suite.ctx))
 }
 
+func (suite *KeeperTestSuite) TestInitGenesisLockedStakings() {
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+	suite.setLockTiers(sdk.NewDec(2))
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.StakeLocked(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000)))
+	suite.StakeLocked(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom2, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.StakeLocked(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom2, 300_000)))
+
+	var genState *types.GenesisState
+	suite.Require().NotPanics(func() {
+		genState = suite.keeper.ExportGenesis(suite.ctx)
+	})
+	suite.Require().Len(genState.LockedStakings, 3)
+	suite.Require().Len(genState.QueuedLockedStakings, 1)
+
+	err := types.ValidateGenesis(*genState)
+	suite.Require().NoError(err)
+
+	suite.Require().NotPanics(func() {
+		suite.keeper.InitGenesis(suite.ctx, *genState)
+	})
+	suite.Require().Equal(genState, suite.keeper.ExportGenesis(suite.ctx))
+
+	totalLockedStakings, found := suite.keeper.GetTotalLockedStakings(suite.ctx, denom1)
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(1_500_000), totalLockedStakings.Amount))
+	suite.Require().True(decEq(sdk.NewDec(3_000_000), totalLockedStakings.WeightedAmount))
+	suite.Require().Equal(uint64(4), suite.keeper.GetGlobalLockedStakingId(suite.ctx))
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom2, 1_300_000)),
+		suite.keeper.GetAllLockedCoinsByFarmer(suite.ctx, suite.addrs[1])))
+}
+
+func (suite *KeeperTestSuite) TestInitGenesisUnbondings() {
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+	suite.setUnbondingPeriod(unbondingPeriod)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom2, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000), sdk.NewInt64Coin(denom2, 300_000)))
+
+	var genState *types.GenesisState
+	suite.Require().NotPanics(func() {
+		genState = suite.keeper.ExportGenesis(suite.ctx)
+	})
+	suite.Require().Len(genState.Unbondings, 2)
+
+	err := types.ValidateGenesis(*genState)
+	suite.Require().NoError(err)
+
+	suite.Require().NotPanics(func() {
+		suite.keeper.InitGenesis(suite.ctx, *genState)
+	})
+	suite.Require().Equal(genState, suite.keeper.ExportGenesis(suite.ctx))
+	suite.Require().Equal(uint64(2), suite.keeper.GetGlobalUnbondingId(suite.ctx))
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000), sdk.NewInt64Coin(denom2, 300_000)),
+		suite.keeper.GetAllUnbondingCoinsByFarmer(suite.ctx, suite.addrs[0])))
+}
+
+func (suite *KeeperTestSuite) TestInitGenesisRewardsWithdrawAddresses() {
+	err := suite.keeper.SetRewardsWithdrawAddress(suite.ctx, suite.addrs[0], suite.addrs[1])
+	suite.Require().NoError(err)
+	err = suite.keeper.SetRewardsWithdrawAddress(suite.ctx, suite.addrs[2], suite.addrs[1])
+	suite.Require().NoError(err)
+
+	var genState *types.GenesisState
+	suite.Require().NotPanics(func() {
+		genState = suite.keeper.ExportGenesis(suite.ctx)
+	})
+	suite.Require().Len(genState.RewardsWithdrawAddressRecords, 2)
+
+	err = types.ValidateGenesis(*genState)
+	suite.Require().NoError(err)
+
+	suite.Require().NotPanics(func() {
+		suite.keeper.InitGenesis(suite.ctx, *genState)
+	})
+	suite.Require().Equal(genState, suite.keeper.ExportGenesis(suite.ctx))
+	suite.Require().Equal(suite.addrs[1], suite.keeper.GetRewardsWithdrawAddress(suite.ctx, suite.addrs[0]))
+	suite.Require().Equal(suite.addrs[1], suite.keeper.GetRewardsWithdrawAddress(suite.ctx, suite.addrs[2]))
+}
+
 func (suite *KeeperTestSuite) TestInitGenesisPanics() {
 	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-06T00:00:00Z"))
 
@@ -148,9 +234,9 @@ func (suite *KeeperTestSuite) TestInitGenesisPanics() {
 			true,
 		},
 		{
-			"invalid current epoch days",
+			"invalid current epoch duration",
 			func(genState *types.GenesisState) {
-				genState.CurrentEpochDays = 0
+				genState.CurrentEpochDuration = 0
 			},
 			true,
 		},
@@ -223,7 +309,8 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 			func() {
 				err := genState.Params.Validate()
 				suite.Require().NoError(err)
-				suite.Require().Equal(suite.keeper.GetParams(suite.ctx), genState.Params)
+				// The param store decodes empty lock tiers as nil, so compare them as strings.
+				suite.Require().Equal(suite.keeper.GetParams(suite.ctx).String(), genState.Params.String())
 			},
 		},
 		{
@@ -366,12 +453,62 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 			},
 		},
 		{
-			"CurrentEpochDays",
+			"CurrentEpochDuration",
 			func() {
-				suite.Require().Equal(uint32(1), genState.CurrentEpochDays)
+				suite.Require().Equal(24*time.Hour, genState.CurrentEpochDuration)
+			},
+		},
+		{
+			"GlobalEpoch",
+			func() {
+				suite.Require().Equal(uint64(2), genState.GlobalEpoch)
 			},
 		},
 	} {
 		suite.Run(tc.name, tc.check)
 	}
 }
+
+func (suite *KeeperTestSuite) TestInitGenesisDepositRequests() {
+	pool := suite.createPool(suite.addrs[5], 100_000_000, 100_000_000)
+
+	_, err := suite.keeper.DepositAndStake(suite.ctx, suite.addrs[0], pool.Id, sdk.NewCoins(sdk.NewInt64Coin(denom1, 10_000_000), sdk.NewInt64Coin(denom2, 10_000_000)))
+	suite.Require().NoError(err)
+	_, err = suite.keeper.DepositAndStake(suite.ctx, suite.addrs[1], pool.Id, sdk.NewCoins(sdk.NewInt64Coin(denom1, 10_000_000), sdk.NewInt64Coin(denom2, 10_000_000)))
+	suite.Require().NoError(err)
+
+	var genState *types.GenesisState
+	suite.Require().NotPanics(func() {
+		genState = suite.keeper.ExportGenesis(suite.ctx)
+	})
+	suite.Require().Len(genState.DepositRequests, 2)
+
+	err = types.ValidateGenesis(*genState)
+	suite.Require().NoError(err)
+
+	suite.Require().NotPanics(func() {
+		suite.keeper.InitGenesis(suite.ctx, *genState)
+	})
+	suite.Require().Equal(genState, suite.keeper.ExportGenesis(suite.ctx))
+	suite.Require().Equal(uint64(2), suite.keeper.GetGlobalDepositRequestId(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestInitGenesisAllowedStakingDenoms() {
+	suite.keeper.SetAllowedStakingDenom(suite.ctx, denom2)
+	suite.keeper.SetAllowedStakingDenom(suite.ctx, denom1)
+
+	var genState *types.GenesisState
+	suite.Require().NotPanics(func() {
+		genState = suite.keeper.ExportGenesis(suite.ctx)
+	})
+	suite.Require().Equal([]string{denom1, denom2}, genState.AllowedStakingDenoms)
+
+	err := types.ValidateGenesis(*genState)
+	suite.Require().NoError(err)
+
+	suite.Require().NotPanics(func() {
+		suite.keeper.InitGenesis(suite.ctx, *genState)
+	})
+	suite.Require().Equal(genState, suite.keeper.ExportGenesis(suite.ctx))
+	suite.Require().False(suite.keeper.IsStakingDenomAllowed(suite.ctx, denom3))
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"strconv"
 
 	"google.golang.org/grpc/codes"
@@ -242,14 +244,540 @@ func (k Querier) Rewards(c context.Context, req *types.QueryRewardsRequest) (*ty
 	return resp, nil
 }
 
-// CurrentEpochDays queries current epoch days.
-func (k Querier) CurrentEpochDays(c context.Context, req *types.QueryCurrentEpochDaysRequest) (*types.QueryCurrentEpochDaysResponse, error) {
+// RewardsWithdrawAddress queries the rewards withdraw address of a farmer.
+func (k Querier) RewardsWithdrawAddress(c context.Context, req *types.QueryRewardsWithdrawAddressRequest) (*types.QueryRewardsWithdrawAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	farmerAcc, err := sdk.AccAddressFromBech32(req.Farmer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	withdrawAddr := k.Keeper.GetRewardsWithdrawAddress(ctx, farmerAcc)
+
+	return &types.QueryRewardsWithdrawAddressResponse{WithdrawAddress: withdrawAddr.String()}, nil
+}
+
+// CurrentEpochDuration queries current epoch duration.
+func (k Querier) CurrentEpochDuration(c context.Context, req *types.QueryCurrentEpochDurationRequest) (*types.QueryCurrentEpochDurationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	currentEpochDuration := k.Keeper.GetCurrentEpochDuration(ctx)
+
+	return &types.QueryCurrentEpochDurationResponse{CurrentEpochDuration: currentEpochDuration}, nil
+}
+
+// EpochInfo queries the global epoch number and the times of the last and next epochs.
+func (k Querier) EpochInfo(c context.Context, req *types.QueryEpochInfoRequest) (*types.QueryEpochInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp := &types.QueryEpochInfoResponse{
+		GlobalEpoch:          k.Keeper.GetGlobalEpoch(ctx),
+		CurrentEpochDuration: k.Keeper.GetCurrentEpochDuration(ctx),
+		NextEpochDuration:    k.Keeper.GetParams(ctx).NextEpochDuration,
+	}
+	if lastEpochTime, found := k.Keeper.GetLastEpochTime(ctx); found {
+		resp.LastEpochTime = &lastEpochTime
+	}
+	if nextEpochTime, found := k.Keeper.GetNextEpochTime(ctx); found {
+		resp.NextEpochTime = &nextEpochTime
+	}
+
+	return resp, nil
+}
+
+// LockedStakings queries locked stakings for a farmer, along with the queued
+// locked stakings.
+func (k Querier) LockedStakings(c context.Context, req *types.QueryLockedStakingsRequest) (*types.QueryLockedStakingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	farmerAcc, err := sdk.AccAddressFromBech32(req.Farmer)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.StakingCoinDenom != "" {
+		if err := sdk.ValidateDenom(req.StakingCoinDenom); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	lockedStakings := []types.LockedStaking{}
+	cb := func(lockedStaking types.LockedStaking) (stop bool) {
+		lockedStakings = append(lockedStakings, lockedStaking)
+		return false
+	}
+	if req.StakingCoinDenom == "" {
+		k.Keeper.IterateLockedStakingsByFarmer(ctx, farmerAcc, cb)
+	} else {
+		k.Keeper.IterateLockedStakingsByFarmerAndDenom(ctx, farmerAcc, req.StakingCoinDenom, cb)
+	}
+
+	queuedLockedStakings := []types.LockedStaking{}
+	for _, lockedStaking := range k.Keeper.GetQueuedLockedStakingsByFarmer(ctx, farmerAcc) {
+		if req.StakingCoinDenom == "" || lockedStaking.StakingCoinDenom == req.StakingCoinDenom {
+			queuedLockedStakings = append(queuedLockedStakings, lockedStaking)
+		}
+	}
+
+	return &types.QueryLockedStakingsResponse{LockedStakings: lockedStakings, QueuedLockedStakings: queuedLockedStakings}, nil
+}
+
+// Unbondings queries all unbondings by a farmer.
+func (k Querier) Unbondings(c context.Context, req *types.QueryUnbondingsRequest) (*types.QueryUnbondingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	farmerAcc, err := sdk.AccAddressFromBech32(req.Farmer)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.StakingCoinDenom != "" {
+		if err := sdk.ValidateDenom(req.StakingCoinDenom); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	unbondings := []types.Unbonding{}
+	cb := func(unbonding types.Unbonding) (stop bool) {
+		unbondings = append(unbondings, unbonding)
+		return false
+	}
+	if req.StakingCoinDenom == "" {
+		k.Keeper.IterateUnbondingsByFarmer(ctx, farmerAcc, cb)
+	} else {
+		k.Keeper.IterateUnbondingsByFarmerAndDenom(ctx, farmerAcc, req.StakingCoinDenom, cb)
+	}
+
+	return &types.QueryUnbondingsResponse{Unbondings: unbondings}, nil
+}
+
+// StakingsDetail queries staking and queued staking records for a farmer,
+// along with their epoch information.
+func (k Querier) StakingsDetail(c context.Context, req *types.QueryStakingsDetailRequest) (*types.QueryStakingsDetailResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	farmerAcc, err := sdk.AccAddressFromBech32(req.Farmer)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.StakingCoinDenom != "" {
+		if err := sdk.ValidateDenom(req.StakingCoinDenom); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stakings := []types.StakingDetail{}
+	k.Keeper.IterateStakingsByFarmer(ctx, farmerAcc, func(stakingCoinDenom string, staking types.Staking) (stop bool) {
+		if req.StakingCoinDenom != "" && stakingCoinDenom != req.StakingCoinDenom {
+			return false
+		}
+		stakings = append(stakings, types.StakingDetail{
+			StakingCoinDenom: stakingCoinDenom,
+			Amount:           staking.Amount,
+			StartingEpoch:    staking.StartingEpoch,
+			CurrentEpoch:     k.Keeper.GetCurrentEpoch(ctx, stakingCoinDenom),
+		})
+		return false
+	})
+
+	resp := &types.QueryStakingsDetailResponse{
+		Stakings:       stakings,
+		QueuedStakings: k.queuedStakingDetails(ctx, farmerAcc, req.StakingCoinDenom),
+	}
+	if t, found := k.Keeper.GetNextEpochTime(ctx); found {
+		resp.NextEpochTime = &t
+	}
+
+	return resp, nil
+}
+
+// QueuedStakings queries queued stakings for a farmer, along with the
+// expected time they become staked.
+func (k Querier) QueuedStakings(c context.Context, req *types.QueryQueuedStakingsRequest) (*types.QueryQueuedStakingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	farmerAcc, err := sdk.AccAddressFromBech32(req.Farmer)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.StakingCoinDenom != "" {
+		if err := sdk.ValidateDenom(req.StakingCoinDenom); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp := &types.QueryQueuedStakingsResponse{
+		QueuedStakings: k.queuedStakingDetails(ctx, farmerAcc, req.StakingCoinDenom),
+	}
+	if t, found := k.Keeper.GetNextEpochTime(ctx); found {
+		resp.NextEpochTime = &t
+	}
+
+	return resp, nil
+}
+
+// StakingsByDenom queries all stakings of farmers for a staking coin denom.
+// Locked stakings and queued locked stakings are returned as well, but only
+// the stakings are paginated.
+func (k Querier) StakingsByDenom(c context.Context, req *types.QueryStakingsByDenomRequest) (*types.QueryStakingsByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.StakingCoinDenom); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	stakingStore := prefix.NewStore(store, types.GetStakingsByDenomPrefix(req.StakingCoinDenom))
+
+	var stakings []types.FarmerStaking
+	pageRes, err := query.Paginate(stakingStore, req.Pagination, func(key, value []byte) error {
+		var staking types.Staking
+		if err := k.cdc.Unmarshal(value, &staking); err != nil {
+			return err
+		}
+
+		stakings = append(stakings, types.FarmerStaking{
+			Farmer:        sdk.AccAddress(key).String(),
+			Amount:        staking.Amount,
+			StartingEpoch: staking.StartingEpoch,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStakingsByDenomResponse{
+		Stakings:             stakings,
+		Pagination:           pageRes,
+		LockedStakings:       k.GetLockedStakingsByDenom(ctx, req.StakingCoinDenom),
+		QueuedLockedStakings: k.GetQueuedLockedStakingsByDenom(ctx, req.StakingCoinDenom),
+	}, nil
+}
+
+// QueuedStakingsByDenom queries all queued stakings of farmers for a staking coin denom.
+func (k Querier) QueuedStakingsByDenom(c context.Context, req *types.QueryQueuedStakingsByDenomRequest) (*types.QueryQueuedStakingsByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.StakingCoinDenom); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	queuedStakingStore := prefix.NewStore(store, types.GetQueuedStakingsByDenomPrefix(req.StakingCoinDenom))
+
+	var queuedStakings []types.FarmerQueuedStaking
+	pageRes, err := query.Paginate(queuedStakingStore, req.Pagination, func(key, value []byte) error {
+		var queuedStaking types.QueuedStaking
+		if err := k.cdc.Unmarshal(value, &queuedStaking); err != nil {
+			return err
+		}
+
+		queuedStakings = append(queuedStakings, types.FarmerQueuedStaking{
+			Farmer: sdk.AccAddress(key).String(),
+			Amount: queuedStaking.Amount,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryQueuedStakingsByDenomResponse{QueuedStakings: queuedStakings, Pagination: pageRes}, nil
+}
+
+// queuedStakingDetails returns queued staking details by a farmer.
+// If stakingCoinDenom is not empty, only the queued staking for the denom
+// is returned.
+func (k Querier) queuedStakingDetails(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string) []types.QueuedStakingDetail {
+	queuedStakings := []types.QueuedStakingDetail{}
+	k.Keeper.IterateQueuedStakingsByFarmer(ctx, farmerAcc, func(denom string, queuedStaking types.QueuedStaking) (stop bool) {
+		if stakingCoinDenom != "" && denom != stakingCoinDenom {
+			return false
+		}
+		queuedStakings = append(queuedStakings, types.QueuedStakingDetail{
+			StakingCoinDenom: denom,
+			Amount:           queuedStaking.Amount,
+		})
+		return false
+	})
+	return queuedStakings
+}
+
+// HistoricalRewards queries historical rewards for a staking coin denom.
+func (k Querier) HistoricalRewards(c context.Context, req *types.QueryHistoricalRewardsRequest) (*types.QueryHistoricalRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.StakingCoinDenom); err != nil {
+		return nil, err
+	}
+
+	if req.EndingEpoch != 0 && req.EndingEpoch < req.StartingEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "ending epoch %d must not be less than starting epoch %d", req.EndingEpoch, req.StartingEpoch)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	historicalRewardsStore := newEpochRangeStore(
+		prefix.NewStore(store, types.GetHistoricalRewardsPrefix(req.StakingCoinDenom)), req.StartingEpoch, req.EndingEpoch)
+
+	var historicalRewards []types.HistoricalRewardsResponse
+	pageRes, err := query.Paginate(historicalRewardsStore, req.Pagination, func(key, value []byte) error {
+		var rewards types.HistoricalRewards
+		if err := k.cdc.Unmarshal(value, &rewards); err != nil {
+			return err
+		}
+
+		historicalRewards = append(historicalRewards, types.HistoricalRewardsResponse{
+			Epoch:                 sdk.BigEndianToUint64(key),
+			CumulativeUnitRewards: rewards.CumulativeUnitRewards,
+			ReferenceCount:        rewards.ReferenceCount,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHistoricalRewardsResponse{HistoricalRewards: historicalRewards, Pagination: pageRes}, nil
+}
+
+// CurrentEpoch queries the current epoch for a staking coin denom.
+func (k Querier) CurrentEpoch(c context.Context, req *types.QueryCurrentEpochRequest) (*types.QueryCurrentEpochResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.StakingCoinDenom); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.GetCurrentEpochKey(req.StakingCoinDenom)) {
+		return nil, status.Errorf(codes.NotFound, "current epoch for %s not found", req.StakingCoinDenom)
+	}
+	currentEpoch := k.Keeper.GetCurrentEpoch(ctx, req.StakingCoinDenom)
+
+	return &types.QueryCurrentEpochResponse{CurrentEpoch: currentEpoch}, nil
+}
+
+// OutstandingRewards queries outstanding rewards for a staking coin denom.
+func (k Querier) OutstandingRewards(c context.Context, req *types.QueryOutstandingRewardsRequest) (*types.QueryOutstandingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.StakingCoinDenom); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	outstanding, found := k.Keeper.GetOutstandingRewards(ctx, req.StakingCoinDenom)
+	if !found {
+		outstanding.Rewards = sdk.DecCoins{}
+	}
+
+	return &types.QueryOutstandingRewardsResponse{Rewards: outstanding.Rewards}, nil
+}
+
+// AnnualRewards queries estimated annual rewards per unit of staking coin.
+func (k Querier) AnnualRewards(c context.Context, req *types.QueryAnnualRewardsRequest) (*types.QueryAnnualRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.StakingCoinDenom != "" {
+		if err := sdk.ValidateDenom(req.StakingCoinDenom); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.PlanId != 0 {
+		if _, found := k.Keeper.GetPlan(ctx, req.PlanId); !found {
+			return nil, status.Errorf(codes.NotFound, "plan %d not found", req.PlanId)
+		}
+	}
+
+	allAnnualRewards, epochsPerYear := k.Keeper.EstimateAnnualRewards(ctx)
+
+	annualRewards := []types.StakingCoinAnnualRewards{}
+	for _, ar := range allAnnualRewards {
+		if req.StakingCoinDenom != "" && ar.StakingCoinDenom != req.StakingCoinDenom {
+			continue
+		}
+
+		if req.PlanId != 0 {
+			var plans []types.PlanAnnualRewards
+			for _, plan := range ar.Plans {
+				if plan.PlanId == req.PlanId {
+					plans = append(plans, plan)
+				}
+			}
+			if len(plans) == 0 {
+				continue
+			}
+			// Only count rewards from the plan.
+			ar.Plans = plans
+			ar.EpochUnitRewards = plans[0].EpochUnitRewards
+			ar.AnnualUnitRewards = plans[0].AnnualUnitRewards
+		}
+
+		annualRewards = append(annualRewards, ar)
+	}
+
+	return &types.QueryAnnualRewardsResponse{AnnualRewards: annualRewards, EpochsPerYear: epochsPerYear}, nil
+}
+
+// AllocationPreview queries the rewards allocation that will happen at the
+// end of the current epoch.
+// It runs the allocation on a cached context at the next epoch time, so the
+// state is not modified and plans starting or ending before then are
+// counted as the end blocker will count them.
+func (k Querier) AllocationPreview(c context.Context, req *types.QueryAllocationPreviewRequest) (*types.QueryAllocationPreviewResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	cacheCtx, _ := ctx.CacheContext()
+	if nextEpochTime, found := k.Keeper.GetNextEpochTime(ctx); found && nextEpochTime.After(ctx.BlockTime()) {
+		cacheCtx = cacheCtx.WithBlockTime(nextEpochTime)
+	}
+
+	allocInfos, skippedInfos := k.Keeper.AllocationInfosWithSkipped(cacheCtx)
+	sort.Slice(allocInfos, func(i, j int) bool {
+		return allocInfos[i].Plan.GetId() < allocInfos[j].Plan.GetId()
+	})
+	sort.Slice(skippedInfos, func(i, j int) bool {
+		return skippedInfos[i].Plan.GetId() < skippedInfos[j].Plan.GetId()
+	})
+
+	resp := &types.QueryAllocationPreviewResponse{
+		Allocations:  []types.PlanAllocationPreview{},
+		UnitRewards:  []types.UnitRewardsPreview{},
+		SkippedPlans: []types.SkippedPlanPreview{},
+	}
+	for _, allocInfo := range allocInfos {
+		resp.Allocations = append(resp.Allocations, types.PlanAllocationPreview{
+			PlanId:             allocInfo.Plan.GetId(),
+			FarmingPoolAddress: allocInfo.Plan.GetFarmingPoolAddress().String(),
+			Amount:             allocInfo.Amount,
+		})
+	}
+	for _, skippedInfo := range skippedInfos {
+		resp.SkippedPlans = append(resp.SkippedPlans, types.SkippedPlanPreview{
+			PlanId:             skippedInfo.Plan.GetId(),
+			FarmingPoolAddress: skippedInfo.Plan.GetFarmingPoolAddress().String(),
+			Amount:             skippedInfo.Amount,
+			Reason: fmt.Sprintf("insufficient farming pool balance; requested %s, available %s",
+				skippedInfo.RequestedAmount, skippedInfo.AvailableBalance),
+		})
+	}
+
+	// Run the allocation and compare historical rewards before and after it,
+	// to get the unit rewards increase for each staking coin denom.
+	// The previous historical rewards are read in advance, since the
+	// allocation deletes them once no staking references them.
+	prevEpochs := map[string]uint64{}
+	prevHistoricals := map[string]types.HistoricalRewards{}
+	k.Keeper.IterateCurrentEpochs(cacheCtx, func(stakingCoinDenom string, currentEpoch uint64) (stop bool) {
+		prevEpochs[stakingCoinDenom] = currentEpoch
+		prevHistoricals[stakingCoinDenom], _ = k.Keeper.GetHistoricalRewards(cacheCtx, stakingCoinDenom, currentEpoch-1)
+		return false
+	})
+
+	if err := k.Keeper.AllocateRewards(cacheCtx); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	k.Keeper.IterateCurrentEpochs(cacheCtx, func(stakingCoinDenom string, currentEpoch uint64) (stop bool) {
+		prevEpoch := prevEpochs[stakingCoinDenom]
+		if currentEpoch == prevEpoch {
+			return false
+		}
+		prev := prevHistoricals[stakingCoinDenom]
+		cur, _ := k.Keeper.GetHistoricalRewards(cacheCtx, stakingCoinDenom, prevEpoch)
+		resp.UnitRewards = append(resp.UnitRewards, types.UnitRewardsPreview{
+			StakingCoinDenom: stakingCoinDenom,
+			UnitRewards:      cur.CumulativeUnitRewards.Sub(prev.CumulativeUnitRewards),
+		})
+		return false
+	})
+
+	return resp, nil
+}
+
+// PlanFunding queries the budgets funding the farming pool of a plan,
+// with the projected inflow and the plan's outflow per epoch.
+func (k Querier) PlanFunding(c context.Context, req *types.QueryPlanFundingRequest) (*types.QueryPlanFundingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	plan, found := k.Keeper.GetPlan(ctx, req.PlanId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "plan %d not found", req.PlanId)
+	}
+
+	resp := k.Keeper.PlanFunding(ctx, plan)
+
+	return &resp, nil
+}
+
+// AllowedStakingDenoms queries the denoms allowed to be staked.
+func (k Querier) AllowedStakingDenoms(c context.Context, req *types.QueryAllowedStakingDenomsRequest) (*types.QueryAllowedStakingDenomsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	currentEpochDays := k.Keeper.GetCurrentEpochDays(ctx)
 
-	return &types.QueryCurrentEpochDaysResponse{CurrentEpochDays: currentEpochDays}, nil
+	return &types.QueryAllowedStakingDenomsResponse{Denoms: k.Keeper.GetAllAllowedStakingDenoms(ctx)}, nil
 }
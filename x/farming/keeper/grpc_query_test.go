@@ -1,8 +1,12 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
+	simapp "github.com/tendermint/farming/app"
 	"github.com/tendermint/farming/x/farming"
 	"github.com/tendermint/farming/x/farming/types"
 )
@@ -430,3 +434,952 @@ func (suite *KeeperTestSuite) TestGRPCRewards() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCStakingsDetail() {
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000), sdk.NewInt64Coin(denom2, 1500)))
+	suite.AdvanceEpoch()
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500)))
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryStakingsDetailRequest
+		expectErr bool
+		postRun   func(*types.QueryStakingsDetailResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"query by farmer addr",
+			&types.QueryStakingsDetailRequest{Farmer: suite.addrs[0].String()},
+			false,
+			func(resp *types.QueryStakingsDetailResponse) {
+				suite.Require().Len(resp.Stakings, 2)
+				suite.Require().Equal(denom1, resp.Stakings[0].StakingCoinDenom)
+				suite.Require().True(intEq(sdk.NewInt(1000), resp.Stakings[0].Amount))
+				suite.Require().Equal(denom2, resp.Stakings[1].StakingCoinDenom)
+				suite.Require().True(intEq(sdk.NewInt(1500), resp.Stakings[1].Amount))
+				for _, staking := range resp.Stakings {
+					suite.Require().Equal(suite.keeper.GetCurrentEpoch(suite.ctx, staking.StakingCoinDenom), staking.CurrentEpoch)
+					st, found := suite.keeper.GetStaking(suite.ctx, staking.StakingCoinDenom, suite.addrs[0])
+					suite.Require().True(found)
+					suite.Require().Equal(st.StartingEpoch, staking.StartingEpoch)
+				}
+				suite.Require().Len(resp.QueuedStakings, 1)
+				suite.Require().Equal(denom1, resp.QueuedStakings[0].StakingCoinDenom)
+				suite.Require().True(intEq(sdk.NewInt(500), resp.QueuedStakings[0].Amount))
+				nextEpochTime, _ := suite.keeper.GetNextEpochTime(suite.ctx)
+				suite.Require().NotNil(resp.NextEpochTime)
+				suite.Require().Equal(nextEpochTime, *resp.NextEpochTime)
+			},
+		},
+		{
+			"query with staking coin denom",
+			&types.QueryStakingsDetailRequest{Farmer: suite.addrs[0].String(), StakingCoinDenom: denom2},
+			false,
+			func(resp *types.QueryStakingsDetailResponse) {
+				suite.Require().Len(resp.Stakings, 1)
+				suite.Require().Equal(denom2, resp.Stakings[0].StakingCoinDenom)
+				suite.Require().True(intEq(sdk.NewInt(1500), resp.Stakings[0].Amount))
+				suite.Require().Empty(resp.QueuedStakings)
+			},
+		},
+		{
+			"farmer without stakings",
+			&types.QueryStakingsDetailRequest{Farmer: suite.addrs[1].String()},
+			false,
+			func(resp *types.QueryStakingsDetailResponse) {
+				suite.Require().Empty(resp.Stakings)
+				suite.Require().Empty(resp.QueuedStakings)
+			},
+		},
+		{
+			"invalid farmer addr",
+			&types.QueryStakingsDetailRequest{Farmer: "invalid"},
+			true,
+			nil,
+		},
+		{
+			"invalid staking coin denom",
+			&types.QueryStakingsDetailRequest{Farmer: suite.addrs[0].String(), StakingCoinDenom: "!"},
+			true,
+			nil,
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.StakingsDetail(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueuedStakings() {
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000), sdk.NewInt64Coin(denom2, 1500)))
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryQueuedStakingsRequest
+		expectErr bool
+		postRun   func(*types.QueryQueuedStakingsResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"query by farmer addr",
+			&types.QueryQueuedStakingsRequest{Farmer: suite.addrs[0].String()},
+			false,
+			func(resp *types.QueryQueuedStakingsResponse) {
+				suite.Require().Len(resp.QueuedStakings, 2)
+				suite.Require().Equal(denom1, resp.QueuedStakings[0].StakingCoinDenom)
+				suite.Require().True(intEq(sdk.NewInt(1000), resp.QueuedStakings[0].Amount))
+				suite.Require().Equal(denom2, resp.QueuedStakings[1].StakingCoinDenom)
+				suite.Require().True(intEq(sdk.NewInt(1500), resp.QueuedStakings[1].Amount))
+			},
+		},
+		{
+			"query with staking coin denom",
+			&types.QueryQueuedStakingsRequest{Farmer: suite.addrs[0].String(), StakingCoinDenom: denom1},
+			false,
+			func(resp *types.QueryQueuedStakingsResponse) {
+				suite.Require().Len(resp.QueuedStakings, 1)
+				suite.Require().Equal(denom1, resp.QueuedStakings[0].StakingCoinDenom)
+			},
+		},
+		{
+			"invalid farmer addr",
+			&types.QueryQueuedStakingsRequest{Farmer: "invalid"},
+			true,
+			nil,
+		},
+		{
+			"invalid staking coin denom",
+			&types.QueryQueuedStakingsRequest{Farmer: suite.addrs[0].String(), StakingCoinDenom: "!"},
+			true,
+			nil,
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.QueuedStakings(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCStakingsByDenom() {
+	for i := 0; i < 3; i++ {
+		suite.Stake(suite.addrs[i], sdk.NewCoins(sdk.NewInt64Coin(denom1, int64(1000*(i+1)))))
+	}
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom2, 1000)))
+	suite.setLockTiers(sdk.NewDec(2))
+	suite.StakeLocked(suite.addrs[4], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000)))
+	suite.AdvanceEpoch()
+	suite.Stake(suite.addrs[3], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000)))
+	suite.StakeLocked(suite.addrs[5], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500), sdk.NewInt64Coin(denom2, 500)))
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryStakingsByDenomRequest
+		expectErr bool
+		postRun   func(*types.QueryStakingsByDenomResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"query by staking coin denom",
+			&types.QueryStakingsByDenomRequest{StakingCoinDenom: denom1},
+			false,
+			func(resp *types.QueryStakingsByDenomResponse) {
+				suite.Require().Len(resp.Stakings, 3)
+				amounts := map[string]sdk.Int{}
+				for _, staking := range resp.Stakings {
+					suite.Require().Equal(uint64(1), staking.StartingEpoch)
+					amounts[staking.Farmer] = staking.Amount
+				}
+				for i := 0; i < 3; i++ {
+					suite.Require().True(intEq(sdk.NewInt(int64(1000*(i+1))), amounts[suite.addrs[i].String()]))
+				}
+				// Locked stakings are listed separately.
+				suite.Require().NotContains(amounts, suite.addrs[4].String())
+				suite.Require().Len(resp.LockedStakings, 1)
+				suite.Require().Equal(suite.addrs[4].String(), resp.LockedStakings[0].Farmer)
+				suite.Require().True(intEq(sdk.NewInt(1000), resp.LockedStakings[0].Amount))
+				suite.Require().Len(resp.QueuedLockedStakings, 1)
+				suite.Require().Equal(suite.addrs[5].String(), resp.QueuedLockedStakings[0].Farmer)
+				suite.Require().Equal(denom1, resp.QueuedLockedStakings[0].StakingCoinDenom)
+				suite.Require().True(intEq(sdk.NewInt(500), resp.QueuedLockedStakings[0].Amount))
+			},
+		},
+		{
+			"query with pagination",
+			&types.QueryStakingsByDenomRequest{StakingCoinDenom: denom1, Pagination: &query.PageRequest{Limit: 2}},
+			false,
+			func(resp *types.QueryStakingsByDenomResponse) {
+				suite.Require().Len(resp.Stakings, 2)
+				suite.Require().NotNil(resp.Pagination.NextKey)
+			},
+		},
+		{
+			"no stakings",
+			&types.QueryStakingsByDenomRequest{StakingCoinDenom: denom3},
+			false,
+			func(resp *types.QueryStakingsByDenomResponse) {
+				suite.Require().Empty(resp.Stakings)
+				suite.Require().Empty(resp.LockedStakings)
+				suite.Require().Empty(resp.QueuedLockedStakings)
+			},
+		},
+		{
+			"invalid staking coin denom",
+			&types.QueryStakingsByDenomRequest{StakingCoinDenom: "!"},
+			true,
+			nil,
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.StakingsByDenom(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueuedStakingsByDenom() {
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000)))
+	suite.AdvanceEpoch()
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000)))
+	suite.Stake(suite.addrs[2], sdk.NewCoins(sdk.NewInt64Coin(denom1, 2000)))
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryQueuedStakingsByDenomRequest
+		expectErr bool
+		postRun   func(*types.QueryQueuedStakingsByDenomResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"query by staking coin denom",
+			&types.QueryQueuedStakingsByDenomRequest{StakingCoinDenom: denom1},
+			false,
+			func(resp *types.QueryQueuedStakingsByDenomResponse) {
+				suite.Require().Len(resp.QueuedStakings, 2)
+				amounts := map[string]sdk.Int{}
+				for _, queuedStaking := range resp.QueuedStakings {
+					amounts[queuedStaking.Farmer] = queuedStaking.Amount
+				}
+				suite.Require().True(intEq(sdk.NewInt(1000), amounts[suite.addrs[1].String()]))
+				suite.Require().True(intEq(sdk.NewInt(2000), amounts[suite.addrs[2].String()]))
+			},
+		},
+		{
+			"query with pagination",
+			&types.QueryQueuedStakingsByDenomRequest{StakingCoinDenom: denom1, Pagination: &query.PageRequest{Limit: 1}},
+			false,
+			func(resp *types.QueryQueuedStakingsByDenomResponse) {
+				suite.Require().Len(resp.QueuedStakings, 1)
+				suite.Require().NotNil(resp.Pagination.NextKey)
+			},
+		},
+		{
+			"no queued stakings",
+			&types.QueryQueuedStakingsByDenomRequest{StakingCoinDenom: denom2},
+			false,
+			func(resp *types.QueryQueuedStakingsByDenomResponse) {
+				suite.Require().Empty(resp.QueuedStakings)
+			},
+		},
+		{
+			"invalid staking coin denom",
+			&types.QueryQueuedStakingsByDenomRequest{StakingCoinDenom: "!"},
+			true,
+			nil,
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.QueuedStakingsByDenom(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCHistoricalRewards() {
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	// Each farmer's staking references the historical rewards of a different
+	// epoch, so that none of them gets pruned.
+	for i := 0; i < 4; i++ {
+		suite.Stake(suite.addrs[i], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+		suite.AdvanceEpoch()
+	}
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryHistoricalRewardsRequest
+		expectErr bool
+		postRun   func(*types.QueryHistoricalRewardsResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"query by staking coin denom",
+			&types.QueryHistoricalRewardsRequest{StakingCoinDenom: denom1},
+			false,
+			func(resp *types.QueryHistoricalRewardsResponse) {
+				suite.Require().Len(resp.HistoricalRewards, 4)
+				for i, rewards := range resp.HistoricalRewards {
+					suite.Require().Equal(uint64(i), rewards.Epoch)
+				}
+				suite.Require().True(decCoinsEq(
+					sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 1)),
+					resp.HistoricalRewards[1].CumulativeUnitRewards))
+				// The latest historical rewards is also referenced by the current epoch.
+				suite.Require().Equal(uint32(1), resp.HistoricalRewards[2].ReferenceCount)
+				suite.Require().Equal(uint32(2), resp.HistoricalRewards[3].ReferenceCount)
+			},
+		},
+		{
+			"query with epoch range",
+			&types.QueryHistoricalRewardsRequest{StakingCoinDenom: denom1, StartingEpoch: 1, EndingEpoch: 2},
+			false,
+			func(resp *types.QueryHistoricalRewardsResponse) {
+				suite.Require().Len(resp.HistoricalRewards, 2)
+				suite.Require().Equal(uint64(1), resp.HistoricalRewards[0].Epoch)
+				suite.Require().Equal(uint64(2), resp.HistoricalRewards[1].Epoch)
+			},
+		},
+		{
+			"query with pagination",
+			&types.QueryHistoricalRewardsRequest{StakingCoinDenom: denom1, StartingEpoch: 1, Pagination: &query.PageRequest{Limit: 2}},
+			false,
+			func(resp *types.QueryHistoricalRewardsResponse) {
+				suite.Require().Len(resp.HistoricalRewards, 2)
+				suite.Require().Equal(uint64(1), resp.HistoricalRewards[0].Epoch)
+				suite.Require().NotNil(resp.Pagination.NextKey)
+			},
+		},
+		{
+			"query with pagination offset",
+			&types.QueryHistoricalRewardsRequest{StakingCoinDenom: denom1, StartingEpoch: 1, EndingEpoch: 2, Pagination: &query.PageRequest{Offset: 1, CountTotal: true}},
+			false,
+			func(resp *types.QueryHistoricalRewardsResponse) {
+				suite.Require().Len(resp.HistoricalRewards, 1)
+				suite.Require().Equal(uint64(2), resp.HistoricalRewards[0].Epoch)
+				suite.Require().Equal(uint64(2), resp.Pagination.Total)
+			},
+		},
+		{
+			"query with pagination key beyond the epoch range",
+			&types.QueryHistoricalRewardsRequest{StakingCoinDenom: denom1, EndingEpoch: 1, Pagination: &query.PageRequest{Key: sdk.Uint64ToBigEndian(3)}},
+			false,
+			func(resp *types.QueryHistoricalRewardsResponse) {
+				suite.Require().Empty(resp.HistoricalRewards)
+			},
+		},
+		{
+			"no historical rewards",
+			&types.QueryHistoricalRewardsRequest{StakingCoinDenom: denom2},
+			false,
+			func(resp *types.QueryHistoricalRewardsResponse) {
+				suite.Require().Empty(resp.HistoricalRewards)
+			},
+		},
+		{
+			"invalid epoch range",
+			&types.QueryHistoricalRewardsRequest{StakingCoinDenom: denom1, StartingEpoch: 3, EndingEpoch: 2},
+			true,
+			nil,
+		},
+		{
+			"invalid staking coin denom",
+			&types.QueryHistoricalRewardsRequest{StakingCoinDenom: "!"},
+			true,
+			nil,
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.HistoricalRewards(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCCurrentEpoch() {
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryCurrentEpochRequest
+		expectErr bool
+		postRun   func(*types.QueryCurrentEpochResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"query by staking coin denom",
+			&types.QueryCurrentEpochRequest{StakingCoinDenom: denom1},
+			false,
+			func(resp *types.QueryCurrentEpochResponse) {
+				suite.Require().Equal(uint64(2), resp.CurrentEpoch)
+			},
+		},
+		{
+			"staking coin denom without stakings",
+			&types.QueryCurrentEpochRequest{StakingCoinDenom: denom2},
+			true,
+			nil,
+		},
+		{
+			"invalid staking coin denom",
+			&types.QueryCurrentEpochRequest{StakingCoinDenom: "!"},
+			true,
+			nil,
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.CurrentEpoch(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCEpochInfo() {
+	resp, err := suite.querier.EpochInfo(sdk.WrapSDKContext(suite.ctx), nil)
+	suite.Require().Error(err)
+	suite.Require().Nil(resp)
+
+	// Before the first epoch has started.
+	resp, err = suite.querier.EpochInfo(sdk.WrapSDKContext(suite.ctx), &types.QueryEpochInfoRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), resp.GlobalEpoch)
+	suite.Require().Nil(resp.LastEpochTime)
+	suite.Require().Equal(24*time.Hour, resp.CurrentEpochDuration)
+	suite.Require().Equal(24*time.Hour, resp.NextEpochDuration)
+	suite.Require().Nil(resp.NextEpochTime)
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-11T12:34:56Z"))
+	farming.EndBlocker(suite.ctx, suite.keeper)
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-12T00:00:05Z"))
+	farming.EndBlocker(suite.ctx, suite.keeper)
+
+	// The new epoch duration takes effect after the current epoch.
+	params := suite.keeper.GetParams(suite.ctx)
+	params.NextEpochDuration = time.Hour
+	suite.keeper.SetParams(suite.ctx, params)
+
+	resp, err = suite.querier.EpochInfo(sdk.WrapSDKContext(suite.ctx), &types.QueryEpochInfoRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), resp.GlobalEpoch)
+	suite.Require().Equal(types.ParseTime("2021-08-12T00:00:05Z"), *resp.LastEpochTime)
+	suite.Require().Equal(24*time.Hour, resp.CurrentEpochDuration)
+	suite.Require().Equal(time.Hour, resp.NextEpochDuration)
+	suite.Require().Equal(types.ParseTime("2021-08-13T00:00:00Z"), *resp.NextEpochTime)
+}
+
+func (suite *KeeperTestSuite) TestGRPCOutstandingRewards() {
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryOutstandingRewardsRequest
+		expectErr bool
+		postRun   func(*types.QueryOutstandingRewardsResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"query by staking coin denom",
+			&types.QueryOutstandingRewardsRequest{StakingCoinDenom: denom1},
+			false,
+			func(resp *types.QueryOutstandingRewardsResponse) {
+				suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 1000000)), resp.Rewards))
+			},
+		},
+		{
+			"staking coin denom without stakings",
+			&types.QueryOutstandingRewardsRequest{StakingCoinDenom: denom2},
+			false,
+			func(resp *types.QueryOutstandingRewardsResponse) {
+				suite.Require().True(resp.Rewards.IsZero())
+			},
+		},
+		{
+			"invalid staking coin denom",
+			&types.QueryOutstandingRewardsRequest{StakingCoinDenom: "!"},
+			true,
+			nil,
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.OutstandingRewards(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCAnnualRewards() {
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "0.5", denom2: "0.5"}, map[string]int64{denom3: 1000000})
+	suite.CreateFixedAmountPlan(suite.addrs[5], map[string]string{denom1: "1"}, map[string]int64{denom3: 500000})
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000), sdk.NewInt64Coin(denom2, 2000000)))
+	suite.keeper.ProcessQueuedCoins(suite.ctx)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryAnnualRewardsRequest
+		expectErr bool
+		postRun   func(*types.QueryAnnualRewardsResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"query all",
+			&types.QueryAnnualRewardsRequest{},
+			false,
+			func(resp *types.QueryAnnualRewardsResponse) {
+				suite.Require().Len(resp.AnnualRewards, 2)
+				suite.Require().True(decEq(sdk.NewDec(365), resp.EpochsPerYear))
+			},
+		},
+		{
+			"query by staking coin denom",
+			&types.QueryAnnualRewardsRequest{StakingCoinDenom: denom1},
+			false,
+			func(resp *types.QueryAnnualRewardsResponse) {
+				suite.Require().Len(resp.AnnualRewards, 1)
+				suite.Require().Equal(denom1, resp.AnnualRewards[0].StakingCoinDenom)
+				suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 365)), resp.AnnualRewards[0].AnnualUnitRewards))
+			},
+		},
+		{
+			"query by plan id",
+			&types.QueryAnnualRewardsRequest{PlanId: 2},
+			false,
+			func(resp *types.QueryAnnualRewardsResponse) {
+				suite.Require().Len(resp.AnnualRewards, 1)
+				suite.Require().Equal(denom1, resp.AnnualRewards[0].StakingCoinDenom)
+				suite.Require().Len(resp.AnnualRewards[0].Plans, 1)
+				suite.Require().True(decCoinsEq(
+					sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom3, sdk.MustNewDecFromStr("182.5"))),
+					resp.AnnualRewards[0].AnnualUnitRewards))
+			},
+		},
+		{
+			"plan not found",
+			&types.QueryAnnualRewardsRequest{PlanId: 3},
+			true,
+			nil,
+		},
+		{
+			"invalid staking coin denom",
+			&types.QueryAnnualRewardsRequest{StakingCoinDenom: "!"},
+			true,
+			nil,
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.AnnualRewards(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCAllocationPreview() {
+	farmingPoolAcc := simapp.AddTestAddrs(suite.app, suite.ctx, 1, sdk.ZeroInt())[0]
+	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, farmingPoolAcc, sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)))
+	suite.Require().NoError(err)
+
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "0.5", denom2: "0.5"}, map[string]int64{denom3: 1000000})
+	// The sum of epoch amounts of these plans is over the balances the farming pool has.
+	suite.CreateFixedAmountPlan(farmingPoolAcc, map[string]string{denom1: "1"}, map[string]int64{denom3: 700000})
+	suite.CreateFixedAmountPlan(farmingPoolAcc, map[string]string{denom2: "1"}, map[string]int64{denom3: 400000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000), sdk.NewInt64Coin(denom2, 2000000)))
+	suite.keeper.ProcessQueuedCoins(suite.ctx)
+
+	_, err = suite.querier.AllocationPreview(sdk.WrapSDKContext(suite.ctx), nil)
+	suite.Require().Error(err)
+
+	resp, err := suite.querier.AllocationPreview(sdk.WrapSDKContext(suite.ctx), &types.QueryAllocationPreviewRequest{})
+	suite.Require().NoError(err)
+
+	suite.Require().Len(resp.Allocations, 1)
+	suite.Require().Equal(uint64(1), resp.Allocations[0].PlanId)
+	suite.Require().Equal(suite.addrs[4].String(), resp.Allocations[0].FarmingPoolAddress)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)), resp.Allocations[0].Amount))
+
+	suite.Require().Len(resp.UnitRewards, 2)
+	for _, unitRewards := range resp.UnitRewards {
+		switch unitRewards.StakingCoinDenom {
+		case denom1:
+			suite.Require().True(decCoinsEq(
+				sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom3, sdk.MustNewDecFromStr("0.5"))),
+				unitRewards.UnitRewards))
+		case denom2:
+			suite.Require().True(decCoinsEq(
+				sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom3, sdk.MustNewDecFromStr("0.25"))),
+				unitRewards.UnitRewards))
+		default:
+			suite.FailNow("unexpected staking coin denom", unitRewards.StakingCoinDenom)
+		}
+	}
+
+	suite.Require().Len(resp.SkippedPlans, 2)
+	suite.Require().Equal(uint64(2), resp.SkippedPlans[0].PlanId)
+	suite.Require().Equal(farmingPoolAcc.String(), resp.SkippedPlans[0].FarmingPoolAddress)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 700000)), resp.SkippedPlans[0].Amount))
+	suite.Require().Equal(uint64(3), resp.SkippedPlans[1].PlanId)
+	suite.Require().NotEmpty(resp.SkippedPlans[1].Reason)
+
+	// The state must not be modified by the preview.
+	suite.Require().Equal(uint64(1), suite.keeper.GetCurrentEpoch(suite.ctx, denom1))
+	plan, _ := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().True(plan.GetDistributedCoins().IsZero())
+	suite.Require().True(coinsEq(initialBalances, suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[4])))
+}
+
+func (suite *KeeperTestSuite) TestGRPCAllocationPreviewAtNextEpochTime() {
+	// The plan starts after the current block time, but before the next epoch time.
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T12:00:00Z"))
+	suite.keeper.SetLastEpochTime(suite.ctx, types.ParseTime("2021-08-01T00:00:00Z"))
+	suite.keeper.SetPlan(suite.ctx, suite.sampleFixedAmtPlans[0])
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000), sdk.NewInt64Coin(denom2, 1000000)))
+	suite.keeper.ProcessQueuedCoins(suite.ctx)
+
+	resp, err := suite.querier.AllocationPreview(sdk.WrapSDKContext(suite.ctx), &types.QueryAllocationPreviewRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Allocations, 1)
+	suite.Require().Equal(uint64(1), resp.Allocations[0].PlanId)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)), resp.Allocations[0].Amount))
+
+	// The preview must match the allocation done at the next epoch time.
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-02T00:00:00Z"))
+	suite.AdvanceEpoch()
+	plan, _ := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().True(coinsEq(resp.Allocations[0].Amount, plan.GetDistributedCoins()))
+}
+
+func (suite *KeeperTestSuite) TestGRPCPlanFunding() {
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+
+	_, err := suite.querier.PlanFunding(sdk.WrapSDKContext(suite.ctx), nil)
+	suite.Require().EqualError(err, "rpc error: code = InvalidArgument desc = empty request")
+
+	_, err = suite.querier.PlanFunding(sdk.WrapSDKContext(suite.ctx), &types.QueryPlanFundingRequest{PlanId: 2})
+	suite.Require().EqualError(err, "rpc error: code = NotFound desc = plan 2 not found")
+
+	resp, err := suite.querier.PlanFunding(sdk.WrapSDKContext(suite.ctx), &types.QueryPlanFundingRequest{PlanId: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), resp.PlanId)
+	suite.Require().Equal(suite.addrs[4].String(), resp.FarmingPoolAddress)
+	suite.Require().Empty(resp.Budgets)
+	suite.Require().True(resp.ProjectedInflow.IsZero())
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)), resp.EpochOutflow))
+	suite.Require().False(resp.Sufficient)
+}
+
+func (suite *KeeperTestSuite) TestGRPCLockedStakings() {
+	suite.setLockTiers(sdk.NewDec(2))
+	suite.StakeLocked(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000), sdk.NewInt64Coin(denom2, 1500)))
+	suite.StakeLocked(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500)))
+	suite.AdvanceEpoch()
+	suite.StakeLocked(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom2, 700)))
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryLockedStakingsRequest
+		expectErr bool
+		postRun   func(*types.QueryLockedStakingsResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"query by farmer addr",
+			&types.QueryLockedStakingsRequest{Farmer: suite.addrs[0].String()},
+			false,
+			func(resp *types.QueryLockedStakingsResponse) {
+				suite.Require().Len(resp.LockedStakings, 3)
+				suite.Require().Equal(denom1, resp.LockedStakings[0].StakingCoinDenom)
+				suite.Require().True(intEq(sdk.NewInt(1000), resp.LockedStakings[0].Amount))
+				suite.Require().Equal(denom1, resp.LockedStakings[1].StakingCoinDenom)
+				suite.Require().True(intEq(sdk.NewInt(500), resp.LockedStakings[1].Amount))
+				suite.Require().Equal(denom2, resp.LockedStakings[2].StakingCoinDenom)
+				suite.Require().True(intEq(sdk.NewInt(1500), resp.LockedStakings[2].Amount))
+				suite.Require().Len(resp.QueuedLockedStakings, 1)
+				suite.Require().Equal(uint64(4), resp.QueuedLockedStakings[0].Id)
+				suite.Require().True(intEq(sdk.NewInt(700), resp.QueuedLockedStakings[0].Amount))
+			},
+		},
+		{
+			"query with staking coin denom",
+			&types.QueryLockedStakingsRequest{Farmer: suite.addrs[0].String(), StakingCoinDenom: denom2},
+			false,
+			func(resp *types.QueryLockedStakingsResponse) {
+				suite.Require().Len(resp.LockedStakings, 1)
+				suite.Require().Equal(uint64(2), resp.LockedStakings[0].Id)
+				suite.Require().Len(resp.QueuedLockedStakings, 1)
+				suite.Require().Equal(uint64(4), resp.QueuedLockedStakings[0].Id)
+			},
+		},
+		{
+			"query with staking coin denom without queued locked stakings",
+			&types.QueryLockedStakingsRequest{Farmer: suite.addrs[0].String(), StakingCoinDenom: denom1},
+			false,
+			func(resp *types.QueryLockedStakingsResponse) {
+				suite.Require().Len(resp.LockedStakings, 2)
+				suite.Require().Empty(resp.QueuedLockedStakings)
+			},
+		},
+		{
+			"farmer without locked stakings",
+			&types.QueryLockedStakingsRequest{Farmer: suite.addrs[1].String()},
+			false,
+			func(resp *types.QueryLockedStakingsResponse) {
+				suite.Require().Empty(resp.LockedStakings)
+				suite.Require().Empty(resp.QueuedLockedStakings)
+			},
+		},
+		{
+			"invalid farmer addr",
+			&types.QueryLockedStakingsRequest{Farmer: "invalid"},
+			true,
+			nil,
+		},
+		{
+			"invalid staking coin denom",
+			&types.QueryLockedStakingsRequest{Farmer: suite.addrs[0].String(), StakingCoinDenom: "!"},
+			true,
+			nil,
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.LockedStakings(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCUnbondings() {
+	suite.setUnbondingPeriod(unbondingPeriod)
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000), sdk.NewInt64Coin(denom2, 1500)))
+	suite.AdvanceEpoch()
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 400), sdk.NewInt64Coin(denom2, 1500)))
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 100)))
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryUnbondingsRequest
+		expectErr bool
+		postRun   func(*types.QueryUnbondingsResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"query by farmer addr",
+			&types.QueryUnbondingsRequest{Farmer: suite.addrs[0].String()},
+			false,
+			func(resp *types.QueryUnbondingsResponse) {
+				suite.Require().Len(resp.Unbondings, 3)
+				suite.Require().Equal(denom1, resp.Unbondings[0].StakingCoinDenom)
+				suite.Require().True(intEq(sdk.NewInt(400), resp.Unbondings[0].Amount))
+				suite.Require().Equal(denom1, resp.Unbondings[1].StakingCoinDenom)
+				suite.Require().True(intEq(sdk.NewInt(100), resp.Unbondings[1].Amount))
+				suite.Require().Equal(denom2, resp.Unbondings[2].StakingCoinDenom)
+				suite.Require().True(intEq(sdk.NewInt(1500), resp.Unbondings[2].Amount))
+			},
+		},
+		{
+			"query with staking coin denom",
+			&types.QueryUnbondingsRequest{Farmer: suite.addrs[0].String(), StakingCoinDenom: denom2},
+			false,
+			func(resp *types.QueryUnbondingsResponse) {
+				suite.Require().Len(resp.Unbondings, 1)
+				suite.Require().Equal(uint64(2), resp.Unbondings[0].Id)
+			},
+		},
+		{
+			"farmer without unbondings",
+			&types.QueryUnbondingsRequest{Farmer: suite.addrs[1].String()},
+			false,
+			func(resp *types.QueryUnbondingsResponse) {
+				suite.Require().Empty(resp.Unbondings)
+			},
+		},
+		{
+			"invalid farmer addr",
+			&types.QueryUnbondingsRequest{Farmer: "invalid"},
+			true,
+			nil,
+		},
+		{
+			"invalid staking coin denom",
+			&types.QueryUnbondingsRequest{Farmer: suite.addrs[0].String(), StakingCoinDenom: "!"},
+			true,
+			nil,
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.Unbondings(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCRewardsWithdrawAddress() {
+	err := suite.keeper.SetRewardsWithdrawAddress(suite.ctx, suite.addrs[0], suite.addrs[1])
+	suite.Require().NoError(err)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryRewardsWithdrawAddressRequest
+		expectErr bool
+		postRun   func(*types.QueryRewardsWithdrawAddressResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"query by farmer addr",
+			&types.QueryRewardsWithdrawAddressRequest{Farmer: suite.addrs[0].String()},
+			false,
+			func(resp *types.QueryRewardsWithdrawAddressResponse) {
+				suite.Require().Equal(suite.addrs[1].String(), resp.WithdrawAddress)
+			},
+		},
+		{
+			"farmer without withdraw address",
+			&types.QueryRewardsWithdrawAddressRequest{Farmer: suite.addrs[2].String()},
+			false,
+			func(resp *types.QueryRewardsWithdrawAddressResponse) {
+				suite.Require().Equal(suite.addrs[2].String(), resp.WithdrawAddress)
+			},
+		},
+		{
+			"invalid farmer addr",
+			&types.QueryRewardsWithdrawAddressRequest{Farmer: "invalid"},
+			true,
+			nil,
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.RewardsWithdrawAddress(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCAllowedStakingDenoms() {
+	_, err := suite.querier.AllowedStakingDenoms(sdk.WrapSDKContext(suite.ctx), nil)
+	suite.Require().Error(err)
+
+	resp, err := suite.querier.AllowedStakingDenoms(sdk.WrapSDKContext(suite.ctx), &types.QueryAllowedStakingDenomsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(resp.Denoms)
+
+	suite.keeper.SetAllowedStakingDenom(suite.ctx, denom2)
+	suite.keeper.SetAllowedStakingDenom(suite.ctx, denom1)
+
+	resp, err = suite.querier.AllowedStakingDenoms(sdk.WrapSDKContext(suite.ctx), &types.QueryAllowedStakingDenomsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{denom1, denom2}, resp.Denoms)
+}
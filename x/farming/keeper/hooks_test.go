@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/keeper"
+	"github.com/tendermint/farming/x/farming/types"
+
+	_ "github.com/stretchr/testify/suite"
+)
+
+var _ types.FarmingHooks = &mockFarmingHooks{}
+
+// mockFarmingHooks records every hook call it receives.
+type mockFarmingHooks struct {
+	staked           []sdk.Coins
+	unstaked         []sdk.Coins
+	withdrawnRewards map[string]sdk.Coins
+	processed        map[string]sdk.Int
+	epochsAdvanced   int
+	terminatedPlans  []uint64
+}
+
+func newMockFarmingHooks() *mockFarmingHooks {
+	return &mockFarmingHooks{
+		withdrawnRewards: map[string]sdk.Coins{},
+		processed:        map[string]sdk.Int{},
+	}
+}
+
+func (h *mockFarmingHooks) AfterStaked(_ sdk.Context, _ sdk.AccAddress, stakingCoins sdk.Coins) {
+	h.staked = append(h.staked, stakingCoins)
+}
+
+func (h *mockFarmingHooks) BeforeUnstaked(_ sdk.Context, _ sdk.AccAddress, unstakingCoins sdk.Coins) {
+	h.unstaked = append(h.unstaked, unstakingCoins)
+}
+
+func (h *mockFarmingHooks) AfterRewardsWithdrawn(_ sdk.Context, _ sdk.AccAddress, stakingCoinDenom string, rewards sdk.Coins) {
+	h.withdrawnRewards[stakingCoinDenom] = h.withdrawnRewards[stakingCoinDenom].Add(rewards...)
+}
+
+func (h *mockFarmingHooks) AfterQueuedStakingProcessed(_ sdk.Context, _ sdk.AccAddress, stakingCoinDenom string, amount sdk.Int) {
+	amt, ok := h.processed[stakingCoinDenom]
+	if !ok {
+		amt = sdk.ZeroInt()
+	}
+	h.processed[stakingCoinDenom] = amt.Add(amount)
+}
+
+func (h *mockFarmingHooks) AfterEpochAdvanced(_ sdk.Context) {
+	h.epochsAdvanced++
+}
+
+func (h *mockFarmingHooks) AfterPlanTerminated(_ sdk.Context, plan types.PlanI) {
+	h.terminatedPlans = append(h.terminatedPlans, plan.GetId())
+}
+
+// setMockHooks replaces suite.keeper with a new keeper that has mock hooks set.
+func (suite *KeeperTestSuite) setMockHooks() *mockFarmingHooks {
+	hooks := newMockFarmingHooks()
+	k := keeper.NewKeeper(
+		suite.app.AppCodec(), suite.app.GetKey(types.StoreKey), suite.app.GetSubspace(types.ModuleName),
+		suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.BudgetKeeper, suite.app.LiquidityKeeper, suite.app.ModuleAccountAddrs(),
+	)
+	suite.keeper = *k.SetHooks(types.NewMultiFarmingHooks(hooks))
+	return hooks
+}
+
+func (suite *KeeperTestSuite) TestSetHooksTwice() {
+	k := keeper.NewKeeper(
+		suite.app.AppCodec(), suite.app.GetKey(types.StoreKey), suite.app.GetSubspace(types.ModuleName),
+		suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.BudgetKeeper, suite.app.LiquidityKeeper, suite.app.ModuleAccountAddrs(),
+	)
+	k.SetHooks(types.NewMultiFarmingHooks())
+	suite.Require().Panics(func() {
+		k.SetHooks(types.NewMultiFarmingHooks())
+	})
+}
+
+func (suite *KeeperTestSuite) TestHooks() {
+	hooks := suite.setMockHooks()
+
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.Require().Len(hooks.staked, 1)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), hooks.staked[0]))
+
+	suite.AdvanceEpoch()
+	suite.Require().Equal(1, hooks.epochsAdvanced)
+	suite.Require().True(intEq(sdk.NewInt(1_000_000), hooks.processed[denom1]))
+
+	suite.AdvanceEpoch()
+	suite.Require().Equal(2, hooks.epochsAdvanced)
+
+	suite.Harvest(suite.addrs[0], []string{denom1})
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)), hooks.withdrawnRewards[denom1]))
+
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000)))
+	suite.Require().Len(hooks.unstaked, 1)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000)), hooks.unstaked[0]))
+
+	plan, found := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().NoError(suite.keeper.TerminatePlan(suite.ctx, plan))
+	suite.Require().Equal([]uint64{1}, hooks.terminatedPlans)
+}
+
+func (suite *KeeperTestSuite) TestHooksQueuedLockedStaking() {
+	hooks := suite.setMockHooks()
+	suite.setLockTiers(sdk.NewDec(2))
+
+	suite.StakeLocked(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom2, 500_000)))
+	suite.Require().Len(hooks.staked, 1)
+	suite.Require().Empty(hooks.processed)
+
+	suite.AdvanceEpoch()
+	suite.Require().True(intEq(sdk.NewInt(1_000_000), hooks.processed[denom1]))
+	suite.Require().True(intEq(sdk.NewInt(500_000), hooks.processed[denom2]))
+}
+
+func (suite *KeeperTestSuite) TestHooksWithdrawAllRewards() {
+	hooks := suite.setMockHooks()
+
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "0.5", denom2: "0.5"}, map[string]int64{denom3: 1000000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom2, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	rewards, err := suite.keeper.WithdrawAllRewards(suite.ctx, suite.addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)), rewards))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 500000)), hooks.withdrawnRewards[denom1]))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 500000)), hooks.withdrawnRewards[denom2]))
+}
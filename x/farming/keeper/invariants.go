@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -24,8 +25,14 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		OutstandingRewardsAmountInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "non-negative-historical-rewards",
 		NonNegativeHistoricalRewardsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "historical-rewards-reference-count",
+		HistoricalRewardsReferenceCountInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "positive-total-stakings-amount",
 		PositiveTotalStakingsAmountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "positive-locked-staking-amount",
+		PositiveLockedStakingAmountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "locked-stakings-amount",
+		LockedStakingsAmountInvariant(k))
 }
 
 // AllInvariants runs all invariants of the farming module.
@@ -38,7 +45,10 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			NonNegativeOutstandingRewardsInvariant,
 			OutstandingRewardsAmountInvariant,
 			NonNegativeHistoricalRewardsInvariant,
+			HistoricalRewardsReferenceCountInvariant,
 			PositiveTotalStakingsAmountInvariant,
+			PositiveLockedStakingAmountInvariant,
+			LockedStakingsAmountInvariant,
 		} {
 			res, stop := inv(k)(ctx)
 			if stop {
@@ -91,13 +101,13 @@ func PositiveQueuedStakingAmountInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
-// StakingReservedAmountInvariant checks that the balance of StakingReserveAcc greater than the amount of staked, Queued coins in all staking objects.
+// StakingReservedAmountInvariant checks that the balance of StakingReserveAcc greater than the amount of staked, Queued, locked and unbonding coins in all staking objects.
 func StakingReservedAmountInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		err := k.ValidateStakingReservedAmount(ctx)
 		broken := err != nil
 		return sdk.FormatInvariant(types.ModuleName, "staking reserved amount",
-			"the balance of StakingReserveAcc less than the amount of staked, queued coins in all staking objects",
+			"the balance of StakingReserveAcc less than the amount of staked, queued, locked and unbonding coins in all staking objects",
 		), broken
 	}
 }
@@ -178,6 +188,67 @@ func NonNegativeHistoricalRewardsInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
+// HistoricalRewardsReferenceCountInvariant checks that the reference count of
+// all HistoricalRewards equals the number of stakings and locked stakings
+// starting from it, plus one if it is the latest one of the staking coin denom.
+func HistoricalRewardsReferenceCountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		type historicalRewardsKey struct {
+			stakingCoinDenom string
+			epoch            uint64
+		}
+		expected := map[historicalRewardsKey]uint32{}
+		k.IterateCurrentEpochs(ctx, func(stakingCoinDenom string, currentEpoch uint64) (stop bool) {
+			expected[historicalRewardsKey{stakingCoinDenom, currentEpoch - 1}]++
+			return false
+		})
+		k.IterateStakings(ctx, func(stakingCoinDenom string, _ sdk.AccAddress, staking types.Staking) (stop bool) {
+			expected[historicalRewardsKey{stakingCoinDenom, staking.StartingEpoch - 1}]++
+			return false
+		})
+		k.IterateLockedStakings(ctx, func(lockedStaking types.LockedStaking) (stop bool) {
+			expected[historicalRewardsKey{lockedStaking.StakingCoinDenom, lockedStaking.StartingEpoch - 1}]++
+			return false
+		})
+
+		msg := ""
+		count := 0
+		k.IterateHistoricalRewards(ctx, func(stakingCoinDenom string, epoch uint64, rewards types.HistoricalRewards) (stop bool) {
+			key := historicalRewardsKey{stakingCoinDenom, epoch}
+			if rewards.ReferenceCount != expected[key] {
+				msg += fmt.Sprintf("\t%v has reference count %d at epoch %d, expected %d\n",
+					stakingCoinDenom, rewards.ReferenceCount, epoch, expected[key])
+				count++
+			}
+			delete(expected, key)
+			return false
+		})
+
+		// The remaining keys are referenced, but have no historical rewards.
+		var missing []historicalRewardsKey
+		for key := range expected {
+			missing = append(missing, key)
+		}
+		sort.Slice(missing, func(i, j int) bool {
+			if missing[i].stakingCoinDenom != missing[j].stakingCoinDenom {
+				return missing[i].stakingCoinDenom < missing[j].stakingCoinDenom
+			}
+			return missing[i].epoch < missing[j].epoch
+		})
+		for _, key := range missing {
+			msg += fmt.Sprintf("\t%v has no historical rewards at epoch %d referenced %d times\n",
+				key.stakingCoinDenom, key.epoch, expected[key])
+			count++
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "historical rewards reference count",
+			fmt.Sprintf("found %d historical rewards with wrong reference count\n%s", count, msg),
+		), broken
+	}
+}
+
 // PositiveTotalStakingsAmountInvariant checks that all TotalStakings
 // have positive amount.
 func PositiveTotalStakingsAmountInvariant(k Keeper) sdk.Invariant {
@@ -199,3 +270,36 @@ func PositiveTotalStakingsAmountInvariant(k Keeper) sdk.Invariant {
 		), broken
 	}
 }
+
+// PositiveLockedStakingAmountInvariant checks that the amount of locked staking coins is positive.
+func PositiveLockedStakingAmountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		count := 0
+		k.IterateLockedStakings(ctx, func(lockedStaking types.LockedStaking) (stop bool) {
+			if !lockedStaking.Amount.IsPositive() {
+				msg += fmt.Sprintf("\t%v has non-positive locked staking amount for locked staking %d: %v\n",
+					lockedStaking.Farmer, lockedStaking.Id, sdk.Coin{Denom: lockedStaking.StakingCoinDenom, Amount: lockedStaking.Amount})
+				count++
+			}
+			return false
+		})
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "positive locked staking amount",
+			fmt.Sprintf("found %d locked staking coins with non-positive amount\n%s", count, msg),
+		), broken
+	}
+}
+
+// LockedStakingsAmountInvariant checks that TotalLockedStakings are
+// consistent with the locked stakings.
+func LockedStakingsAmountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		err := k.ValidateLockedStakingsAmount(ctx)
+		broken := err != nil
+		return sdk.FormatInvariant(types.ModuleName, "locked stakings amount",
+			"the total locked stakings differ from the sum of the locked stakings",
+		), broken
+	}
+}
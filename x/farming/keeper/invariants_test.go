@@ -275,6 +275,37 @@ func (suite *KeeperTestSuite) TestNonNegativeHistoricalRewardsInvariant() {
 	suite.Require().True(broken)
 }
 
+func (suite *KeeperTestSuite) TestHistoricalRewardsReferenceCountInvariant() {
+	k, ctx := suite.keeper, suite.ctx
+
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	// This is normal.
+	_, broken := farmingkeeper.HistoricalRewardsReferenceCountInvariant(k)(ctx)
+	suite.Require().False(broken)
+
+	// Wrong reference count.
+	historical, _ := k.GetHistoricalRewards(ctx, denom1, 0)
+	historical.ReferenceCount++
+	k.SetHistoricalRewards(ctx, denom1, 0, historical)
+	_, broken = farmingkeeper.HistoricalRewardsReferenceCountInvariant(k)(ctx)
+	suite.Require().True(broken)
+
+	// Reset.
+	historical.ReferenceCount--
+	k.SetHistoricalRewards(ctx, denom1, 0, historical)
+	_, broken = farmingkeeper.HistoricalRewardsReferenceCountInvariant(k)(ctx)
+	suite.Require().False(broken)
+
+	// Historical rewards referenced by a staking is missing.
+	k.DeleteHistoricalRewards(ctx, denom1, 0)
+	_, broken = farmingkeeper.HistoricalRewardsReferenceCountInvariant(k)(ctx)
+	suite.Require().True(broken)
+}
+
 func (suite *KeeperTestSuite) TestPositiveTotalStakingsAmountInvariant() {
 	k, ctx := suite.keeper, suite.ctx
 
@@ -293,3 +324,76 @@ func (suite *KeeperTestSuite) TestPositiveTotalStakingsAmountInvariant() {
 	_, broken = farmingkeeper.PositiveTotalStakingsAmountInvariant(k)(ctx)
 	suite.Require().True(broken)
 }
+
+func (suite *KeeperTestSuite) TestPositiveLockedStakingAmountInvariant() {
+	k, ctx := suite.keeper, suite.ctx
+
+	lockedStaking := types.LockedStaking{
+		Id:               1,
+		Farmer:           suite.addrs[0].String(),
+		StakingCoinDenom: denom1,
+		Amount:           sdk.NewInt(1000000),
+		Multiplier:       sdk.NewDec(2),
+		StartingEpoch:    1,
+		UnlockTime:       types.ParseTime("2021-08-31T00:00:00Z"),
+	}
+
+	// Normal locked staking
+	k.SetLockedStaking(ctx, lockedStaking)
+	_, broken := farmingkeeper.PositiveLockedStakingAmountInvariant(k)(ctx)
+	suite.Require().False(broken)
+
+	// Zero-amount locked staking
+	lockedStaking.Amount = sdk.ZeroInt()
+	k.SetLockedStaking(ctx, lockedStaking)
+	_, broken = farmingkeeper.PositiveLockedStakingAmountInvariant(k)(ctx)
+	suite.Require().True(broken)
+
+	// Negative-amount locked staking
+	lockedStaking.Amount = sdk.NewInt(-1)
+	k.SetLockedStaking(ctx, lockedStaking)
+	_, broken = farmingkeeper.PositiveLockedStakingAmountInvariant(k)(ctx)
+	suite.Require().True(broken)
+}
+
+func (suite *KeeperTestSuite) TestLockedStakingsAmountInvariant() {
+	suite.setLockTiers(sdk.NewDec(2))
+
+	suite.StakeLocked(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.StakeLocked(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000), sdk.NewInt64Coin(denom2, 500_000)))
+	suite.AdvanceEpoch()
+
+	k, ctx := suite.keeper, suite.ctx
+
+	_, broken := farmingkeeper.LockedStakingsAmountInvariant(k)(ctx)
+	suite.Require().False(broken)
+
+	// Wrong total locked staking amount
+	k.SetTotalLockedStakings(ctx, denom1, types.TotalLockedStakings{
+		Amount:         sdk.NewInt(1_000_000),
+		WeightedAmount: sdk.NewDec(3_000_000),
+	})
+	_, broken = farmingkeeper.LockedStakingsAmountInvariant(k)(ctx)
+	suite.Require().True(broken)
+
+	// Wrong total weighted amount
+	k.SetTotalLockedStakings(ctx, denom1, types.TotalLockedStakings{
+		Amount:         sdk.NewInt(1_500_000),
+		WeightedAmount: sdk.NewDec(1_500_000),
+	})
+	_, broken = farmingkeeper.LockedStakingsAmountInvariant(k)(ctx)
+	suite.Require().True(broken)
+
+	// Restore
+	k.SetTotalLockedStakings(ctx, denom1, types.TotalLockedStakings{
+		Amount:         sdk.NewInt(1_500_000),
+		WeightedAmount: sdk.NewDec(3_000_000),
+	})
+	_, broken = farmingkeeper.LockedStakingsAmountInvariant(k)(ctx)
+	suite.Require().False(broken)
+
+	// Missing total locked stakings
+	k.DeleteTotalLockedStakings(ctx, denom2)
+	_, broken = farmingkeeper.LockedStakingsAmountInvariant(k)(ctx)
+	suite.Require().True(broken)
+}
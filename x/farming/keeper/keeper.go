@@ -35,8 +35,11 @@ type Keeper struct {
 	cdc        codec.BinaryCodec
 	paramSpace paramtypes.Subspace
 
-	bankKeeper    types.BankKeeper
-	accountKeeper types.AccountKeeper
+	bankKeeper      types.BankKeeper
+	accountKeeper   types.AccountKeeper
+	budgetKeeper    types.BudgetKeeper
+	liquidityKeeper types.LiquidityKeeper
+	hooks           types.FarmingHooks
 
 	blockedAddrs map[string]bool
 }
@@ -46,8 +49,8 @@ type Keeper struct {
 // - sending to and from ModuleAccounts
 // - minting, burning PoolCoins
 func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
-	accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper,
-	blockedAddrs map[string]bool,
+	accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, budgetKeeper types.BudgetKeeper,
+	liquidityKeeper types.LiquidityKeeper, blockedAddrs map[string]bool,
 ) Keeper {
 	// ensure farming module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -60,15 +63,28 @@ func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Su
 	}
 
 	return Keeper{
-		storeKey:      key,
-		cdc:           cdc,
-		paramSpace:    paramSpace,
-		accountKeeper: accountKeeper,
-		bankKeeper:    bankKeeper,
-		blockedAddrs:  blockedAddrs,
+		storeKey:        key,
+		cdc:             cdc,
+		paramSpace:      paramSpace,
+		accountKeeper:   accountKeeper,
+		bankKeeper:      bankKeeper,
+		budgetKeeper:    budgetKeeper,
+		liquidityKeeper: liquidityKeeper,
+		blockedAddrs:    blockedAddrs,
 	}
 }
 
+// SetHooks sets the farming hooks.
+func (k *Keeper) SetHooks(fh types.FarmingHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set farming hooks twice")
+	}
+
+	k.hooks = fh
+
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -148,12 +148,12 @@ func (suite *KeeperTestSuite) Stake(farmerAcc sdk.AccAddress, amt sdk.Coins) {
 
 // Unstake is a convenient method to test Keeper.Unstake.
 func (suite *KeeperTestSuite) Unstake(farmerAcc sdk.AccAddress, amt sdk.Coins) {
-	err := suite.keeper.Unstake(suite.ctx, farmerAcc, amt)
+	_, err := suite.keeper.Unstake(suite.ctx, farmerAcc, amt)
 	suite.Require().NoError(err)
 }
 
 func (suite *KeeperTestSuite) Harvest(farmerAcc sdk.AccAddress, stakingCoinDenoms []string) {
-	err := suite.keeper.Harvest(suite.ctx, farmerAcc, stakingCoinDenoms)
+	_, err := suite.keeper.Harvest(suite.ctx, farmerAcc, stakingCoinDenoms)
 	suite.Require().NoError(err)
 }
 
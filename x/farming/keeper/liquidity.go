@@ -0,0 +1,309 @@
+package keeper
+
+import (
+	"strconv"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	liquiditytypes "github.com/gravity-devs/liquidity/x/liquidity/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// GetGlobalDepositRequestId returns the global deposit request id counter.
+func (k Keeper) GetGlobalDepositRequestId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GlobalDepositRequestIdKey)
+	if bz == nil {
+		return 0
+	}
+	var val gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &val)
+	return val.GetValue()
+}
+
+// SetGlobalDepositRequestId sets the global deposit request id counter.
+func (k Keeper) SetGlobalDepositRequestId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
+	store.Set(types.GlobalDepositRequestIdKey, bz)
+}
+
+// GetNextDepositRequestIdWithUpdate increments the global deposit request id
+// counter and returns the next id.
+func (k Keeper) GetNextDepositRequestIdWithUpdate(ctx sdk.Context) uint64 {
+	id := k.GetGlobalDepositRequestId(ctx) + 1
+	k.SetGlobalDepositRequestId(ctx, id)
+	return id
+}
+
+// GetDepositRequest returns a deposit request for given id.
+func (k Keeper) GetDepositRequest(ctx sdk.Context, id uint64) (req types.DepositRequest, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDepositRequestKey(id))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &req)
+	found = true
+	return
+}
+
+// SetDepositRequest sets a deposit request along with its index.
+func (k Keeper) SetDepositRequest(ctx sdk.Context, req types.DepositRequest) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&req)
+	store.Set(types.GetDepositRequestKey(req.Id), bz)
+	store.Set(types.GetDepositRequestIndexKey(req.PoolId, req.BatchIndex, req.Id), []byte{})
+}
+
+// DeleteDepositRequest deletes a deposit request along with its index.
+func (k Keeper) DeleteDepositRequest(ctx sdk.Context, req types.DepositRequest) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDepositRequestKey(req.Id))
+	store.Delete(types.GetDepositRequestIndexKey(req.PoolId, req.BatchIndex, req.Id))
+}
+
+// IterateDepositRequests iterates through all deposit requests stored in the
+// store in ascending order of id and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateDepositRequests(ctx sdk.Context, cb func(req types.DepositRequest) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DepositRequestKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var req types.DepositRequest
+		k.cdc.MustUnmarshal(iter.Value(), &req)
+		if cb(req) {
+			break
+		}
+	}
+}
+
+// GetAllDepositRequests returns all deposit requests in the store.
+func (k Keeper) GetAllDepositRequests(ctx sdk.Context) []types.DepositRequest {
+	reqs := []types.DepositRequest{}
+	k.IterateDepositRequests(ctx, func(req types.DepositRequest) (stop bool) {
+		reqs = append(reqs, req)
+		return false
+	})
+	return reqs
+}
+
+// DepositAndStake deposits coins to a liquidity pool on behalf of the farmer.
+// The deposit is made from the deposit reserve account of a new deposit request,
+// so that the pool coins minted by the deposit can be told apart and staked
+// for the farmer once the deposit batch is executed.
+// It returns the id of the deposit request created.
+func (k Keeper) DepositAndStake(ctx sdk.Context, farmerAcc sdk.AccAddress, poolId uint64, depositCoins sdk.Coins) (uint64, error) {
+	if k.liquidityKeeper.GetCircuitBreakerEnabled(ctx) {
+		return 0, liquiditytypes.ErrCircuitBreakerEnabled
+	}
+
+	pool, found := k.liquidityKeeper.GetPool(ctx, poolId)
+	if !found {
+		return 0, sdkerrors.Wrapf(liquiditytypes.ErrPoolNotExists, "pool %d not found", poolId)
+	}
+	if !k.IsStakingDenomAllowed(ctx, pool.PoolCoinDenom) {
+		return 0, sdkerrors.Wrapf(types.ErrStakingDenomNotAllowed, "%s is not allowed to be staked", pool.PoolCoinDenom)
+	}
+
+	id := k.GetNextDepositRequestIdWithUpdate(ctx)
+	reserveAcc := types.DepositReserveAcc(id)
+	if err := k.bankKeeper.SendCoins(ctx, farmerAcc, reserveAcc, depositCoins); err != nil {
+		return 0, err
+	}
+
+	msgState, err := k.liquidityKeeper.DepositWithinBatch(ctx, liquiditytypes.NewMsgDepositWithinBatch(reserveAcc, poolId, depositCoins))
+	if err != nil {
+		return 0, err
+	}
+	batch, _ := k.liquidityKeeper.GetPoolBatch(ctx, poolId)
+
+	k.SetDepositRequest(ctx, types.DepositRequest{
+		Id:           id,
+		Farmer:       farmerAcc.String(),
+		PoolId:       poolId,
+		MsgIndex:     msgState.MsgIndex,
+		DepositCoins: depositCoins,
+		BatchIndex:   batch.Index,
+	})
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDepositAndStake,
+			sdk.NewAttribute(types.AttributeKeyDepositRequestId, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyMsgIndex, strconv.FormatUint(msgState.MsgIndex, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositCoins, depositCoins.String()),
+		),
+	})
+
+	return id, nil
+}
+
+// ProcessDepositRequests stakes the pool coins minted by the executed deposit
+// batches for the farmers, and refunds the deposit coins that were not accepted.
+// Deposit requests are indexed by pool and batch, so only the deposit requests
+// of the batches that have been executed are visited.
+func (k Keeper) ProcessDepositRequests(ctx sdk.Context) {
+	start := types.DepositRequestIndexKeyPrefix
+	for {
+		poolId, found := k.nextDepositRequestPoolId(ctx, start)
+		if !found {
+			break
+		}
+		poolPrefix := types.GetDepositRequestsByPoolPrefix(poolId)
+		start = sdk.PrefixEndBytes(poolPrefix)
+
+		// The deposit requests of the batches before nextBatchIndex have been
+		// executed. If the pool doesn't exist anymore, all of them are refunded.
+		end := start
+		nextBatchIndex := uint64(0)
+		if batch, found := k.liquidityKeeper.GetPoolBatch(ctx, poolId); found {
+			nextBatchIndex = batch.Index
+			if batch.Executed {
+				nextBatchIndex++
+			}
+			end = types.GetDepositRequestsByPoolBatchPrefix(poolId, nextBatchIndex)
+		}
+
+		for _, req := range k.getDepositRequestsByIndexRange(ctx, poolPrefix, end) {
+			// The liquidity module marks deposit msg states as to be deleted once
+			// they are executed, and carries the others over to the next batch.
+			msgState, found := k.liquidityKeeper.GetPoolBatchDepositMsgState(ctx, req.PoolId, req.MsgIndex)
+			if found && !msgState.ToBeDeleted {
+				k.DeleteDepositRequest(ctx, req)
+				req.BatchIndex = nextBatchIndex
+				k.SetDepositRequest(ctx, req)
+				continue
+			}
+			if err := k.processDepositRequest(ctx, req); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+// nextDepositRequestPoolId returns the id of the first pool that has deposit
+// requests, starting from the given deposit request index key.
+func (k Keeper) nextDepositRequestPoolId(ctx sdk.Context, start []byte) (poolId uint64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.DepositRequestIndexKeyPrefix))
+	defer iter.Close()
+	if !iter.Valid() {
+		return
+	}
+	poolId, _, _ = types.ParseDepositRequestIndexKey(iter.Key())
+	found = true
+	return
+}
+
+// getDepositRequestsByIndexRange returns the deposit requests whose index
+// keys are in the range [start, end).
+func (k Keeper) getDepositRequestsByIndexRange(ctx sdk.Context, start, end []byte) (reqs []types.DepositRequest) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(start, end)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, _, id := types.ParseDepositRequestIndexKey(iter.Key())
+		req, _ := k.GetDepositRequest(ctx, id)
+		reqs = append(reqs, req)
+	}
+	return
+}
+
+// processDepositRequest stakes the pool coins in the deposit reserve account
+// of the deposit request for the farmer, and sends the rest of the coins back
+// to the farmer. If the pool coins can't be staked, they are sent back too.
+func (k Keeper) processDepositRequest(ctx sdk.Context, req types.DepositRequest) error {
+	farmerAcc := req.GetFarmer()
+	reserveAcc := types.DepositReserveAcc(req.Id)
+	balances := k.bankKeeper.GetAllBalances(ctx, reserveAcc)
+
+	stakedCoins := sdk.NewCoins()
+	if pool, found := k.liquidityKeeper.GetPool(ctx, req.PoolId); found {
+		stakedCoins = sdk.NewCoins(sdk.NewCoin(pool.PoolCoinDenom, balances.AmountOf(pool.PoolCoinDenom)))
+	}
+	if !stakedCoins.IsZero() {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.stakeFor(cacheCtx, reserveAcc, farmerAcc, stakedCoins); err != nil {
+			k.Logger(ctx).Error("failed to stake pool coins", "deposit_request_id", req.Id, "error", err)
+			stakedCoins = sdk.NewCoins()
+		} else {
+			writeCache()
+		}
+	}
+
+	refundedCoins := balances.Sub(stakedCoins)
+	if !refundedCoins.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, reserveAcc, farmerAcc, refundedCoins); err != nil {
+			return err
+		}
+	}
+
+	k.DeleteDepositRequest(ctx, req)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDepositRequestProcessed,
+			sdk.NewAttribute(types.AttributeKeyDepositRequestId, strconv.FormatUint(req.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyStakedCoins, stakedCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundedCoins.String()),
+		),
+	})
+
+	return nil
+}
+
+// UnstakeAndWithdraw unstakes pool coins and submits them for withdrawal from
+// the liquidity pool. The reserve coins are sent to the farmer by the liquidity
+// module once the withdrawal batch is executed.
+// Since the unstaked coins must be released right away, it is not available
+// while the unbonding period is set.
+// It returns the rewards withdrawn as a side effect of unstaking.
+func (k Keeper) UnstakeAndWithdraw(ctx sdk.Context, farmerAcc sdk.AccAddress, poolId uint64, poolCoin sdk.Coin) (sdk.Coins, error) {
+	if k.liquidityKeeper.GetCircuitBreakerEnabled(ctx) {
+		return nil, liquiditytypes.ErrCircuitBreakerEnabled
+	}
+
+	pool, found := k.liquidityKeeper.GetPool(ctx, poolId)
+	if !found {
+		return nil, sdkerrors.Wrapf(liquiditytypes.ErrPoolNotExists, "pool %d not found", poolId)
+	}
+	if poolCoin.Denom != pool.PoolCoinDenom {
+		return nil, sdkerrors.Wrapf(liquiditytypes.ErrBadPoolCoinDenom, "%s is not the pool coin denom of pool %d", poolCoin.Denom, poolId)
+	}
+
+	if unbondingPeriod := k.GetParams(ctx).UnbondingPeriod; unbondingPeriod > 0 {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "unstaked coins can't be withdrawn from the pool during the unbonding period of %s", unbondingPeriod)
+	}
+
+	withdrawnRewards, err := k.Unstake(ctx, farmerAcc, sdk.NewCoins(poolCoin))
+	if err != nil {
+		return nil, err
+	}
+
+	msgState, err := k.liquidityKeeper.WithdrawWithinBatch(ctx, liquiditytypes.NewMsgWithdrawWithinBatch(farmerAcc, poolId, poolCoin))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnstakeAndWithdraw,
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyMsgIndex, strconv.FormatUint(msgState.MsgIndex, 10)),
+			sdk.NewAttribute(types.AttributeKeyPoolCoin, poolCoin.String()),
+		),
+	})
+
+	return withdrawnRewards, nil
+}
@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	liquiditytypes "github.com/gravity-devs/liquidity/x/liquidity/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// createPool is a convenient method to create a liquidity pool of denom1 and
+// denom2, with 1_000_000 pool coins minted to the creator.
+func (suite *KeeperTestSuite) createPool(creatorAcc sdk.AccAddress, amt1, amt2 int64) liquiditytypes.Pool {
+	pool, err := suite.app.LiquidityKeeper.CreatePool(suite.ctx, liquiditytypes.NewMsgCreatePool(
+		creatorAcc, liquiditytypes.DefaultPoolTypeID, sdk.NewCoins(sdk.NewInt64Coin(denom1, amt1), sdk.NewInt64Coin(denom2, amt2))))
+	suite.Require().NoError(err)
+	return pool
+}
+
+// executePoolBatches executes the liquidity pool batches and then processes
+// the deposit requests, in the same order as the end blockers.
+func (suite *KeeperTestSuite) executePoolBatches() {
+	suite.app.LiquidityKeeper.ExecutePoolBatches(suite.ctx)
+	suite.keeper.ProcessDepositRequests(suite.ctx)
+}
+
+func (suite *KeeperTestSuite) TestDepositAndStake() {
+	pool := suite.createPool(suite.addrs[5], 100_000_000, 100_000_000)
+
+	_, err := suite.keeper.DepositAndStake(suite.ctx, suite.addrs[0], 10, sdk.NewCoins(sdk.NewInt64Coin(denom1, 10_000_000), sdk.NewInt64Coin(denom2, 10_000_000)))
+	suite.Require().ErrorIs(err, liquiditytypes.ErrPoolNotExists)
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	id, err := suite.keeper.DepositAndStake(suite.ctx, suite.addrs[0], pool.Id, sdk.NewCoins(sdk.NewInt64Coin(denom1, 10_000_000), sdk.NewInt64Coin(denom2, 20_000_000)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), id)
+
+	balancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 10_000_000), sdk.NewInt64Coin(denom2, 20_000_000)),
+		balancesBefore.Sub(balancesAfter)))
+
+	req, found := suite.keeper.GetDepositRequest(suite.ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.addrs[0].String(), req.Farmer)
+	suite.Require().Equal(pool.Id, req.PoolId)
+	batch, _ := suite.app.LiquidityKeeper.GetPoolBatch(suite.ctx, pool.Id)
+	suite.Require().Equal(batch.Index, req.BatchIndex)
+
+	// Nothing happens until the deposit batch is executed.
+	suite.keeper.ProcessDepositRequests(suite.ctx)
+	_, found = suite.keeper.GetDepositRequest(suite.ctx, id)
+	suite.Require().True(found)
+
+	suite.executePoolBatches()
+
+	_, found = suite.keeper.GetDepositRequest(suite.ctx, id)
+	suite.Require().False(found)
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, types.DepositReserveAcc(id)).IsZero())
+
+	// The minted pool coins are staked for the farmer, and the deposit coins
+	// that were not accepted are refunded.
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(pool.PoolCoinDenom, 100_000)),
+		suite.keeper.GetAllQueuedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 10_000_000), sdk.NewInt64Coin(denom2, 10_000_000)),
+		balancesBefore.Sub(suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0]))))
+}
+
+func (suite *KeeperTestSuite) TestProcessDepositRequestsByPoolBatch() {
+	pool1 := suite.createPool(suite.addrs[4], 100_000_000, 100_000_000)
+	pool2, err := suite.app.LiquidityKeeper.CreatePool(suite.ctx, liquiditytypes.NewMsgCreatePool(
+		suite.addrs[5], liquiditytypes.DefaultPoolTypeID, sdk.NewCoins(sdk.NewInt64Coin(denom2, 100_000_000), sdk.NewInt64Coin(denom3, 100_000_000))))
+	suite.Require().NoError(err)
+
+	id1, err := suite.keeper.DepositAndStake(suite.ctx, suite.addrs[0], pool1.Id, sdk.NewCoins(sdk.NewInt64Coin(denom1, 10_000_000), sdk.NewInt64Coin(denom2, 10_000_000)))
+	suite.Require().NoError(err)
+	id2, err := suite.keeper.DepositAndStake(suite.ctx, suite.addrs[1], pool2.Id, sdk.NewCoins(sdk.NewInt64Coin(denom2, 10_000_000), sdk.NewInt64Coin(denom3, 10_000_000)))
+	suite.Require().NoError(err)
+
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	req1, _ := suite.keeper.GetDepositRequest(suite.ctx, id1)
+	suite.Require().True(store.Has(types.GetDepositRequestIndexKey(pool1.Id, req1.BatchIndex, id1)))
+
+	// Only the batch of pool1 is executed, so the deposit request of pool2 is
+	// left untouched.
+	batch, _ := suite.app.LiquidityKeeper.GetPoolBatch(suite.ctx, pool1.Id)
+	batch.Executed = true
+	suite.app.LiquidityKeeper.SetPoolBatch(suite.ctx, batch)
+	msgState, _ := suite.app.LiquidityKeeper.GetPoolBatchDepositMsgState(suite.ctx, pool1.Id, req1.MsgIndex)
+	msgState.ToBeDeleted = true
+	suite.app.LiquidityKeeper.SetPoolBatchDepositMsgState(suite.ctx, pool1.Id, msgState)
+	suite.keeper.ProcessDepositRequests(suite.ctx)
+
+	_, found := suite.keeper.GetDepositRequest(suite.ctx, id1)
+	suite.Require().False(found)
+	suite.Require().False(store.Has(types.GetDepositRequestIndexKey(pool1.Id, req1.BatchIndex, id1)))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, types.DepositReserveAcc(id1)).IsZero())
+
+	_, found = suite.keeper.GetDepositRequest(suite.ctx, id2)
+	suite.Require().True(found)
+	suite.Require().True(suite.keeper.GetAllQueuedCoinsByFarmer(suite.ctx, suite.addrs[1]).IsZero())
+
+	suite.executePoolBatches()
+	_, found = suite.keeper.GetDepositRequest(suite.ctx, id2)
+	suite.Require().False(found)
+	suite.Require().False(suite.keeper.GetAllQueuedCoinsByFarmer(suite.ctx, suite.addrs[1]).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestDepositAndStakeEarnsRewards() {
+	pool := suite.createPool(suite.addrs[5], 100_000_000, 100_000_000)
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{pool.PoolCoinDenom: "1"}, map[string]int64{denom3: 1_000_000})
+
+	_, err := suite.keeper.DepositAndStake(suite.ctx, suite.addrs[0], pool.Id, sdk.NewCoins(sdk.NewInt64Coin(denom1, 10_000_000), sdk.NewInt64Coin(denom2, 10_000_000)))
+	suite.Require().NoError(err)
+	suite.executePoolBatches()
+
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)), suite.AllRewards(suite.addrs[0])))
+}
+
+func (suite *KeeperTestSuite) TestUnstakeAndWithdraw() {
+	pool := suite.createPool(suite.addrs[0], 100_000_000, 100_000_000)
+	poolCoin := sdk.NewInt64Coin(pool.PoolCoinDenom, 500_000)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(pool.PoolCoinDenom, 1_000_000)))
+	suite.AdvanceEpoch()
+
+	_, err := suite.keeper.UnstakeAndWithdraw(suite.ctx, suite.addrs[0], 10, poolCoin)
+	suite.Require().ErrorIs(err, liquiditytypes.ErrPoolNotExists)
+
+	_, err = suite.keeper.UnstakeAndWithdraw(suite.ctx, suite.addrs[0], pool.Id, sdk.NewInt64Coin(denom1, 500_000))
+	suite.Require().ErrorIs(err, liquiditytypes.ErrBadPoolCoinDenom)
+
+	// The unstaked coins can't be withdrawn while they are unbonding.
+	params := suite.keeper.GetParams(suite.ctx)
+	params.UnbondingPeriod = 7 * 24 * time.Hour
+	suite.keeper.SetParams(suite.ctx, params)
+	_, err = suite.keeper.UnstakeAndWithdraw(suite.ctx, suite.addrs[0], pool.Id, poolCoin)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	params.UnbondingPeriod = 0
+	suite.keeper.SetParams(suite.ctx, params)
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	_, err = suite.keeper.UnstakeAndWithdraw(suite.ctx, suite.addrs[0], pool.Id, poolCoin)
+	suite.Require().NoError(err)
+
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(pool.PoolCoinDenom, 500_000)),
+		suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+	// The unstaked pool coins are escrowed by the liquidity module.
+	suite.Require().True(coinsEq(balancesBefore, suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+
+	suite.executePoolBatches()
+
+	balancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 50_000_000), sdk.NewInt64Coin(denom2, 50_000_000)),
+		balancesAfter.Sub(balancesBefore)))
+}
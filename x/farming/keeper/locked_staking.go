@@ -0,0 +1,528 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// GetGlobalLockedStakingId returns the global locked staking id counter.
+func (k Keeper) GetGlobalLockedStakingId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GlobalLockedStakingIdKey)
+	if bz == nil {
+		return 0
+	}
+	var val gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &val)
+	return val.GetValue()
+}
+
+// SetGlobalLockedStakingId sets the global locked staking id counter.
+func (k Keeper) SetGlobalLockedStakingId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
+	store.Set(types.GlobalLockedStakingIdKey, bz)
+}
+
+// GetNextLockedStakingIdWithUpdate increments the global locked staking id
+// counter and returns the next id.
+func (k Keeper) GetNextLockedStakingIdWithUpdate(ctx sdk.Context) uint64 {
+	id := k.GetGlobalLockedStakingId(ctx) + 1
+	k.SetGlobalLockedStakingId(ctx, id)
+	return id
+}
+
+// GetLockedStaking returns a locked staking for given id.
+func (k Keeper) GetLockedStaking(ctx sdk.Context, id uint64) (lockedStaking types.LockedStaking, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLockedStakingKey(id))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &lockedStaking)
+	found = true
+	return
+}
+
+// SetLockedStaking sets a locked staking along with its indexes.
+func (k Keeper) SetLockedStaking(ctx sdk.Context, lockedStaking types.LockedStaking) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&lockedStaking)
+	store.Set(types.GetLockedStakingKey(lockedStaking.Id), bz)
+	store.Set(types.GetLockedStakingIndexKey(lockedStaking.GetFarmer(), lockedStaking.StakingCoinDenom, lockedStaking.Id), []byte{})
+	store.Set(types.GetLockedStakingByDenomIndexKey(lockedStaking.StakingCoinDenom, lockedStaking.Id), []byte{})
+	store.Set(types.GetLockedStakingUnlockQueueKey(lockedStaking.UnlockTime, lockedStaking.Id), []byte{})
+}
+
+// DeleteLockedStaking deletes a locked staking along with its indexes.
+func (k Keeper) DeleteLockedStaking(ctx sdk.Context, lockedStaking types.LockedStaking) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetLockedStakingKey(lockedStaking.Id))
+	store.Delete(types.GetLockedStakingIndexKey(lockedStaking.GetFarmer(), lockedStaking.StakingCoinDenom, lockedStaking.Id))
+	store.Delete(types.GetLockedStakingByDenomIndexKey(lockedStaking.StakingCoinDenom, lockedStaking.Id))
+	store.Delete(types.GetLockedStakingUnlockQueueKey(lockedStaking.UnlockTime, lockedStaking.Id))
+}
+
+// GetQueuedLockedStaking returns a locked staking waiting in the queue for
+// given id.
+func (k Keeper) GetQueuedLockedStaking(ctx sdk.Context, id uint64) (lockedStaking types.LockedStaking, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetQueuedLockedStakingKey(id))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &lockedStaking)
+	found = true
+	return
+}
+
+// SetQueuedLockedStaking sets a locked staking waiting in the queue along
+// with its indexes.
+func (k Keeper) SetQueuedLockedStaking(ctx sdk.Context, lockedStaking types.LockedStaking) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&lockedStaking)
+	store.Set(types.GetQueuedLockedStakingKey(lockedStaking.Id), bz)
+	store.Set(types.GetQueuedLockedStakingIndexKey(lockedStaking.GetFarmer(), lockedStaking.StakingCoinDenom, lockedStaking.Id), []byte{})
+	store.Set(types.GetQueuedLockedStakingByDenomIndexKey(lockedStaking.StakingCoinDenom, lockedStaking.Id), []byte{})
+}
+
+// DeleteQueuedLockedStaking deletes a locked staking waiting in the queue
+// along with its indexes.
+func (k Keeper) DeleteQueuedLockedStaking(ctx sdk.Context, lockedStaking types.LockedStaking) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetQueuedLockedStakingKey(lockedStaking.Id))
+	store.Delete(types.GetQueuedLockedStakingIndexKey(lockedStaking.GetFarmer(), lockedStaking.StakingCoinDenom, lockedStaking.Id))
+	store.Delete(types.GetQueuedLockedStakingByDenomIndexKey(lockedStaking.StakingCoinDenom, lockedStaking.Id))
+}
+
+// IterateQueuedLockedStakings iterates through all queued locked stakings
+// stored in the store in ascending order of id and invokes callback function
+// for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateQueuedLockedStakings(ctx sdk.Context, cb func(lockedStaking types.LockedStaking) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.QueuedLockedStakingKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var lockedStaking types.LockedStaking
+		k.cdc.MustUnmarshal(iter.Value(), &lockedStaking)
+		if cb(lockedStaking) {
+			break
+		}
+	}
+}
+
+// IterateLockedStakings iterates through all locked stakings stored in the
+// store in ascending order of id and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateLockedStakings(ctx sdk.Context, cb func(lockedStaking types.LockedStaking) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.LockedStakingKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var lockedStaking types.LockedStaking
+		k.cdc.MustUnmarshal(iter.Value(), &lockedStaking)
+		if cb(lockedStaking) {
+			break
+		}
+	}
+}
+
+// IterateLockedStakingsByFarmer iterates through all locked stakings by a
+// farmer stored in the store and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateLockedStakingsByFarmer(ctx sdk.Context, farmerAcc sdk.AccAddress, cb func(lockedStaking types.LockedStaking) (stop bool)) {
+	k.iterateLockedStakingsByIndexPrefix(ctx, types.GetLockedStakingsByFarmerPrefix(farmerAcc), cb)
+}
+
+// IterateLockedStakingsByFarmerAndDenom iterates through all locked stakings
+// by a farmer for a given staking coin denom stored in the store and invokes
+// callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateLockedStakingsByFarmerAndDenom(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, cb func(lockedStaking types.LockedStaking) (stop bool)) {
+	k.iterateLockedStakingsByIndexPrefix(ctx, types.GetLockedStakingsByFarmerAndDenomPrefix(farmerAcc, stakingCoinDenom), cb)
+}
+
+func (k Keeper) iterateLockedStakingsByIndexPrefix(ctx sdk.Context, prefix []byte, cb func(lockedStaking types.LockedStaking) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, _, id := types.ParseLockedStakingIndexKey(iter.Key())
+		lockedStaking, _ := k.GetLockedStaking(ctx, id)
+		if cb(lockedStaking) {
+			break
+		}
+	}
+}
+
+// GetLockedStakingsByFarmerAndDenom returns all locked stakings by a farmer
+// for a given staking coin denom.
+func (k Keeper) GetLockedStakingsByFarmerAndDenom(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string) (lockedStakings []types.LockedStaking) {
+	k.IterateLockedStakingsByFarmerAndDenom(ctx, farmerAcc, stakingCoinDenom, func(lockedStaking types.LockedStaking) (stop bool) {
+		lockedStakings = append(lockedStakings, lockedStaking)
+		return false
+	})
+	return
+}
+
+// GetLockedStakingsByDenom returns all locked stakings for a given staking
+// coin denom in ascending order of id.
+func (k Keeper) GetLockedStakingsByDenom(ctx sdk.Context, stakingCoinDenom string) (lockedStakings []types.LockedStaking) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetLockedStakingsByDenomPrefix(stakingCoinDenom))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, id := types.ParseLockedStakingByDenomIndexKey(iter.Key())
+		lockedStaking, _ := k.GetLockedStaking(ctx, id)
+		lockedStakings = append(lockedStakings, lockedStaking)
+	}
+	return
+}
+
+// GetAllLockedCoinsByFarmer returns all coins that are locked by a farmer,
+// including the coins of the queued locked stakings.
+func (k Keeper) GetAllLockedCoinsByFarmer(ctx sdk.Context, farmerAcc sdk.AccAddress) sdk.Coins {
+	lockedCoins := sdk.NewCoins()
+	k.IterateLockedStakingsByFarmer(ctx, farmerAcc, func(lockedStaking types.LockedStaking) (stop bool) {
+		lockedCoins = lockedCoins.Add(sdk.NewCoin(lockedStaking.StakingCoinDenom, lockedStaking.Amount))
+		return false
+	})
+	for _, lockedStaking := range k.GetQueuedLockedStakingsByFarmer(ctx, farmerAcc) {
+		lockedCoins = lockedCoins.Add(sdk.NewCoin(lockedStaking.StakingCoinDenom, lockedStaking.Amount))
+	}
+	return lockedCoins
+}
+
+// GetQueuedLockedStakingsByFarmer returns all queued locked stakings by a
+// farmer.
+func (k Keeper) GetQueuedLockedStakingsByFarmer(ctx sdk.Context, farmerAcc sdk.AccAddress) []types.LockedStaking {
+	return k.getQueuedLockedStakingsByIndexPrefix(ctx, types.GetQueuedLockedStakingsByFarmerPrefix(farmerAcc))
+}
+
+// GetQueuedLockedStakingsByFarmerAndDenom returns all queued locked stakings
+// by a farmer for a given staking coin denom.
+func (k Keeper) GetQueuedLockedStakingsByFarmerAndDenom(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string) []types.LockedStaking {
+	return k.getQueuedLockedStakingsByIndexPrefix(ctx, types.GetQueuedLockedStakingsByFarmerAndDenomPrefix(farmerAcc, stakingCoinDenom))
+}
+
+// GetQueuedLockedStakingsByDenom returns all queued locked stakings for a
+// given staking coin denom in ascending order of id.
+func (k Keeper) GetQueuedLockedStakingsByDenom(ctx sdk.Context, stakingCoinDenom string) (lockedStakings []types.LockedStaking) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetQueuedLockedStakingsByDenomPrefix(stakingCoinDenom))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, id := types.ParseQueuedLockedStakingByDenomIndexKey(iter.Key())
+		lockedStaking, _ := k.GetQueuedLockedStaking(ctx, id)
+		lockedStakings = append(lockedStakings, lockedStaking)
+	}
+	return
+}
+
+func (k Keeper) getQueuedLockedStakingsByIndexPrefix(ctx sdk.Context, prefix []byte) (lockedStakings []types.LockedStaking) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, _, id := types.ParseQueuedLockedStakingIndexKey(iter.Key())
+		lockedStaking, _ := k.GetQueuedLockedStaking(ctx, id)
+		lockedStakings = append(lockedStakings, lockedStaking)
+	}
+	return
+}
+
+// GetTotalLockedStakings returns total locked stakings for given staking coin denom.
+func (k Keeper) GetTotalLockedStakings(ctx sdk.Context, stakingCoinDenom string) (totalLockedStakings types.TotalLockedStakings, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTotalLockedStakingsKey(stakingCoinDenom))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &totalLockedStakings)
+	found = true
+	return
+}
+
+// SetTotalLockedStakings sets total locked stakings for given staking coin denom.
+func (k Keeper) SetTotalLockedStakings(ctx sdk.Context, stakingCoinDenom string, totalLockedStakings types.TotalLockedStakings) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&totalLockedStakings)
+	store.Set(types.GetTotalLockedStakingsKey(stakingCoinDenom), bz)
+}
+
+// DeleteTotalLockedStakings deletes total locked stakings for given staking coin denom.
+func (k Keeper) DeleteTotalLockedStakings(ctx sdk.Context, stakingCoinDenom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTotalLockedStakingsKey(stakingCoinDenom))
+}
+
+// IterateTotalLockedStakings iterates through all total locked stakings
+// stored in the store and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateTotalLockedStakings(ctx sdk.Context, cb func(stakingCoinDenom string, totalLockedStakings types.TotalLockedStakings) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TotalLockedStakingsKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var totalLockedStakings types.TotalLockedStakings
+		k.cdc.MustUnmarshal(iter.Value(), &totalLockedStakings)
+		stakingCoinDenom := types.ParseTotalLockedStakingsKey(iter.Key())
+		if cb(stakingCoinDenom, totalLockedStakings) {
+			break
+		}
+	}
+}
+
+// increaseTotalLockedStakings adds the amount and the weight of a locked
+// staking to the total locked stakings.
+func (k Keeper) increaseTotalLockedStakings(ctx sdk.Context, lockedStaking types.LockedStaking) {
+	total, found := k.GetTotalLockedStakings(ctx, lockedStaking.StakingCoinDenom)
+	if !found {
+		total = types.TotalLockedStakings{Amount: sdk.ZeroInt(), WeightedAmount: sdk.ZeroDec()}
+	}
+	total.Amount = total.Amount.Add(lockedStaking.Amount)
+	total.WeightedAmount = total.WeightedAmount.Add(lockedStaking.Weight())
+	k.SetTotalLockedStakings(ctx, lockedStaking.StakingCoinDenom, total)
+}
+
+// decreaseTotalLockedStakings subtracts the amount and the weight of a locked
+// staking from the total locked stakings.
+func (k Keeper) decreaseTotalLockedStakings(ctx sdk.Context, lockedStaking types.LockedStaking) {
+	total, found := k.GetTotalLockedStakings(ctx, lockedStaking.StakingCoinDenom)
+	if !found {
+		panic("total locked stakings not found")
+	}
+	if total.Amount.Equal(lockedStaking.Amount) {
+		k.DeleteTotalLockedStakings(ctx, lockedStaking.StakingCoinDenom)
+		return
+	}
+	total.Amount = total.Amount.Sub(lockedStaking.Amount)
+	total.WeightedAmount = total.WeightedAmount.Sub(lockedStaking.Weight())
+	k.SetTotalLockedStakings(ctx, lockedStaking.StakingCoinDenom, total)
+}
+
+// totalStakingWeight returns the total weight of the stakings for a staking
+// coin denom, which is the denominator of the unit rewards.
+// Locked stakings are weighted by their multipliers.
+func (k Keeper) totalStakingWeight(ctx sdk.Context, stakingCoinDenom string, totalStakings types.TotalStakings) sdk.Dec {
+	weight := totalStakings.Amount.ToDec()
+	if locked, found := k.GetTotalLockedStakings(ctx, stakingCoinDenom); found {
+		weight = weight.Sub(locked.Amount.ToDec()).Add(locked.WeightedAmount)
+	}
+	return weight
+}
+
+// StakeLocked locks staking coins for one of the lock tiers' duration.
+// Like Stake, the coins are queued and start earning rewards at the end of
+// the current epoch, and a locked staking is created for each staking coin
+// denom.
+// Only the farmer can lock its staking coins, so the farmer always pays them.
+// It charges the delayed staking gas fee for each locked staking, since they
+// are processed in the end blocker.
+// It returns the ids of the locked stakings created.
+func (k Keeper) StakeLocked(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Coins, lockDuration time.Duration) ([]uint64, error) {
+	if k.blockedAddrs[farmerAcc.String()] {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to stake", farmerAcc)
+	}
+
+	params := k.GetParams(ctx)
+	tier, found := params.LockTier(lockDuration)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidLockDuration, "no lock tier with duration %s", lockDuration)
+	}
+
+	if err := k.validateStakingCoinsAllowed(ctx, amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.ReserveStakingCoins(ctx, farmerAcc, amount); err != nil {
+		return nil, err
+	}
+
+	unlockTime := ctx.BlockTime().Add(lockDuration)
+	var ids []uint64
+	for _, coin := range amount {
+		lockedStaking := types.LockedStaking{
+			Id:               k.GetNextLockedStakingIdWithUpdate(ctx),
+			Farmer:           farmerAcc.String(),
+			StakingCoinDenom: coin.Denom,
+			Amount:           coin.Amount,
+			Multiplier:       tier.Multiplier,
+			UnlockTime:       unlockTime,
+		}
+		k.SetQueuedLockedStaking(ctx, lockedStaking)
+		ids = append(ids, lockedStaking.Id)
+	}
+
+	ctx.GasMeter().ConsumeGas(sdk.Gas(len(ids))*params.DelayedStakingGasFee, "DelayedStakingGasFee")
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeStake,
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyPayer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyStakingCoins, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyLockDuration, lockDuration.String()),
+			sdk.NewAttribute(types.AttributeKeyUnlockTime, unlockTime.Format(time.RFC3339)),
+			sdk.NewAttribute(types.AttributeKeyLockedStakingIds, joinIds(ids)),
+		),
+	})
+
+	k.AfterStaked(ctx, farmerAcc, amount)
+
+	return ids, nil
+}
+
+// ProcessQueuedLockedStakings moves queued locked stakings into locked
+// stakings, which start earning rewards from the current epoch.
+func (k Keeper) ProcessQueuedLockedStakings(ctx sdk.Context) {
+	var queued []types.LockedStaking
+	k.IterateQueuedLockedStakings(ctx, func(lockedStaking types.LockedStaking) (stop bool) {
+		queued = append(queued, lockedStaking)
+		return false
+	})
+
+	for _, lockedStaking := range queued {
+		k.DeleteQueuedLockedStaking(ctx, lockedStaking)
+		k.IncreaseTotalStakings(ctx, lockedStaking.StakingCoinDenom, lockedStaking.Amount)
+		lockedStaking.StartingEpoch = k.GetCurrentEpoch(ctx, lockedStaking.StakingCoinDenom)
+		k.SetLockedStaking(ctx, lockedStaking)
+		k.incrementReferenceCount(ctx, lockedStaking.StakingCoinDenom, lockedStaking.StartingEpoch-1)
+		k.increaseTotalLockedStakings(ctx, lockedStaking)
+
+		k.AfterQueuedStakingProcessed(ctx, lockedStaking.GetFarmer(), lockedStaking.StakingCoinDenom, lockedStaking.Amount)
+	}
+}
+
+// unlockStakings turns the locked stakings of a farmer for a staking coin
+// denom into the farmer's staking, after withdrawing the accumulated rewards.
+// Total stakings don't change since they include locked stakings.
+// It returns the withdrawn rewards.
+func (k Keeper) unlockStakings(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, lockedStakings []types.LockedStaking) (sdk.Coins, error) {
+	rewards, err := k.WithdrawRewards(ctx, farmerAcc, stakingCoinDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	// All of the farmer's stakings for the denom reference the latest
+	// historical rewards after the rewards are withdrawn.
+	currentEpoch := k.GetCurrentEpoch(ctx, stakingCoinDenom)
+	staking, found := k.GetStaking(ctx, stakingCoinDenom, farmerAcc)
+	if !found {
+		staking.Amount = sdk.ZeroInt()
+		k.incrementReferenceCount(ctx, stakingCoinDenom, currentEpoch-1)
+	}
+	for _, lockedStaking := range lockedStakings {
+		k.DeleteLockedStaking(ctx, lockedStaking)
+		k.decrementReferenceCount(ctx, stakingCoinDenom, currentEpoch-1)
+		k.decreaseTotalLockedStakings(ctx, lockedStaking)
+		staking.Amount = staking.Amount.Add(lockedStaking.Amount)
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeStakingUnlocked,
+				sdk.NewAttribute(types.AttributeKeyLockedStakingId, strconv.FormatUint(lockedStaking.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+				sdk.NewAttribute(types.AttributeKeyStakingCoinDenom, stakingCoinDenom),
+				sdk.NewAttribute(types.AttributeKeyAmount, lockedStaking.Amount.String()),
+			),
+		})
+	}
+	staking.StartingEpoch = currentEpoch
+	k.SetStaking(ctx, stakingCoinDenom, farmerAcc, staking)
+
+	return rewards, nil
+}
+
+// unlockMaturedStakings unlocks the locked stakings of a farmer for a
+// staking coin denom whose unlock time has passed.
+// It returns the withdrawn rewards.
+func (k Keeper) unlockMaturedStakings(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string) (sdk.Coins, error) {
+	var matured []types.LockedStaking
+	k.IterateLockedStakingsByFarmerAndDenom(ctx, farmerAcc, stakingCoinDenom, func(lockedStaking types.LockedStaking) (stop bool) {
+		if !lockedStaking.UnlockTime.After(ctx.BlockTime()) {
+			matured = append(matured, lockedStaking)
+		}
+		return false
+	})
+	if len(matured) == 0 {
+		return sdk.NewCoins(), nil
+	}
+	return k.unlockStakings(ctx, farmerAcc, stakingCoinDenom, matured)
+}
+
+// ProcessUnlockedStakings turns the locked stakings whose unlock time has
+// passed into normal stakings.
+// It causes accumulated rewards to be withdrawn to the farmers.
+func (k Keeper) ProcessUnlockedStakings(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.LockedStakingUnlockQueueKeyPrefix, sdk.PrefixEndBytes(types.GetLockedStakingUnlockQueueTimePrefix(ctx.BlockTime())))
+	defer iter.Close()
+
+	type farmerDenom struct {
+		farmer string
+		denom  string
+	}
+	var keys []farmerDenom
+	lockedStakingsByKey := map[farmerDenom][]types.LockedStaking{}
+	for ; iter.Valid(); iter.Next() {
+		_, id := types.ParseLockedStakingUnlockQueueKey(iter.Key())
+		lockedStaking, _ := k.GetLockedStaking(ctx, id)
+		key := farmerDenom{lockedStaking.Farmer, lockedStaking.StakingCoinDenom}
+		if _, ok := lockedStakingsByKey[key]; !ok {
+			keys = append(keys, key)
+		}
+		lockedStakingsByKey[key] = append(lockedStakingsByKey[key], lockedStaking)
+	}
+
+	for _, key := range keys {
+		lockedStakings := lockedStakingsByKey[key]
+		if _, err := k.unlockStakings(ctx, lockedStakings[0].GetFarmer(), key.denom, lockedStakings); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ValidateLockedStakingsAmount checks that the total locked stakings of each
+// staking coin denom match the sum of the locked stakings.
+func (k Keeper) ValidateLockedStakingsAmount(ctx sdk.Context) error {
+	totals := map[string]types.TotalLockedStakings{} // (staking coin denom) => (total locked stakings)
+	k.IterateLockedStakings(ctx, func(lockedStaking types.LockedStaking) (stop bool) {
+		total, ok := totals[lockedStaking.StakingCoinDenom]
+		if !ok {
+			total = types.TotalLockedStakings{Amount: sdk.ZeroInt(), WeightedAmount: sdk.ZeroDec()}
+		}
+		total.Amount = total.Amount.Add(lockedStaking.Amount)
+		total.WeightedAmount = total.WeightedAmount.Add(lockedStaking.Weight())
+		totals[lockedStaking.StakingCoinDenom] = total
+		return false
+	})
+
+	var err error
+	numTotals := 0
+	k.IterateTotalLockedStakings(ctx, func(stakingCoinDenom string, totalLockedStakings types.TotalLockedStakings) (stop bool) {
+		numTotals++
+		total, ok := totals[stakingCoinDenom]
+		if !ok || !total.Amount.Equal(totalLockedStakings.Amount) || !total.WeightedAmount.Equal(totalLockedStakings.WeightedAmount) {
+			err = types.ErrInvalidLockedStakingsAmount
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	if numTotals != len(totals) {
+		return types.ErrInvalidLockedStakingsAmount
+	}
+
+	return nil
+}
@@ -0,0 +1,236 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+const lockDuration = 30 * 24 * time.Hour
+
+// setLockTiers sets a single lock tier with the lock duration and the given multiplier.
+func (suite *KeeperTestSuite) setLockTiers(multiplier sdk.Dec) {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.LockTiers = []types.LockTier{{Duration: lockDuration, Multiplier: multiplier}}
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
+// StakeLocked is a convenient method to test Keeper.StakeLocked.
+func (suite *KeeperTestSuite) StakeLocked(farmerAcc sdk.AccAddress, amt sdk.Coins) []uint64 {
+	ids, err := suite.keeper.StakeLocked(suite.ctx, farmerAcc, amt, lockDuration)
+	suite.Require().NoError(err)
+	return ids
+}
+
+func (suite *KeeperTestSuite) TestStakeLocked() {
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+
+	_, err := suite.keeper.StakeLocked(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), lockDuration)
+	suite.Require().ErrorIs(err, types.ErrInvalidLockDuration)
+
+	suite.setLockTiers(sdk.NewDec(2))
+
+	_, err = suite.keeper.StakeLocked(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), time.Hour)
+	suite.Require().ErrorIs(err, types.ErrInvalidLockDuration)
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	ids := suite.StakeLocked(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom2, 500_000)))
+	suite.Require().Equal([]uint64{1, 2}, ids)
+
+	balancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom2, 500_000)), balancesBefore.Sub(balancesAfter)))
+
+	// Locked staking coins are queued like staking coins.
+	_, found := suite.keeper.GetLockedStaking(suite.ctx, 1)
+	suite.Require().False(found)
+	suite.Require().Len(suite.keeper.GetQueuedLockedStakingsByFarmer(suite.ctx, suite.addrs[0]), 2)
+	queuedLockedStakings := suite.keeper.GetQueuedLockedStakingsByFarmerAndDenom(suite.ctx, suite.addrs[0], denom2)
+	suite.Require().Len(queuedLockedStakings, 1)
+	suite.Require().Equal(uint64(2), queuedLockedStakings[0].Id)
+	suite.Require().Empty(suite.keeper.GetQueuedLockedStakingsByFarmer(suite.ctx, suite.addrs[1]))
+	suite.Require().True(suite.keeper.GetAllQueuedCoinsByFarmer(suite.ctx, suite.addrs[0]).IsZero())
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom2, 500_000)),
+		suite.keeper.GetAllLockedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+	_, found = suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().False(found)
+	_, found = suite.keeper.GetTotalLockedStakings(suite.ctx, denom1)
+	suite.Require().False(found)
+
+	suite.AdvanceEpoch()
+
+	lockedStaking, found := suite.keeper.GetLockedStaking(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.addrs[0].String(), lockedStaking.Farmer)
+	suite.Require().Equal(denom1, lockedStaking.StakingCoinDenom)
+	suite.Require().True(intEq(sdk.NewInt(1_000_000), lockedStaking.Amount))
+	suite.Require().True(decEq(sdk.NewDec(2), lockedStaking.Multiplier))
+	suite.Require().Equal(uint64(1), lockedStaking.StartingEpoch)
+	suite.Require().Equal(types.ParseTime("2021-08-31T00:00:00Z"), lockedStaking.UnlockTime)
+
+	suite.Require().Empty(suite.keeper.GetQueuedLockedStakingsByFarmer(suite.ctx, suite.addrs[0]))
+	suite.Require().True(suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0]).IsZero())
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom2, 500_000)),
+		suite.keeper.GetAllLockedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+
+	totalStakings, found := suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(1_000_000), totalStakings.Amount))
+
+	totalLockedStakings, found := suite.keeper.GetTotalLockedStakings(suite.ctx, denom1)
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(1_000_000), totalLockedStakings.Amount))
+	suite.Require().True(decEq(sdk.NewDec(2_000_000), totalLockedStakings.WeightedAmount))
+}
+
+func (suite *KeeperTestSuite) TestStakeLockedBeforeEpochEnd() {
+	suite.setLockTiers(sdk.NewDec(2))
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1_000_000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+
+	// Locking coins right before the epoch ends doesn't take a share of
+	// the rewards for that epoch.
+	suite.StakeLocked(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)), suite.AllRewards(suite.addrs[0])))
+	suite.Require().True(suite.AllRewards(suite.addrs[1]).IsZero())
+
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_333_333)), suite.AllRewards(suite.addrs[0])))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 666_666)), suite.AllRewards(suite.addrs[1])))
+}
+
+func (suite *KeeperTestSuite) TestStakeLockedDelayedStakingGasFee() {
+	suite.setLockTiers(sdk.NewDec(2))
+
+	suite.ctx = suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	suite.StakeLocked(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom2, 1_000_000)))
+	gasConsumed := suite.ctx.GasMeter().GasConsumed()
+
+	// The delayed staking gas fee is charged for each locked staking.
+	params := suite.keeper.GetParams(suite.ctx)
+	suite.Require().GreaterOrEqual(gasConsumed, 2*params.DelayedStakingGasFee)
+}
+
+func (suite *KeeperTestSuite) TestLockedStakingRewardBoost() {
+	suite.setLockTiers(sdk.NewDec(2))
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1_000_000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch() // queued coins => staked coins
+	suite.StakeLocked(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+
+	// The locked staking is queued and earns nothing in this epoch.
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)), suite.AllRewards(suite.addrs[0])))
+	suite.Require().True(suite.AllRewards(suite.addrs[1]).IsZero())
+	suite.Harvest(suite.addrs[0], []string{denom1})
+
+	// The locked staking weighs twice as much as the staking of the same amount.
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 333_333)), suite.AllRewards(suite.addrs[0])))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 666_666)), suite.AllRewards(suite.addrs[1])))
+
+	balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, suite.addrs[1], denom3)
+	suite.Harvest(suite.addrs[1], []string{denom1})
+	balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, suite.addrs[1], denom3)
+	suite.Require().True(intEq(sdk.NewInt(666_666), balanceAfter.Amount.Sub(balanceBefore.Amount)))
+	suite.Require().True(suite.AllRewards(suite.addrs[1]).IsZero())
+
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 666_666)), suite.AllRewards(suite.addrs[0])))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 666_666)), suite.AllRewards(suite.addrs[1])))
+
+	annualRewards, _ := suite.keeper.EstimateAnnualRewards(suite.ctx)
+	suite.Require().Len(annualRewards, 1)
+	suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom3, sdk.MustNewDecFromStr("0.333333333333333333"))), annualRewards[0].EpochUnitRewards))
+}
+
+func (suite *KeeperTestSuite) TestUnstakeLocked() {
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+	suite.setLockTiers(sdk.NewDecWithPrec(15, 1))
+
+	suite.StakeLocked(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000)))
+	suite.AdvanceEpoch()
+
+	_, err := suite.keeper.Unstake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 600_000)))
+	suite.Require().ErrorIs(err, types.ErrStakingLocked)
+
+	_, err = suite.keeper.Unstake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 2_000_000)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000)))
+	suite.Require().True(suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0]).IsZero())
+
+	_, err = suite.keeper.Unstake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1)))
+	suite.Require().ErrorIs(err, types.ErrStakingLocked)
+
+	// Once the unlock time has passed, the locked staking can be unstaked
+	// before it is processed at the end of an epoch.
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-31T00:00:00Z"))
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 400_000)))
+	suite.Require().True(suite.keeper.GetAllLockedCoinsByFarmer(suite.ctx, suite.addrs[0]).IsZero())
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 600_000)), suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+
+	_, found := suite.keeper.GetTotalLockedStakings(suite.ctx, denom1)
+	suite.Require().False(found)
+	totalStakings, _ := suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().True(intEq(sdk.NewInt(600_000), totalStakings.Amount))
+}
+
+func (suite *KeeperTestSuite) TestProcessUnlockedStakings() {
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+	suite.setLockTiers(sdk.NewDec(2))
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1_000_000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.StakeLocked(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000)))
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-02T00:00:00Z"))
+	suite.StakeLocked(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000)))
+	suite.AdvanceEpoch() // queued locked stakings => locked stakings
+	suite.Harvest(suite.addrs[0], []string{denom1})
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-30T00:00:00Z"))
+	suite.AdvanceEpoch()
+	suite.Require().Len(suite.keeper.GetLockedStakingsByFarmerAndDenom(suite.ctx, suite.addrs[1], denom1), 2)
+
+	// Only the first locked staking is unlocked.
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-31T00:00:00Z"))
+	balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, suite.addrs[1], denom3)
+	suite.AdvanceEpoch()
+	balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, suite.addrs[1], denom3)
+
+	// Rewards are withdrawn when the locked staking is unlocked.
+	suite.Require().True(intEq(sdk.NewInt(1_333_333), balanceAfter.Amount.Sub(balanceBefore.Amount)))
+
+	lockedStakings := suite.keeper.GetLockedStakingsByFarmerAndDenom(suite.ctx, suite.addrs[1], denom1)
+	suite.Require().Len(lockedStakings, 1)
+	suite.Require().Equal(uint64(2), lockedStakings[0].Id)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000)), suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[1])))
+
+	totalLockedStakings, _ := suite.keeper.GetTotalLockedStakings(suite.ctx, denom1)
+	suite.Require().True(intEq(sdk.NewInt(500_000), totalLockedStakings.Amount))
+	suite.Require().True(decEq(sdk.NewDec(1_000_000), totalLockedStakings.WeightedAmount))
+	totalStakings, _ := suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().True(intEq(sdk.NewInt(2_000_000), totalStakings.Amount))
+
+	// The unlocked staking isn't boosted anymore.
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_066_666)), suite.AllRewards(suite.addrs[0])))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 600_000)), suite.AllRewards(suite.addrs[1])))
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-09-01T00:00:00Z"))
+	suite.AdvanceEpoch()
+	suite.Require().True(suite.keeper.GetAllLockedCoinsByFarmer(suite.ctx, suite.addrs[1]).IsZero())
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[1])))
+	_, found := suite.keeper.GetTotalLockedStakings(suite.ctx, denom1)
+	suite.Require().False(found)
+}
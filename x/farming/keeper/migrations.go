@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/tendermint/farming/x/farming/migrations/v2"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+// The params store key is needed to delete parameters that no longer exist,
+// which can't be done through the subspace.
+type Migrator struct {
+	keeper         Keeper
+	paramsStoreKey sdk.StoreKey
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper, paramsStoreKey sdk.StoreKey) Migrator {
+	return Migrator{keeper: keeper, paramsStoreKey: paramsStoreKey}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.paramSpace, m.paramsStoreKey)
+}
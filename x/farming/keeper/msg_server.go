@@ -10,6 +10,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/tendermint/farming/x/farming/types"
 )
@@ -34,11 +35,15 @@ func (k msgServer) CreateFixedAmountPlan(goCtx context.Context, msg *types.MsgCr
 		return nil, err
 	}
 
-	if _, err := k.Keeper.CreateFixedAmountPlan(ctx, msg, poolAcc, msg.GetCreator(), types.PlanTypePrivate); err != nil {
+	plan, err := k.Keeper.CreateFixedAmountPlan(ctx, msg, poolAcc, msg.GetCreator(), types.PlanTypePrivate)
+	if err != nil {
 		return nil, err
 	}
 
-	return &types.MsgCreateFixedAmountPlanResponse{}, nil
+	return &types.MsgCreateFixedAmountPlanResponse{
+		PlanId:             plan.GetId(),
+		FarmingPoolAddress: plan.GetFarmingPoolAddress().String(),
+	}, nil
 }
 
 // CreateRatioPlan defines a method for creating ratio farming plan.
@@ -49,7 +54,8 @@ func (k msgServer) CreateRatioPlan(goCtx context.Context, msg *types.MsgCreateRa
 		return nil, err
 	}
 
-	if _, err := k.Keeper.CreateRatioPlan(ctx, msg, poolAcc, msg.GetCreator(), types.PlanTypePrivate); err != nil {
+	plan, err := k.Keeper.CreateRatioPlan(ctx, msg, poolAcc, msg.GetCreator(), types.PlanTypePrivate)
+	if err != nil {
 		return nil, err
 	}
 
@@ -58,14 +64,47 @@ func (k msgServer) CreateRatioPlan(goCtx context.Context, msg *types.MsgCreateRa
 		return nil, err
 	}
 
-	return &types.MsgCreateRatioPlanResponse{}, nil
+	return &types.MsgCreateRatioPlanResponse{
+		PlanId:             plan.GetId(),
+		FarmingPoolAddress: plan.GetFarmingPoolAddress().String(),
+	}, nil
+}
+
+// CreateDecayingPlan defines a method for creating decaying farming plan.
+func (k msgServer) CreateDecayingPlan(goCtx context.Context, msg *types.MsgCreateDecayingPlan) (*types.MsgCreateDecayingPlanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	poolAcc, err := k.DerivePrivatePlanFarmingPoolAcc(ctx, msg.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := k.Keeper.CreateDecayingPlan(ctx, msg, poolAcc, msg.GetCreator(), types.PlanTypePrivate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDecayingPlanResponse{
+		PlanId:             plan.GetId(),
+		FarmingPoolAddress: plan.GetFarmingPoolAddress().String(),
+	}, nil
 }
 
 // Stake defines a method for staking coins to the farming plan.
 func (k msgServer) Stake(goCtx context.Context, msg *types.MsgStake) (*types.MsgStakeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if err := k.Keeper.Stake(ctx, msg.GetFarmer(), msg.StakingCoins); err != nil {
+	if msg.LockDuration > 0 {
+		if !msg.GetPayer().Equals(msg.GetFarmer()) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "staking coins can't be locked on behalf of another farmer")
+		}
+		ids, err := k.Keeper.StakeLocked(ctx, msg.GetFarmer(), msg.StakingCoins, msg.LockDuration)
+		if err != nil {
+			return nil, err
+		}
+		return &types.MsgStakeResponse{LockedStakingIds: ids}, nil
+	}
+
+	if err := k.Keeper.StakeFor(ctx, msg.GetPayer(), msg.GetFarmer(), msg.StakingCoins); err != nil {
 		return nil, err
 	}
 
@@ -76,22 +115,117 @@ func (k msgServer) Stake(goCtx context.Context, msg *types.MsgStake) (*types.Msg
 func (k msgServer) Unstake(goCtx context.Context, msg *types.MsgUnstake) (*types.MsgUnstakeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if err := k.Keeper.Unstake(ctx, msg.GetFarmer(), msg.UnstakingCoins); err != nil {
+	withdrawnRewards, err := k.Keeper.Unstake(ctx, msg.GetFarmer(), msg.UnstakingCoins)
+	if err != nil {
 		return nil, err
 	}
 
-	return &types.MsgUnstakeResponse{}, nil
+	return &types.MsgUnstakeResponse{WithdrawnRewards: withdrawnRewards}, nil
 }
 
 // Harvest defines a method for claiming farming rewards from the farming plan.
 func (k msgServer) Harvest(goCtx context.Context, msg *types.MsgHarvest) (*types.MsgHarvestResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if err := k.Keeper.Harvest(ctx, msg.GetFarmer(), msg.StakingCoinDenoms); err != nil {
+	if msg.StakeRewards {
+		harvestedRewards, stakedRewards, err := k.Keeper.HarvestAndStake(ctx, msg.GetFarmer(), msg.StakingCoinDenoms)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.MsgHarvestResponse{HarvestedRewards: harvestedRewards, StakedRewards: stakedRewards}, nil
+	}
+
+	harvestedRewards, err := k.Keeper.Harvest(ctx, msg.GetFarmer(), msg.StakingCoinDenoms)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgHarvestResponse{HarvestedRewards: harvestedRewards}, nil
+}
+
+// ModifyPrivatePlan defines a method for modifying an existing private farming plan.
+func (k msgServer) ModifyPrivatePlan(goCtx context.Context, msg *types.MsgModifyPrivatePlan) (*types.MsgModifyPrivatePlanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.Keeper.ModifyPrivatePlan(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	plans := k.GetPlans(ctx)
+	if err := types.ValidateTotalEpochRatio(plans); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgModifyPrivatePlanResponse{}, nil
+}
+
+// TerminatePrivatePlan defines a method for terminating a private farming plan.
+func (k msgServer) TerminatePrivatePlan(goCtx context.Context, msg *types.MsgTerminatePrivatePlan) (*types.MsgTerminatePrivatePlanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.TerminatePrivatePlan(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTerminatePrivatePlanResponse{}, nil
+}
+
+// FundPrivatePlan defines a method for funding the farming pool of a private farming plan.
+func (k msgServer) FundPrivatePlan(goCtx context.Context, msg *types.MsgFundPrivatePlan) (*types.MsgFundPrivatePlanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.FundPrivatePlan(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundPrivatePlanResponse{}, nil
+}
+
+// CancelUnbonding defines a method for canceling an unbonding and staking the coins again.
+func (k msgServer) CancelUnbonding(goCtx context.Context, msg *types.MsgCancelUnbonding) (*types.MsgCancelUnbondingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.CancelUnbonding(ctx, msg.GetFarmer(), msg.UnbondingId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelUnbondingResponse{}, nil
+}
+
+// SetRewardsWithdrawAddress defines a method for setting the address that the farmer's rewards are withdrawn to.
+func (k msgServer) SetRewardsWithdrawAddress(goCtx context.Context, msg *types.MsgSetRewardsWithdrawAddress) (*types.MsgSetRewardsWithdrawAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.SetRewardsWithdrawAddress(ctx, msg.GetFarmer(), msg.GetWithdrawAddress()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetRewardsWithdrawAddressResponse{}, nil
+}
+
+// DepositAndStake defines a method for depositing coins to a liquidity pool and staking the minted pool coins.
+func (k msgServer) DepositAndStake(goCtx context.Context, msg *types.MsgDepositAndStake) (*types.MsgDepositAndStakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id, err := k.Keeper.DepositAndStake(ctx, msg.GetFarmer(), msg.PoolId, msg.DepositCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDepositAndStakeResponse{DepositRequestId: id}, nil
+}
+
+// UnstakeAndWithdraw defines a method for unstaking pool coins and withdrawing them from the liquidity pool.
+func (k msgServer) UnstakeAndWithdraw(goCtx context.Context, msg *types.MsgUnstakeAndWithdraw) (*types.MsgUnstakeAndWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	withdrawnRewards, err := k.Keeper.UnstakeAndWithdraw(ctx, msg.GetFarmer(), msg.PoolId, msg.PoolCoin)
+	if err != nil {
 		return nil, err
 	}
 
-	return &types.MsgHarvestResponse{}, nil
+	return &types.MsgUnstakeAndWithdrawResponse{WithdrawnRewards: withdrawnRewards}, nil
 }
 
 // AdvanceEpoch defines a method for advancing epoch by one, just for testing purpose
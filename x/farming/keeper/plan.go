@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"strconv"
+	"time"
 
 	gogotypes "github.com/gogo/protobuf/types"
 
@@ -33,24 +34,84 @@ func (k Keeper) GetPlans(ctx sdk.Context) (plans []types.PlanI) {
 }
 
 // SetPlan sets a plan for a given plan id.
+// It also adds the plan to the end time index and the active plan index,
+// which only contain the plans that are not terminated.
+// It doesn't delete the old index keys of the plan, which is done by
+// TerminatePlan and setModifiedPlan since only they change the terminated
+// state or the end time of a plan.
 func (k Keeper) SetPlan(ctx sdk.Context, plan types.PlanI) {
-	id := plan.GetId()
-	store := ctx.KVStore(k.storeKey)
+	k.setPlan(ctx, plan)
+	if !plan.GetTerminated() {
+		store := ctx.KVStore(k.storeKey)
+		store.Set(types.GetPlanEndTimeIndexKey(plan.GetEndTime(), plan.GetId()), []byte{})
+		store.Set(types.GetActivePlanIndexKey(plan.GetId()), []byte{})
+	}
+}
 
+// setPlan sets a plan without touching the plan indexes.
+// It is used for the updates that change neither the terminated state nor
+// the end time of a plan, such as the reward allocation.
+func (k Keeper) setPlan(ctx sdk.Context, plan types.PlanI) {
 	bz, err := k.MarshalPlan(plan)
 	if err != nil {
 		panic(err)
 	}
 
-	store.Set(types.GetPlanKey(id), bz)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPlanKey(plan.GetId()), bz)
+}
+
+// setModifiedPlan sets a plan modified from the stored plan whose end time
+// was oldEndTime, moving the end time index key if the end time has changed.
+func (k Keeper) setModifiedPlan(ctx sdk.Context, plan types.PlanI, oldEndTime time.Time) {
+	if !plan.GetEndTime().Equal(oldEndTime) {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetPlanEndTimeIndexKey(oldEndTime, plan.GetId()))
+	}
+	k.SetPlan(ctx, plan)
 }
 
 // RemovePlan removes a plan from the store.
 // NOTE: this will cause supply invariant violation if called
 func (k Keeper) RemovePlan(ctx sdk.Context, plan types.PlanI) {
-	id := plan.GetId()
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.GetPlanKey(id))
+	deletePlanIndexes(store, plan)
+	store.Delete(types.GetPlanKey(plan.GetId()))
+}
+
+// deletePlanIndexes deletes the index keys of a plan.
+func deletePlanIndexes(store sdk.KVStore, plan types.PlanI) {
+	store.Delete(types.GetPlanEndTimeIndexKey(plan.GetEndTime(), plan.GetId()))
+	store.Delete(types.GetActivePlanIndexKey(plan.GetId()))
+}
+
+// GetActivePlans returns all plans that are not terminated, using the
+// active plan index.
+func (k Keeper) GetActivePlans(ctx sdk.Context) (plans []types.PlanI) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ActivePlanIndexKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		plan, _ := k.GetPlan(ctx, types.ParseActivePlanIndexKey(iter.Key()))
+		plans = append(plans, plan)
+	}
+
+	return plans
+}
+
+// GetPlansEndedBefore returns the plans that are not terminated and whose
+// end time is before the given time, in the order of the end time.
+func (k Keeper) GetPlansEndedBefore(ctx sdk.Context, t time.Time) (plans []types.PlanI) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.PlanEndTimeIndexKeyPrefix, types.GetPlanEndTimeIndexTimePrefix(t))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, id := types.ParsePlanEndTimeIndexKey(iter.Key())
+		plan, _ := k.GetPlan(ctx, id)
+		plans = append(plans, plan)
+	}
+
+	return plans
 }
 
 // IteratePlans iterates over all the stored plans and performs a callback function.
@@ -123,6 +184,10 @@ func (k Keeper) UnmarshalPlan(bz []byte) (plan types.PlanI, err error) {
 
 // CreateFixedAmountPlan sets fixed amount plan.
 func (k Keeper) CreateFixedAmountPlan(ctx sdk.Context, msg *types.MsgCreateFixedAmountPlan, farmingPoolAcc, terminationAcc sdk.AccAddress, typ types.PlanType) (types.PlanI, error) {
+	if err := k.ValidateStakingCoinWeightsAllowed(ctx, msg.StakingCoinWeights); err != nil {
+		return nil, err
+	}
+
 	nextId := k.GetNextPlanIdWithUpdate(ctx)
 	if typ == types.PlanTypePrivate {
 		params := k.GetParams(ctx)
@@ -169,6 +234,10 @@ func (k Keeper) CreateFixedAmountPlan(ctx sdk.Context, msg *types.MsgCreateFixed
 
 // CreateRatioPlan sets ratio plan.
 func (k Keeper) CreateRatioPlan(ctx sdk.Context, msg *types.MsgCreateRatioPlan, farmingPoolAcc, terminationAcc sdk.AccAddress, typ types.PlanType) (types.PlanI, error) {
+	if err := k.ValidateStakingCoinWeightsAllowed(ctx, msg.StakingCoinWeights); err != nil {
+		return nil, err
+	}
+
 	nextId := k.GetNextPlanIdWithUpdate(ctx)
 	if typ == types.PlanTypePrivate {
 		params := k.GetParams(ctx)
@@ -213,6 +282,58 @@ func (k Keeper) CreateRatioPlan(ctx sdk.Context, msg *types.MsgCreateRatioPlan,
 	return ratioPlan, nil
 }
 
+// CreateDecayingPlan sets decaying plan.
+func (k Keeper) CreateDecayingPlan(ctx sdk.Context, msg *types.MsgCreateDecayingPlan, farmingPoolAcc, terminationAcc sdk.AccAddress, typ types.PlanType) (types.PlanI, error) {
+	if err := k.ValidateStakingCoinWeightsAllowed(ctx, msg.StakingCoinWeights); err != nil {
+		return nil, err
+	}
+
+	nextId := k.GetNextPlanIdWithUpdate(ctx)
+	if typ == types.PlanTypePrivate {
+		params := k.GetParams(ctx)
+
+		farmingFeeCollectorAcc, err := sdk.AccAddressFromBech32(params.FarmingFeeCollector)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, msg.GetCreator(), farmingFeeCollectorAcc, params.PrivatePlanCreationFee); err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to pay private plan creation fee")
+		}
+	}
+
+	basePlan := types.NewBasePlan(
+		nextId,
+		msg.Name,
+		typ,
+		farmingPoolAcc.String(),
+		terminationAcc.String(),
+		msg.StakingCoinWeights,
+		msg.StartTime,
+		msg.EndTime,
+	)
+
+	decayingPlan := types.NewDecayingPlan(basePlan, msg.EpochAmount, msg.DecayFactor, msg.DecayEpochs, msg.DecayTimes)
+
+	k.SetPlan(ctx, decayingPlan)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateDecayingPlan,
+			sdk.NewAttribute(types.AttributeKeyPlanId, strconv.FormatUint(nextId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPlanName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyFarmingPoolAddress, farmingPoolAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyStartTime, msg.StartTime.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, msg.EndTime.String()),
+			sdk.NewAttribute(types.AttributeKeyEpochAmount, msg.EpochAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyDecayFactor, msg.DecayFactor.String()),
+			sdk.NewAttribute(types.AttributeKeyDecayEpochs, strconv.FormatUint(uint64(msg.DecayEpochs), 10)),
+		),
+	})
+
+	return decayingPlan, nil
+}
+
 // TerminatePlan sends all remaining coins in the plan's farming pool to
 // the termination address and mark the plan as terminated.
 func (k Keeper) TerminatePlan(ctx sdk.Context, plan types.PlanI) error {
@@ -225,8 +346,10 @@ func (k Keeper) TerminatePlan(ctx sdk.Context, plan types.PlanI) error {
 		}
 	}
 
+	store := ctx.KVStore(k.storeKey)
+	deletePlanIndexes(store, plan)
 	_ = plan.SetTerminated(true)
-	k.SetPlan(ctx, plan)
+	k.setPlan(ctx, plan)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -237,6 +360,132 @@ func (k Keeper) TerminatePlan(ctx sdk.Context, plan types.PlanI) error {
 		),
 	})
 
+	k.AfterPlanTerminated(ctx, plan)
+
+	return nil
+}
+
+// GetPrivatePlanForTermination returns the private plan with the given id
+// after checking that the given address is the termination address of the plan
+// and the plan is not terminated yet.
+func (k Keeper) GetPrivatePlanForTermination(ctx sdk.Context, planId uint64, terminationAcc sdk.AccAddress) (types.PlanI, error) {
+	plan, found := k.GetPlan(ctx, planId)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "plan %d is not found", planId)
+	}
+
+	if plan.GetType() != types.PlanTypePrivate {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPlanType, "plan %d is not a private plan", planId)
+	}
+
+	if !plan.GetTerminationAddress().Equals(terminationAcc) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the termination address of plan %d", terminationAcc, planId)
+	}
+
+	if plan.GetTerminated() {
+		return nil, sdkerrors.Wrapf(types.ErrPlanAlreadyTerminated, "plan %d", planId)
+	}
+
+	return plan, nil
+}
+
+// ModifyPrivatePlan updates the non-empty fields of the private plan.
+func (k Keeper) ModifyPrivatePlan(ctx sdk.Context, msg *types.MsgModifyPrivatePlan) (types.PlanI, error) {
+	plan, err := k.GetPrivatePlanForTermination(ctx, msg.PlanId, msg.GetTerminationAddress())
+	if err != nil {
+		return nil, err
+	}
+	oldEndTime := plan.GetEndTime()
+
+	if msg.Name != "" {
+		if err := plan.SetName(msg.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.StakingCoinWeights != nil {
+		if err := k.ValidateStakingCoinWeightsAllowed(ctx, msg.StakingCoinWeights); err != nil {
+			return nil, err
+		}
+		if err := plan.SetStakingCoinWeights(msg.StakingCoinWeights); err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.StartTime != nil {
+		if err := plan.SetStartTime(*msg.StartTime); err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.EndTime != nil {
+		if err := plan.SetEndTime(*msg.EndTime); err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.IsForFixedAmountPlan() {
+		// change the plan to fixed amount plan
+		plan = types.NewFixedAmountPlan(plan.GetBasePlan(), msg.EpochAmount)
+	} else if msg.IsForRatioPlan() {
+		// change the plan to ratio plan
+		plan = types.NewRatioPlan(plan.GetBasePlan(), msg.EpochRatio)
+	} else if msg.IsForDecayingPlan() {
+		// change the plan to decaying plan
+		plan = types.ToDecayingPlan(plan, msg.EpochAmount, msg.DecayFactor, msg.DecayEpochs, msg.DecayTimes)
+	}
+
+	if err := plan.Validate(); err != nil {
+		return nil, err
+	}
+
+	k.setModifiedPlan(ctx, plan, oldEndTime)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeModifyPrivatePlan,
+			sdk.NewAttribute(types.AttributeKeyPlanId, strconv.FormatUint(plan.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeyPlanName, plan.GetName()),
+			sdk.NewAttribute(types.AttributeKeyFarmingPoolAddress, plan.GetFarmingPoolAddress().String()),
+			sdk.NewAttribute(types.AttributeKeyStartTime, plan.GetStartTime().String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, plan.GetEndTime().String()),
+		),
+	})
+
+	return plan, nil
+}
+
+// TerminatePrivatePlan terminates the private plan on behalf of its termination address.
+func (k Keeper) TerminatePrivatePlan(ctx sdk.Context, msg *types.MsgTerminatePrivatePlan) error {
+	plan, err := k.GetPrivatePlanForTermination(ctx, msg.PlanId, msg.GetTerminationAddress())
+	if err != nil {
+		return err
+	}
+
+	return k.TerminatePlan(ctx, plan)
+}
+
+// FundPrivatePlan sends coins from the termination address of the private plan
+// to the plan's farming pool.
+func (k Keeper) FundPrivatePlan(ctx sdk.Context, msg *types.MsgFundPrivatePlan) error {
+	plan, err := k.GetPrivatePlanForTermination(ctx, msg.PlanId, msg.GetTerminationAddress())
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, msg.GetTerminationAddress(), plan.GetFarmingPoolAddress(), msg.Amount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFundPrivatePlan,
+			sdk.NewAttribute(types.AttributeKeyPlanId, strconv.FormatUint(plan.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeyFarmingPoolAddress, plan.GetFarmingPoolAddress().String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	})
+
 	return nil
 }
 
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/tendermint/farming/app"
+	"github.com/tendermint/farming/x/farming/keeper"
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// setupBenchmarkPlans stores numPlans plans, of which only numActivePlans are
+// not terminated, which is the usual state of a long-running chain.
+func setupBenchmarkPlans(b *testing.B, numPlans, numActivePlans int) (sdk.Context, keeper.Keeper) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	k := app.FarmingKeeper
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.ZeroInt())[0]
+	for i := 1; i <= numPlans; i++ {
+		plan := types.NewFixedAmountPlan(
+			types.NewBasePlan(
+				uint64(i),
+				"plan",
+				types.PlanTypePublic,
+				addr.String(),
+				addr.String(),
+				sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom1, sdk.OneDec())),
+				types.ParseTime("0001-01-01T00:00:00Z"),
+				types.ParseTime("9999-12-31T00:00:00Z"),
+			),
+			sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)),
+		)
+		if i > numActivePlans {
+			_ = plan.SetTerminated(true)
+		}
+		k.SetPlan(ctx, plan)
+	}
+
+	b.ResetTimer()
+	return ctx, k
+}
+
+// BenchmarkGetPlansScan measures how the EndBlocker found the plans to
+// terminate before the end time index was added.
+func BenchmarkGetPlansScan(b *testing.B) {
+	ctx, k := setupBenchmarkPlans(b, 5000, 10)
+	for i := 0; i < b.N; i++ {
+		for _, plan := range k.GetPlans(ctx) {
+			if !plan.GetTerminated() && !ctx.BlockTime().Before(plan.GetEndTime()) {
+				b.Fatal("unexpected plan to terminate")
+			}
+		}
+	}
+}
+
+func BenchmarkGetPlansEndedBefore(b *testing.B) {
+	ctx, k := setupBenchmarkPlans(b, 5000, 10)
+	for i := 0; i < b.N; i++ {
+		if len(k.GetPlansEndedBefore(ctx, ctx.BlockTime())) != 0 {
+			b.Fatal("unexpected plan to terminate")
+		}
+	}
+}
+
+func BenchmarkGetActivePlans(b *testing.B) {
+	ctx, k := setupBenchmarkPlans(b, 5000, 10)
+	for i := 0; i < b.N; i++ {
+		if len(k.GetActivePlans(ctx)) != 10 {
+			b.Fatal("unexpected number of active plans")
+		}
+	}
+}
+
+func BenchmarkAllocationInfos(b *testing.B) {
+	ctx, k := setupBenchmarkPlans(b, 5000, 10)
+	for i := 0; i < b.N; i++ {
+		k.AllocationInfos(ctx)
+	}
+}
@@ -1,6 +1,12 @@
 package keeper_test
 
 import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
 	"github.com/tendermint/farming/x/farming/types"
 )
 
@@ -60,3 +66,218 @@ func (suite *KeeperTestSuite) TestGlobalPlanId() {
 	nextPlanId = suite.keeper.GetNextPlanIdWithUpdate(cacheCtx)
 	suite.Require().Equal(uint64(3), nextPlanId)
 }
+
+func (suite *KeeperTestSuite) createPrivateFixedAmountPlan(creatorAcc sdk.AccAddress) types.PlanI {
+	name := "private-plan"
+	poolAcc, err := suite.keeper.DerivePrivatePlanFarmingPoolAcc(suite.ctx, name)
+	suite.Require().NoError(err)
+
+	msg := types.NewMsgCreateFixedAmountPlan(
+		name,
+		creatorAcc,
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom1, sdk.OneDec())),
+		types.ParseTime("0001-01-01T00:00:00Z"),
+		types.ParseTime("9999-12-31T00:00:00Z"),
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)),
+	)
+	plan, err := suite.keeper.CreateFixedAmountPlan(suite.ctx, msg, poolAcc, creatorAcc, types.PlanTypePrivate)
+	suite.Require().NoError(err)
+
+	return plan
+}
+
+func (suite *KeeperTestSuite) TestModifyPrivatePlan() {
+	plan := suite.createPrivateFixedAmountPlan(suite.addrs[0])
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1_000_000})
+
+	endTime := types.ParseTime("2030-01-01T00:00:00Z")
+
+	for _, tc := range []struct {
+		name        string
+		msg         *types.MsgModifyPrivatePlan
+		expectedErr error
+	}{
+		{
+			"not found",
+			types.NewMsgModifyPrivatePlan(10, suite.addrs[0], "", nil, nil, &endTime, nil, sdk.Dec{}),
+			sdkerrors.ErrNotFound,
+		},
+		{
+			"public plan",
+			types.NewMsgModifyPrivatePlan(2, suite.addrs[4], "", nil, nil, &endTime, nil, sdk.Dec{}),
+			types.ErrInvalidPlanType,
+		},
+		{
+			"not the termination address",
+			types.NewMsgModifyPrivatePlan(plan.GetId(), suite.addrs[1], "", nil, nil, &endTime, nil, sdk.Dec{}),
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"invalid end time",
+			types.NewMsgModifyPrivatePlan(plan.GetId(), suite.addrs[0], "", nil, nil, &time.Time{}, nil, sdk.Dec{}),
+			types.ErrInvalidPlanEndTime,
+		},
+		{
+			"happy case",
+			types.NewMsgModifyPrivatePlan(plan.GetId(), suite.addrs[0], "new-name", nil, nil, &endTime, nil, sdk.NewDecWithPrec(1, 1)),
+			nil,
+		},
+	} {
+		suite.Run(tc.name, func() {
+			cacheCtx, _ := suite.ctx.CacheContext()
+			modified, err := suite.keeper.ModifyPrivatePlan(cacheCtx, tc.msg)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			stored, found := suite.keeper.GetPlan(cacheCtx, plan.GetId())
+			suite.Require().True(found)
+			suite.Require().Equal(modified, stored)
+			suite.Require().Equal("new-name", stored.GetName())
+			suite.Require().Equal(endTime, stored.GetEndTime())
+			suite.Require().Equal(plan.GetStartTime(), stored.GetStartTime())
+			suite.Require().Equal(plan.GetFarmingPoolAddress(), stored.GetFarmingPoolAddress())
+
+			ratioPlan, ok := stored.(*types.RatioPlan)
+			suite.Require().True(ok)
+			suite.Require().True(decEq(sdk.NewDecWithPrec(1, 1), ratioPlan.EpochRatio))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestModifyPrivatePlanToDecayingPlan() {
+	plan := suite.createPrivateFixedAmountPlan(suite.addrs[0])
+
+	msg := types.NewMsgModifyPrivatePlan(plan.GetId(), suite.addrs[0], "", nil, nil, nil, sdk.NewCoins(sdk.NewInt64Coin(denom3, 2_000_000)), sdk.Dec{})
+	msg.DecayFactor = sdk.NewDecWithPrec(5, 1)
+	msg.DecayTimes = []time.Time{types.ParseTime("2022-01-01T00:00:00Z")}
+	suite.Require().NoError(msg.ValidateBasic())
+
+	_, err := suite.keeper.ModifyPrivatePlan(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	stored, _ := suite.keeper.GetPlan(suite.ctx, plan.GetId())
+	decayingPlan, ok := stored.(*types.DecayingPlan)
+	suite.Require().True(ok)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 2_000_000)), decayingPlan.EpochAmount))
+	suite.Require().True(decEq(sdk.NewDecWithPrec(5, 1), decayingPlan.DecayFactor))
+	suite.Require().Equal([]time.Time{types.ParseTime("2022-01-01T00:00:00Z")}, decayingPlan.DecayTimes)
+
+	// Elapsed epochs are preserved when the decay schedule of a decaying plan is modified.
+	decayingPlan.ElapsedEpochs = 3
+	suite.keeper.SetPlan(suite.ctx, decayingPlan)
+
+	msg.DecayEpochs = 2
+	msg.DecayTimes = nil
+	_, err = suite.keeper.ModifyPrivatePlan(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	stored, _ = suite.keeper.GetPlan(suite.ctx, plan.GetId())
+	decayingPlan = stored.(*types.DecayingPlan)
+	suite.Require().Equal(uint32(2), decayingPlan.DecayEpochs)
+	suite.Require().Equal(uint64(3), decayingPlan.ElapsedEpochs)
+}
+
+func (suite *KeeperTestSuite) TestTerminatePrivatePlan() {
+	plan := suite.createPrivateFixedAmountPlan(suite.addrs[0])
+
+	poolBalances := sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000))
+	err := suite.app.BankKeeper.SendCoins(suite.ctx, suite.addrs[1], plan.GetFarmingPoolAddress(), poolBalances)
+	suite.Require().NoError(err)
+
+	err = suite.keeper.TerminatePrivatePlan(suite.ctx, types.NewMsgTerminatePrivatePlan(plan.GetId(), suite.addrs[1]))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	err = suite.keeper.TerminatePrivatePlan(suite.ctx, types.NewMsgTerminatePrivatePlan(plan.GetId(), suite.addrs[0]))
+	suite.Require().NoError(err)
+
+	plan, found := suite.keeper.GetPlan(suite.ctx, plan.GetId())
+	suite.Require().True(found)
+	suite.Require().True(plan.GetTerminated())
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, plan.GetFarmingPoolAddress()).IsZero())
+	suite.Require().True(coinsEq(balancesBefore.Add(poolBalances...), suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])))
+
+	err = suite.keeper.TerminatePrivatePlan(suite.ctx, types.NewMsgTerminatePrivatePlan(plan.GetId(), suite.addrs[0]))
+	suite.Require().ErrorIs(err, types.ErrPlanAlreadyTerminated)
+}
+
+func (suite *KeeperTestSuite) TestFundPrivatePlan() {
+	plan := suite.createPrivateFixedAmountPlan(suite.addrs[0])
+	amount := sdk.NewCoins(sdk.NewInt64Coin(denom3, 5_000_000))
+
+	err := suite.keeper.FundPrivatePlan(suite.ctx, types.NewMsgFundPrivatePlan(plan.GetId(), suite.addrs[1], amount))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	err = suite.keeper.FundPrivatePlan(suite.ctx, types.NewMsgFundPrivatePlan(plan.GetId(), suite.addrs[0], amount))
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(amount, suite.app.BankKeeper.GetAllBalances(suite.ctx, plan.GetFarmingPoolAddress())))
+
+	err = suite.keeper.FundPrivatePlan(suite.ctx, types.NewMsgFundPrivatePlan(plan.GetId(), suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom3, 10_000_000_000))))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+}
+
+func (suite *KeeperTestSuite) TestFundPrivatePlanWithAuthz() {
+	plan := suite.createPrivateFixedAmountPlan(suite.addrs[0])
+	amount := sdk.NewCoins(sdk.NewInt64Coin(denom3, 5_000_000))
+	msg := types.NewMsgFundPrivatePlan(plan.GetId(), suite.addrs[0], amount)
+
+	// the grantee cannot execute the message without a grant
+	_, err := suite.app.AuthzKeeper.DispatchActions(suite.ctx, suite.addrs[1], []sdk.Msg{msg})
+	suite.Require().Error(err)
+
+	err = suite.app.AuthzKeeper.SaveGrant(
+		suite.ctx, suite.addrs[1], suite.addrs[0],
+		authz.NewGenericAuthorization(sdk.MsgTypeURL(msg)), suite.ctx.BlockTime().AddDate(1, 0, 0),
+	)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.AuthzKeeper.DispatchActions(suite.ctx, suite.addrs[1], []sdk.Msg{msg})
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(amount, suite.app.BankKeeper.GetAllBalances(suite.ctx, plan.GetFarmingPoolAddress())))
+}
+
+func (suite *KeeperTestSuite) TestPlanIndexes() {
+	for _, plan := range suite.samplePlans {
+		suite.keeper.SetPlan(suite.ctx, plan)
+	}
+
+	planIds := func(plans []types.PlanI) (ids []uint64) {
+		for _, plan := range plans {
+			ids = append(ids, plan.GetId())
+		}
+		return
+	}
+
+	endTime := types.ParseTime("2021-08-10T00:00:00Z")
+
+	// Plans are returned in the order of their end time,
+	// and a plan ending at the given time is not included.
+	suite.Require().Equal([]uint64{4, 3}, planIds(suite.keeper.GetPlansEndedBefore(suite.ctx, endTime)))
+	suite.Require().Equal([]uint64{1, 2, 3, 4}, planIds(suite.keeper.GetActivePlans(suite.ctx)))
+
+	// Changing the end time of a plan moves its index.
+	newEndTime := types.ParseTime("2021-08-08T00:00:00Z")
+	err := suite.keeper.ModifyPublicPlanProposal(suite.ctx, []types.ModifyPlanRequest{{PlanId: 2, EndTime: &newEndTime}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{4, 2, 3}, planIds(suite.keeper.GetPlansEndedBefore(suite.ctx, endTime)))
+
+	newEndTime = types.ParseTime("2021-08-11T00:00:00Z")
+	_, err = suite.keeper.ModifyPrivatePlan(suite.ctx, types.NewMsgModifyPrivatePlan(1, suite.addrs[4], "", nil, nil, &newEndTime, nil, sdk.Dec{}))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{4, 2, 3, 1}, planIds(suite.keeper.GetPlansEndedBefore(suite.ctx, types.ParseTime("9999-12-31T00:00:00Z"))))
+
+	// Terminated plans are removed from the indexes.
+	plan, _ := suite.keeper.GetPlan(suite.ctx, 3)
+	suite.Require().NoError(suite.keeper.TerminatePlan(suite.ctx, plan))
+	suite.Require().Equal([]uint64{4, 2}, planIds(suite.keeper.GetPlansEndedBefore(suite.ctx, endTime)))
+	suite.Require().Equal([]uint64{1, 2, 4}, planIds(suite.keeper.GetActivePlans(suite.ctx)))
+
+	// Removed plans are removed from the indexes.
+	plan, _ = suite.keeper.GetPlan(suite.ctx, 4)
+	suite.keeper.RemovePlan(suite.ctx, plan)
+	suite.Require().Equal([]uint64{2}, planIds(suite.keeper.GetPlansEndedBefore(suite.ctx, endTime)))
+	suite.Require().Equal([]uint64{1, 2}, planIds(suite.keeper.GetActivePlans(suite.ctx)))
+}
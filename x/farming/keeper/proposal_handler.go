@@ -65,6 +65,30 @@ func (k Keeper) AddPublicPlanProposal(ctx sdk.Context, proposals []types.AddPlan
 
 			logger := k.Logger(ctx)
 			logger.Info("created public fixed amount plan", "fixed_amount_plan", plan)
+
+			k.warnInsufficientFunding(ctx, plan)
+		} else if p.IsForDecayingPlan() {
+			msg := types.NewMsgCreateDecayingPlan(
+				p.GetName(),
+				farmingPoolAcc,
+				p.GetStakingCoinWeights(),
+				p.GetStartTime(),
+				p.GetEndTime(),
+				p.EpochAmount,
+				p.DecayFactor,
+				p.GetDecayEpochs(),
+				p.GetDecayTimes(),
+			)
+
+			plan, err := k.CreateDecayingPlan(ctx, msg, farmingPoolAcc, terminationAcc, types.PlanTypePublic)
+			if err != nil {
+				return err
+			}
+
+			logger := k.Logger(ctx)
+			logger.Info("created public decaying plan", "decaying_plan", plan)
+
+			k.warnInsufficientFunding(ctx, plan)
 		} else {
 			msg := types.NewMsgCreateRatioPlan(
 				p.GetName(),
@@ -82,6 +106,8 @@ func (k Keeper) AddPublicPlanProposal(ctx sdk.Context, proposals []types.AddPlan
 
 			logger := k.Logger(ctx)
 			logger.Info("created public ratio amount plan", "ratio_plan", plan)
+
+			k.warnInsufficientFunding(ctx, plan)
 		}
 	}
 
@@ -99,6 +125,7 @@ func (k Keeper) ModifyPublicPlanProposal(ctx sdk.Context, proposals []types.Modi
 		if plan.GetType() != types.PlanTypePublic {
 			return sdkerrors.Wrapf(types.ErrInvalidPlanType, "plan %d is not a public plan", p.GetPlanId())
 		}
+		oldEndTime := plan.GetEndTime()
 
 		if p.GetName() != "" {
 			if err := plan.SetName(p.GetName()); err != nil {
@@ -127,6 +154,9 @@ func (k Keeper) ModifyPublicPlanProposal(ctx sdk.Context, proposals []types.Modi
 		}
 
 		if p.GetStakingCoinWeights() != nil {
+			if err := k.ValidateStakingCoinWeightsAllowed(ctx, p.GetStakingCoinWeights()); err != nil {
+				return err
+			}
 			if err := plan.SetStakingCoinWeights(p.GetStakingCoinWeights()); err != nil {
 				return err
 			}
@@ -157,9 +187,16 @@ func (k Keeper) ModifyPublicPlanProposal(ctx sdk.Context, proposals []types.Modi
 
 			logger := k.Logger(ctx)
 			logger.Info("updated public ratio plan", "ratio_plan", plan)
+
+		} else if p.IsForDecayingPlan() {
+			// change the plan to decaying plan
+			plan = types.ToDecayingPlan(plan, p.GetEpochAmount(), p.DecayFactor, p.GetDecayEpochs(), p.GetDecayTimes())
+
+			logger := k.Logger(ctx)
+			logger.Info("updated public decaying plan", "decaying_plan", plan)
 		}
 
-		k.SetPlan(ctx, plan)
+		k.setModifiedPlan(ctx, plan, oldEndTime)
 	}
 
 	return nil
@@ -189,3 +226,35 @@ func (k Keeper) DeletePublicPlanProposal(ctx sdk.Context, proposals []types.Dele
 
 	return nil
 }
+
+// HandleStakingDenomsProposal is a handler for executing a staking denoms proposal.
+// Removing a denom doesn't affect the coins already staked, but new stakings of
+// the denom and new plans using the denom are rejected.
+// The proposal is rejected if it removes all the allowed staking denoms, since
+// that would lift the restriction and allow any denom to be staked.
+func HandleStakingDenomsProposal(ctx sdk.Context, k Keeper, proposal *types.StakingDenomsProposal) error {
+	logger := k.Logger(ctx)
+	restricted := k.IsStakingDenomRestricted(ctx)
+
+	for _, denom := range proposal.AddDenoms {
+		if k.HasAllowedStakingDenom(ctx, denom) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is already allowed to be staked", denom)
+		}
+		k.SetAllowedStakingDenom(ctx, denom)
+		logger.Info("added allowed staking denom", "denom", denom)
+	}
+
+	for _, denom := range proposal.RemoveDenoms {
+		if !k.HasAllowedStakingDenom(ctx, denom) {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "%s is not an allowed staking denom", denom)
+		}
+		k.DeleteAllowedStakingDenom(ctx, denom)
+		logger.Info("removed allowed staking denom", "denom", denom)
+	}
+
+	if restricted && !k.IsStakingDenomRestricted(ctx) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot remove all the allowed staking denoms")
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	budgettypes "github.com/tendermint/budget/x/budget/types"
 
 	"github.com/tendermint/farming/x/farming"
 	"github.com/tendermint/farming/x/farming/keeper"
@@ -71,6 +72,26 @@ func (suite *KeeperTestSuite) TestAddPlanRequest() {
 	suite.Require().Equal("plan1", plan.GetName())
 }
 
+func (suite *KeeperTestSuite) TestAddDecayingPlanRequest() {
+	addr := suite.addrs[4].String()
+
+	req := testAddPlanRequest("plan1", addr, addr, "1denom1", "1000000denom3", "")
+	req.DecayFactor = sdk.NewDecWithPrec(5, 1)
+	req.DecayEpochs = 30
+	proposal := types.NewPublicPlanProposal("title", "description", []types.AddPlanRequest{req}, nil, nil)
+	err := suite.govHandler(suite.ctx, proposal)
+	suite.Require().NoError(err)
+
+	plan, found := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().True(found)
+	decayingPlan, ok := plan.(*types.DecayingPlan)
+	suite.Require().True(ok)
+	suite.Require().Equal(types.PlanTypePublic, decayingPlan.GetType())
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)), decayingPlan.EpochAmount))
+	suite.Require().True(decEq(sdk.NewDecWithPrec(5, 1), decayingPlan.DecayFactor))
+	suite.Require().Equal(uint32(30), decayingPlan.DecayEpochs)
+}
+
 func testModifyPlanRequest(
 	id uint64, name string, farmingPoolAcc, terminationAcc string,
 	weightsStr, startTimeStr, endTimeStr, epochAmountStr, epochRatioStr string,
@@ -846,3 +867,97 @@ func (suite *KeeperTestSuite) TestDeletePublicPlan() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestAddPlanRequestUnderfunded() {
+	farmingPoolAcc := suite.addrs[4]
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-09-01T00:00:00Z"))
+	suite.setBudgets(budgettypes.Budget{
+		Name:               "budget1",
+		Rate:               sdk.NewDecWithPrec(1, 1),
+		SourceAddress:      suite.addrs[0].String(),
+		DestinationAddress: farmingPoolAcc.String(),
+		StartTime:          types.ParseTime("2021-08-01T00:00:00Z"),
+		EndTime:            types.ParseTime("2022-08-01T00:00:00Z"),
+	})
+	suite.app.BudgetKeeper.SetTotalCollectedCoins(suite.ctx, "budget1", sdk.NewCoins(sdk.NewInt64Coin(denom3, 31_000_000)))
+
+	underfunded := func() bool {
+		for _, ev := range suite.ctx.EventManager().Events() {
+			if ev.Type == types.EventTypePlanUnderfunded {
+				return true
+			}
+		}
+		return false
+	}
+
+	// The budget covers the plan's demand.
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	req := testAddPlanRequest("plan1", farmingPoolAcc.String(), farmingPoolAcc.String(), "1denom1", "1000000denom3", "")
+	proposal := types.NewPublicPlanProposal("title", "description", []types.AddPlanRequest{req}, nil, nil)
+	err := suite.govHandler(suite.ctx, proposal)
+	suite.Require().NoError(err)
+	suite.Require().False(underfunded())
+
+	// The plans' demand exceeds the projected inflow, but the plan is still created.
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	req = testAddPlanRequest("plan2", farmingPoolAcc.String(), farmingPoolAcc.String(), "1denom2", "1denom3", "")
+	proposal = types.NewPublicPlanProposal("title", "description", []types.AddPlanRequest{req}, nil, nil)
+	err = suite.govHandler(suite.ctx, proposal)
+	suite.Require().NoError(err)
+	suite.Require().True(underfunded())
+
+	_, found := suite.keeper.GetPlan(suite.ctx, 2)
+	suite.Require().True(found)
+}
+
+func (suite *KeeperTestSuite) TestStakingDenomsProposal() {
+	err := keeper.HandleStakingDenomsProposal(suite.ctx, suite.keeper,
+		types.NewStakingDenomsProposal("title", "description", []string{denom1, denom2}, nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{denom1, denom2}, suite.keeper.GetAllAllowedStakingDenoms(suite.ctx))
+
+	err = keeper.HandleStakingDenomsProposal(suite.ctx, suite.keeper,
+		types.NewStakingDenomsProposal("title", "description", []string{denom1}, nil))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	err = keeper.HandleStakingDenomsProposal(suite.ctx, suite.keeper,
+		types.NewStakingDenomsProposal("title", "description", nil, []string{denom3}))
+	suite.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	// Removing a denom doesn't affect the coins already staked.
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom2, 1_000_000)))
+	suite.AdvanceEpoch()
+
+	handler := farming.NewPublicPlanProposalHandler(suite.keeper)
+	err = handler(suite.ctx, types.NewStakingDenomsProposal("title", "description", []string{denom3}, []string{denom2}))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{denom1, denom3}, suite.keeper.GetAllAllowedStakingDenoms(suite.ctx))
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom2, 1_000_000)),
+		suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+
+	err = suite.keeper.Stake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom2, 1_000_000)))
+	suite.Require().ErrorIs(err, types.ErrStakingDenomNotAllowed)
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom2, 1_000_000)))
+}
+
+func (suite *KeeperTestSuite) TestStakingDenomsProposalRemoveLastDenom() {
+	handler := farming.NewPublicPlanProposalHandler(suite.keeper)
+
+	err := handler(suite.ctx, types.NewStakingDenomsProposal("title", "description", []string{denom1}, nil))
+	suite.Require().NoError(err)
+
+	// Removing the last allowed denom would allow any denom to be staked.
+	cacheCtx, _ := suite.ctx.CacheContext()
+	err = handler(cacheCtx, types.NewStakingDenomsProposal("title", "description", nil, []string{denom1}))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	suite.Require().Equal([]string{denom1}, suite.keeper.GetAllAllowedStakingDenoms(suite.ctx))
+
+	err = suite.keeper.Stake(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom2, 1_000_000)))
+	suite.Require().ErrorIs(err, types.ErrStakingDenomNotAllowed)
+
+	// Replacing the last allowed denom is fine.
+	err = handler(suite.ctx, types.NewStakingDenomsProposal("title", "description", []string{denom2}, []string{denom1}))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{denom2}, suite.keeper.GetAllAllowedStakingDenoms(suite.ctx))
+}
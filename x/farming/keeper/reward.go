@@ -1,10 +1,14 @@
 package keeper
 
 import (
+	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	gogotypes "github.com/gogo/protobuf/types"
 
 	"github.com/tendermint/farming/x/farming/types"
@@ -66,6 +70,44 @@ func (k Keeper) IterateHistoricalRewards(ctx sdk.Context, cb func(stakingCoinDen
 	}
 }
 
+// incrementReferenceCount increments the reference count of historical
+// rewards for a given staking coin denom and an epoch number.
+func (k Keeper) incrementReferenceCount(ctx sdk.Context, stakingCoinDenom string, epoch uint64) {
+	historical, found := k.GetHistoricalRewards(ctx, stakingCoinDenom, epoch)
+	if !found {
+		panic(fmt.Sprintf("historical rewards for %s at epoch %d not found", stakingCoinDenom, epoch))
+	}
+	historical.ReferenceCount++
+	k.SetHistoricalRewards(ctx, stakingCoinDenom, epoch, historical)
+}
+
+// decrementReferenceCount decrements the reference count of historical
+// rewards for a given staking coin denom and an epoch number, and deletes
+// the historical rewards once no one references it.
+func (k Keeper) decrementReferenceCount(ctx sdk.Context, stakingCoinDenom string, epoch uint64) {
+	historical, found := k.GetHistoricalRewards(ctx, stakingCoinDenom, epoch)
+	if !found {
+		panic(fmt.Sprintf("historical rewards for %s at epoch %d not found", stakingCoinDenom, epoch))
+	}
+	if historical.ReferenceCount == 0 {
+		panic("cannot set negative reference count")
+	}
+	historical.ReferenceCount--
+	if historical.ReferenceCount == 0 {
+		k.DeleteHistoricalRewards(ctx, stakingCoinDenom, epoch)
+	} else {
+		k.SetHistoricalRewards(ctx, stakingCoinDenom, epoch, historical)
+	}
+}
+
+// moveStartingEpochReference moves the reference of a staking or a locked
+// staking from the historical rewards of its starting epoch to the latest
+// historical rewards, which is referenced by the current epoch.
+func (k Keeper) moveStartingEpochReference(ctx sdk.Context, stakingCoinDenom string, startingEpoch, currentEpoch uint64) {
+	k.incrementReferenceCount(ctx, stakingCoinDenom, currentEpoch-1)
+	k.decrementReferenceCount(ctx, stakingCoinDenom, startingEpoch-1)
+}
+
 // GetCurrentEpoch returns the current epoch number for a given
 // staking coin denom.
 func (k Keeper) GetCurrentEpoch(ctx sdk.Context, stakingCoinDenom string) uint64 {
@@ -180,16 +222,26 @@ func (k Keeper) DecreaseOutstandingRewards(ctx sdk.Context, stakingCoinDenom str
 
 // CalculateRewards returns rewards accumulated until endingEpoch
 // for a farmer for a given staking coin denom.
+// Rewards of the farmer's locked stakings are included, weighted by their
+// multipliers.
 func (k Keeper) CalculateRewards(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, endingEpoch uint64) (rewards sdk.DecCoins) {
+	rewards = sdk.NewDecCoins()
+	ending, _ := k.GetHistoricalRewards(ctx, stakingCoinDenom, endingEpoch)
+
 	staking, found := k.GetStaking(ctx, stakingCoinDenom, farmerAcc)
-	if !found {
-		return sdk.NewDecCoins()
+	if found {
+		starting, _ := k.GetHistoricalRewards(ctx, stakingCoinDenom, staking.StartingEpoch-1)
+		diff := ending.CumulativeUnitRewards.Sub(starting.CumulativeUnitRewards)
+		rewards = rewards.Add(diff.MulDecTruncate(staking.Amount.ToDec())...)
 	}
 
-	starting, _ := k.GetHistoricalRewards(ctx, stakingCoinDenom, staking.StartingEpoch-1)
-	ending, _ := k.GetHistoricalRewards(ctx, stakingCoinDenom, endingEpoch)
-	diff := ending.CumulativeUnitRewards.Sub(starting.CumulativeUnitRewards)
-	rewards = diff.MulDecTruncate(staking.Amount.ToDec())
+	k.IterateLockedStakingsByFarmerAndDenom(ctx, farmerAcc, stakingCoinDenom, func(lockedStaking types.LockedStaking) (stop bool) {
+		starting, _ := k.GetHistoricalRewards(ctx, stakingCoinDenom, lockedStaking.StartingEpoch-1)
+		diff := ending.CumulativeUnitRewards.Sub(starting.CumulativeUnitRewards)
+		rewards = rewards.Add(diff.MulDecTruncate(lockedStaking.Weight())...)
+		return false
+	})
+
 	return
 }
 
@@ -207,21 +259,63 @@ func (k Keeper) Rewards(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDe
 // current epoch for a farmer.
 func (k Keeper) AllRewards(ctx sdk.Context, farmerAcc sdk.AccAddress) sdk.Coins {
 	totalRewards := sdk.NewCoins()
-	k.IterateStakingsByFarmer(ctx, farmerAcc, func(stakingCoinDenom string, staking types.Staking) (stop bool) {
+	for _, stakingCoinDenom := range k.stakedCoinDenomsByFarmer(ctx, farmerAcc) {
 		rewards := k.Rewards(ctx, farmerAcc, stakingCoinDenom)
 		totalRewards = totalRewards.Add(rewards...)
+	}
+	return totalRewards
+}
+
+// stakedCoinDenomsByFarmer returns the sorted staking coin denoms that a
+// farmer has either a staking or a locked staking for.
+func (k Keeper) stakedCoinDenomsByFarmer(ctx sdk.Context, farmerAcc sdk.AccAddress) []string {
+	var stakingCoinDenoms []string
+	seen := map[string]bool{}
+	k.IterateStakingsByFarmer(ctx, farmerAcc, func(stakingCoinDenom string, _ types.Staking) (stop bool) {
+		stakingCoinDenoms = append(stakingCoinDenoms, stakingCoinDenom)
+		seen[stakingCoinDenom] = true
 		return false
 	})
-	return totalRewards
+	k.IterateLockedStakingsByFarmer(ctx, farmerAcc, func(lockedStaking types.LockedStaking) (stop bool) {
+		if !seen[lockedStaking.StakingCoinDenom] {
+			stakingCoinDenoms = append(stakingCoinDenoms, lockedStaking.StakingCoinDenom)
+			seen[lockedStaking.StakingCoinDenom] = true
+		}
+		return false
+	})
+	sort.Strings(stakingCoinDenoms)
+	return stakingCoinDenoms
 }
 
 // WithdrawRewards withdraws accumulated rewards for a farmer for a given
-// staking coin denom.
+// staking coin denom to the farmer's rewards withdraw address.
 // It decreases outstanding rewards and set the starting epoch of a
 // staking.
 func (k Keeper) WithdrawRewards(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string) (sdk.Coins, error) {
+	truncatedRewards, err := k.withdrawRewards(ctx, farmerAcc, stakingCoinDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !truncatedRewards.IsZero() {
+		withdrawAddr := k.GetRewardsWithdrawAddress(ctx, farmerAcc)
+		if err := k.bankKeeper.SendCoins(ctx, types.RewardsReserveAcc, withdrawAddr, truncatedRewards); err != nil {
+			return nil, err
+		}
+
+		k.afterRewardsWithdrawn(ctx, farmerAcc, stakingCoinDenom, truncatedRewards)
+	}
+
+	return truncatedRewards, nil
+}
+
+// withdrawRewards decreases outstanding rewards and set the starting epoch of a
+// staking and locked stakings as if the accumulated rewards were withdrawn,
+// and returns the truncated rewards. Sending the rewards is up to the caller.
+func (k Keeper) withdrawRewards(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string) (sdk.Coins, error) {
 	staking, found := k.GetStaking(ctx, stakingCoinDenom, farmerAcc)
-	if !found {
+	lockedStakings := k.GetLockedStakingsByFarmerAndDenom(ctx, farmerAcc, stakingCoinDenom)
+	if !found && len(lockedStakings) == 0 {
 		return nil, types.ErrStakingNotExists
 	}
 
@@ -230,66 +324,78 @@ func (k Keeper) WithdrawRewards(ctx sdk.Context, farmerAcc sdk.AccAddress, staki
 	truncatedRewards, _ := rewards.TruncateDecimal()
 
 	if !rewards.IsZero() {
-		if !truncatedRewards.IsZero() {
-			if err := k.bankKeeper.SendCoins(ctx, types.RewardsReserveAcc, farmerAcc, truncatedRewards); err != nil {
-				return nil, err
-			}
-
-			ctx.EventManager().EmitEvents(sdk.Events{
-				sdk.NewEvent(
-					types.EventTypeRewardsWithdrawn,
-					sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
-					sdk.NewAttribute(types.AttributeKeyStakingCoinDenom, stakingCoinDenom),
-					sdk.NewAttribute(types.AttributeKeyRewardCoins, truncatedRewards.String()),
-				),
-			})
-		}
-
 		k.DecreaseOutstandingRewards(ctx, stakingCoinDenom, rewards)
 	}
 
-	staking.StartingEpoch = currentEpoch
-	k.SetStaking(ctx, stakingCoinDenom, farmerAcc, staking)
+	if found {
+		k.moveStartingEpochReference(ctx, stakingCoinDenom, staking.StartingEpoch, currentEpoch)
+		staking.StartingEpoch = currentEpoch
+		k.SetStaking(ctx, stakingCoinDenom, farmerAcc, staking)
+	}
+	for _, lockedStaking := range lockedStakings {
+		k.moveStartingEpochReference(ctx, stakingCoinDenom, lockedStaking.StartingEpoch, currentEpoch)
+		lockedStaking.StartingEpoch = currentEpoch
+		k.SetLockedStaking(ctx, lockedStaking)
+	}
 
 	return truncatedRewards, nil
 }
 
-// WithdrawAllRewards withdraws all accumulated rewards for a farmer.
+// afterRewardsWithdrawn emits an event and calls the hook for the rewards
+// withdrawn for a staking coin denom.
+func (k Keeper) afterRewardsWithdrawn(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, rewards sdk.Coins) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRewardsWithdrawn,
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyStakingCoinDenom, stakingCoinDenom),
+			sdk.NewAttribute(types.AttributeKeyRewardCoins, rewards.String()),
+		),
+	})
+
+	k.AfterRewardsWithdrawn(ctx, farmerAcc, stakingCoinDenom, rewards)
+}
+
+// WithdrawAllRewards withdraws all accumulated rewards for a farmer to the
+// farmer's rewards withdraw address.
 func (k Keeper) WithdrawAllRewards(ctx sdk.Context, farmerAcc sdk.AccAddress) (sdk.Coins, error) {
 	totalRewards := sdk.NewCoins()
-	k.IterateStakingsByFarmer(ctx, farmerAcc, func(stakingCoinDenom string, staking types.Staking) (stop bool) {
-		currentEpoch := k.GetCurrentEpoch(ctx, stakingCoinDenom)
-		rewards := k.CalculateRewards(ctx, farmerAcc, stakingCoinDenom, currentEpoch-1)
-		truncatedRewards, _ := rewards.TruncateDecimal()
+	rewardsByDenom := map[string]sdk.Coins{} // (staking coin denom) => (withdrawn rewards)
+	var stakingCoinDenoms []string
+	for _, stakingCoinDenom := range k.stakedCoinDenomsByFarmer(ctx, farmerAcc) {
+		truncatedRewards, err := k.withdrawRewards(ctx, farmerAcc, stakingCoinDenom)
+		if err != nil {
+			return nil, err
+		}
 		totalRewards = totalRewards.Add(truncatedRewards...)
-
-		if !rewards.IsZero() {
-			k.DecreaseOutstandingRewards(ctx, stakingCoinDenom, rewards)
+		if !truncatedRewards.IsZero() {
+			rewardsByDenom[stakingCoinDenom] = truncatedRewards
+			stakingCoinDenoms = append(stakingCoinDenoms, stakingCoinDenom)
 		}
-
-		staking.StartingEpoch = currentEpoch
-		k.SetStaking(ctx, stakingCoinDenom, farmerAcc, staking)
-
-		return false
-	})
+	}
 
 	if !totalRewards.IsZero() {
-		if err := k.bankKeeper.SendCoins(ctx, types.RewardsReserveAcc, farmerAcc, totalRewards); err != nil {
+		withdrawAddr := k.GetRewardsWithdrawAddress(ctx, farmerAcc)
+		if err := k.bankKeeper.SendCoins(ctx, types.RewardsReserveAcc, withdrawAddr, totalRewards); err != nil {
 			return nil, err
 		}
 	}
 
+	for _, stakingCoinDenom := range stakingCoinDenoms {
+		k.afterRewardsWithdrawn(ctx, farmerAcc, stakingCoinDenom, rewardsByDenom[stakingCoinDenom])
+	}
+
 	return totalRewards, nil
 }
 
-// Harvest claims farming rewards from the reward pool.
-func (k Keeper) Harvest(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenoms []string) error {
+// Harvest claims farming rewards from the reward pool and returns the harvested rewards.
+func (k Keeper) Harvest(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenoms []string) (sdk.Coins, error) {
 	totalRewards := sdk.NewCoins()
 
 	for _, denom := range stakingCoinDenoms {
 		rewards, err := k.WithdrawRewards(ctx, farmerAcc, denom)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		totalRewards = totalRewards.Add(rewards...)
 	}
@@ -303,7 +409,84 @@ func (k Keeper) Harvest(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDe
 		),
 	})
 
-	return nil
+	return totalRewards, nil
+}
+
+// HarvestAndStake claims farming rewards from the reward pool and stakes
+// the rewards whose denoms are staking coin denoms of the plans and are allowed
+// to be staked, so they are added to the farmer's queued coins without passing
+// through the farmer's balance.
+// The rest of the rewards are sent to the farmer's rewards withdraw address.
+// It returns the harvested rewards and the part of them that is staked.
+func (k Keeper) HarvestAndStake(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenoms []string) (harvested, staked sdk.Coins, err error) {
+	harvested = sdk.NewCoins()
+	rewardsByDenom := map[string]sdk.Coins{} // (staking coin denom) => (withdrawn rewards)
+	for _, denom := range stakingCoinDenoms {
+		rewards, err := k.withdrawRewards(ctx, farmerAcc, denom)
+		if err != nil {
+			return nil, nil, err
+		}
+		rewardsByDenom[denom] = rewards
+		harvested = harvested.Add(rewards...)
+	}
+
+	stakable := k.stakingCoinDenomsInPlans(ctx)
+	staked, unstaked := sdk.NewCoins(), sdk.NewCoins()
+	for _, coin := range harvested {
+		if stakable[coin.Denom] && k.IsStakingDenomAllowed(ctx, coin.Denom) {
+			staked = staked.Add(coin)
+		} else {
+			unstaked = unstaked.Add(coin)
+		}
+	}
+
+	if !harvested.IsZero() {
+		var inputs []banktypes.Input
+		var outputs []banktypes.Output
+		inputs = append(inputs, banktypes.NewInput(types.RewardsReserveAcc, harvested))
+		if !unstaked.IsZero() {
+			outputs = append(outputs, banktypes.NewOutput(k.GetRewardsWithdrawAddress(ctx, farmerAcc), unstaked))
+		}
+		for _, coin := range staked {
+			outputs = append(outputs, banktypes.NewOutput(types.StakingReserveAcc(coin.Denom), sdk.Coins{coin}))
+		}
+		if err := k.bankKeeper.InputOutputCoins(ctx, inputs, outputs); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for _, denom := range stakingCoinDenoms {
+		if rewards := rewardsByDenom[denom]; !rewards.IsZero() {
+			k.afterRewardsWithdrawn(ctx, farmerAcc, denom, rewards)
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeHarvest,
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyStakingCoinDenoms, strings.Join(stakingCoinDenoms, ",")),
+			sdk.NewAttribute(types.AttributeKeyRewardCoins, harvested.String()),
+		),
+	})
+
+	if !staked.IsZero() {
+		k.queueStakingCoins(ctx, farmerAcc, farmerAcc, staked)
+	}
+
+	return harvested, staked, nil
+}
+
+// stakingCoinDenomsInPlans returns a set of staking coin denoms defined in
+// the staking coin weights of all plans that are not terminated.
+func (k Keeper) stakingCoinDenomsInPlans(ctx sdk.Context) map[string]bool {
+	denoms := map[string]bool{}
+	for _, plan := range k.GetActivePlans(ctx) {
+		for _, weight := range plan.GetStakingCoinWeights() {
+			denoms[weight.Denom] = true
+		}
+	}
+	return denoms
 }
 
 // AllocationInfo holds information about an allocation for a plan.
@@ -312,11 +495,32 @@ type AllocationInfo struct {
 	Amount sdk.Coins
 }
 
+// SkippedAllocationInfo holds information about an allocation for a plan
+// that is skipped because its farming pool has insufficient balance.
+type SkippedAllocationInfo struct {
+	Plan   types.PlanI
+	Amount sdk.Coins
+	// RequestedAmount is the total amount of coins all plans
+	// want to allocate from the farming pool.
+	RequestedAmount sdk.Coins
+	// AvailableBalance is the balance of the farming pool.
+	AvailableBalance sdk.Coins
+}
+
 // AllocationInfos returns allocation infos for the end
 // of the current epoch.
 // When total allocated coins for a farming pool exceeds the pool's
-// balance, then allocation will not happen.
+// balance, then allocation will not happen, unless partial allocation
+// is enabled by the module parameter.
 func (k Keeper) AllocationInfos(ctx sdk.Context) []AllocationInfo {
+	allocInfos, _ := k.AllocationInfosWithSkipped(ctx)
+	return allocInfos
+}
+
+// AllocationInfosWithSkipped returns allocation infos for the end
+// of the current epoch, along with the allocations skipped due to
+// insufficient farming pool balances.
+func (k Keeper) AllocationInfosWithSkipped(ctx sdk.Context) ([]AllocationInfo, []SkippedAllocationInfo) {
 	// farmingPoolBalances is a cache for balances of each farming pool,
 	// to reduce number of BankKeeper.GetAllBalances calls.
 	// It maps farmingPoolAddress to the pool's balance.
@@ -329,9 +533,9 @@ func (k Keeper) AllocationInfos(ctx sdk.Context) []AllocationInfo {
 	allocCoins := map[string]map[uint64]sdk.Coins{}
 
 	plans := map[uint64]types.PlanI{} // it maps planId to plan.
-	for _, plan := range k.GetPlans(ctx) {
+	for _, plan := range k.GetActivePlans(ctx) {
 		// Add plans that are not terminated and active to the map.
-		if !plan.GetTerminated() && types.IsPlanActiveAt(plan, ctx.BlockTime()) {
+		if types.IsPlanActiveAt(plan, ctx.BlockTime()) {
 			plans[plan.GetId()] = plan
 		}
 	}
@@ -359,19 +563,17 @@ func (k Keeper) AllocationInfos(ctx sdk.Context) []AllocationInfo {
 			allocCoins[farmingPool] = ac
 		}
 
-		// Based on the plan's type, record how many coins the plan wants to
-		// allocate in the allocation map.
-		switch plan := plan.(type) {
-		case *types.FixedAmountPlan:
-			ac[plan.GetId()] = plan.EpochAmount
-		case *types.RatioPlan:
-			ac[plan.GetId()], _ = sdk.NewDecCoinsFromCoins(balances...).MulDecTruncate(plan.EpochRatio).TruncateDecimal()
-		}
+		// Record how many coins the plan wants to allocate in the allocation map.
+		ac[plan.GetId()] = PlanEpochAmount(plan, balances, ctx.BlockTime())
 	}
 
 	// In this step, we check if farming pools have sufficient balance for allocations.
-	// If not, we don't allocate rewards from that farming pool for this epoch.
+	// If not, we don't allocate rewards from that farming pool for this epoch,
+	// unless partial allocation is enabled. In that case, the pool's balance is
+	// allocated pro-rata across the plans.
+	partialAllocation := k.GetParams(ctx).PartialAllocation
 	var allocInfos []AllocationInfo
+	var skippedInfos []SkippedAllocationInfo
 	for farmingPool, planCoins := range allocCoins {
 		totalCoins := sdk.NewCoins()
 		for _, amt := range planCoins {
@@ -380,6 +582,24 @@ func (k Keeper) AllocationInfos(ctx sdk.Context) []AllocationInfo {
 
 		balances := farmingPoolBalances[farmingPool]
 		if !totalCoins.IsAllLTE(balances) {
+			if partialAllocation {
+				for planID, amt := range planCoins {
+					allocInfos = append(allocInfos, AllocationInfo{
+						Plan:   plans[planID],
+						Amount: ProRataAllocCoins(amt, totalCoins, balances),
+					})
+				}
+				continue
+			}
+
+			for planID, amt := range planCoins {
+				skippedInfos = append(skippedInfos, SkippedAllocationInfo{
+					Plan:             plans[planID],
+					Amount:           amt,
+					RequestedAmount:  totalCoins,
+					AvailableBalance: balances,
+				})
+			}
 			continue
 		}
 
@@ -391,7 +611,49 @@ func (k Keeper) AllocationInfos(ctx sdk.Context) []AllocationInfo {
 		}
 	}
 
-	return allocInfos
+	return allocInfos, skippedInfos
+}
+
+// PlanEpochAmount returns the amount of coins the plan wants to allocate
+// for an epoch, based on the plan's type.
+// balances is the farming pool's balance, used by ratio plans.
+func PlanEpochAmount(plan types.PlanI, balances sdk.Coins, t time.Time) sdk.Coins {
+	switch plan := plan.(type) {
+	case *types.FixedAmountPlan:
+		return plan.EpochAmount
+	case *types.RatioPlan:
+		amt, _ := sdk.NewDecCoinsFromCoins(balances...).MulDecTruncate(plan.EpochRatio).TruncateDecimal()
+		return amt
+	case *types.DecayingPlan:
+		return plan.EpochAmountAt(t)
+	}
+	return nil
+}
+
+// ProRataAllocCoins returns the portion of amount that can be allocated
+// when the total amount requested from a farming pool exceeds the pool's
+// balances.
+// For each denom the pool can't cover, amount is scaled down by the ratio
+// of the pool's balance to the total requested amount.
+func ProRataAllocCoins(amount, totalAmount, balances sdk.Coins) sdk.Coins {
+	allocCoins := sdk.NewCoins()
+	for _, coin := range amount {
+		total := totalAmount.AmountOf(coin.Denom)
+		balance := balances.AmountOf(coin.Denom)
+		if total.LTE(balance) {
+			allocCoins = allocCoins.Add(coin)
+			continue
+		}
+		allocCoins = allocCoins.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(balance).Quo(total)))
+	}
+	return allocCoins
+}
+
+// WeightedAllocCoins returns the portion of amount that is allocated
+// to a staking coin denom with the given weight.
+func WeightedAllocCoins(amount sdk.Coins, weight sdk.Dec) sdk.Coins {
+	allocCoins, _ := sdk.NewDecCoinsFromCoins(amount...).MulDecTruncate(weight).TruncateDecimal()
+	return allocCoins
 }
 
 // AllocateRewards updates historical rewards and current epoch info
@@ -403,14 +665,17 @@ func (k Keeper) AllocateRewards(ctx sdk.Context) error {
 	unitRewardsByDenom := map[string]sdk.DecCoins{}
 
 	// Get allocation information first.
-	allocInfos := k.AllocationInfos(ctx)
+	allocInfos, skippedInfos := k.AllocationInfosWithSkipped(ctx)
+	k.recordSkippedAllocations(ctx, skippedInfos)
+	k.increaseElapsedEpochs(ctx, allocInfos, skippedInfos)
 
 	for _, allocInfo := range allocInfos {
 		totalAllocCoins := sdk.NewCoins()
 
 		// Calculate how many coins are allocated based on each staking coin weight.
 		// It is calculated with the following formula:
-		// (unit rewards for this epoch) = (weighted rewards for the denom) / (total staking amount for the denom)
+		// (unit rewards for this epoch) = (weighted rewards for the denom) / (total staking weight for the denom)
+		// where locked stakings are weighted by their multipliers in the total staking weight.
 		for _, weight := range allocInfo.Plan.GetStakingCoinWeights() {
 			// Check if there are any coins staked for this denom.
 			// If not, skip this denom for rewards allocation.
@@ -419,12 +684,12 @@ func (k Keeper) AllocateRewards(ctx sdk.Context) error {
 				continue
 			}
 
-			allocCoins, _ := sdk.NewDecCoinsFromCoins(allocInfo.Amount...).MulDecTruncate(weight.Amount).TruncateDecimal()
+			allocCoins := WeightedAllocCoins(allocInfo.Amount, weight.Amount)
 			allocCoinsDec := sdk.NewDecCoinsFromCoins(allocCoins...)
 
 			// Multiple plans can have same denom in their staking coin weights,
 			// so we accumulate all unit rewards for this denom in the table.
-			unitRewardsByDenom[weight.Denom] = unitRewardsByDenom[weight.Denom].Add(allocCoinsDec.QuoDecTruncate(totalStakings.Amount.ToDec())...)
+			unitRewardsByDenom[weight.Denom] = unitRewardsByDenom[weight.Denom].Add(allocCoinsDec.QuoDecTruncate(k.totalStakingWeight(ctx, weight.Denom, totalStakings))...)
 
 			k.IncreaseOutstandingRewards(ctx, weight.Denom, allocCoinsDec)
 
@@ -445,7 +710,7 @@ func (k Keeper) AllocateRewards(ctx sdk.Context) error {
 		t := ctx.BlockTime()
 		_ = allocInfo.Plan.SetLastDistributionTime(&t)
 		_ = allocInfo.Plan.SetDistributedCoins(allocInfo.Plan.GetDistributedCoins().Add(totalAllocCoins...))
-		k.SetPlan(ctx, allocInfo.Plan)
+		k.setPlan(ctx, allocInfo.Plan)
 
 		ctx.EventManager().EmitEvents(sdk.Events{
 			sdk.NewEvent(
@@ -458,18 +723,145 @@ func (k Keeper) AllocateRewards(ctx sdk.Context) error {
 
 	// For each staking coin denom in the table, increase cumulative unit rewards
 	// and increment current epoch number by 1.
+	// The reference of the current epoch moves to the new historical rewards,
+	// so the previous one gets deleted unless any staking references it.
 	for stakingCoinDenom, unitRewards := range unitRewardsByDenom {
 		currentEpoch := k.GetCurrentEpoch(ctx, stakingCoinDenom)
 		historical, _ := k.GetHistoricalRewards(ctx, stakingCoinDenom, currentEpoch-1)
 		k.SetHistoricalRewards(ctx, stakingCoinDenom, currentEpoch, types.HistoricalRewards{
 			CumulativeUnitRewards: historical.CumulativeUnitRewards.Add(unitRewards...),
+			ReferenceCount:        1,
 		})
+		k.decrementReferenceCount(ctx, stakingCoinDenom, currentEpoch-1)
 		k.SetCurrentEpoch(ctx, stakingCoinDenom, currentEpoch+1)
 	}
 
 	return nil
 }
 
+// increaseElapsedEpochs increases the number of elapsed epochs of decaying
+// plans that were active in this epoch, whether their allocations are
+// skipped or not.
+func (k Keeper) increaseElapsedEpochs(ctx sdk.Context, allocInfos []AllocationInfo, skippedInfos []SkippedAllocationInfo) {
+	plans := make([]types.PlanI, 0, len(allocInfos)+len(skippedInfos))
+	for _, allocInfo := range allocInfos {
+		plans = append(plans, allocInfo.Plan)
+	}
+	for _, skippedInfo := range skippedInfos {
+		plans = append(plans, skippedInfo.Plan)
+	}
+
+	for _, plan := range plans {
+		if plan, ok := plan.(*types.DecayingPlan); ok {
+			plan.ElapsedEpochs++
+			k.setPlan(ctx, plan)
+		}
+	}
+}
+
+// recordSkippedAllocations sets the last skipped time of plans whose
+// allocations are skipped, and emits an event for each farming pool
+// that has insufficient balance.
+func (k Keeper) recordSkippedAllocations(ctx sdk.Context, skippedInfos []SkippedAllocationInfo) {
+	sort.Slice(skippedInfos, func(i, j int) bool {
+		return skippedInfos[i].Plan.GetId() < skippedInfos[j].Plan.GetId()
+	})
+
+	var farmingPools []string
+	// skippedInfosByFarmingPool maps farming pool address to the skipped allocations.
+	skippedInfosByFarmingPool := map[string][]SkippedAllocationInfo{}
+	for _, skippedInfo := range skippedInfos {
+		t := ctx.BlockTime()
+		_ = skippedInfo.Plan.SetLastSkippedTime(&t)
+		k.setPlan(ctx, skippedInfo.Plan)
+
+		farmingPool := skippedInfo.Plan.GetFarmingPoolAddress().String()
+		if _, ok := skippedInfosByFarmingPool[farmingPool]; !ok {
+			farmingPools = append(farmingPools, farmingPool)
+		}
+		skippedInfosByFarmingPool[farmingPool] = append(skippedInfosByFarmingPool[farmingPool], skippedInfo)
+	}
+
+	for _, farmingPool := range farmingPools {
+		infos := skippedInfosByFarmingPool[farmingPool]
+		var planIds []string
+		for _, info := range infos {
+			planIds = append(planIds, strconv.FormatUint(info.Plan.GetId(), 10))
+		}
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeRewardsAllocationSkipped,
+				sdk.NewAttribute(types.AttributeKeyFarmingPoolAddress, farmingPool),
+				sdk.NewAttribute(types.AttributeKeyPlanIds, strings.Join(planIds, ",")),
+				sdk.NewAttribute(types.AttributeKeyRequestedAmount, infos[0].RequestedAmount.String()),
+				sdk.NewAttribute(types.AttributeKeyAvailableBalance, infos[0].AvailableBalance.String()),
+			),
+		})
+	}
+}
+
+// yearDuration is the length of a year used to annualize rewards.
+const yearDuration = 365 * 24 * time.Hour
+
+// EstimateAnnualRewards estimates rewards per unit of each staking coin denom
+// for a year, assuming that the allocations for the current epoch are repeated
+// in every epoch.
+// It uses the same allocation infos and weight math as AllocateRewards, and
+// it doesn't modify the state.
+// Staking coin denoms without any stakings are excluded from the result.
+func (k Keeper) EstimateAnnualRewards(ctx sdk.Context) (annualRewards []types.StakingCoinAnnualRewards, epochsPerYear sdk.Dec) {
+	epochsPerYear = sdk.NewDec(int64(yearDuration)).QuoInt64(int64(k.GetCurrentEpochDuration(ctx)))
+
+	allocInfos := k.AllocationInfos(ctx)
+	sort.Slice(allocInfos, func(i, j int) bool {
+		return allocInfos[i].Plan.GetId() < allocInfos[j].Plan.GetId()
+	})
+
+	// annualRewardsByDenom maps staking coin denom to the estimated annual rewards.
+	annualRewardsByDenom := map[string]*types.StakingCoinAnnualRewards{}
+	var stakingCoinDenoms []string
+
+	for _, allocInfo := range allocInfos {
+		for _, weight := range allocInfo.Plan.GetStakingCoinWeights() {
+			totalStakings, found := k.GetTotalStakings(ctx, weight.Denom)
+			if !found {
+				continue
+			}
+
+			allocCoins := WeightedAllocCoins(allocInfo.Amount, weight.Amount)
+			unitRewards := sdk.NewDecCoinsFromCoins(allocCoins...).QuoDecTruncate(k.totalStakingWeight(ctx, weight.Denom, totalStakings))
+
+			ar, ok := annualRewardsByDenom[weight.Denom]
+			if !ok {
+				ar = &types.StakingCoinAnnualRewards{
+					StakingCoinDenom:   weight.Denom,
+					TotalStakingAmount: totalStakings.Amount,
+					EpochUnitRewards:   sdk.DecCoins{},
+				}
+				annualRewardsByDenom[weight.Denom] = ar
+				stakingCoinDenoms = append(stakingCoinDenoms, weight.Denom)
+			}
+			ar.EpochUnitRewards = ar.EpochUnitRewards.Add(unitRewards...)
+			ar.Plans = append(ar.Plans, types.PlanAnnualRewards{
+				PlanId:            allocInfo.Plan.GetId(),
+				EpochRewards:      allocCoins,
+				EpochUnitRewards:  unitRewards,
+				AnnualUnitRewards: unitRewards.MulDecTruncate(epochsPerYear),
+			})
+		}
+	}
+
+	sort.Strings(stakingCoinDenoms)
+	for _, denom := range stakingCoinDenoms {
+		ar := annualRewardsByDenom[denom]
+		ar.AnnualUnitRewards = ar.EpochUnitRewards.MulDecTruncate(epochsPerYear)
+		annualRewards = append(annualRewards, *ar)
+	}
+
+	return annualRewards, epochsPerYear
+}
+
 // ValidateRemainingRewardsAmount checks that the balance of the
 // rewards reserve pool is greater than the total amount of
 // unwithdrawn rewards.
@@ -480,6 +872,22 @@ func (k Keeper) ValidateRemainingRewardsAmount(ctx sdk.Context) error {
 		remainingRewards = remainingRewards.Add(rewards...)
 		return false
 	})
+	// Add rewards of the farmers who have only locked stakings for a staking coin denom,
+	// since the rewards of the others are already included above.
+	seen := map[string]bool{} // (farmer + staking coin denom) => (seen)
+	k.IterateLockedStakings(ctx, func(lockedStaking types.LockedStaking) (stop bool) {
+		farmerAcc := lockedStaking.GetFarmer()
+		key := string(types.GetStakingKey(lockedStaking.StakingCoinDenom, farmerAcc))
+		if seen[key] {
+			return false
+		}
+		seen[key] = true
+		if _, found := k.GetStaking(ctx, lockedStaking.StakingCoinDenom, farmerAcc); !found {
+			rewards := k.Rewards(ctx, farmerAcc, lockedStaking.StakingCoinDenom)
+			remainingRewards = remainingRewards.Add(rewards...)
+		}
+		return false
+	})
 
 	rewardsReservePoolBalances := k.bankKeeper.GetAllBalances(ctx, types.RewardsReserveAcc)
 	if !rewardsReservePoolBalances.IsAllGTE(remainingRewards) {
@@ -1,11 +1,15 @@
 package keeper_test
 
 import (
+	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	simapp "github.com/tendermint/farming/app"
+	"github.com/tendermint/farming/x/farming/keeper"
 	"github.com/tendermint/farming/x/farming/types"
 
 	_ "github.com/stretchr/testify/suite"
@@ -250,6 +254,35 @@ func (suite *KeeperTestSuite) TestAllocateRewards() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestAllocateRewards_DecayingPlan() {
+	// The epoch amount is halved every 2 epochs.
+	_, err := suite.keeper.CreateDecayingPlan(suite.ctx, types.NewMsgCreateDecayingPlan(
+		"plan1",
+		suite.addrs[4],
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom1, sdk.OneDec())),
+		types.ParseTime("0001-01-01T00:00:00Z"),
+		types.ParseTime("9999-12-31T00:00:00Z"),
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)),
+		sdk.NewDecWithPrec(5, 1),
+		2,
+		nil,
+	), suite.addrs[4], suite.addrs[4], types.PlanTypePublic)
+	suite.Require().NoError(err)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch() // The plan is active, but there are no staked coins yet.
+
+	for _, expected := range []int64{1_000_000, 1_500_000, 2_000_000, 2_250_000, 2_500_000} {
+		suite.AdvanceEpoch()
+		rewards := suite.keeper.Rewards(suite.ctx, suite.addrs[0], denom1)
+		suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, expected)), rewards))
+	}
+
+	plan, _ := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().Equal(uint64(6), plan.(*types.DecayingPlan).ElapsedEpochs)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 2_500_000)), plan.GetDistributedCoins()))
+}
+
 func (suite *KeeperTestSuite) TestAllocateRewards_FixedAmountPlanAllBalances() {
 	farmingPoolAcc := simapp.AddTestAddrs(suite.app, suite.ctx, 1, sdk.ZeroInt())[0]
 	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, farmingPoolAcc, sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)))
@@ -305,6 +338,82 @@ func (suite *KeeperTestSuite) TestAllocateRewards_FixedAmountPlanOverBalances()
 	suite.Require().True(rewards.IsZero())
 }
 
+func (suite *KeeperTestSuite) TestAllocateRewards_Skipped() {
+	farmingPoolAcc := simapp.AddTestAddrs(suite.app, suite.ctx, 1, sdk.ZeroInt())[0]
+	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, farmingPoolAcc, sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)))
+	suite.Require().NoError(err)
+
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
+	suite.CreateFixedAmountPlan(farmingPoolAcc, map[string]string{denom1: "1"}, map[string]int64{denom3: 700000})
+	suite.CreateFixedAmountPlan(farmingPoolAcc, map[string]string{denom2: "1"}, map[string]int64{denom3: 400000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000), sdk.NewInt64Coin(denom2, 1000000)))
+	suite.keeper.ProcessQueuedCoins(suite.ctx)
+
+	t := types.ParseTime("2021-09-01T00:00:00Z")
+	suite.ctx = suite.ctx.WithBlockTime(t).WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(suite.keeper.AllocateRewards(suite.ctx))
+
+	var skippedEvents []sdk.Event
+	for _, ev := range suite.ctx.EventManager().Events() {
+		if ev.Type == types.EventTypeRewardsAllocationSkipped {
+			skippedEvents = append(skippedEvents, ev)
+		}
+	}
+	suite.Require().Len(skippedEvents, 1)
+	attrs := map[string]string{}
+	for _, attr := range skippedEvents[0].Attributes {
+		attrs[string(attr.Key)] = string(attr.Value)
+	}
+	suite.Require().Equal(farmingPoolAcc.String(), attrs[types.AttributeKeyFarmingPoolAddress])
+	suite.Require().Equal("2,3", attrs[types.AttributeKeyPlanIds])
+	suite.Require().Equal("1100000denom3", attrs[types.AttributeKeyRequestedAmount])
+	suite.Require().Equal("1000000denom3", attrs[types.AttributeKeyAvailableBalance])
+
+	plan, _ := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().Nil(plan.GetLastSkippedTime())
+	for _, planId := range []uint64{2, 3} {
+		plan, _ := suite.keeper.GetPlan(suite.ctx, planId)
+		suite.Require().NotNil(plan.GetLastSkippedTime())
+		suite.Require().Equal(t, *plan.GetLastSkippedTime())
+		suite.Require().Nil(plan.GetLastDistributionTime())
+	}
+}
+
+func (suite *KeeperTestSuite) TestAllocateRewards_PartialAllocation() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.PartialAllocation = true
+	suite.keeper.SetParams(suite.ctx, params)
+
+	farmingPoolAcc := simapp.AddTestAddrs(suite.app, suite.ctx, 1, sdk.ZeroInt())[0]
+	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, farmingPoolAcc, sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)))
+	suite.Require().NoError(err)
+
+	// The sum of epoch amounts is over the balances the farming pool has,
+	// so the balances are allocated pro-rata.
+	suite.CreateFixedAmountPlan(farmingPoolAcc, map[string]string{denom1: "1"}, map[string]int64{denom3: 700000})
+	suite.CreateFixedAmountPlan(farmingPoolAcc, map[string]string{denom2: "1"}, map[string]int64{denom3: 400000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000), sdk.NewInt64Coin(denom2, 1000000)))
+	suite.keeper.ProcessQueuedCoins(suite.ctx)
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(suite.keeper.AllocateRewards(suite.ctx))
+
+	for _, ev := range suite.ctx.EventManager().Events() {
+		suite.Require().NotEqual(types.EventTypeRewardsAllocationSkipped, ev.Type)
+	}
+
+	plan, _ := suite.keeper.GetPlan(suite.ctx, 1)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 636363)), plan.GetDistributedCoins()))
+	plan, _ = suite.keeper.GetPlan(suite.ctx, 2)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 363636)), plan.GetDistributedCoins()))
+
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 1)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, farmingPoolAcc)))
+}
+
 func (suite *KeeperTestSuite) TestAllocateRewards_RatioPlanOverBalances() {
 	farmingPoolAcc := simapp.AddTestAddrs(suite.app, suite.ctx, 1, sdk.ZeroInt())[0]
 	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, farmingPoolAcc, sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)))
@@ -323,6 +432,56 @@ func (suite *KeeperTestSuite) TestAllocateRewards_RatioPlanOverBalances() {
 	suite.Require().True(rewards.IsZero())
 }
 
+func (suite *KeeperTestSuite) TestEstimateAnnualRewards() {
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "0.5", denom2: "0.5"}, map[string]int64{denom3: 1000000})
+	suite.CreateFixedAmountPlan(suite.addrs[5], map[string]string{denom1: "1"}, map[string]int64{denom3: 500000})
+
+	// There are no stakings yet.
+	annualRewards, epochsPerYear := suite.keeper.EstimateAnnualRewards(suite.ctx)
+	suite.Require().Empty(annualRewards)
+	suite.Require().True(decEq(sdk.NewDec(365), epochsPerYear))
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000), sdk.NewInt64Coin(denom2, 2000000)))
+	suite.keeper.ProcessQueuedCoins(suite.ctx)
+
+	annualRewards, _ = suite.keeper.EstimateAnnualRewards(suite.ctx)
+	suite.Require().Len(annualRewards, 2)
+
+	suite.Require().Equal(denom1, annualRewards[0].StakingCoinDenom)
+	suite.Require().True(intEq(sdk.NewInt(1000000), annualRewards[0].TotalStakingAmount))
+	suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 1)), annualRewards[0].EpochUnitRewards))
+	suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 365)), annualRewards[0].AnnualUnitRewards))
+	suite.Require().Len(annualRewards[0].Plans, 2)
+	suite.Require().Equal(uint64(1), annualRewards[0].Plans[0].PlanId)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 500000)), annualRewards[0].Plans[0].EpochRewards))
+	suite.Require().Equal(uint64(2), annualRewards[0].Plans[1].PlanId)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 500000)), annualRewards[0].Plans[1].EpochRewards))
+
+	suite.Require().Equal(denom2, annualRewards[1].StakingCoinDenom)
+	suite.Require().True(decCoinsEq(
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom3, sdk.MustNewDecFromStr("0.25"))),
+		annualRewards[1].EpochUnitRewards))
+	suite.Require().True(decCoinsEq(
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom3, sdk.MustNewDecFromStr("91.25"))),
+		annualRewards[1].AnnualUnitRewards))
+
+	// The estimation must match the actual allocation.
+	suite.AdvanceEpoch()
+	historical, found := suite.keeper.GetHistoricalRewards(suite.ctx, denom1, 1)
+	suite.Require().True(found)
+	suite.Require().True(decCoinsEq(annualRewards[0].EpochUnitRewards, historical.CumulativeUnitRewards))
+
+	// Longer epochs mean fewer epochs in a year.
+	suite.keeper.SetCurrentEpochDuration(suite.ctx, 7*24*time.Hour)
+	_, epochsPerYear = suite.keeper.EstimateAnnualRewards(suite.ctx)
+	suite.Require().True(decEq(sdk.NewDec(365).QuoInt64(7), epochsPerYear))
+
+	// Sub-day epochs mean more epochs in a year.
+	suite.keeper.SetCurrentEpochDuration(suite.ctx, time.Hour)
+	_, epochsPerYear = suite.keeper.EstimateAnnualRewards(suite.ctx)
+	suite.Require().True(decEq(sdk.NewDec(365*24), epochsPerYear))
+}
+
 func (suite *KeeperTestSuite) TestOutstandingRewards() {
 	// The block time here is not important, and has chosen randomly.
 	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-09-01T00:00:00Z"))
@@ -361,7 +520,7 @@ func (suite *KeeperTestSuite) TestHarvest() {
 		suite.keeper.SetPlan(suite.ctx, plan)
 	}
 
-	err := suite.keeper.Harvest(suite.ctx, suite.addrs[0], []string{denom1})
+	_, err := suite.keeper.Harvest(suite.ctx, suite.addrs[0], []string{denom1})
 	suite.Require().EqualError(types.ErrStakingNotExists, err.Error())
 
 	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
@@ -374,8 +533,9 @@ func (suite *KeeperTestSuite) TestHarvest() {
 
 	rewards := suite.keeper.AllRewards(suite.ctx, suite.addrs[0])
 
-	err = suite.keeper.Harvest(suite.ctx, suite.addrs[0], []string{denom1})
+	harvested, err := suite.keeper.Harvest(suite.ctx, suite.addrs[0], []string{denom1})
 	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(rewards, harvested))
 
 	balancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
 	suite.Require().True(coinsEq(balancesBefore.Add(rewards...), balancesAfter))
@@ -383,6 +543,79 @@ func (suite *KeeperTestSuite) TestHarvest() {
 	suite.Require().True(suite.keeper.AllRewards(suite.ctx, suite.addrs[0]).IsZero())
 }
 
+func (suite *KeeperTestSuite) TestWithdrawAllRewardsEvents() {
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "0.3", denom2: "0.7"}, map[string]int64{denom3: 1_000_000})
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom2, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err := suite.keeper.WithdrawAllRewards(suite.ctx, suite.addrs[0])
+	suite.Require().NoError(err)
+
+	// A rewards_withdrawn event is emitted for each staking coin denom,
+	// like WithdrawRewards does.
+	rewardsByDenom := map[string]string{}
+	for _, ev := range suite.ctx.EventManager().Events() {
+		if ev.Type != types.EventTypeRewardsWithdrawn {
+			continue
+		}
+		attrs := map[string]string{}
+		for _, attr := range ev.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+		suite.Require().Equal(suite.addrs[0].String(), attrs[types.AttributeKeyFarmer])
+		rewardsByDenom[attrs[types.AttributeKeyStakingCoinDenom]] = attrs[types.AttributeKeyRewardCoins]
+	}
+	suite.Require().Equal(map[string]string{denom1: "300000denom3", denom2: "700000denom3"}, rewardsByDenom)
+}
+
+func (suite *KeeperTestSuite) TestHarvestAndStake() {
+	// The plan distributes denom1, which is also its staking coin denom, and denom3.
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{
+		denom1: 1_000_000,
+		denom3: 2_000_000,
+	})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	rewards := suite.keeper.Rewards(suite.ctx, suite.addrs[0], denom1)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom3, 2_000_000)), rewards))
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	reserveBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, types.StakingReserveAcc(denom1))
+
+	suite.ctx = suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	harvested, staked, err := suite.keeper.HarvestAndStake(suite.ctx, suite.addrs[0], []string{denom1})
+	suite.Require().NoError(err)
+	suite.Require().GreaterOrEqual(suite.ctx.GasMeter().GasConsumed(), suite.keeper.GetParams(suite.ctx).DelayedStakingGasFee)
+
+	suite.Require().True(coinsEq(rewards, harvested))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), staked))
+
+	// Only the rewards that can't be staked are sent to the farmer.
+	balancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(balancesBefore.Add(sdk.NewInt64Coin(denom3, 2_000_000)), balancesAfter))
+
+	// The staked rewards are moved to the staking reserve account and queued.
+	reserveAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, types.StakingReserveAcc(denom1))
+	suite.Require().True(coinsEq(reserveBefore.Add(staked...), reserveAfter))
+	queuedStaking, found := suite.keeper.GetQueuedStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(1_000_000), queuedStaking.Amount))
+
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, types.RewardsReserveAcc).IsZero())
+	suite.Require().True(suite.keeper.AllRewards(suite.ctx, suite.addrs[0]).IsZero())
+
+	// The queued rewards are staked in the next epoch.
+	suite.AdvanceEpoch()
+	staking, found := suite.keeper.GetStaking(suite.ctx, denom1, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().True(intEq(sdk.NewInt(2_000_000), staking.Amount))
+}
+
 func (suite *KeeperTestSuite) TestMultipleHarvest() {
 	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
 
@@ -426,24 +659,75 @@ func (suite *KeeperTestSuite) TestHistoricalRewards() {
 
 	// After a farmer has staked(not queued) coins, historical rewards records will be created for each epoch.
 	// Here we advance epoch three times, and this will create 3 historical rewards records.
+	// The records that no staking references are pruned, so only the record
+	// of the farmer's starting epoch and the latest record remain.
 	suite.AdvanceEpoch()
 	suite.AdvanceEpoch()
 	suite.AdvanceEpoch()
 
-	// First, ensure that we have only 3 entries in the store.
+	// First, ensure that we have only 2 entries in the store.
 	count = 0
 	suite.keeper.IterateHistoricalRewards(suite.ctx, func(stakingCoinDenom string, epoch uint64, rewards types.HistoricalRewards) (stop bool) {
 		count++
 		return false
 	})
-	suite.Require().Equal(4, count)
+	suite.Require().Equal(2, count)
+
+	// Next, check if cumulative unit rewards and reference counts are correct.
+	historical, found := suite.keeper.GetHistoricalRewards(suite.ctx, denom1, 0)
+	suite.Require().True(found)
+	suite.Require().True(historical.CumulativeUnitRewards.IsZero())
+	suite.Require().Equal(uint32(1), historical.ReferenceCount)
+	for i := uint64(1); i <= 2; i++ {
+		_, found := suite.keeper.GetHistoricalRewards(suite.ctx, denom1, i)
+		suite.Require().False(found)
+	}
+	historical, found = suite.keeper.GetHistoricalRewards(suite.ctx, denom1, 3)
+	suite.Require().True(found)
+	suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, 6)), historical.CumulativeUnitRewards))
+	suite.Require().Equal(uint32(1), historical.ReferenceCount)
+}
+
+func (suite *KeeperTestSuite) TestHistoricalRewardsReferenceCount() {
+	suite.setLockTiers(sdk.NewDec(2))
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1000000})
 
-	// Next, check if cumulative unit rewards is correct.
-	for i := uint64(1); i <= 3; i++ {
-		historical, found := suite.keeper.GetHistoricalRewards(suite.ctx, denom1, i)
-		suite.Require().True(found)
-		suite.Require().True(decCoinsEq(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom3, int64(i*2))), historical.CumulativeUnitRewards))
+	referenceCounts := func() map[uint64]uint32 {
+		counts := map[uint64]uint32{}
+		suite.keeper.IterateHistoricalRewards(suite.ctx, func(_ string, epoch uint64, rewards types.HistoricalRewards) (stop bool) {
+			counts[epoch] = rewards.ReferenceCount
+			return false
+		})
+		return counts
 	}
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.AdvanceEpoch()
+	suite.StakeLocked(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+
+	// The current epoch and the staking reference epoch 0, while the queued
+	// locked staking doesn't reference any epoch yet.
+	suite.Require().Equal(map[uint64]uint32{0: 2}, referenceCounts())
+
+	// The reference of the current epoch moves to the new historical rewards,
+	// and the locked staking starts referencing it too.
+	suite.AdvanceEpoch()
+	suite.Require().Equal(map[uint64]uint32{0: 1, 1: 2}, referenceCounts())
+
+	// Harvesting moves the reference of the staking to the latest epoch.
+	suite.Harvest(suite.addrs[0], []string{denom1})
+	suite.Require().Equal(map[uint64]uint32{1: 3}, referenceCounts())
+
+	// Unstaking all coins releases the reference.
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	suite.Require().Equal(map[uint64]uint32{1: 2}, referenceCounts())
+
+	// Historical rewards that no one references are deleted.
+	suite.AdvanceEpoch()
+	suite.Require().Equal(map[uint64]uint32{1: 1, 2: 1}, referenceCounts())
+
+	_, broken := keeper.HistoricalRewardsReferenceCountInvariant(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
 }
 
 // Test if initialization and pruning of staking coin info work properly.
@@ -502,3 +786,46 @@ func (suite *KeeperTestSuite) TestInitializeAndPruneStakingCoinInfo() {
 	_, found = suite.keeper.GetOutstandingRewards(suite.ctx, denom1)
 	suite.Require().False(found)
 }
+
+func TestProRataAllocCoins(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		amount      sdk.Coins
+		totalAmount sdk.Coins
+		balances    sdk.Coins
+		expected    sdk.Coins
+	}{
+		{
+			"sufficient balances",
+			sdk.NewCoins(sdk.NewInt64Coin(denom3, 700000)),
+			sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)),
+			sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)),
+			sdk.NewCoins(sdk.NewInt64Coin(denom3, 700000)),
+		},
+		{
+			"insufficient balances",
+			sdk.NewCoins(sdk.NewInt64Coin(denom3, 700000)),
+			sdk.NewCoins(sdk.NewInt64Coin(denom3, 1400000)),
+			sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)),
+			sdk.NewCoins(sdk.NewInt64Coin(denom3, 500000)),
+		},
+		{
+			"only insufficient denoms are scaled",
+			sdk.NewCoins(sdk.NewInt64Coin(denom2, 100), sdk.NewInt64Coin(denom3, 700000)),
+			sdk.NewCoins(sdk.NewInt64Coin(denom2, 100), sdk.NewInt64Coin(denom3, 1400000)),
+			sdk.NewCoins(sdk.NewInt64Coin(denom2, 100), sdk.NewInt64Coin(denom3, 1000000)),
+			sdk.NewCoins(sdk.NewInt64Coin(denom2, 100), sdk.NewInt64Coin(denom3, 500000)),
+		},
+		{
+			"no balances",
+			sdk.NewCoins(sdk.NewInt64Coin(denom3, 700000)),
+			sdk.NewCoins(sdk.NewInt64Coin(denom3, 1400000)),
+			sdk.NewCoins(),
+			sdk.NewCoins(),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.True(t, tc.expected.IsEqual(keeper.ProRataAllocCoins(tc.amount, tc.totalAmount, tc.balances)))
+		})
+	}
+}
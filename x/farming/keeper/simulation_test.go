@@ -31,7 +31,7 @@ type UnstakeAction struct {
 
 func (ua UnstakeAction) Do(suite *KeeperTestSuite) {
 	fmt.Printf("Unstake(%s, %s)\n", ua.farmerAcc, ua.amount)
-	err := suite.keeper.Unstake(suite.ctx, ua.farmerAcc, ua.amount)
+	_, err := suite.keeper.Unstake(suite.ctx, ua.farmerAcc, ua.amount)
 	suite.Require().NoError(err)
 }
 
@@ -42,7 +42,7 @@ type HarvestAction struct {
 
 func (ha HarvestAction) Do(suite *KeeperTestSuite) {
 	fmt.Printf("Harvest(%s, %s)\n", ha.farmerAcc, ha.stakingCoinDenoms)
-	err := suite.keeper.Harvest(suite.ctx, ha.farmerAcc, ha.stakingCoinDenoms)
+	_, err := suite.keeper.Harvest(suite.ctx, ha.farmerAcc, ha.stakingCoinDenoms)
 	suite.Require().NoError(err)
 }
 
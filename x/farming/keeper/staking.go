@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -226,17 +228,18 @@ func (k Keeper) IterateTotalStakings(ctx sdk.Context, cb func(stakingCoinDenom s
 	}
 }
 
-// ReserveStakingCoins sends staking coins to the staking reserve account.
-func (k Keeper) ReserveStakingCoins(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoins sdk.Coins) error {
+// ReserveStakingCoins sends staking coins from the payer, which is usually
+// the farmer, to the staking reserve account.
+func (k Keeper) ReserveStakingCoins(ctx sdk.Context, payerAcc sdk.AccAddress, stakingCoins sdk.Coins) error {
 	if stakingCoins.Len() == 1 {
-		if err := k.bankKeeper.SendCoins(ctx, farmerAcc, types.StakingReserveAcc(stakingCoins[0].Denom), stakingCoins); err != nil {
+		if err := k.bankKeeper.SendCoins(ctx, payerAcc, types.StakingReserveAcc(stakingCoins[0].Denom), stakingCoins); err != nil {
 			return err
 		}
 	} else {
 		var inputs []banktypes.Input
 		var outputs []banktypes.Output
 		for _, coin := range stakingCoins {
-			inputs = append(inputs, banktypes.NewInput(farmerAcc, sdk.Coins{coin}))
+			inputs = append(inputs, banktypes.NewInput(payerAcc, sdk.Coins{coin}))
 			outputs = append(outputs, banktypes.NewOutput(types.StakingReserveAcc(coin.Denom), sdk.Coins{coin}))
 		}
 		if err := k.bankKeeper.InputOutputCoins(ctx, inputs, outputs); err != nil {
@@ -269,7 +272,7 @@ func (k Keeper) ReleaseStakingCoins(ctx sdk.Context, farmerAcc sdk.AccAddress, s
 // afterStakingCoinAdded is called after a new staking coin denom appeared
 // during ProcessQueuedCoins.
 func (k Keeper) afterStakingCoinAdded(ctx sdk.Context, stakingCoinDenom string) {
-	k.SetHistoricalRewards(ctx, stakingCoinDenom, 0, types.HistoricalRewards{CumulativeUnitRewards: sdk.DecCoins{}})
+	k.SetHistoricalRewards(ctx, stakingCoinDenom, 0, types.HistoricalRewards{CumulativeUnitRewards: sdk.DecCoins{}, ReferenceCount: 1})
 	k.SetCurrentEpoch(ctx, stakingCoinDenom, 1)
 	k.SetOutstandingRewards(ctx, stakingCoinDenom, types.OutstandingRewards{Rewards: sdk.DecCoins{}})
 }
@@ -298,10 +301,75 @@ func (k Keeper) afterStakingCoinRemoved(ctx sdk.Context, stakingCoinDenom string
 
 // Stake stores staking coins to queued coins, and it will be processed in the next epoch.
 func (k Keeper) Stake(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Coins) error {
-	if err := k.ReserveStakingCoins(ctx, farmerAcc, amount); err != nil {
+	return k.StakeFor(ctx, farmerAcc, farmerAcc, amount)
+}
+
+// StakeFor stores staking coins paid by the payer to the farmer's queued coins,
+// and it will be processed in the next epoch.
+// The staking position belongs to the farmer, so only the farmer can unstake
+// the coins and receive the rewards.
+// If the payer is not the farmer, the number of staking coin denoms the farmer
+// ends up with is limited by the MaxThirdPartyStakingDenoms parameter.
+func (k Keeper) StakeFor(ctx sdk.Context, payerAcc, farmerAcc sdk.AccAddress, amount sdk.Coins) error {
+	if !payerAcc.Equals(farmerAcc) {
+		if err := k.validateThirdPartyStakingDenoms(ctx, farmerAcc, amount); err != nil {
+			return err
+		}
+	}
+	return k.stakeFor(ctx, payerAcc, farmerAcc, amount)
+}
+
+// validateThirdPartyStakingDenoms returns an error if a stake paid by another
+// account would open a position for a new staking coin denom and make the
+// farmer have more staking coin denoms staked or queued than allowed.
+func (k Keeper) validateThirdPartyStakingDenoms(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Coins) error {
+	maxDenoms := k.GetParams(ctx).MaxThirdPartyStakingDenoms
+	if maxDenoms == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "staking on behalf of another farmer is disabled")
+	}
+
+	denoms := map[string]bool{}
+	for _, coin := range k.GetAllStakedCoinsByFarmer(ctx, farmerAcc).Add(k.GetAllQueuedCoinsByFarmer(ctx, farmerAcc)...) {
+		denoms[coin.Denom] = true
+	}
+	numNewDenoms := 0
+	for _, coin := range amount {
+		if !denoms[coin.Denom] {
+			denoms[coin.Denom] = true
+			numNewDenoms++
+		}
+	}
+	if numNewDenoms > 0 && len(denoms) > int(maxDenoms) {
+		return sdkerrors.Wrapf(
+			types.ErrTooManyStakingDenoms, "%s would have %d staking coin denoms, more than %d", farmerAcc, len(denoms), maxDenoms)
+	}
+	return nil
+}
+
+// stakeFor is StakeFor without the limit on the staking coin denoms, for the
+// stakes the farmer has requested, such as the pool coins of a deposit request.
+func (k Keeper) stakeFor(ctx sdk.Context, payerAcc, farmerAcc sdk.AccAddress, amount sdk.Coins) error {
+	if k.blockedAddrs[farmerAcc.String()] {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to stake", farmerAcc)
+	}
+
+	if err := k.validateStakingCoinsAllowed(ctx, amount); err != nil {
 		return err
 	}
 
+	if err := k.ReserveStakingCoins(ctx, payerAcc, amount); err != nil {
+		return err
+	}
+
+	k.queueStakingCoins(ctx, payerAcc, farmerAcc, amount)
+
+	return nil
+}
+
+// queueStakingCoins adds already reserved staking coins to the farmer's queued coins.
+// It charges the delayed staking gas fee for each staking coin denom the farmer
+// has already staked.
+func (k Keeper) queueStakingCoins(ctx sdk.Context, payerAcc, farmerAcc sdk.AccAddress, amount sdk.Coins) {
 	numStakingCoinDenoms := 0
 	for _, coin := range amount {
 		queuedStaking, found := k.GetQueuedStaking(ctx, coin.Denom, farmerAcc)
@@ -326,17 +394,34 @@ func (k Keeper) Stake(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Coin
 		sdk.NewEvent(
 			types.EventTypeStake,
 			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyPayer, payerAcc.String()),
 			sdk.NewAttribute(types.AttributeKeyStakingCoins, amount.String()),
 		),
 	})
 
-	return nil
+	k.AfterStaked(ctx, farmerAcc, amount)
 }
 
 // Unstake unstakes an amount of staking coins from the staking reserve account.
-// It causes accumulated rewards to be withdrawn to the farmer.
-func (k Keeper) Unstake(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Coins) error {
+// It causes accumulated rewards to be withdrawn to the farmer and
+// returns the withdrawn rewards.
+// Locked stakings can't be unstaked until their unlock time.
+// If the unbonding period is set, the unstaked coins are released to the
+// farmer after the unbonding period instead of immediately.
+func (k Keeper) Unstake(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Coins) (withdrawnRewards sdk.Coins, err error) {
+	k.BeforeUnstaked(ctx, farmerAcc, amount)
+
+	withdrawnRewards = sdk.NewCoins()
+
 	for _, coin := range amount {
+		// Locked stakings whose unlock time has passed but haven't been
+		// processed yet at the end of an epoch can be unstaked right away.
+		rewards, err := k.unlockMaturedStakings(ctx, farmerAcc, coin.Denom)
+		if err != nil {
+			return nil, err
+		}
+		withdrawnRewards = withdrawnRewards.Add(rewards...)
+
 		staking, found := k.GetStaking(ctx, coin.Denom, farmerAcc)
 		if !found {
 			staking.Amount = sdk.ZeroInt()
@@ -349,24 +434,41 @@ func (k Keeper) Unstake(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Co
 
 		availableAmt := staking.Amount.Add(queuedStaking.Amount)
 		if availableAmt.LT(coin.Amount) {
-			return sdkerrors.Wrapf(
+			lockedAmt := sdk.ZeroInt()
+			k.IterateLockedStakingsByFarmerAndDenom(ctx, farmerAcc, coin.Denom, func(lockedStaking types.LockedStaking) (stop bool) {
+				lockedAmt = lockedAmt.Add(lockedStaking.Amount)
+				return false
+			})
+			for _, lockedStaking := range k.GetQueuedLockedStakingsByFarmerAndDenom(ctx, farmerAcc, coin.Denom) {
+				lockedAmt = lockedAmt.Add(lockedStaking.Amount)
+			}
+			if availableAmt.Add(lockedAmt).GTE(coin.Amount) {
+				return nil, sdkerrors.Wrapf(
+					types.ErrStakingLocked, "%s%s of %s%s is locked", lockedAmt, coin.Denom, coin.Amount, coin.Denom)
+			}
+			return nil, sdkerrors.Wrapf(
 				sdkerrors.ErrInsufficientFunds, "%s%s is smaller than %s%s", availableAmt, coin.Denom, coin.Amount, coin.Denom)
 		}
 
 		queuedStaking.Amount = queuedStaking.Amount.Sub(coin.Amount)
 		if queuedStaking.Amount.IsNegative() {
-			if _, err := k.WithdrawRewards(ctx, farmerAcc, coin.Denom); err != nil {
-				return err
+			rewards, err := k.WithdrawRewards(ctx, farmerAcc, coin.Denom)
+			if err != nil {
+				return nil, err
 			}
+			withdrawnRewards = withdrawnRewards.Add(rewards...)
 
+			// The staking references the latest historical rewards after
+			// the rewards are withdrawn.
+			currentEpoch := k.GetCurrentEpoch(ctx, coin.Denom)
 			removedFromStaking := queuedStaking.Amount.Neg() // Make negative a positive
 			staking.Amount = staking.Amount.Sub(removedFromStaking)
 			if staking.Amount.IsPositive() {
-				currentEpoch := k.GetCurrentEpoch(ctx, coin.Denom)
 				staking.StartingEpoch = currentEpoch
 				k.SetStaking(ctx, coin.Denom, farmerAcc, staking)
 			} else {
 				k.DeleteStaking(ctx, coin.Denom, farmerAcc)
+				k.decrementReferenceCount(ctx, coin.Denom, currentEpoch-1)
 			}
 
 			k.DeleteQueuedStaking(ctx, coin.Denom, farmerAcc)
@@ -378,22 +480,32 @@ func (k Keeper) Unstake(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Co
 		}
 	}
 
-	if err := k.ReleaseStakingCoins(ctx, farmerAcc, amount); err != nil {
-		return err
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+		sdk.NewAttribute(types.AttributeKeyUnstakingCoins, amount.String()),
+	}
+	// Unstaked coins earn no rewards while unbonding, since they have
+	// already been removed from the stakings.
+	if unbondingPeriod := k.GetParams(ctx).UnbondingPeriod; unbondingPeriod > 0 {
+		completionTime := ctx.BlockTime().Add(unbondingPeriod)
+		ids := k.startUnbonding(ctx, farmerAcc, amount, completionTime)
+		attrs = append(attrs,
+			sdk.NewAttribute(types.AttributeKeyUnbondingIds, joinIds(ids)),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		)
+	} else if err := k.ReleaseStakingCoins(ctx, farmerAcc, amount); err != nil {
+		return nil, err
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeUnstake,
-			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
-			sdk.NewAttribute(types.AttributeKeyUnstakingCoins, amount.String()),
-		),
+		sdk.NewEvent(types.EventTypeUnstake, attrs...),
 	})
 
-	return nil
+	return withdrawnRewards, nil
 }
 
-// ProcessQueuedCoins moves queued coins into staked coins.
+// ProcessQueuedCoins moves queued coins into staked coins, and queued
+// locked stakings into locked stakings.
 // It causes accumulated rewards to be withdrawn to the farmer.
 func (k Keeper) ProcessQueuedCoins(ctx sdk.Context) {
 	k.IterateQueuedStakings(ctx, func(stakingCoinDenom string, farmerAcc sdk.AccAddress, queuedStaking types.QueuedStaking) (stop bool) {
@@ -408,18 +520,26 @@ func (k Keeper) ProcessQueuedCoins(ctx sdk.Context) {
 
 		k.DeleteQueuedStaking(ctx, stakingCoinDenom, farmerAcc)
 		k.IncreaseTotalStakings(ctx, stakingCoinDenom, queuedStaking.Amount)
+		currentEpoch := k.GetCurrentEpoch(ctx, stakingCoinDenom)
+		if !found {
+			k.incrementReferenceCount(ctx, stakingCoinDenom, currentEpoch-1)
+		}
 		k.SetStaking(ctx, stakingCoinDenom, farmerAcc, types.Staking{
 			Amount:        staking.Amount.Add(queuedStaking.Amount),
-			StartingEpoch: k.GetCurrentEpoch(ctx, stakingCoinDenom),
+			StartingEpoch: currentEpoch,
 		})
 
+		k.AfterQueuedStakingProcessed(ctx, farmerAcc, stakingCoinDenom, queuedStaking.Amount)
+
 		return false
 	})
+
+	k.ProcessQueuedLockedStakings(ctx)
 }
 
 // ValidateStakingReservedAmount checks that the balance of
-// StakingReserveAcc greater than the amount of staked, queued coins in all
-// staking objects.
+// StakingReserveAcc greater than the amount of staked, queued, locked, queued
+// locked and unbonding coins in all staking objects.
 func (k Keeper) ValidateStakingReservedAmount(ctx sdk.Context) error {
 	reservedCoins := sdk.NewCoins()
 	k.IterateStakings(ctx, func(stakingCoinDenom string, _ sdk.AccAddress, staking types.Staking) (stop bool) {
@@ -430,6 +550,18 @@ func (k Keeper) ValidateStakingReservedAmount(ctx sdk.Context) error {
 		reservedCoins = reservedCoins.Add(sdk.NewCoin(stakingCoinDenom, queuedStaking.Amount))
 		return false
 	})
+	k.IterateLockedStakings(ctx, func(lockedStaking types.LockedStaking) (stop bool) {
+		reservedCoins = reservedCoins.Add(sdk.NewCoin(lockedStaking.StakingCoinDenom, lockedStaking.Amount))
+		return false
+	})
+	k.IterateQueuedLockedStakings(ctx, func(lockedStaking types.LockedStaking) (stop bool) {
+		reservedCoins = reservedCoins.Add(sdk.NewCoin(lockedStaking.StakingCoinDenom, lockedStaking.Amount))
+		return false
+	})
+	k.IterateUnbondings(ctx, func(unbonding types.Unbonding) (stop bool) {
+		reservedCoins = reservedCoins.Add(sdk.NewCoin(unbonding.StakingCoinDenom, unbonding.Amount))
+		return false
+	})
 
 	for _, coin := range reservedCoins {
 		balanceStakingReserveAcc := k.bankKeeper.GetAllBalances(ctx, types.StakingReserveAcc(coin.Denom))
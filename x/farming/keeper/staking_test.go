@@ -4,9 +4,13 @@ import (
 	"math/rand"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	_ "github.com/stretchr/testify/suite"
 	simapp "github.com/tendermint/farming/app"
 	"github.com/tendermint/farming/x/farming/types"
+	"github.com/tendermint/tendermint/crypto"
 )
 
 func (suite *KeeperTestSuite) TestStake() {
@@ -87,6 +91,71 @@ func (suite *KeeperTestSuite) TestStakeInAdvance() {
 	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1000000)), suite.AllRewards(suite.addrs[0])))
 }
 
+func (suite *KeeperTestSuite) TestStakeFor() {
+	farmerAcc := sdk.AccAddress(crypto.AddressHash([]byte("farmer")))
+
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1_000_000})
+
+	payerBalancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	err := suite.keeper.StakeFor(suite.ctx, suite.addrs[0], farmerAcc, sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.Require().NoError(err)
+
+	// The payer pays the staking coins.
+	payerBalancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), payerBalancesBefore.Sub(payerBalancesAfter)))
+
+	// The staking position belongs to the farmer.
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)),
+		suite.keeper.GetAllQueuedCoinsByFarmer(suite.ctx, farmerAcc)))
+	suite.Require().True(suite.keeper.GetAllQueuedCoinsByFarmer(suite.ctx, suite.addrs[0]).IsZero())
+
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)), suite.AllRewards(farmerAcc)))
+	suite.Require().True(suite.AllRewards(suite.addrs[0]).IsZero())
+
+	// The farmer can unstake the coins without paying anything.
+	suite.Unstake(farmerAcc, sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom3, 1_000_000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, farmerAcc)))
+
+	// Module accounts can't be the farmer.
+	err = suite.keeper.StakeFor(suite.ctx, suite.addrs[0], authtypes.NewModuleAddress(distrtypes.ModuleName), sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+func (suite *KeeperTestSuite) TestStakeForMaxThirdPartyStakingDenoms() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.MaxThirdPartyStakingDenoms = 2
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// The farmer stakes denom1 by itself, and the payer opens a position for denom2.
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	err := suite.keeper.StakeFor(suite.ctx, suite.addrs[0], suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom2, 1)))
+	suite.Require().NoError(err)
+
+	// The payer can't open a position for another denom, even with dust.
+	err = suite.keeper.StakeFor(suite.ctx, suite.addrs[0], suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom3, 1)))
+	suite.Require().ErrorIs(err, types.ErrTooManyStakingDenoms)
+
+	// The payer can still add to the farmer's existing positions.
+	err = suite.keeper.StakeFor(suite.ctx, suite.addrs[0], suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1), sdk.NewInt64Coin(denom2, 1)))
+	suite.Require().NoError(err)
+
+	// The farmer itself isn't limited.
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom3, 1)))
+
+	// Zero disables staking on behalf of another farmer.
+	params.MaxThirdPartyStakingDenoms = 0
+	suite.keeper.SetParams(suite.ctx, params)
+	err = suite.keeper.StakeFor(suite.ctx, suite.addrs[0], suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
 func (suite *KeeperTestSuite) TestUnstake() {
 	for _, tc := range []struct {
 		name            string
@@ -160,7 +229,7 @@ func (suite *KeeperTestSuite) TestUnstake() {
 			// At this moment, we have 500000denom1,1000000denom2 staked and
 			// 500000denom1 queued.
 
-			err := suite.keeper.Unstake(suite.ctx, suite.addrs[tc.addrIdx], tc.amt)
+			_, err := suite.keeper.Unstake(suite.ctx, suite.addrs[tc.addrIdx], tc.amt)
 			if tc.expectErr {
 				suite.Error(err)
 			} else {
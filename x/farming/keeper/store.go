@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"bytes"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// epochRangeStore is a KVStore whose keys are big endian encoded epochs.
+// Its iterators only visit the epochs within [startingEpoch, endingEpoch],
+// so paginated queries can seek directly to the starting epoch instead of
+// scanning and filtering the earlier epochs.
+type epochRangeStore struct {
+	sdk.KVStore
+	start []byte
+	end   []byte // exclusive; nil means there is no upper bound
+}
+
+// newEpochRangeStore returns a new epochRangeStore.
+// endingEpoch of zero means there is no upper bound.
+func newEpochRangeStore(store sdk.KVStore, startingEpoch, endingEpoch uint64) epochRangeStore {
+	rs := epochRangeStore{KVStore: store, start: sdk.Uint64ToBigEndian(startingEpoch)}
+	if endingEpoch != 0 && endingEpoch != math.MaxUint64 {
+		rs.end = sdk.Uint64ToBigEndian(endingEpoch + 1)
+	}
+	return rs
+}
+
+// clamp narrows the given iteration domain to the epoch range.
+func (rs epochRangeStore) clamp(start, end []byte) ([]byte, []byte) {
+	if start == nil || bytes.Compare(start, rs.start) < 0 {
+		start = rs.start
+	}
+	if rs.end != nil && (end == nil || bytes.Compare(end, rs.end) > 0) {
+		end = rs.end
+	}
+	if end != nil && bytes.Compare(start, end) > 0 {
+		end = start
+	}
+	return start, end
+}
+
+// Iterator implements sdk.KVStore.
+func (rs epochRangeStore) Iterator(start, end []byte) sdk.Iterator {
+	start, end = rs.clamp(start, end)
+	return rs.KVStore.Iterator(start, end)
+}
+
+// ReverseIterator implements sdk.KVStore.
+func (rs epochRangeStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	start, end = rs.clamp(start, end)
+	return rs.KVStore.ReverseIterator(start, end)
+}
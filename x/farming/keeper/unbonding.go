@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// GetGlobalUnbondingId returns the global unbonding id counter.
+func (k Keeper) GetGlobalUnbondingId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GlobalUnbondingIdKey)
+	if bz == nil {
+		return 0
+	}
+	var val gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &val)
+	return val.GetValue()
+}
+
+// SetGlobalUnbondingId sets the global unbonding id counter.
+func (k Keeper) SetGlobalUnbondingId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
+	store.Set(types.GlobalUnbondingIdKey, bz)
+}
+
+// GetNextUnbondingIdWithUpdate increments the global unbonding id counter
+// and returns the next id.
+func (k Keeper) GetNextUnbondingIdWithUpdate(ctx sdk.Context) uint64 {
+	id := k.GetGlobalUnbondingId(ctx) + 1
+	k.SetGlobalUnbondingId(ctx, id)
+	return id
+}
+
+// GetUnbonding returns an unbonding for given id.
+func (k Keeper) GetUnbonding(ctx sdk.Context, id uint64) (unbonding types.Unbonding, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetUnbondingKey(id))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &unbonding)
+	found = true
+	return
+}
+
+// SetUnbonding sets an unbonding along with its indexes.
+func (k Keeper) SetUnbonding(ctx sdk.Context, unbonding types.Unbonding) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&unbonding)
+	store.Set(types.GetUnbondingKey(unbonding.Id), bz)
+	store.Set(types.GetUnbondingIndexKey(unbonding.GetFarmer(), unbonding.StakingCoinDenom, unbonding.Id), []byte{})
+	store.Set(types.GetUnbondingQueueKey(unbonding.CompletionTime, unbonding.Id), []byte{})
+}
+
+// DeleteUnbonding deletes an unbonding along with its indexes.
+func (k Keeper) DeleteUnbonding(ctx sdk.Context, unbonding types.Unbonding) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetUnbondingKey(unbonding.Id))
+	store.Delete(types.GetUnbondingIndexKey(unbonding.GetFarmer(), unbonding.StakingCoinDenom, unbonding.Id))
+	store.Delete(types.GetUnbondingQueueKey(unbonding.CompletionTime, unbonding.Id))
+}
+
+// IterateUnbondings iterates through all unbondings stored in the store
+// in ascending order of id and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateUnbondings(ctx sdk.Context, cb func(unbonding types.Unbonding) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.UnbondingKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var unbonding types.Unbonding
+		k.cdc.MustUnmarshal(iter.Value(), &unbonding)
+		if cb(unbonding) {
+			break
+		}
+	}
+}
+
+// IterateUnbondingsByFarmer iterates through all unbondings by a farmer
+// stored in the store and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateUnbondingsByFarmer(ctx sdk.Context, farmerAcc sdk.AccAddress, cb func(unbonding types.Unbonding) (stop bool)) {
+	k.iterateUnbondingsByIndexPrefix(ctx, types.GetUnbondingsByFarmerPrefix(farmerAcc), cb)
+}
+
+// IterateUnbondingsByFarmerAndDenom iterates through all unbondings by a
+// farmer for a given staking coin denom stored in the store and invokes
+// callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateUnbondingsByFarmerAndDenom(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, cb func(unbonding types.Unbonding) (stop bool)) {
+	k.iterateUnbondingsByIndexPrefix(ctx, types.GetUnbondingsByFarmerAndDenomPrefix(farmerAcc, stakingCoinDenom), cb)
+}
+
+func (k Keeper) iterateUnbondingsByIndexPrefix(ctx sdk.Context, prefix []byte, cb func(unbonding types.Unbonding) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, _, id := types.ParseUnbondingIndexKey(iter.Key())
+		unbonding, _ := k.GetUnbonding(ctx, id)
+		if cb(unbonding) {
+			break
+		}
+	}
+}
+
+// GetAllUnbondingCoinsByFarmer returns all coins that are unbonding by a farmer.
+func (k Keeper) GetAllUnbondingCoinsByFarmer(ctx sdk.Context, farmerAcc sdk.AccAddress) sdk.Coins {
+	unbondingCoins := sdk.NewCoins()
+	k.IterateUnbondingsByFarmer(ctx, farmerAcc, func(unbonding types.Unbonding) (stop bool) {
+		unbondingCoins = unbondingCoins.Add(sdk.NewCoin(unbonding.StakingCoinDenom, unbonding.Amount))
+		return false
+	})
+	return unbondingCoins
+}
+
+// startUnbonding creates an unbonding for each of the unstaked coins, which
+// are kept in the staking reserve account until the completion time.
+// It returns the ids of the created unbondings.
+func (k Keeper) startUnbonding(ctx sdk.Context, farmerAcc sdk.AccAddress, amount sdk.Coins, completionTime time.Time) []uint64 {
+	var ids []uint64
+	for _, coin := range amount {
+		unbonding := types.Unbonding{
+			Id:               k.GetNextUnbondingIdWithUpdate(ctx),
+			Farmer:           farmerAcc.String(),
+			StakingCoinDenom: coin.Denom,
+			Amount:           coin.Amount,
+			CompletionTime:   completionTime,
+		}
+		k.SetUnbonding(ctx, unbonding)
+		ids = append(ids, unbonding.Id)
+	}
+	return ids
+}
+
+// ProcessMaturedUnbondings releases the coins of the unbondings whose
+// completion time has passed to the farmers.
+func (k Keeper) ProcessMaturedUnbondings(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.UnbondingQueueKeyPrefix, sdk.PrefixEndBytes(types.GetUnbondingQueueTimePrefix(ctx.BlockTime())))
+	defer iter.Close()
+
+	var unbondings []types.Unbonding
+	for ; iter.Valid(); iter.Next() {
+		_, id := types.ParseUnbondingQueueKey(iter.Key())
+		unbonding, _ := k.GetUnbonding(ctx, id)
+		unbondings = append(unbondings, unbonding)
+	}
+
+	for _, unbonding := range unbondings {
+		k.DeleteUnbonding(ctx, unbonding)
+		amount := sdk.NewCoins(sdk.NewCoin(unbonding.StakingCoinDenom, unbonding.Amount))
+		if err := k.ReleaseStakingCoins(ctx, unbonding.GetFarmer(), amount); err != nil {
+			panic(err)
+		}
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeUnbondingCompleted,
+				sdk.NewAttribute(types.AttributeKeyUnbondingId, strconv.FormatUint(unbonding.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyFarmer, unbonding.Farmer),
+				sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			),
+		})
+	}
+}
+
+// CancelUnbonding cancels an unbonding of a farmer and queues the unbonding
+// coins for staking again, as if they were staked through Stake.
+func (k Keeper) CancelUnbonding(ctx sdk.Context, farmerAcc sdk.AccAddress, id uint64) error {
+	unbonding, found := k.GetUnbonding(ctx, id)
+	if !found || !unbonding.GetFarmer().Equals(farmerAcc) {
+		return sdkerrors.Wrapf(types.ErrUnbondingNotExists, "unbonding %d of %s is not found", id, farmerAcc)
+	}
+
+	amount := sdk.NewCoins(sdk.NewCoin(unbonding.StakingCoinDenom, unbonding.Amount))
+	if err := k.validateStakingCoinsAllowed(ctx, amount); err != nil {
+		return err
+	}
+
+	k.DeleteUnbonding(ctx, unbonding)
+	k.queueStakingCoins(ctx, farmerAcc, farmerAcc, amount)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelUnbonding,
+			sdk.NewAttribute(types.AttributeKeyUnbondingId, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	})
+
+	return nil
+}
+
+// joinIds returns the comma-separated list of the ids, used in event attributes.
+func joinIds(ids []uint64) string {
+	idStrs := make([]string, len(ids))
+	for i, id := range ids {
+		idStrs[i] = strconv.FormatUint(id, 10)
+	}
+	return strings.Join(idStrs, ",")
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+const unbondingPeriod = 7 * 24 * time.Hour
+
+// setUnbondingPeriod sets the unbonding period to the given duration.
+func (suite *KeeperTestSuite) setUnbondingPeriod(period time.Duration) {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.UnbondingPeriod = period
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
+func (suite *KeeperTestSuite) TestUnstakeWithUnbondingPeriod() {
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-01T00:00:00Z"))
+	suite.setUnbondingPeriod(unbondingPeriod)
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1_000_000})
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.Stake(suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000)))
+	balancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+
+	// The unstaked coins are not released yet.
+	suite.Require().True(coinsEq(balancesBefore, balancesAfter))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000)), suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000)), suite.keeper.GetAllUnbondingCoinsByFarmer(suite.ctx, suite.addrs[0])))
+
+	unbonding, found := suite.keeper.GetUnbonding(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.addrs[0].String(), unbonding.Farmer)
+	suite.Require().Equal(denom1, unbonding.StakingCoinDenom)
+	suite.Require().True(intEq(sdk.NewInt(500_000), unbonding.Amount))
+	suite.Require().Equal(types.ParseTime("2021-08-08T00:00:00Z"), unbonding.CompletionTime)
+
+	totalStakings, _ := suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().True(intEq(sdk.NewInt(1_500_000), totalStakings.Amount))
+	suite.Require().NoError(suite.keeper.ValidateStakingReservedAmount(suite.ctx))
+
+	// The unbonding coins don't earn rewards.
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 333_333)), suite.AllRewards(suite.addrs[0])))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 666_666)), suite.AllRewards(suite.addrs[1])))
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-07T23:59:59Z"))
+	suite.keeper.ProcessMaturedUnbondings(suite.ctx)
+	_, found = suite.keeper.GetUnbonding(suite.ctx, 1)
+	suite.Require().True(found)
+
+	suite.ctx = suite.ctx.WithBlockTime(types.ParseTime("2021-08-08T00:00:00Z"))
+	suite.keeper.ProcessMaturedUnbondings(suite.ctx)
+	_, found = suite.keeper.GetUnbonding(suite.ctx, 1)
+	suite.Require().False(found)
+
+	balancesAfter = suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000)), balancesAfter.Sub(balancesBefore)))
+	suite.Require().True(suite.keeper.GetAllUnbondingCoinsByFarmer(suite.ctx, suite.addrs[0]).IsZero())
+	suite.Require().NoError(suite.keeper.ValidateStakingReservedAmount(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestUnstakeAllWithUnbondingPeriod() {
+	suite.setUnbondingPeriod(unbondingPeriod)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom2, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 500_000)))
+
+	// Both the queued and the staked coins are unbonded.
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_500_000), sdk.NewInt64Coin(denom2, 1_000_000)))
+	suite.Require().True(suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0]).IsZero())
+	suite.Require().True(suite.keeper.GetAllQueuedCoinsByFarmer(suite.ctx, suite.addrs[0]).IsZero())
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_500_000), sdk.NewInt64Coin(denom2, 1_000_000)),
+		suite.keeper.GetAllUnbondingCoinsByFarmer(suite.ctx, suite.addrs[0])))
+
+	_, found := suite.keeper.GetTotalStakings(suite.ctx, denom1)
+	suite.Require().False(found)
+	suite.Require().NoError(suite.keeper.ValidateStakingReservedAmount(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestCancelUnbonding() {
+	suite.setUnbondingPeriod(unbondingPeriod)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 400_000)))
+
+	err := suite.keeper.CancelUnbonding(suite.ctx, suite.addrs[0], 2)
+	suite.Require().ErrorIs(err, types.ErrUnbondingNotExists)
+
+	// Only the farmer can cancel the unbonding.
+	err = suite.keeper.CancelUnbonding(suite.ctx, suite.addrs[1], 1)
+	suite.Require().ErrorIs(err, types.ErrUnbondingNotExists)
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	err = suite.keeper.CancelUnbonding(suite.ctx, suite.addrs[0], 1)
+	suite.Require().NoError(err)
+	balancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(balancesBefore, balancesAfter))
+
+	_, found := suite.keeper.GetUnbonding(suite.ctx, 1)
+	suite.Require().False(found)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 400_000)), suite.keeper.GetAllQueuedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+	suite.Require().NoError(suite.keeper.ValidateStakingReservedAmount(suite.ctx))
+
+	// The canceled unbonding coins are staked at the end of the epoch.
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)), suite.keeper.GetAllStakedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+
+	err = suite.keeper.CancelUnbonding(suite.ctx, suite.addrs[0], 1)
+	suite.Require().ErrorIs(err, types.ErrUnbondingNotExists)
+}
+
+func (suite *KeeperTestSuite) TestCancelUnbondingNotAllowedDenom() {
+	suite.setUnbondingPeriod(unbondingPeriod)
+
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 400_000)))
+
+	// The denom of the unbonding is no longer allowed to be staked.
+	suite.keeper.SetAllowedStakingDenom(suite.ctx, denom2)
+
+	err := suite.keeper.CancelUnbonding(suite.ctx, suite.addrs[0], 1)
+	suite.Require().ErrorIs(err, types.ErrStakingDenomNotAllowed)
+
+	_, found := suite.keeper.GetUnbonding(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().True(suite.keeper.GetAllQueuedCoinsByFarmer(suite.ctx, suite.addrs[0]).IsZero())
+}
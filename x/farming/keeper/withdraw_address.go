@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// GetRewardsWithdrawAddress returns the address that the rewards of a farmer
+// are withdrawn to. It returns the farmer itself if the farmer hasn't set
+// a rewards withdraw address.
+func (k Keeper) GetRewardsWithdrawAddress(ctx sdk.Context, farmerAcc sdk.AccAddress) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRewardsWithdrawAddressKey(farmerAcc))
+	if bz == nil {
+		return farmerAcc
+	}
+	return bz
+}
+
+// setRewardsWithdrawAddress sets the rewards withdraw address of a farmer.
+func (k Keeper) setRewardsWithdrawAddress(ctx sdk.Context, farmerAcc, withdrawAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRewardsWithdrawAddressKey(farmerAcc), withdrawAddr)
+}
+
+// deleteRewardsWithdrawAddress deletes the rewards withdraw address of a farmer.
+func (k Keeper) deleteRewardsWithdrawAddress(ctx sdk.Context, farmerAcc sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRewardsWithdrawAddressKey(farmerAcc))
+}
+
+// IterateRewardsWithdrawAddresses iterates through all the farmers that have
+// set a rewards withdraw address.
+func (k Keeper) IterateRewardsWithdrawAddresses(ctx sdk.Context, cb func(farmerAcc, withdrawAddr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.RewardsWithdrawAddressKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		farmerAcc := types.ParseRewardsWithdrawAddressKey(iter.Key())
+		if cb(farmerAcc, iter.Value()) {
+			break
+		}
+	}
+}
+
+// SetRewardsWithdrawAddress sets the address that the rewards of a farmer
+// are withdrawn to, including the rewards withdrawn implicitly while staking
+// and unstaking. Setting the farmer itself resets the withdraw address.
+// Blocked addresses, such as module accounts, can't be the withdraw address.
+func (k Keeper) SetRewardsWithdrawAddress(ctx sdk.Context, farmerAcc, withdrawAddr sdk.AccAddress) error {
+	if k.blockedAddrs[withdrawAddr.String()] {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive rewards", withdrawAddr)
+	}
+
+	if withdrawAddr.Equals(farmerAcc) {
+		k.deleteRewardsWithdrawAddress(ctx, farmerAcc)
+	} else {
+		k.setRewardsWithdrawAddress(ctx, farmerAcc, withdrawAddr)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetRewardsWithdrawAddress,
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmerAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, withdrawAddr.String()),
+		),
+	})
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+func (suite *KeeperTestSuite) TestSetRewardsWithdrawAddress() {
+	withdrawAddr := sdk.AccAddress(crypto.AddressHash([]byte("withdrawAddr")))
+
+	suite.Require().Equal(suite.addrs[0], suite.keeper.GetRewardsWithdrawAddress(suite.ctx, suite.addrs[0]))
+
+	err := suite.keeper.SetRewardsWithdrawAddress(suite.ctx, suite.addrs[0], withdrawAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(withdrawAddr, suite.keeper.GetRewardsWithdrawAddress(suite.ctx, suite.addrs[0]))
+	suite.Require().Equal(suite.addrs[1], suite.keeper.GetRewardsWithdrawAddress(suite.ctx, suite.addrs[1]))
+
+	// Module accounts can't receive rewards.
+	err = suite.keeper.SetRewardsWithdrawAddress(suite.ctx, suite.addrs[0], authtypes.NewModuleAddress(distrtypes.ModuleName))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().Equal(withdrawAddr, suite.keeper.GetRewardsWithdrawAddress(suite.ctx, suite.addrs[0]))
+
+	// Setting the farmer itself resets the withdraw address.
+	err = suite.keeper.SetRewardsWithdrawAddress(suite.ctx, suite.addrs[0], suite.addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.addrs[0], suite.keeper.GetRewardsWithdrawAddress(suite.ctx, suite.addrs[0]))
+
+	numRecords := 0
+	suite.keeper.IterateRewardsWithdrawAddresses(suite.ctx, func(_, _ sdk.AccAddress) (stop bool) {
+		numRecords++
+		return false
+	})
+	suite.Require().Zero(numRecords)
+}
+
+func (suite *KeeperTestSuite) TestRewardsWithdrawnToWithdrawAddress() {
+	withdrawAddr := sdk.AccAddress(crypto.AddressHash([]byte("withdrawAddr")))
+
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom3: 1_000_000})
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	err := suite.keeper.SetRewardsWithdrawAddress(suite.ctx, suite.addrs[0], withdrawAddr)
+	suite.Require().NoError(err)
+
+	farmerBalancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+
+	// Harvest.
+	suite.Harvest(suite.addrs[0], []string{denom1})
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, withdrawAddr)))
+
+	// Implicit withdrawal while processing queued coins.
+	suite.AdvanceEpoch()
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 3_000_000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, withdrawAddr)))
+
+	// Implicit withdrawal while unstaking.
+	suite.AdvanceEpoch()
+	suite.Unstake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 2_000_000)))
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 4_000_000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, withdrawAddr)))
+
+	// The farmer receives the unstaked coins, but no rewards.
+	farmerBalancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.addrs[0])
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)),
+		farmerBalancesAfter.Sub(farmerBalancesBefore)))
+}
+
+func (suite *KeeperTestSuite) TestWithdrawAllRewardsToWithdrawAddress() {
+	withdrawAddr := sdk.AccAddress(crypto.AddressHash([]byte("withdrawAddr")))
+
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "0.5", denom2: "0.5"}, map[string]int64{denom3: 1_000_000})
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom2, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	err := suite.keeper.SetRewardsWithdrawAddress(suite.ctx, suite.addrs[0], withdrawAddr)
+	suite.Require().NoError(err)
+
+	rewards, err := suite.keeper.WithdrawAllRewards(suite.ctx, suite.addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)), rewards))
+	suite.Require().True(coinsEq(rewards, suite.app.BankKeeper.GetAllBalances(suite.ctx, withdrawAddr)))
+}
+
+func (suite *KeeperTestSuite) TestHarvestAndStakeToWithdrawAddress() {
+	withdrawAddr := sdk.AccAddress(crypto.AddressHash([]byte("withdrawAddr")))
+
+	suite.CreateFixedAmountPlan(suite.addrs[4], map[string]string{denom1: "1"}, map[string]int64{denom1: 100_000, denom3: 1_000_000})
+	suite.Stake(suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000)))
+	suite.AdvanceEpoch()
+	suite.AdvanceEpoch()
+
+	err := suite.keeper.SetRewardsWithdrawAddress(suite.ctx, suite.addrs[0], withdrawAddr)
+	suite.Require().NoError(err)
+
+	harvested, staked, err := suite.keeper.HarvestAndStake(suite.ctx, suite.addrs[0], []string{denom1})
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 100_000), sdk.NewInt64Coin(denom3, 1_000_000)), harvested))
+	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 100_000)), staked))
+
+	// The staked rewards belong to the farmer, and the rest go to the withdraw address.
+	suite.Require().True(coinsEq(staked, suite.keeper.GetAllQueuedCoinsByFarmer(suite.ctx, suite.addrs[0])))
+	suite.Require().True(coinsEq(
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, withdrawAddr)))
+}
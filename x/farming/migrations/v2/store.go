@@ -0,0 +1,210 @@
+package v2
+
+import (
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+const day = 24 * time.Hour
+
+// migrateParams converts the v1 parameters to the v2 parameters.
+// NextEpochDays is replaced by NextEpochDuration, and the parameters
+// added in v2 are set to their default values.
+// The subspace can't delete a parameter, so NextEpochDays is deleted from the
+// params store directly.
+func migrateParams(ctx sdk.Context, paramSpace paramstypes.Subspace, paramsStoreKey sdk.StoreKey) error {
+	params := types.DefaultParams()
+
+	// The parameters kept from v1 are registered in the v2 key table as well,
+	// so they can be read through the subspace as they are.
+	paramSpace.GetIfExists(ctx, types.KeyPrivatePlanCreationFee, &params.PrivatePlanCreationFee)
+	paramSpace.GetIfExists(ctx, types.KeyFarmingFeeCollector, &params.FarmingFeeCollector)
+	paramSpace.GetIfExists(ctx, types.KeyDelayedStakingGasFee, &params.DelayedStakingGasFee)
+
+	if bz := paramSpace.GetRaw(ctx, KeyNextEpochDays); bz != nil {
+		var nextEpochDays uint32
+		if err := codec.NewLegacyAmino().UnmarshalJSON(bz, &nextEpochDays); err != nil {
+			return err
+		}
+		params.NextEpochDuration = time.Duration(nextEpochDays) * day
+
+		paramsStore := prefix.NewStore(ctx.KVStore(paramsStoreKey), append([]byte(paramSpace.Name()), '/'))
+		paramsStore.Delete(KeyNextEpochDays)
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	paramSpace.SetParamSet(ctx, &params)
+
+	return nil
+}
+
+// migrateCurrentEpochDays converts the current epoch days to the current
+// epoch duration.
+func migrateCurrentEpochDays(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	bz := store.Get(CurrentEpochDaysKey)
+	if bz == nil {
+		return nil
+	}
+
+	var val gogotypes.UInt32Value
+	if err := cdc.Unmarshal(bz, &val); err != nil {
+		return err
+	}
+
+	epochDuration := time.Duration(val.GetValue()) * day
+	store.Set(types.CurrentEpochDurationKey, cdc.MustMarshal(gogotypes.DurationProto(epochDuration)))
+	store.Delete(CurrentEpochDaysKey)
+
+	return nil
+}
+
+// referenceCounts returns the number of references to historical rewards,
+// keyed by historical rewards keys.
+// The latest historical rewards of each staking coin denom is referenced by
+// the current epoch, and the others are referenced by the stakings and the
+// locked stakings that start from them.
+func referenceCounts(store sdk.KVStore, cdc codec.BinaryCodec) map[string]uint32 {
+	counts := map[string]uint32{}
+
+	iter := sdk.KVStorePrefixIterator(store, types.CurrentEpochKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var val gogotypes.UInt64Value
+		cdc.MustUnmarshal(iter.Value(), &val)
+		stakingCoinDenom := types.ParseCurrentEpochKey(iter.Key())
+		counts[string(types.GetHistoricalRewardsKey(stakingCoinDenom, val.GetValue()-1))]++
+	}
+
+	stakingIter := sdk.KVStorePrefixIterator(store, types.StakingKeyPrefix)
+	defer stakingIter.Close()
+	for ; stakingIter.Valid(); stakingIter.Next() {
+		var staking types.Staking
+		cdc.MustUnmarshal(stakingIter.Value(), &staking)
+		stakingCoinDenom, _ := types.ParseStakingKey(stakingIter.Key())
+		counts[string(types.GetHistoricalRewardsKey(stakingCoinDenom, staking.StartingEpoch-1))]++
+	}
+
+	lockedStakingIter := sdk.KVStorePrefixIterator(store, types.LockedStakingKeyPrefix)
+	defer lockedStakingIter.Close()
+	for ; lockedStakingIter.Valid(); lockedStakingIter.Next() {
+		var lockedStaking types.LockedStaking
+		cdc.MustUnmarshal(lockedStakingIter.Value(), &lockedStaking)
+		counts[string(types.GetHistoricalRewardsKey(lockedStaking.StakingCoinDenom, lockedStaking.StartingEpoch-1))]++
+	}
+
+	return counts
+}
+
+// migrateHistoricalRewards sets the reference count of all historical
+// rewards, and deletes the historical rewards that no one references.
+func migrateHistoricalRewards(store sdk.KVStore, cdc codec.BinaryCodec) {
+	counts := referenceCounts(store, cdc)
+
+	var keys [][]byte
+	iter := sdk.KVStorePrefixIterator(store, types.HistoricalRewardsKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	for _, key := range keys {
+		count := counts[string(key)]
+		if count == 0 {
+			store.Delete(key)
+			continue
+		}
+
+		var historical types.HistoricalRewards
+		cdc.MustUnmarshal(store.Get(key), &historical)
+		historical.ReferenceCount = count
+		store.Set(key, cdc.MustMarshal(&historical))
+	}
+}
+
+// migrateGlobalEpoch sets the global epoch number, which doesn't exist in v1.
+// The number of epochs that have ended isn't recorded in v1, so it is
+// derived from the staking coin denom that has received rewards for the
+// most epochs.
+func migrateGlobalEpoch(store sdk.KVStore, cdc codec.BinaryCodec) {
+	globalEpoch := uint64(0)
+
+	iter := sdk.KVStorePrefixIterator(store, types.CurrentEpochKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var val gogotypes.UInt64Value
+		cdc.MustUnmarshal(iter.Value(), &val)
+		if val.GetValue() > 0 && val.GetValue()-1 > globalEpoch {
+			globalEpoch = val.GetValue() - 1
+		}
+	}
+
+	store.Set(types.GlobalEpochKey, cdc.MustMarshal(&gogotypes.UInt64Value{Value: globalEpoch}))
+}
+
+// migratePlanIndexes adds the end time index and the active plan index of
+// the plans that are not terminated.
+func migratePlanIndexes(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	iter := sdk.KVStorePrefixIterator(store, types.PlanKeyPrefix)
+	defer iter.Close()
+
+	var plans []types.PlanI
+	for ; iter.Valid(); iter.Next() {
+		var plan types.PlanI
+		if err := cdc.UnmarshalInterface(iter.Value(), &plan); err != nil {
+			return err
+		}
+		plans = append(plans, plan)
+	}
+
+	for _, plan := range plans {
+		if plan.GetTerminated() {
+			continue
+		}
+		store.Set(types.GetPlanEndTimeIndexKey(plan.GetEndTime(), plan.GetId()), []byte{})
+		store.Set(types.GetActivePlanIndexKey(plan.GetId()), []byte{})
+	}
+
+	return nil
+}
+
+// MigrateStore performs in-place store migrations from v1 to v2.
+// The migration includes:
+//
+// - Replace the NextEpochDays parameter with the NextEpochDuration parameter.
+// - Delete the NextEpochDays parameter.
+// - Set the parameters added in v2 to their default values.
+// - Replace the current epoch days with the current epoch duration.
+// - Set the reference count of historical rewards.
+// - Prune the historical rewards that no staking references.
+// - Set the global epoch number.
+// - Add the end time index and the active plan index of plans.
+func MigrateStore(
+	ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec,
+	paramSpace paramstypes.Subspace, paramsStoreKey sdk.StoreKey,
+) error {
+	if err := migrateParams(ctx, paramSpace, paramsStoreKey); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+
+	if err := migrateCurrentEpochDays(store, cdc); err != nil {
+		return err
+	}
+
+	migrateHistoricalRewards(store, cdc)
+	migrateGlobalEpoch(store, cdc)
+
+	return migratePlanIndexes(store, cdc)
+}
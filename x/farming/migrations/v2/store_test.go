@@ -0,0 +1,242 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	simapp "github.com/tendermint/farming/app"
+	"github.com/tendermint/farming/x/farming/keeper"
+	v2 "github.com/tendermint/farming/x/farming/migrations/v2"
+	"github.com/tendermint/farming/x/farming/types"
+)
+
+// setV1State overwrites the farming module state with the given v1 parameters
+// and current epoch days. The current epoch days are not set if it is zero.
+func setV1State(app *simapp.FarmingApp, ctx sdk.Context, params v2.Params, currentEpochDays uint32) {
+	paramSpace := paramstypes.NewSubspace(
+		app.AppCodec(), app.LegacyAmino(),
+		app.GetKey(paramstypes.StoreKey), app.GetTKey(paramstypes.TStoreKey),
+		types.ModuleName,
+	).WithKeyTable(v2.ParamKeyTable())
+	paramSpace.SetParamSet(ctx, &params)
+
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	store.Delete(types.CurrentEpochDurationKey)
+	if currentEpochDays != 0 {
+		store.Set(v2.CurrentEpochDaysKey, app.AppCodec().MustMarshal(&gogotypes.UInt32Value{Value: currentEpochDays}))
+	}
+}
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	creationFee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000))
+	feeCollector := sdk.AccAddress([]byte("feeCollector")).String()
+	setV1State(app, ctx, v2.Params{
+		PrivatePlanCreationFee: creationFee,
+		NextEpochDays:          2,
+		FarmingFeeCollector:    feeCollector,
+		DelayedStakingGasFee:   sdk.Gas(30000),
+	}, 3)
+
+	err := keeper.NewMigrator(app.FarmingKeeper, app.GetKey(paramstypes.StoreKey)).Migrate1to2(ctx)
+	require.NoError(t, err)
+
+	params := app.FarmingKeeper.GetParams(ctx)
+	require.True(t, creationFee.IsEqual(params.PrivatePlanCreationFee))
+	require.Equal(t, 48*time.Hour, params.NextEpochDuration)
+	require.Equal(t, feeCollector, params.FarmingFeeCollector)
+	require.Equal(t, sdk.Gas(30000), params.DelayedStakingGasFee)
+	require.Equal(t, types.DefaultPartialAllocation, params.PartialAllocation)
+	require.Equal(t, types.DefaultMaxCatchUpEpochs, params.MaxCatchUpEpochs)
+	require.Empty(t, params.LockTiers)
+	require.Equal(t, types.DefaultUnbondingPeriod, params.UnbondingPeriod)
+	require.Equal(t, types.DefaultMaxThirdPartyStakingDenoms, params.MaxThirdPartyStakingDenoms)
+
+	require.Equal(t, 72*time.Hour, app.FarmingKeeper.GetCurrentEpochDuration(ctx))
+	require.False(t, ctx.KVStore(app.GetKey(types.StoreKey)).Has(v2.CurrentEpochDaysKey))
+
+	// The NextEpochDays parameter is deleted.
+	paramSpace := app.GetSubspace(types.ModuleName)
+	require.False(t, paramSpace.Has(ctx, v2.KeyNextEpochDays))
+	require.Nil(t, paramSpace.GetRaw(ctx, v2.KeyNextEpochDays))
+}
+
+func TestMigrateStoreWithoutCurrentEpochDays(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	setV1State(app, ctx, v2.Params{
+		PrivatePlanCreationFee: types.DefaultPrivatePlanCreationFee,
+		NextEpochDays:          1,
+		FarmingFeeCollector:    types.DefaultFarmingFeeCollector,
+		DelayedStakingGasFee:   types.DefaultDelayedStakingGasFee,
+	}, 0)
+
+	err := keeper.NewMigrator(app.FarmingKeeper, app.GetKey(paramstypes.StoreKey)).Migrate1to2(ctx)
+	require.NoError(t, err)
+
+	// The current epoch duration falls back to the next epoch duration.
+	require.Equal(t, 24*time.Hour, app.FarmingKeeper.GetParams(ctx).NextEpochDuration)
+	require.Equal(t, 24*time.Hour, app.FarmingKeeper.GetCurrentEpochDuration(ctx))
+	require.False(t, ctx.KVStore(app.GetKey(types.StoreKey)).Has(types.CurrentEpochDurationKey))
+}
+
+func TestMigrateStoreInvalidParams(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	setV1State(app, ctx, v2.Params{
+		PrivatePlanCreationFee: types.DefaultPrivatePlanCreationFee,
+		NextEpochDays:          0,
+		FarmingFeeCollector:    types.DefaultFarmingFeeCollector,
+		DelayedStakingGasFee:   types.DefaultDelayedStakingGasFee,
+	}, 1)
+
+	err := keeper.NewMigrator(app.FarmingKeeper, app.GetKey(paramstypes.StoreKey)).Migrate1to2(ctx)
+	require.EqualError(t, err, "next epoch duration must be positive: 0s")
+}
+
+func TestMigrateStoreHistoricalRewards(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	k := app.FarmingKeeper
+
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.ZeroInt())
+	for _, addr := range addrs {
+		err := simapp.FundAccount(app.BankKeeper, ctx, addr, sdk.NewCoins(
+			sdk.NewInt64Coin("denom1", 1_000_000_000), sdk.NewInt64Coin("denom2", 1_000_000_000)))
+		require.NoError(t, err)
+	}
+
+	_, err := k.CreateFixedAmountPlan(ctx, types.NewMsgCreateFixedAmountPlan(
+		"plan",
+		addrs[2],
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom1", sdk.OneDec())),
+		types.ParseTime("0001-01-01T00:00:00Z"),
+		types.ParseTime("9999-12-31T00:00:00Z"),
+		sdk.NewCoins(sdk.NewInt64Coin("denom2", 1_000_000)),
+	), addrs[2], addrs[2], types.PlanTypePublic)
+	require.NoError(t, err)
+
+	advanceEpoch := func() {
+		require.NoError(t, k.AdvanceEpoch(ctx))
+	}
+
+	err = k.Stake(ctx, addrs[0], sdk.NewCoins(sdk.NewInt64Coin("denom1", 1_000_000)))
+	require.NoError(t, err)
+	advanceEpoch()
+	advanceEpoch()
+	err = k.Stake(ctx, addrs[1], sdk.NewCoins(sdk.NewInt64Coin("denom1", 1_000_000)))
+	require.NoError(t, err)
+	advanceEpoch()
+	advanceEpoch()
+
+	// Reproduce the v1 state, where historical rewards have no reference
+	// count and are never pruned.
+	for epoch := uint64(0); epoch < k.GetCurrentEpoch(ctx, "denom1"); epoch++ {
+		k.SetHistoricalRewards(ctx, "denom1", epoch, types.HistoricalRewards{
+			CumulativeUnitRewards: sdk.NewDecCoins(sdk.NewInt64DecCoin("denom2", int64(epoch))),
+		})
+	}
+	_, broken := keeper.HistoricalRewardsReferenceCountInvariant(k)(ctx)
+	require.True(t, broken)
+
+	err = keeper.NewMigrator(k, app.GetKey(paramstypes.StoreKey)).Migrate1to2(ctx)
+	require.NoError(t, err)
+
+	_, broken = keeper.HistoricalRewardsReferenceCountInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// The stakings start from epoch 1 and 3, and the current epoch is 4.
+	counts := map[uint64]uint32{}
+	k.IterateHistoricalRewards(ctx, func(_ string, epoch uint64, rewards types.HistoricalRewards) (stop bool) {
+		counts[epoch] = rewards.ReferenceCount
+		return false
+	})
+	require.Equal(t, map[uint64]uint32{0: 1, 2: 1, 3: 1}, counts)
+
+	// The cumulative unit rewards are kept as they are.
+	historical, _ := k.GetHistoricalRewards(ctx, "denom1", 2)
+	require.True(t, historical.CumulativeUnitRewards.IsEqual(sdk.NewDecCoins(sdk.NewInt64DecCoin("denom2", 2))))
+}
+
+func TestMigrateStoreGlobalEpoch(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	k := app.FarmingKeeper
+
+	// Reproduce the v1 state, where the global epoch number doesn't exist.
+	k.SetCurrentEpoch(ctx, "denom1", 3)
+	k.SetCurrentEpoch(ctx, "denom2", 5)
+	ctx.KVStore(app.GetKey(types.StoreKey)).Delete(types.GlobalEpochKey)
+
+	err := keeper.NewMigrator(k, app.GetKey(paramstypes.StoreKey)).Migrate1to2(ctx)
+	require.NoError(t, err)
+
+	// Rewards have been allocated to denom2 for 4 epochs.
+	require.Equal(t, uint64(4), k.GetGlobalEpoch(ctx))
+}
+
+func TestMigrateStorePlanIndexes(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	k := app.FarmingKeeper
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.ZeroInt())[0]
+	endTimes := []string{"2021-08-10T00:00:00Z", "2021-08-05T00:00:00Z", "2021-08-01T00:00:00Z"}
+	for i, endTime := range endTimes {
+		plan := types.NewFixedAmountPlan(
+			types.NewBasePlan(
+				uint64(i+1),
+				"plan",
+				types.PlanTypePublic,
+				addr.String(),
+				addr.String(),
+				sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom1", sdk.OneDec())),
+				types.ParseTime("2021-07-01T00:00:00Z"),
+				types.ParseTime(endTime),
+			),
+			sdk.NewCoins(sdk.NewInt64Coin("denom2", 1_000_000)),
+		)
+		if i == 2 {
+			_ = plan.SetTerminated(true)
+		}
+		k.SetPlan(ctx, plan)
+	}
+
+	// Reproduce the v1 state, where plans are not indexed.
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	for _, prefix := range [][]byte{types.PlanEndTimeIndexKeyPrefix, types.ActivePlanIndexKeyPrefix} {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		var keys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	require.Empty(t, k.GetActivePlans(ctx))
+
+	err := keeper.NewMigrator(k, app.GetKey(paramstypes.StoreKey)).Migrate1to2(ctx)
+	require.NoError(t, err)
+
+	planIds := func(plans []types.PlanI) (ids []uint64) {
+		for _, plan := range plans {
+			ids = append(ids, plan.GetId())
+		}
+		return
+	}
+	require.Equal(t, []uint64{1, 2}, planIds(k.GetActivePlans(ctx)))
+	require.Equal(t, []uint64{2, 1}, planIds(k.GetPlansEndedBefore(ctx, types.ParseTime("9999-12-31T00:00:00Z"))))
+}
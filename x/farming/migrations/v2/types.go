@@ -0,0 +1,48 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// keys and parameter store keys of the v1 store layout
+var (
+	CurrentEpochDaysKey = []byte("currentEpochDays")
+
+	KeyPrivatePlanCreationFee = []byte("PrivatePlanCreationFee")
+	KeyNextEpochDays          = []byte("NextEpochDays")
+	KeyFarmingFeeCollector    = []byte("FarmingFeeCollector")
+	KeyDelayedStakingGasFee   = []byte("DelayedStakingGasFee")
+)
+
+var _ paramstypes.ParamSet = (*Params)(nil)
+
+// Params defines the v1 parameters of the farming module.
+// It is frozen and must not be modified.
+type Params struct {
+	PrivatePlanCreationFee sdk.Coins
+	NextEpochDays          uint32
+	FarmingFeeCollector    string
+	DelayedStakingGasFee   sdk.Gas
+}
+
+// ParamKeyTable returns the v1 parameter key table.
+func ParamKeyTable() paramstypes.KeyTable {
+	return paramstypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements paramstypes.ParamSet.
+func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
+	return paramstypes.ParamSetPairs{
+		paramstypes.NewParamSetPair(KeyPrivatePlanCreationFee, &p.PrivatePlanCreationFee, validateAny),
+		paramstypes.NewParamSetPair(KeyNextEpochDays, &p.NextEpochDays, validateAny),
+		paramstypes.NewParamSetPair(KeyFarmingFeeCollector, &p.FarmingFeeCollector, validateAny),
+		paramstypes.NewParamSetPair(KeyDelayedStakingGasFee, &p.DelayedStakingGasFee, validateAny),
+	}
+}
+
+// validateAny accepts any value, since the v1 parameters are only read
+// during the migration and validated again as v2 parameters.
+func validateAny(interface{}) error {
+	return nil
+}
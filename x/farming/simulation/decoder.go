@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/tendermint/farming/x/farming/types"
@@ -33,6 +34,31 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &sB)
 			return fmt.Sprintf("%v\n%v", sA, sB)
 
+		case bytes.Equal(kvA.Key[:1], types.LockedStakingKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.QueuedLockedStakingKeyPrefix):
+			var sA, sB types.LockedStaking
+			cdc.MustUnmarshal(kvA.Value, &sA)
+			cdc.MustUnmarshal(kvB.Value, &sB)
+			return fmt.Sprintf("%v\n%v", sA, sB)
+
+		case bytes.Equal(kvA.Key[:1], types.TotalLockedStakingsKeyPrefix):
+			var sA, sB types.TotalLockedStakings
+			cdc.MustUnmarshal(kvA.Value, &sA)
+			cdc.MustUnmarshal(kvB.Value, &sB)
+			return fmt.Sprintf("%v\n%v", sA, sB)
+
+		case bytes.Equal(kvA.Key[:1], types.UnbondingKeyPrefix):
+			var uA, uB types.Unbonding
+			cdc.MustUnmarshal(kvA.Value, &uA)
+			cdc.MustUnmarshal(kvB.Value, &uB)
+			return fmt.Sprintf("%v\n%v", uA, uB)
+
+		case bytes.Equal(kvA.Key[:1], types.DepositRequestKeyPrefix):
+			var rA, rB types.DepositRequest
+			cdc.MustUnmarshal(kvA.Value, &rA)
+			cdc.MustUnmarshal(kvB.Value, &rB)
+			return fmt.Sprintf("%v\n%v", rA, rB)
+
 		case bytes.Equal(kvA.Key[:1], types.HistoricalRewardsKeyPrefix):
 			var rA, rB types.HistoricalRewards
 			cdc.MustUnmarshal(kvA.Value, &rA)
@@ -45,6 +71,9 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &rB)
 			return fmt.Sprintf("%v\n%v", rA, rB)
 
+		case bytes.Equal(kvA.Key[:1], types.RewardsWithdrawAddressKeyPrefix):
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
 		default:
 			panic(fmt.Sprintf("invalid farming key prefix %X", kvA.Key[:1]))
 		}
@@ -5,8 +5,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/tendermint/farming/x/farming/simulation"
@@ -20,16 +22,27 @@ func TestDecodeFarmingStore(t *testing.T) {
 	basePlan := types.BasePlan{}
 	staking := types.Staking{}
 	queuedStaking := types.QueuedStaking{}
+	lockedStaking := types.LockedStaking{}
+	totalLockedStakings := types.TotalLockedStakings{}
+	unbonding := types.Unbonding{}
+	depositRequest := types.DepositRequest{}
 	historicalRewards := types.HistoricalRewards{}
 	outstandingRewards := types.OutstandingRewards{}
+	withdrawAddr := sdk.AccAddress(crypto.AddressHash([]byte("withdrawAddr")))
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.PlanKeyPrefix, Value: cdc.MustMarshal(&basePlan)},
 			{Key: types.StakingKeyPrefix, Value: cdc.MustMarshal(&staking)},
 			{Key: types.QueuedStakingKeyPrefix, Value: cdc.MustMarshal(&queuedStaking)},
+			{Key: types.LockedStakingKeyPrefix, Value: cdc.MustMarshal(&lockedStaking)},
+			{Key: types.TotalLockedStakingsKeyPrefix, Value: cdc.MustMarshal(&totalLockedStakings)},
+			{Key: types.QueuedLockedStakingKeyPrefix, Value: cdc.MustMarshal(&lockedStaking)},
+			{Key: types.UnbondingKeyPrefix, Value: cdc.MustMarshal(&unbonding)},
+			{Key: types.DepositRequestKeyPrefix, Value: cdc.MustMarshal(&depositRequest)},
 			{Key: types.HistoricalRewardsKeyPrefix, Value: cdc.MustMarshal(&historicalRewards)},
 			{Key: types.OutstandingRewardsKeyPrefix, Value: cdc.MustMarshal(&outstandingRewards)},
+			{Key: types.RewardsWithdrawAddressKeyPrefix, Value: withdrawAddr},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -41,8 +54,14 @@ func TestDecodeFarmingStore(t *testing.T) {
 		{"Plan", fmt.Sprintf("%v\n%v", basePlan, basePlan)},
 		{"Staking", fmt.Sprintf("%v\n%v", staking, staking)},
 		{"QueuedStaking", fmt.Sprintf("%v\n%v", queuedStaking, queuedStaking)},
+		{"LockedStaking", fmt.Sprintf("%v\n%v", lockedStaking, lockedStaking)},
+		{"TotalLockedStakings", fmt.Sprintf("%v\n%v", totalLockedStakings, totalLockedStakings)},
+		{"QueuedLockedStaking", fmt.Sprintf("%v\n%v", lockedStaking, lockedStaking)},
+		{"Unbonding", fmt.Sprintf("%v\n%v", unbonding, unbonding)},
+		{"DepositRequest", fmt.Sprintf("%v\n%v", depositRequest, depositRequest)},
 		{"HistoricalRewardsKeyPrefix", fmt.Sprintf("%v\n%v", historicalRewards, historicalRewards)},
 		{"OutstandingRewardsKeyPrefix", fmt.Sprintf("%v\n%v", outstandingRewards, outstandingRewards)},
+		{"RewardsWithdrawAddress", fmt.Sprintf("%v\n%v", withdrawAddr, withdrawAddr)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -4,6 +4,7 @@ package simulation
 
 import (
 	"math/rand"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -15,9 +16,11 @@ import (
 // Simulation parameter constants.
 const (
 	PrivatePlanCreationFee = "private_plan_creation_fee"
-	NextEpochDays          = "next_epoch_days"
+	NextEpochDuration      = "next_epoch_duration"
 	FarmingFeeCollector    = "farming_fee_collector"
-	CurrentEpochDays       = "current_epoch_days"
+	CurrentEpochDuration   = "current_epoch_duration"
+	PartialAllocation      = "partial_allocation"
+	MaxCatchUpEpochs       = "max_catch_up_epochs"
 )
 
 // GenPrivatePlanCreationFee return randomized private plan creation fee.
@@ -25,14 +28,14 @@ func GenPrivatePlanCreationFee(r *rand.Rand) sdk.Coins {
 	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 0, 100_000_000))))
 }
 
-// GenNextEpochDays return default next epoch days.
-func GenNextEpochDays(r *rand.Rand) uint32 {
-	return uint32(simulation.RandIntBetween(r, int(types.DefaultNextEpochDays), 10))
+// GenNextEpochDuration returns randomized next epoch duration.
+func GenNextEpochDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, 240)) * time.Hour
 }
 
-// GenCurrentEpochDays returns current epoch days.
-func GenCurrentEpochDays(r *rand.Rand) uint32 {
-	return uint32(simulation.RandIntBetween(r, int(types.DefaultCurrentEpochDays), 10))
+// GenCurrentEpochDuration returns randomized current epoch duration.
+func GenCurrentEpochDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, 240)) * time.Hour
 }
 
 // GenFarmingFeeCollector returns default farming fee collector.
@@ -40,6 +43,16 @@ func GenFarmingFeeCollector(r *rand.Rand) string {
 	return types.DefaultFarmingFeeCollector
 }
 
+// GenPartialAllocation returns randomized partial allocation.
+func GenPartialAllocation(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenMaxCatchUpEpochs returns randomized max catch up epochs.
+func GenMaxCatchUpEpochs(r *rand.Rand) uint32 {
+	return uint32(simulation.RandIntBetween(r, 0, 10))
+}
+
 // RandomizedGenState generates a random GenesisState for farming.
 func RandomizedGenState(simState *module.SimulationState) {
 	var privatePlanCreationFee sdk.Coins
@@ -48,10 +61,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { privatePlanCreationFee = GenPrivatePlanCreationFee(r) },
 	)
 
-	var nextEpochDays uint32
+	var nextEpochDuration time.Duration
 	simState.AppParams.GetOrGenerate(
-		simState.Cdc, NextEpochDays, &nextEpochDays, simState.Rand,
-		func(r *rand.Rand) { nextEpochDays = GenNextEpochDays(r) },
+		simState.Cdc, NextEpochDuration, &nextEpochDuration, simState.Rand,
+		func(r *rand.Rand) { nextEpochDuration = GenNextEpochDuration(r) },
 	)
 
 	var feeCollector string
@@ -60,19 +73,33 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { feeCollector = GenFarmingFeeCollector(r) },
 	)
 
-	var currentEpochDays uint32
+	var currentEpochDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CurrentEpochDuration, &currentEpochDuration, simState.Rand,
+		func(r *rand.Rand) { currentEpochDuration = GenCurrentEpochDuration(r) },
+	)
+
+	var partialAllocation bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, PartialAllocation, &partialAllocation, simState.Rand,
+		func(r *rand.Rand) { partialAllocation = GenPartialAllocation(r) },
+	)
+
+	var maxCatchUpEpochs uint32
 	simState.AppParams.GetOrGenerate(
-		simState.Cdc, CurrentEpochDays, &currentEpochDays, simState.Rand,
-		func(r *rand.Rand) { currentEpochDays = GenCurrentEpochDays(r) },
+		simState.Cdc, MaxCatchUpEpochs, &maxCatchUpEpochs, simState.Rand,
+		func(r *rand.Rand) { maxCatchUpEpochs = GenMaxCatchUpEpochs(r) },
 	)
 
 	farmingGenesis := types.GenesisState{
 		Params: types.Params{
 			PrivatePlanCreationFee: privatePlanCreationFee,
-			NextEpochDays:          nextEpochDays,
 			FarmingFeeCollector:    feeCollector,
+			PartialAllocation:      partialAllocation,
+			NextEpochDuration:      nextEpochDuration,
+			MaxCatchUpEpochs:       maxCatchUpEpochs,
 		},
-		CurrentEpochDays: currentEpochDays,
+		CurrentEpochDuration: currentEpochDuration,
 	}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&farmingGenesis)
 }
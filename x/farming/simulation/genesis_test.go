@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -41,12 +42,14 @@ func TestRandomizedGenState(t *testing.T) {
 	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &genState)
 
 	dec1 := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(36122540)))
-	dec3 := uint32(5)
+	dec3 := 235 * time.Hour
 	dec4 := "cosmos1h292smhhttwy0rl3qr4p6xsvpvxc4v05s6rxtczwq3cs6qc462mqejwy8x"
 
 	require.Equal(t, dec1, genState.Params.PrivatePlanCreationFee)
-	require.Equal(t, dec3, genState.Params.NextEpochDays)
+	require.Equal(t, dec3, genState.Params.NextEpochDuration)
 	require.Equal(t, dec4, genState.Params.FarmingFeeCollector)
+	require.True(t, genState.Params.PartialAllocation)
+	require.Equal(t, uint32(1), genState.Params.MaxCatchUpEpochs)
 }
 
 // TestRandomizedGenState tests abnormal scenarios of applying RandomizedGenState.
@@ -20,9 +20,13 @@ import (
 const (
 	OpWeightMsgCreateFixedAmountPlan = "op_weight_msg_create_fixed_amount_plan"
 	OpWeightMsgCreateRatioPlan       = "op_weight_msg_create_ratio_plan"
+	OpWeightMsgCreateDecayingPlan    = "op_weight_msg_create_decaying_plan"
 	OpWeightMsgStake                 = "op_weight_msg_stake"
 	OpWeightMsgUnstake               = "op_weight_msg_unstake"
 	OpWeightMsgHarvest               = "op_weight_msg_harvest"
+	OpWeightMsgModifyPrivatePlan     = "op_weight_msg_modify_private_plan"
+	OpWeightMsgTerminatePrivatePlan  = "op_weight_msg_terminate_private_plan"
+	OpWeightMsgFundPrivatePlan       = "op_weight_msg_fund_private_plan"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights.
@@ -45,6 +49,13 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgCreateDecayingPlan int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateDecayingPlan, &weightMsgCreateDecayingPlan, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateDecayingPlan = params.DefaultWeightMsgCreateDecayingPlan
+		},
+	)
+
 	var weightMsgStake int
 	appParams.GetOrGenerate(cdc, OpWeightMsgStake, &weightMsgStake, nil,
 		func(_ *rand.Rand) {
@@ -66,6 +77,27 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgModifyPrivatePlan int
+	appParams.GetOrGenerate(cdc, OpWeightMsgModifyPrivatePlan, &weightMsgModifyPrivatePlan, nil,
+		func(_ *rand.Rand) {
+			weightMsgModifyPrivatePlan = params.DefaultWeightMsgModifyPrivatePlan
+		},
+	)
+
+	var weightMsgTerminatePrivatePlan int
+	appParams.GetOrGenerate(cdc, OpWeightMsgTerminatePrivatePlan, &weightMsgTerminatePrivatePlan, nil,
+		func(_ *rand.Rand) {
+			weightMsgTerminatePrivatePlan = params.DefaultWeightMsgTerminatePrivatePlan
+		},
+	)
+
+	var weightMsgFundPrivatePlan int
+	appParams.GetOrGenerate(cdc, OpWeightMsgFundPrivatePlan, &weightMsgFundPrivatePlan, nil,
+		func(_ *rand.Rand) {
+			weightMsgFundPrivatePlan = params.DefaultWeightMsgFundPrivatePlan
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateFixedAmountPlan,
@@ -75,6 +107,10 @@ func WeightedOperations(
 			weightMsgCreateRatioPlan,
 			SimulateMsgCreateRatioPlan(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateDecayingPlan,
+			SimulateMsgCreateDecayingPlan(ak, bk, k),
+		),
 		simulation.NewWeightedOperation(
 			weightMsgStake,
 			SimulateMsgStake(ak, bk, k),
@@ -87,6 +123,18 @@ func WeightedOperations(
 			weightMsgHarvest,
 			SimulateMsgHarvest(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgModifyPrivatePlan,
+			SimulateMsgModifyPrivatePlan(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgTerminatePrivatePlan,
+			SimulateMsgTerminatePrivatePlan(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgFundPrivatePlan,
+			SimulateMsgFundPrivatePlan(ak, bk, k),
+		),
 	}
 }
 
@@ -207,6 +255,71 @@ func SimulateMsgCreateRatioPlan(ak types.AccountKeeper, bk types.BankKeeper, k k
 	}
 }
 
+// SimulateMsgCreateDecayingPlan generates a MsgCreateDecayingPlan with random values
+// nolint: interfacer
+func SimulateMsgCreateDecayingPlan(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		params := k.GetParams(ctx)
+		_, hasNeg := spendable.SafeSub(params.PrivatePlanCreationFee)
+		if hasNeg {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateDecayingPlan, "insufficient balance for plan creation fee"), nil, nil
+		}
+
+		// mint pool coins to simulate the real-world cases
+		poolCoins, err := mintPoolCoins(ctx, r, bk, simAccount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateDecayingPlan, "unable to mint pool coins"), nil, nil
+		}
+
+		name := "simulation-test-" + simtypes.RandStringOfLength(r, 5) // name must be unique
+		creatorAcc := account.GetAddress()
+		stakingCoinWeights := sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 1))
+		startTime := ctx.BlockTime()
+		endTime := startTime.AddDate(0, 1, 0)
+		epochAmount := sdk.NewCoins(
+			sdk.NewInt64Coin(poolCoins[r.Intn(3)].Denom, int64(simtypes.RandIntBetween(r, 10_000_000, 1_000_000_000))),
+		)
+		decayFactor := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 10)), 1)
+		decayEpochs := uint32(simtypes.RandIntBetween(r, 1, 10))
+
+		msg := types.NewMsgCreateDecayingPlan(
+			name,
+			creatorAcc,
+			stakingCoinWeights,
+			startTime,
+			endTime,
+			epochAmount,
+			decayFactor,
+			decayEpochs,
+			nil,
+		)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
 // SimulateMsgStake generates a MsgStake with random values
 // nolint: interfacer
 func SimulateMsgStake(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
@@ -367,6 +480,155 @@ func SimulateMsgHarvest(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Ke
 	}
 }
 
+// SimulateMsgModifyPrivatePlan generates a MsgModifyPrivatePlan with random values
+// nolint: interfacer
+func SimulateMsgModifyPrivatePlan(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		plan, simAccount, found := randomPrivatePlan(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgModifyPrivatePlan, "no private plan to modify"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		// extend the end time of the plan
+		endTime := plan.GetEndTime().AddDate(0, 0, simtypes.RandIntBetween(r, 1, 30))
+
+		msg := types.NewMsgModifyPrivatePlan(
+			plan.GetId(),
+			simAccount.Address,
+			"",
+			nil,
+			nil,
+			&endTime,
+			nil,
+			sdk.Dec{},
+		)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgTerminatePrivatePlan generates a MsgTerminatePrivatePlan with random values
+// nolint: interfacer
+func SimulateMsgTerminatePrivatePlan(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		plan, simAccount, found := randomPrivatePlan(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTerminatePrivatePlan, "no private plan to terminate"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		msg := types.NewMsgTerminatePrivatePlan(plan.GetId(), simAccount.Address)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgFundPrivatePlan generates a MsgFundPrivatePlan with random values
+// nolint: interfacer
+func SimulateMsgFundPrivatePlan(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		plan, simAccount, found := randomPrivatePlan(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgFundPrivatePlan, "no private plan to fund"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		// mint pool coins to simulate the real-world cases
+		poolCoins, err := mintPoolCoins(ctx, r, bk, simAccount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgFundPrivatePlan, "unable to mint pool coins"), nil, nil
+		}
+		amount := sdk.NewCoins(
+			sdk.NewInt64Coin(poolCoins[r.Intn(3)].Denom, int64(simtypes.RandIntBetween(r, 1_000_000_000, 100_000_000_000))),
+		)
+
+		msg := types.NewMsgFundPrivatePlan(plan.GetId(), simAccount.Address, amount)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randomPrivatePlan returns a random private plan that is not terminated yet
+// and whose termination address is one of the simulated accounts.
+func randomPrivatePlan(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (types.PlanI, simtypes.Account, bool) {
+	var plans []types.PlanI
+	var planAccs []simtypes.Account
+	for _, plan := range k.GetPlans(ctx) {
+		if plan.GetType() != types.PlanTypePrivate || plan.GetTerminated() {
+			continue
+		}
+		if acc, found := simtypes.FindAccount(accs, plan.GetTerminationAddress()); found {
+			plans = append(plans, plan)
+			planAccs = append(planAccs, acc)
+		}
+	}
+
+	if len(plans) == 0 {
+		return nil, simtypes.Account{}, false
+	}
+
+	i := r.Intn(len(plans))
+	return plans[i], planAccs[i], true
+}
+
 // mintPoolCoins mints random amount of coins with the provided pool coin denoms and
 // send them to the simulated account.
 func mintPoolCoins(ctx sdk.Context, r *rand.Rand, bk types.BankKeeper, acc simtypes.Account) (mintCoins sdk.Coins, err error) {
@@ -3,6 +3,7 @@ package simulation_test
 import (
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -41,9 +42,13 @@ func TestWeightedOperations(t *testing.T) {
 	}{
 		{params.DefaultWeightMsgCreateFixedAmountPlan, types.ModuleName, types.TypeMsgCreateFixedAmountPlan},
 		{params.DefaultWeightMsgCreateRatioPlan, types.ModuleName, types.TypeMsgCreateRatioPlan},
+		{params.DefaultWeightMsgCreateDecayingPlan, types.ModuleName, types.TypeMsgCreateDecayingPlan},
 		{params.DefaultWeightMsgStake, types.ModuleName, types.TypeMsgStake},
 		{params.DefaultWeightMsgUnstake, types.ModuleName, types.TypeMsgUnstake},
 		{params.DefaultWeightMsgHarvest, types.ModuleName, types.TypeMsgHarvest},
+		{params.DefaultWeightMsgModifyPrivatePlan, types.ModuleName, types.TypeMsgModifyPrivatePlan},
+		{params.DefaultWeightMsgTerminatePrivatePlan, types.ModuleName, types.TypeMsgTerminatePrivatePlan},
+		{params.DefaultWeightMsgFundPrivatePlan, types.ModuleName, types.TypeMsgFundPrivatePlan},
 	}
 
 	for i, w := range weightedOps {
@@ -133,6 +138,46 @@ func TestSimulateMsgCreateRatioPlan(t *testing.T) {
 	require.Len(t, futureOperations, 0)
 }
 
+// TestSimulateMsgCreateDecayingPlan tests the normal scenario of a valid message of type TypeMsgCreateDecayingPlan.
+// Abnormal scenarios, where the message are created by an errors are not tested here.
+func TestSimulateMsgCreateDecayingPlan(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// setup a single account
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	accounts := getTestingAccounts(t, r, app, ctx, 1)
+
+	// setup randomly generated private plan creation fees
+	feeCoins := simulation.GenPrivatePlanCreationFee(r)
+	params := app.FarmingKeeper.GetParams(ctx)
+	params.PrivatePlanCreationFee = feeCoins
+	app.FarmingKeeper.SetParams(ctx, params)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgCreateDecayingPlan(app.AccountKeeper, app.BankKeeper, app.FarmingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgCreateDecayingPlan
+	err = app.AppCodec().UnmarshalJSON(operationMsg.Msg, &msg)
+	require.NoError(t, err)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgCreateDecayingPlan, msg.Type())
+	require.Equal(t, "simulation-test-GkqEG", msg.Name)
+	require.Equal(t, "cosmos1tnh2q55v8wyygtt9srz5safamzdengsnqeycj3", msg.Creator)
+	require.Equal(t, "1.000000000000000000stake", msg.StakingCoinWeights.String())
+	require.Equal(t, "126410694pool3036F43CB8131A1A63D2B3D3B11E9CF6FA2A2B6FEC17D5AD283C25C939614A8C", msg.EpochAmount.String())
+	require.Equal(t, "0.800000000000000000", msg.DecayFactor.String())
+	require.Equal(t, uint32(4), msg.DecayEpochs)
+	require.Len(t, futureOperations, 0)
+}
+
 // TestSimulateMsgStake tests the normal scenario of a valid message of type TypeMsgStake.
 // Abnormal scenarios, where the message are created by an errors are not tested here.
 func TestSimulateMsgStake(t *testing.T) {
@@ -219,9 +264,9 @@ func TestSimulateMsgHarvest(t *testing.T) {
 
 	accounts := getTestingAccounts(t, r, app, ctx, 2)
 
-	// setup epoch days to 1 to ease the test
+	// setup epoch duration to 1 day to ease the test
 	params := app.FarmingKeeper.GetParams(ctx)
-	params.NextEpochDays = 1
+	params.NextEpochDuration = 24 * time.Hour
 	app.FarmingKeeper.SetParams(ctx, params)
 
 	// setup a fixed amount plan
@@ -283,6 +328,165 @@ func TestSimulateMsgHarvest(t *testing.T) {
 	require.Equal(t, sdk.NewInt64Coin("pool93E069B333B5ECEBFE24C6E1437E814003248E0DD7FF8B9F82119F4587449BA5", 100300000000), balances)
 }
 
+// TestSimulateMsgModifyPrivatePlan tests the normal scenario of a valid message of type TypeMsgModifyPrivatePlan.
+// Abnormal scenarios, where the message are created by an errors are not tested here.
+func TestSimulateMsgModifyPrivatePlan(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// setup a single account
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	accounts := getTestingAccounts(t, r, app, ctx, 1)
+
+	// setup a private fixed amount plan
+	_, err := app.FarmingKeeper.CreateFixedAmountPlan(
+		ctx,
+		&types.MsgCreateFixedAmountPlan{
+			Name:    "simulation-test",
+			Creator: accounts[0].Address.String(),
+			StakingCoinWeights: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(10, 1)), // 100%
+			),
+			StartTime: types.ParseTime("0001-01-01T00:00:00Z"),
+			EndTime:   types.ParseTime("9999-01-01T00:00:00Z"),
+			EpochAmount: sdk.NewCoins(
+				sdk.NewInt64Coin("pool93E069B333B5ECEBFE24C6E1437E814003248E0DD7FF8B9F82119F4587449BA5", 300_000_000),
+			),
+		},
+		accounts[0].Address,
+		accounts[0].Address,
+		types.PlanTypePrivate,
+	)
+	require.NoError(t, err)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgModifyPrivatePlan(app.AccountKeeper, app.BankKeeper, app.FarmingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgModifyPrivatePlan
+	err = app.AppCodec().UnmarshalJSON(operationMsg.Msg, &msg)
+	require.NoError(t, err)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgModifyPrivatePlan, msg.Type())
+	require.Equal(t, uint64(1), msg.PlanId)
+	require.Equal(t, "cosmos1tnh2q55v8wyygtt9srz5safamzdengsnqeycj3", msg.TerminationAddress)
+	require.Equal(t, "9999-01-12T00:00:00Z", msg.EndTime.Format(time.RFC3339))
+	require.Len(t, futureOperations, 0)
+}
+
+// TestSimulateMsgTerminatePrivatePlan tests the normal scenario of a valid message of type TypeMsgTerminatePrivatePlan.
+// Abnormal scenarios, where the message are created by an errors are not tested here.
+func TestSimulateMsgTerminatePrivatePlan(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// setup a single account
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	accounts := getTestingAccounts(t, r, app, ctx, 1)
+
+	// setup a private fixed amount plan
+	_, err := app.FarmingKeeper.CreateFixedAmountPlan(
+		ctx,
+		&types.MsgCreateFixedAmountPlan{
+			Name:    "simulation-test",
+			Creator: accounts[0].Address.String(),
+			StakingCoinWeights: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(10, 1)), // 100%
+			),
+			StartTime: types.ParseTime("0001-01-01T00:00:00Z"),
+			EndTime:   types.ParseTime("9999-01-01T00:00:00Z"),
+			EpochAmount: sdk.NewCoins(
+				sdk.NewInt64Coin("pool93E069B333B5ECEBFE24C6E1437E814003248E0DD7FF8B9F82119F4587449BA5", 300_000_000),
+			),
+		},
+		accounts[0].Address,
+		accounts[0].Address,
+		types.PlanTypePrivate,
+	)
+	require.NoError(t, err)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgTerminatePrivatePlan(app.AccountKeeper, app.BankKeeper, app.FarmingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgTerminatePrivatePlan
+	err = app.AppCodec().UnmarshalJSON(operationMsg.Msg, &msg)
+	require.NoError(t, err)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgTerminatePrivatePlan, msg.Type())
+	require.Equal(t, uint64(1), msg.PlanId)
+	require.Equal(t, "cosmos1tnh2q55v8wyygtt9srz5safamzdengsnqeycj3", msg.TerminationAddress)
+
+	plan, found := app.FarmingKeeper.GetPlan(ctx, msg.PlanId)
+	require.True(t, found)
+	require.True(t, plan.GetTerminated())
+	require.Len(t, futureOperations, 0)
+}
+
+// TestSimulateMsgFundPrivatePlan tests the normal scenario of a valid message of type TypeMsgFundPrivatePlan.
+// Abnormal scenarios, where the message are created by an errors are not tested here.
+func TestSimulateMsgFundPrivatePlan(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// setup a single account
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	accounts := getTestingAccounts(t, r, app, ctx, 1)
+
+	// setup a private fixed amount plan
+	_, err := app.FarmingKeeper.CreateFixedAmountPlan(
+		ctx,
+		&types.MsgCreateFixedAmountPlan{
+			Name:    "simulation-test",
+			Creator: accounts[0].Address.String(),
+			StakingCoinWeights: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(10, 1)), // 100%
+			),
+			StartTime: types.ParseTime("0001-01-01T00:00:00Z"),
+			EndTime:   types.ParseTime("9999-01-01T00:00:00Z"),
+			EpochAmount: sdk.NewCoins(
+				sdk.NewInt64Coin("pool93E069B333B5ECEBFE24C6E1437E814003248E0DD7FF8B9F82119F4587449BA5", 300_000_000),
+			),
+		},
+		accounts[0].Address,
+		accounts[0].Address,
+		types.PlanTypePrivate,
+	)
+	require.NoError(t, err)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgFundPrivatePlan(app.AccountKeeper, app.BankKeeper, app.FarmingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgFundPrivatePlan
+	err = app.AppCodec().UnmarshalJSON(operationMsg.Msg, &msg)
+	require.NoError(t, err)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgFundPrivatePlan, msg.Type())
+	require.Equal(t, uint64(1), msg.PlanId)
+	require.Equal(t, "cosmos1tnh2q55v8wyygtt9srz5safamzdengsnqeycj3", msg.TerminationAddress)
+	require.Equal(t, "51183117216poolE4D2617BFE03E1146F6BBA1D9893F2B3D77BA29E7ED532BB721A39FF1ECC1B07", msg.Amount.String())
+	require.Len(t, futureOperations, 0)
+}
+
 func createTestApp(isCheckTx bool) (*farmingapp.FarmingApp, sdk.Context) {
 	app := farmingapp.Setup(isCheckTx)
 
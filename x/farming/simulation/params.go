@@ -25,14 +25,24 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return string(bz)
 			},
 		),
-		simulation.NewSimParamChange(types.ModuleName, string(types.KeyNextEpochDays),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyFarmingFeeCollector),
 			func(r *rand.Rand) string {
-				return fmt.Sprintf("%d", GenNextEpochDays(r))
+				return fmt.Sprintf("\"%s\"", GenFarmingFeeCollector(r))
 			},
 		),
-		simulation.NewSimParamChange(types.ModuleName, string(types.KeyFarmingFeeCollector),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyPartialAllocation),
 			func(r *rand.Rand) string {
-				return fmt.Sprintf("\"%s\"", GenFarmingFeeCollector(r))
+				return fmt.Sprintf("%t", GenPartialAllocation(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyNextEpochDuration),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenNextEpochDuration(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxCatchUpEpochs),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxCatchUpEpochs(r))
 			},
 		),
 	}
@@ -20,12 +20,14 @@ func TestParamChanges(t *testing.T) {
 		subspace    string
 	}{
 		{"farming/PrivatePlanCreationFee", "PrivatePlanCreationFee", "[{\"denom\":\"stake\",\"amount\":\"98498081\"}]", "farming"},
-		{"farming/NextEpochDays", "NextEpochDays", "7", "farming"},
 		{"farming/FarmingFeeCollector", "FarmingFeeCollector", "\"cosmos1h292smhhttwy0rl3qr4p6xsvpvxc4v05s6rxtczwq3cs6qc462mqejwy8x\"", "farming"},
+		{"farming/PartialAllocation", "PartialAllocation", "false", "farming"},
+		{"farming/NextEpochDuration", "NextEpochDuration", "\"828000000000000\"", "farming"},
+		{"farming/MaxCatchUpEpochs", "MaxCatchUpEpochs", "9", "farming"},
 	}
 
 	paramChanges := simulation.ParamChanges(r)
-	require.Len(t, paramChanges, 3)
+	require.Len(t, paramChanges, 5)
 
 	for i, p := range paramChanges {
 		require.Equal(t, expected[i].composedKey, p.ComposedKey())
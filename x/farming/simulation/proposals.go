@@ -119,6 +119,17 @@ func SimulateModifyPublicPlanProposal(ak types.AccountKeeper, bk types.BankKeepe
 					req.StartTime = &startTime
 					req.EndTime = &endTime
 					req.EpochRatio = sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 5)), 1)
+				case *types.DecayingPlan:
+					req.PlanId = plan.GetId()
+					req.Name = "simulation-test-" + simtypes.RandStringOfLength(r, 5)
+					req.FarmingPoolAddress = plan.GetFarmingPoolAddress().String()
+					req.TerminationAddress = plan.GetTerminationAddress().String()
+					req.StakingCoinWeights = plan.GetStakingCoinWeights()
+					req.StartTime = &startTime
+					req.EndTime = &endTime
+					req.EpochAmount = plan.EpochAmount
+					req.DecayFactor = sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 10)), 1)
+					req.DecayEpochs = uint32(simtypes.RandIntBetween(r, 1, 10))
 				}
 				break
 			}
@@ -194,12 +205,16 @@ func ranAddPlanRequests(r *rand.Rand, ctx sdk.Context, simAccount simtypes.Accou
 		req.StartTime = ctx.BlockTime()
 		req.EndTime = ctx.BlockTime().AddDate(0, simtypes.RandIntBetween(r, 1, 28), 0)
 
-		// Generate a fixed amount plan if pseudo-random integer is an even number and
+		// Generate a fixed amount plan or a decaying plan if pseudo-random integer is an even number and
 		// generate a ratio plan if it is an odd number
 		if r.Int()%2 == 0 {
 			req.EpochAmount = sdk.NewCoins(
 				sdk.NewInt64Coin(poolCoins[r.Intn(3)].Denom, int64(simtypes.RandIntBetween(r, 10_000_000, 100_000_000))),
 			)
+			if r.Int()%2 == 0 {
+				req.DecayFactor = sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 10)), 1)
+				req.DecayEpochs = uint32(simtypes.RandIntBetween(r, 1, 10))
+			}
 		} else {
 			req.EpochRatio = sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 10)), 2) // 1% ~ 10%
 		}
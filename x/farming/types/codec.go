@@ -23,14 +23,23 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgCreateFixedAmountPlan{},
 		&MsgCreateRatioPlan{},
+		&MsgCreateDecayingPlan{},
 		&MsgStake{},
 		&MsgUnstake{},
 		&MsgHarvest{},
+		&MsgModifyPrivatePlan{},
+		&MsgTerminatePrivatePlan{},
+		&MsgFundPrivatePlan{},
+		&MsgCancelUnbonding{},
+		&MsgSetRewardsWithdrawAddress{},
+		&MsgDepositAndStake{},
+		&MsgUnstakeAndWithdraw{},
 	)
 
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&PublicPlanProposal{},
+		&StakingDenomsProposal{},
 	)
 
 	registry.RegisterInterface(
@@ -38,6 +47,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*PlanI)(nil),
 		&FixedAmountPlan{},
 		&RatioPlan{},
+		&DecayingPlan{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
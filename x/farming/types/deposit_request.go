@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DepositReserveAcc returns the account that holds the deposit coins of a
+// deposit request, and then the pool coins minted by the deposit until they
+// are staked for the farmer.
+func DepositReserveAcc(depositRequestId uint64) sdk.AccAddress {
+	return DeriveAddress(ReserveAddressType, ModuleName, DepositReserveAccPrefix+AccNameSplitter+strconv.FormatUint(depositRequestId, 10))
+}
+
+// GetFarmer returns the farmer address of the deposit request.
+func (req DepositRequest) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(req.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// Validate validates DepositRequest.
+func (req DepositRequest) Validate() error {
+	if req.Id == 0 {
+		return fmt.Errorf("deposit request id must not be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Farmer); err != nil {
+		return err
+	}
+	if req.PoolId == 0 {
+		return fmt.Errorf("pool id must not be 0")
+	}
+	if err := req.DepositCoins.Validate(); err != nil {
+		return err
+	}
+	if req.DepositCoins.IsZero() {
+		return fmt.Errorf("deposit coins must not be zero")
+	}
+	return nil
+}
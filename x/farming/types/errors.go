@@ -16,4 +16,11 @@ var (
 	ErrInvalidStakingReservedAmount    = sdkerrors.Register(ModuleName, 9, "staking reserved amount invariant broken")
 	ErrInvalidRemainingRewardsAmount   = sdkerrors.Register(ModuleName, 10, "remaining rewards amount invariant broken")
 	ErrInvalidOutstandingRewardsAmount = sdkerrors.Register(ModuleName, 11, "outstanding rewards amount invariant broken")
+	ErrPlanAlreadyTerminated           = sdkerrors.Register(ModuleName, 12, "plan is already terminated")
+	ErrInvalidLockDuration             = sdkerrors.Register(ModuleName, 13, "invalid lock duration")
+	ErrStakingLocked                   = sdkerrors.Register(ModuleName, 14, "staking is locked")
+	ErrInvalidLockedStakingsAmount     = sdkerrors.Register(ModuleName, 15, "locked stakings amount invariant broken")
+	ErrUnbondingNotExists              = sdkerrors.Register(ModuleName, 16, "unbonding not exists")
+	ErrStakingDenomNotAllowed          = sdkerrors.Register(ModuleName, 17, "staking denom not allowed")
+	ErrTooManyStakingDenoms            = sdkerrors.Register(ModuleName, 18, "too many staking coin denoms")
 )
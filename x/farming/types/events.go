@@ -2,14 +2,27 @@ package types
 
 // Event types for the farming module.
 const (
-	EventTypeCreateFixedAmountPlan = "create_fixed_amount_plan"
-	EventTypeCreateRatioPlan       = "create_ratio_plan"
-	EventTypeStake                 = "stake"
-	EventTypeUnstake               = "unstake"
-	EventTypeHarvest               = "harvest"
-	EventTypeRewardsWithdrawn      = "rewards_withdrawn"
-	EventTypePlanTerminated        = "plan_terminated"
-	EventTypeRewardsAllocated      = "rewards_allocated"
+	EventTypeCreateFixedAmountPlan     = "create_fixed_amount_plan"
+	EventTypeCreateRatioPlan           = "create_ratio_plan"
+	EventTypeCreateDecayingPlan        = "create_decaying_plan"
+	EventTypeStake                     = "stake"
+	EventTypeUnstake                   = "unstake"
+	EventTypeHarvest                   = "harvest"
+	EventTypeRewardsWithdrawn          = "rewards_withdrawn"
+	EventTypePlanTerminated            = "plan_terminated"
+	EventTypeRewardsAllocated          = "rewards_allocated"
+	EventTypeRewardsAllocationSkipped  = "rewards_allocation_skipped"
+	EventTypeModifyPrivatePlan         = "modify_private_plan"
+	EventTypeFundPrivatePlan           = "fund_private_plan"
+	EventTypeEpochCaughtUp             = "epoch_caught_up"
+	EventTypePlanUnderfunded           = "plan_underfunded"
+	EventTypeStakingUnlocked           = "staking_unlocked"
+	EventTypeUnbondingCompleted        = "unbonding_completed"
+	EventTypeCancelUnbonding           = "cancel_unbonding"
+	EventTypeSetRewardsWithdrawAddress = "set_rewards_withdraw_address"
+	EventTypeDepositAndStake           = "deposit_and_stake"
+	EventTypeDepositRequestProcessed   = "deposit_request_processed"
+	EventTypeUnstakeAndWithdraw        = "unstake_and_withdraw"
 
 	AttributeKeyPlanId             = "plan_id" //nolint:golint
 	AttributeKeyPlanName           = "plan_name"
@@ -22,8 +35,32 @@ const (
 	AttributeKeyEndTime            = "end_time"
 	AttributeKeyEpochAmount        = "epoch_amount"
 	AttributeKeyEpochRatio         = "epoch_ratio"
+	AttributeKeyDecayFactor        = "decay_factor"
+	AttributeKeyDecayEpochs        = "decay_epochs"
 	AttributeKeyFarmer             = "farmer"
 	AttributeKeyAmount             = "amount"
 	AttributeKeyStakingCoinDenom   = "staking_coin_denom"
 	AttributeKeyStakingCoinDenoms  = "staking_coin_denoms"
+	AttributeKeyPlanIds            = "plan_ids" //nolint:golint
+	AttributeKeyRequestedAmount    = "requested_amount"
+	AttributeKeyAvailableBalance   = "available_balance"
+	AttributeKeyEpochEndTime       = "epoch_end_time"
+	AttributeKeyEpochOutflow       = "epoch_outflow"
+	AttributeKeyProjectedInflow    = "projected_inflow"
+	AttributeKeyLockDuration       = "lock_duration"
+	AttributeKeyUnlockTime         = "unlock_time"
+	AttributeKeyLockedStakingIds   = "locked_staking_ids" //nolint:golint
+	AttributeKeyLockedStakingId    = "locked_staking_id"  //nolint:golint
+	AttributeKeyUnbondingIds       = "unbonding_ids"      //nolint:golint
+	AttributeKeyUnbondingId        = "unbonding_id"       //nolint:golint
+	AttributeKeyCompletionTime     = "completion_time"
+	AttributeKeyWithdrawAddress    = "withdraw_address"
+	AttributeKeyPayer              = "payer"
+	AttributeKeyDepositRequestId   = "deposit_request_id" //nolint:golint
+	AttributeKeyPoolId             = "pool_id"            //nolint:golint
+	AttributeKeyMsgIndex           = "msg_index"
+	AttributeKeyDepositCoins       = "deposit_coins"
+	AttributeKeyPoolCoin           = "pool_coin"
+	AttributeKeyStakedCoins        = "staked_coins"
+	AttributeKeyRefundedCoins      = "refunded_coins"
 )
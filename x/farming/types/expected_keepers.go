@@ -4,6 +4,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	liquiditytypes "github.com/gravity-devs/liquidity/x/liquidity/types"
+	budgettypes "github.com/tendermint/budget/x/budget/types"
 )
 
 // BankKeeper defines the expected bank send keeper
@@ -22,3 +25,29 @@ type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 	GetModuleAddress(name string) sdk.AccAddress
 }
+
+// BudgetKeeper defines the expected budget keeper
+type BudgetKeeper interface {
+	GetParams(ctx sdk.Context) budgettypes.Params
+	GetTotalCollectedCoins(ctx sdk.Context, budgetName string) sdk.Coins
+}
+
+// LiquidityKeeper defines the expected liquidity keeper
+type LiquidityKeeper interface {
+	GetPool(ctx sdk.Context, poolId uint64) (pool liquiditytypes.Pool, found bool)
+	GetCircuitBreakerEnabled(ctx sdk.Context) bool
+	GetPoolBatch(ctx sdk.Context, poolId uint64) (poolBatch liquiditytypes.PoolBatch, found bool)
+	GetPoolBatchDepositMsgState(ctx sdk.Context, poolId, msgIndex uint64) (state liquiditytypes.DepositMsgState, found bool)
+	DepositWithinBatch(ctx sdk.Context, msg *liquiditytypes.MsgDepositWithinBatch) (liquiditytypes.DepositMsgState, error)
+	WithdrawWithinBatch(ctx sdk.Context, msg *liquiditytypes.MsgWithdrawWithinBatch) (liquiditytypes.WithdrawMsgState, error)
+}
+
+// FarmingHooks event hooks for farming object (noalias)
+type FarmingHooks interface {
+	AfterStaked(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoins sdk.Coins)
+	BeforeUnstaked(ctx sdk.Context, farmerAcc sdk.AccAddress, unstakingCoins sdk.Coins)
+	AfterRewardsWithdrawn(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, rewards sdk.Coins)
+	AfterQueuedStakingProcessed(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, amount sdk.Int)
+	AfterEpochAdvanced(ctx sdk.Context)
+	AfterPlanTerminated(ctx sdk.Context, plan PlanI)
+}
@@ -11,6 +11,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -95,14 +96,35 @@ type Params struct {
 	// private_plan_creation_fee specifies the fee for plan creation
 	// this fee prevents from spamming and is collected in the community pool
 	PrivatePlanCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=private_plan_creation_fee,json=privatePlanCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"private_plan_creation_fee" yaml:"private_plan_creation_fee"`
-	// next_epoch_days is the epoch length in number of days
-	// it updates internal state called CurrentEpochDays that is used to process
-	// staking and reward distribution in end blocker
-	NextEpochDays uint32 `protobuf:"varint,2,opt,name=next_epoch_days,json=nextEpochDays,proto3" json:"next_epoch_days,omitempty" yaml:"next_epoch_days"`
 	// farming_fee_collector is the module account address to collect fees within the farming module
 	FarmingFeeCollector string `protobuf:"bytes,3,opt,name=farming_fee_collector,json=farmingFeeCollector,proto3" json:"farming_fee_collector,omitempty" yaml:"farming_fee_collector"`
 	// delayed_staking_gas_fee is used to impose gas fee for the delayed staking
 	DelayedStakingGasFee github_com_cosmos_cosmos_sdk_types.Gas `protobuf:"varint,4,opt,name=delayed_staking_gas_fee,json=delayedStakingGasFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Gas" json:"delayed_staking_gas_fee" yaml:"delayed_staking_gas_fee"`
+	// partial_allocation enables pro-rata allocation of rewards across the plans
+	// of a farming pool when the pool doesn't have enough balance for all of them.
+	// If disabled, none of the plans allocate rewards from the pool in that case.
+	PartialAllocation bool `protobuf:"varint,5,opt,name=partial_allocation,json=partialAllocation,proto3" json:"partial_allocation,omitempty" yaml:"partial_allocation"`
+	// next_epoch_duration is the epoch length
+	// it updates internal state called CurrentEpochDuration that is used to process
+	// staking and reward distribution in end blocker
+	NextEpochDuration time.Duration `protobuf:"bytes,6,opt,name=next_epoch_duration,json=nextEpochDuration,proto3,stdduration" json:"next_epoch_duration" yaml:"next_epoch_duration"`
+	// max_catch_up_epochs is the maximum number of missed epochs that are ended
+	// in a single block after the chain has been down for longer than an epoch.
+	// Zero disables catching up, and only one epoch ends at a time.
+	MaxCatchUpEpochs uint32 `protobuf:"varint,7,opt,name=max_catch_up_epochs,json=maxCatchUpEpochs,proto3" json:"max_catch_up_epochs,omitempty" yaml:"max_catch_up_epochs"`
+	// lock_tiers are the lock durations a farmer can pick when staking, with the
+	// reward weight multiplier applied to the locked staking.
+	// Empty lock tiers disable locked staking.
+	LockTiers []LockTier `protobuf:"bytes,8,rep,name=lock_tiers,json=lockTiers,proto3" json:"lock_tiers" yaml:"lock_tiers"`
+	// unbonding_period is the duration unstaked coins wait for before they are
+	// released to the farmer. They don't earn rewards in the meantime.
+	// Zero disables the unbonding period, and unstaked coins are released immediately.
+	UnbondingPeriod time.Duration `protobuf:"bytes,9,opt,name=unbonding_period,json=unbondingPeriod,proto3,stdduration" json:"unbonding_period" yaml:"unbonding_period"`
+	// max_third_party_staking_denoms is the maximum number of staking coin denoms
+	// a farmer can have staked or queued after a stake paid by another account.
+	// It keeps other accounts from opening an unbounded number of staking
+	// positions under a farmer. Zero disables staking on behalf of another farmer.
+	MaxThirdPartyStakingDenoms uint32 `protobuf:"varint,10,opt,name=max_third_party_staking_denoms,json=maxThirdPartyStakingDenoms,proto3" json:"max_third_party_staking_denoms,omitempty" yaml:"max_third_party_staking_denoms"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -137,6 +159,55 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// LockTier defines a lock duration of locked staking and its reward weight multiplier.
+type LockTier struct {
+	// duration is the duration a locked staking can't be unstaked for
+	Duration time.Duration `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration"`
+	// multiplier is the weight of a locked staking in the reward calculation
+	// relative to an unlocked staking of the same amount
+	Multiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier"`
+}
+
+func (m *LockTier) Reset()         { *m = LockTier{} }
+func (m *LockTier) String() string { return proto.CompactTextString(m) }
+func (*LockTier) ProtoMessage()    {}
+func (*LockTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{1}
+}
+func (m *LockTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockTier.Merge(m, src)
+}
+func (m *LockTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockTier proto.InternalMessageInfo
+
+func (m *LockTier) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
 // BasePlan defines a base plan type and contains the required fields
 // for basic farming plan functionality. Any custom farming plan type must
 // extend this type for additional functionality (for example, fixed amount plan, ratio
@@ -168,13 +239,16 @@ type BasePlan struct {
 	LastDistributionTime *time.Time `protobuf:"bytes,10,opt,name=last_distribution_time,json=lastDistributionTime,proto3,stdtime" json:"last_distribution_time,omitempty" yaml:"last_distribution_time"`
 	// distributed_coins specifies the total coins distributed by this plan
 	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins" yaml:"distributed_coins"`
+	// last_skipped_time specifies the last time an allocation was skipped
+	// due to insufficient balance of the farming pool
+	LastSkippedTime *time.Time `protobuf:"bytes,12,opt,name=last_skipped_time,json=lastSkippedTime,proto3,stdtime" json:"last_skipped_time,omitempty" yaml:"last_skipped_time"`
 }
 
 func (m *BasePlan) Reset()         { *m = BasePlan{} }
 func (m *BasePlan) String() string { return proto.CompactTextString(m) }
 func (*BasePlan) ProtoMessage()    {}
 func (*BasePlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{1}
+	return fileDescriptor_5b657e0809d9de86, []int{2}
 }
 func (m *BasePlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -215,7 +289,7 @@ func (m *FixedAmountPlan) Reset()         { *m = FixedAmountPlan{} }
 func (m *FixedAmountPlan) String() string { return proto.CompactTextString(m) }
 func (*FixedAmountPlan) ProtoMessage()    {}
 func (*FixedAmountPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{2}
+	return fileDescriptor_5b657e0809d9de86, []int{3}
 }
 func (m *FixedAmountPlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,7 +330,7 @@ func (m *RatioPlan) Reset()         { *m = RatioPlan{} }
 func (m *RatioPlan) String() string { return proto.CompactTextString(m) }
 func (*RatioPlan) ProtoMessage()    {}
 func (*RatioPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{3}
+	return fileDescriptor_5b657e0809d9de86, []int{4}
 }
 func (m *RatioPlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -285,6 +359,57 @@ func (m *RatioPlan) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RatioPlan proto.InternalMessageInfo
 
+// DecayingPlan defines a plan that distributes a fixed amount of coins for
+// every epoch, which decays by a factor on a schedule, such as halving.
+// The amount decays every decay_epochs epochs, or at each of decay_times.
+type DecayingPlan struct {
+	*BasePlan `protobuf:"bytes,1,opt,name=base_plan,json=basePlan,proto3,embedded=base_plan" json:"base_plan,omitempty" yaml:"base_plan"`
+	// epoch_amount specifies the initial distributing amount for each epoch
+	EpochAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=epoch_amount,json=epochAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_amount" yaml:"epoch_amount"`
+	// decay_factor specifies the factor the epoch amount is multiplied by
+	// on each decay; 0.5 halves the epoch amount
+	DecayFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=decay_factor,json=decayFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_factor" yaml:"decay_factor"`
+	// decay_epochs specifies the number of epochs between decays
+	DecayEpochs uint32 `protobuf:"varint,4,opt,name=decay_epochs,json=decayEpochs,proto3" json:"decay_epochs,omitempty" yaml:"decay_epochs"`
+	// decay_times specifies the times at which the epoch amount decays
+	DecayTimes []time.Time `protobuf:"bytes,5,rep,name=decay_times,json=decayTimes,proto3,stdtime" json:"decay_times" yaml:"decay_times"`
+	// elapsed_epochs specifies the number of epochs the plan has been active for
+	ElapsedEpochs uint64 `protobuf:"varint,6,opt,name=elapsed_epochs,json=elapsedEpochs,proto3" json:"elapsed_epochs,omitempty" yaml:"elapsed_epochs"`
+}
+
+func (m *DecayingPlan) Reset()         { *m = DecayingPlan{} }
+func (m *DecayingPlan) String() string { return proto.CompactTextString(m) }
+func (*DecayingPlan) ProtoMessage()    {}
+func (*DecayingPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{5}
+}
+func (m *DecayingPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecayingPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecayingPlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecayingPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecayingPlan.Merge(m, src)
+}
+func (m *DecayingPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecayingPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecayingPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecayingPlan proto.InternalMessageInfo
+
 // Staking defines a farmer's staking information.
 type Staking struct {
 	Amount        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
@@ -295,7 +420,7 @@ func (m *Staking) Reset()         { *m = Staking{} }
 func (m *Staking) String() string { return proto.CompactTextString(m) }
 func (*Staking) ProtoMessage()    {}
 func (*Staking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{4}
+	return fileDescriptor_5b657e0809d9de86, []int{6}
 }
 func (m *Staking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -333,7 +458,7 @@ func (m *QueuedStaking) Reset()         { *m = QueuedStaking{} }
 func (m *QueuedStaking) String() string { return proto.CompactTextString(m) }
 func (*QueuedStaking) ProtoMessage()    {}
 func (*QueuedStaking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{5}
+	return fileDescriptor_5b657e0809d9de86, []int{7}
 }
 func (m *QueuedStaking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -371,7 +496,7 @@ func (m *TotalStakings) Reset()         { *m = TotalStakings{} }
 func (m *TotalStakings) String() string { return proto.CompactTextString(m) }
 func (*TotalStakings) ProtoMessage()    {}
 func (*TotalStakings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{6}
+	return fileDescriptor_5b657e0809d9de86, []int{8}
 }
 func (m *TotalStakings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -400,16 +525,197 @@ func (m *TotalStakings) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TotalStakings proto.InternalMessageInfo
 
+// LockedStaking defines a farmer's staking that can't be unstaked until its unlock time.
+type LockedStaking struct {
+	Id               uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Farmer           string                                 `protobuf:"bytes,2,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	StakingCoinDenom string                                 `protobuf:"bytes,3,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty" yaml:"staking_coin_denom"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// multiplier is the reward weight multiplier of the lock tier
+	Multiplier    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier"`
+	StartingEpoch uint64                                 `protobuf:"varint,6,opt,name=starting_epoch,json=startingEpoch,proto3" json:"starting_epoch,omitempty" yaml:"starting_epoch"`
+	// unlock_time is the time after which the locked staking becomes a normal staking
+	UnlockTime time.Time `protobuf:"bytes,7,opt,name=unlock_time,json=unlockTime,proto3,stdtime" json:"unlock_time" yaml:"unlock_time"`
+}
+
+func (m *LockedStaking) Reset()         { *m = LockedStaking{} }
+func (m *LockedStaking) String() string { return proto.CompactTextString(m) }
+func (*LockedStaking) ProtoMessage()    {}
+func (*LockedStaking) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{9}
+}
+func (m *LockedStaking) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockedStaking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockedStaking.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockedStaking) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockedStaking.Merge(m, src)
+}
+func (m *LockedStaking) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockedStaking) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockedStaking.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockedStaking proto.InternalMessageInfo
+
+// TotalLockedStakings defines the total locked staking amount for a staking coin denom,
+// along with the sum of the amounts weighted by their multipliers.
+type TotalLockedStakings struct {
+	Amount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	WeightedAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weighted_amount,json=weightedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weighted_amount" yaml:"weighted_amount"`
+}
+
+func (m *TotalLockedStakings) Reset()         { *m = TotalLockedStakings{} }
+func (m *TotalLockedStakings) String() string { return proto.CompactTextString(m) }
+func (*TotalLockedStakings) ProtoMessage()    {}
+func (*TotalLockedStakings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{10}
+}
+func (m *TotalLockedStakings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TotalLockedStakings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TotalLockedStakings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TotalLockedStakings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TotalLockedStakings.Merge(m, src)
+}
+func (m *TotalLockedStakings) XXX_Size() int {
+	return m.Size()
+}
+func (m *TotalLockedStakings) XXX_DiscardUnknown() {
+	xxx_messageInfo_TotalLockedStakings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TotalLockedStakings proto.InternalMessageInfo
+
+// Unbonding defines unstaked coins of a farmer that are waiting for the unbonding period to pass.
+type Unbonding struct {
+	Id               uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Farmer           string                                 `protobuf:"bytes,2,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	StakingCoinDenom string                                 `protobuf:"bytes,3,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty" yaml:"staking_coin_denom"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// completion_time is the time the unbonding coins are released to the farmer
+	CompletionTime time.Time `protobuf:"bytes,5,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+}
+
+func (m *Unbonding) Reset()         { *m = Unbonding{} }
+func (m *Unbonding) String() string { return proto.CompactTextString(m) }
+func (*Unbonding) ProtoMessage()    {}
+func (*Unbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{11}
+}
+func (m *Unbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Unbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Unbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Unbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Unbonding.Merge(m, src)
+}
+func (m *Unbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *Unbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_Unbonding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Unbonding proto.InternalMessageInfo
+
+// DepositRequest defines a deposit to a liquidity pool made on behalf of a farmer.
+// The pool coins minted by the deposit are staked for the farmer once the deposit
+// batch is executed.
+type DepositRequest struct {
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Farmer string `protobuf:"bytes,2,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	PoolId uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// msg_index is the index of the deposit message in the pool batch
+	MsgIndex     uint64                                   `protobuf:"varint,4,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty" yaml:"msg_index"`
+	DepositCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=deposit_coins,json=depositCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit_coins" yaml:"deposit_coins"`
+	// batch_index is the index of the pool batch the deposit message is executed in
+	BatchIndex uint64 `protobuf:"varint,6,opt,name=batch_index,json=batchIndex,proto3" json:"batch_index,omitempty" yaml:"batch_index"`
+}
+
+func (m *DepositRequest) Reset()         { *m = DepositRequest{} }
+func (m *DepositRequest) String() string { return proto.CompactTextString(m) }
+func (*DepositRequest) ProtoMessage()    {}
+func (*DepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b657e0809d9de86, []int{12}
+}
+func (m *DepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositRequest.Merge(m, src)
+}
+func (m *DepositRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositRequest proto.InternalMessageInfo
+
 // HistoricalRewards defines the cumulative unit rewards for a given staking coin denom and an epoch number.
 type HistoricalRewards struct {
 	CumulativeUnitRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_unit_rewards,json=cumulativeUnitRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_unit_rewards" yaml:"cumulative_unit_rewards"`
+	// reference_count is the number of stakings and locked stakings that start
+	// from this epoch, plus one if this is the latest historical rewards of
+	// the staking coin denom.
+	// Historical rewards are deleted once the reference count drops to zero.
+	ReferenceCount uint32 `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty" yaml:"reference_count"`
 }
 
 func (m *HistoricalRewards) Reset()         { *m = HistoricalRewards{} }
 func (m *HistoricalRewards) String() string { return proto.CompactTextString(m) }
 func (*HistoricalRewards) ProtoMessage()    {}
 func (*HistoricalRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{7}
+	return fileDescriptor_5b657e0809d9de86, []int{13}
 }
 func (m *HistoricalRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -448,7 +754,7 @@ func (m *OutstandingRewards) Reset()         { *m = OutstandingRewards{} }
 func (m *OutstandingRewards) String() string { return proto.CompactTextString(m) }
 func (*OutstandingRewards) ProtoMessage()    {}
 func (*OutstandingRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5b657e0809d9de86, []int{8}
+	return fileDescriptor_5b657e0809d9de86, []int{14}
 }
 func (m *OutstandingRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -481,12 +787,18 @@ func init() {
 	proto.RegisterEnum("cosmos.farming.v1beta1.PlanType", PlanType_name, PlanType_value)
 	proto.RegisterEnum("cosmos.farming.v1beta1.AddressType", AddressType_name, AddressType_value)
 	proto.RegisterType((*Params)(nil), "cosmos.farming.v1beta1.Params")
+	proto.RegisterType((*LockTier)(nil), "cosmos.farming.v1beta1.LockTier")
 	proto.RegisterType((*BasePlan)(nil), "cosmos.farming.v1beta1.BasePlan")
 	proto.RegisterType((*FixedAmountPlan)(nil), "cosmos.farming.v1beta1.FixedAmountPlan")
 	proto.RegisterType((*RatioPlan)(nil), "cosmos.farming.v1beta1.RatioPlan")
+	proto.RegisterType((*DecayingPlan)(nil), "cosmos.farming.v1beta1.DecayingPlan")
 	proto.RegisterType((*Staking)(nil), "cosmos.farming.v1beta1.Staking")
 	proto.RegisterType((*QueuedStaking)(nil), "cosmos.farming.v1beta1.QueuedStaking")
 	proto.RegisterType((*TotalStakings)(nil), "cosmos.farming.v1beta1.TotalStakings")
+	proto.RegisterType((*LockedStaking)(nil), "cosmos.farming.v1beta1.LockedStaking")
+	proto.RegisterType((*TotalLockedStakings)(nil), "cosmos.farming.v1beta1.TotalLockedStakings")
+	proto.RegisterType((*Unbonding)(nil), "cosmos.farming.v1beta1.Unbonding")
+	proto.RegisterType((*DepositRequest)(nil), "cosmos.farming.v1beta1.DepositRequest")
 	proto.RegisterType((*HistoricalRewards)(nil), "cosmos.farming.v1beta1.HistoricalRewards")
 	proto.RegisterType((*OutstandingRewards)(nil), "cosmos.farming.v1beta1.OutstandingRewards")
 }
@@ -496,85 +808,129 @@ func init() {
 }
 
 var fileDescriptor_5b657e0809d9de86 = []byte{
-	// 1248 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x13, 0x47,
-	0x14, 0xf7, 0x04, 0x93, 0xd8, 0xe3, 0x26, 0x71, 0x26, 0x7f, 0x70, 0x0c, 0x78, 0x57, 0x2b, 0xb5,
-	0xb2, 0x52, 0x61, 0x83, 0xd3, 0x53, 0x4e, 0xcd, 0xc6, 0x4e, 0x6a, 0x09, 0x81, 0xd9, 0x38, 0xa5,
-	0x54, 0xaa, 0x56, 0x63, 0xef, 0x60, 0x56, 0xac, 0x77, 0xad, 0x9d, 0x31, 0xe0, 0x0f, 0x50, 0x81,
-	0x72, 0x42, 0x55, 0x0f, 0x6d, 0xa5, 0x48, 0xa8, 0xbd, 0xd1, 0x6b, 0xbf, 0x43, 0x39, 0xd2, 0x9e,
-	0xaa, 0x1e, 0x96, 0x8a, 0x7c, 0x03, 0x9f, 0x7a, 0xac, 0xe6, 0xcf, 0x3a, 0x2e, 0x38, 0x0d, 0x96,
-	0xe8, 0x69, 0x77, 0xde, 0xbc, 0xf7, 0x7b, 0xbf, 0xf7, 0xdb, 0x79, 0x6f, 0x16, 0x16, 0x19, 0xf1,
-	0x1d, 0x12, 0x76, 0x5d, 0x9f, 0x95, 0xef, 0x62, 0xfe, 0xec, 0x94, 0x1f, 0x5c, 0x6b, 0x11, 0x86,
-	0xaf, 0xc5, 0xeb, 0x52, 0x2f, 0x0c, 0x58, 0x80, 0xd6, 0xda, 0x01, 0xed, 0x06, 0xb4, 0x14, 0x5b,
-	0x95, 0x57, 0x7e, 0xa5, 0x13, 0x74, 0x02, 0xe1, 0x52, 0xe6, 0x6f, 0xd2, 0x3b, 0xbf, 0x2e, 0xbd,
-	0x6d, 0xb9, 0xa1, 0x42, 0xe5, 0x56, 0x41, 0xae, 0xca, 0x2d, 0x4c, 0xc9, 0x28, 0x57, 0x3b, 0x70,
-	0x7d, 0xb5, 0xaf, 0x75, 0x82, 0xa0, 0xe3, 0x91, 0xb2, 0x58, 0xb5, 0xfa, 0x77, 0xcb, 0xcc, 0xed,
-	0x12, 0xca, 0x70, 0xb7, 0x27, 0x1d, 0x8c, 0xe3, 0x73, 0x70, 0xb6, 0x81, 0x43, 0xdc, 0xa5, 0xe8,
-	0x39, 0x80, 0xeb, 0xbd, 0xd0, 0x7d, 0x80, 0x19, 0xb1, 0x7b, 0x1e, 0xf6, 0xed, 0x76, 0x48, 0x30,
-	0x73, 0x03, 0xdf, 0xbe, 0x4b, 0x48, 0x0e, 0xe8, 0xe7, 0x8a, 0x99, 0xca, 0x7a, 0x49, 0xa5, 0xe7,
-	0x09, 0x63, 0xda, 0xa5, 0x9d, 0xc0, 0xf5, 0xcd, 0xe6, 0x8b, 0x48, 0x4b, 0x0c, 0x23, 0x4d, 0x1f,
-	0xe0, 0xae, 0xb7, 0x65, 0x9c, 0x8a, 0x64, 0x3c, 0x7f, 0xa5, 0x15, 0x3b, 0x2e, 0xbb, 0xd7, 0x6f,
-	0x95, 0xda, 0x41, 0x57, 0xd5, 0xa3, 0x1e, 0x57, 0xa8, 0x73, 0xbf, 0xcc, 0x06, 0x3d, 0x42, 0x05,
-	0x28, 0xb5, 0xd6, 0x14, 0x4e, 0xc3, 0xc3, 0xfe, 0x8e, 0x42, 0xd9, 0x25, 0x04, 0x99, 0x70, 0xd1,
-	0x27, 0x8f, 0x98, 0x4d, 0x7a, 0x41, 0xfb, 0x9e, 0xed, 0xe0, 0x01, 0xcd, 0xcd, 0xe8, 0xa0, 0x38,
-	0x6f, 0xe6, 0x87, 0x91, 0xb6, 0x26, 0x29, 0xbc, 0xe1, 0x60, 0x58, 0xf3, 0xdc, 0x52, 0xe3, 0x86,
-	0x2a, 0x1e, 0x50, 0xd4, 0x84, 0xab, 0xea, 0x03, 0x70, 0x5e, 0x76, 0x3b, 0xf0, 0x3c, 0xd2, 0x66,
-	0x41, 0x98, 0x3b, 0xa7, 0x83, 0x62, 0xda, 0xd4, 0x87, 0x91, 0x76, 0x49, 0x22, 0x4d, 0x74, 0x33,
-	0xac, 0x65, 0x65, 0xdf, 0x25, 0x64, 0x27, 0xb6, 0xa2, 0xc7, 0x00, 0x5e, 0x70, 0x88, 0x87, 0x07,
-	0xc4, 0xb1, 0x29, 0xc3, 0xf7, 0x79, 0x5c, 0x07, 0x53, 0x21, 0x62, 0x52, 0x07, 0xc5, 0xa4, 0xd9,
-	0xe0, 0x4a, 0xfd, 0x19, 0x69, 0x1f, 0xbd, 0x83, 0x0a, 0x7b, 0x98, 0x0e, 0x23, 0xad, 0x20, 0x69,
-	0x9c, 0x02, 0x6b, 0x58, 0x2b, 0x6a, 0x67, 0x5f, 0x6e, 0xec, 0x61, 0xba, 0x4b, 0xc8, 0x56, 0xea,
-	0xc9, 0x33, 0x2d, 0xf1, 0xdd, 0x33, 0x2d, 0x61, 0xfc, 0x30, 0x07, 0x53, 0x26, 0xa6, 0x42, 0x45,
-	0xb4, 0x00, 0x67, 0x5c, 0x27, 0x07, 0x38, 0x15, 0x6b, 0xc6, 0x75, 0x10, 0x82, 0x49, 0x1f, 0x77,
-	0x89, 0xd0, 0x2f, 0x6d, 0x89, 0x77, 0xf4, 0x09, 0x4c, 0xf2, 0xfc, 0x42, 0x89, 0x85, 0x8a, 0x5e,
-	0x9a, 0x7c, 0x5e, 0x4b, 0x1c, 0xaf, 0x39, 0xe8, 0x11, 0x4b, 0x78, 0xa3, 0x5b, 0x70, 0x25, 0x56,
-	0xaa, 0x17, 0x04, 0x9e, 0x8d, 0x1d, 0x27, 0x24, 0x94, 0x8a, 0xb2, 0xd3, 0xa6, 0x36, 0x8c, 0xb4,
-	0x8b, 0xff, 0xd6, 0x73, 0xdc, 0xcb, 0xb0, 0x90, 0x32, 0x37, 0x82, 0xc0, 0xdb, 0x96, 0x46, 0x74,
-	0x13, 0x2e, 0x33, 0xd1, 0x52, 0xf2, 0xfc, 0xc4, 0x88, 0xe7, 0x05, 0x62, 0x61, 0x18, 0x69, 0x79,
-	0x89, 0x38, 0xc1, 0xc9, 0xb0, 0xd0, 0x98, 0x35, 0x06, 0xfc, 0x11, 0xc0, 0x95, 0x58, 0x3f, 0xde,
-	0x28, 0xf6, 0x43, 0xe2, 0x76, 0xee, 0x31, 0x9a, 0x9b, 0x15, 0x07, 0xfc, 0xd2, 0xc4, 0x03, 0x5e,
-	0x25, 0x6d, 0x71, 0xc6, 0x2d, 0x75, 0xc6, 0x55, 0x19, 0x93, 0x70, 0xf8, 0xf1, 0xfe, 0xf8, 0x1d,
-	0x3e, 0xac, 0x82, 0xa4, 0x16, 0x52, 0x28, 0x7c, 0x75, 0x5b, 0x62, 0xa0, 0x2f, 0x20, 0xa4, 0x0c,
-	0x87, 0xcc, 0xe6, 0xed, 0x9a, 0x9b, 0xd3, 0x41, 0x31, 0x53, 0xc9, 0x97, 0x64, 0x2f, 0x97, 0xe2,
-	0x5e, 0x2e, 0x35, 0xe3, 0x5e, 0x36, 0x2f, 0x2b, 0x5e, 0x4b, 0x23, 0x5e, 0x2a, 0xd6, 0x78, 0xfa,
-	0x4a, 0x03, 0x56, 0x5a, 0x18, 0xb8, 0x3b, 0xb2, 0x60, 0x8a, 0xf8, 0x8e, 0xc4, 0x4d, 0x9d, 0x89,
-	0x7b, 0x51, 0xe1, 0x2e, 0x4a, 0xdc, 0x38, 0x52, 0xa2, 0xce, 0x11, 0xdf, 0x11, 0x98, 0x05, 0x08,
-	0x63, 0xa1, 0x89, 0x93, 0x4b, 0xeb, 0xa0, 0x98, 0xb2, 0xc6, 0x2c, 0xe8, 0x21, 0x5c, 0xf3, 0x30,
-	0x65, 0xb6, 0xe3, 0x52, 0x16, 0xba, 0xad, 0xbe, 0xf8, 0x48, 0x82, 0x01, 0x3c, 0x93, 0xc1, 0x87,
-	0xc3, 0x48, 0xbb, 0x2c, 0xb3, 0x4f, 0xc6, 0x90, 0x5c, 0x56, 0xf8, 0x66, 0x75, 0x6c, 0x4f, 0x10,
-	0xfb, 0x16, 0xc0, 0xa5, 0x51, 0x00, 0x71, 0xc4, 0x77, 0xa2, 0xb9, 0xcc, 0x59, 0x93, 0xec, 0xba,
-	0xaa, 0x3a, 0xa7, 0xba, 0xee, 0x4d, 0x84, 0xe9, 0x26, 0x58, 0x76, 0x2c, 0x5e, 0x58, 0xb6, 0xe6,
-	0x79, 0x5f, 0xfe, 0xfe, 0xcb, 0x95, 0xf3, 0xbc, 0x7d, 0xea, 0xc6, 0xdf, 0x00, 0x2e, 0xee, 0xba,
-	0x8f, 0x88, 0xb3, 0xdd, 0x0d, 0xfa, 0x3e, 0x13, 0x3d, 0x7a, 0x1b, 0xa6, 0x39, 0x2f, 0x31, 0x3d,
-	0x45, 0xab, 0x66, 0x4e, 0x6f, 0xc2, 0xb8, 0xb1, 0xcd, 0xdc, 0xcb, 0x48, 0x03, 0xc3, 0x48, 0xcb,
-	0x4a, 0xde, 0x23, 0x00, 0xc3, 0x4a, 0xb5, 0xe2, 0xe6, 0xff, 0x1a, 0xc0, 0x0f, 0xe4, 0x48, 0xc4,
-	0x22, 0x5b, 0x6e, 0xe6, 0x2c, 0x35, 0xf6, 0x94, 0x1a, 0xcb, 0xea, 0x0c, 0x8c, 0x05, 0x4f, 0x27,
-	0x44, 0x46, 0x84, 0xca, 0x22, 0xb7, 0x92, 0x5c, 0x03, 0xe3, 0x37, 0x00, 0xd3, 0x16, 0x6f, 0xcf,
-	0xff, 0xb7, 0x68, 0x02, 0x65, 0x6e, 0x3b, 0xe4, 0xb9, 0xe4, 0xa0, 0x33, 0xab, 0x53, 0x4c, 0xe1,
-	0x2a, 0x69, 0x0f, 0x23, 0x0d, 0x8d, 0x2b, 0x20, 0xa0, 0x0c, 0x0b, 0x8a, 0x95, 0xa8, 0x41, 0xd5,
-	0xf4, 0x3d, 0x80, 0x73, 0x6a, 0x0e, 0xa3, 0x5d, 0x38, 0xab, 0x64, 0x06, 0x22, 0x67, 0x69, 0x8a,
-	0x9c, 0x75, 0x9f, 0x59, 0x2a, 0x1a, 0x7d, 0x0a, 0x17, 0x44, 0x0b, 0xf3, 0x61, 0x23, 0x12, 0x8a,
-	0x1a, 0x92, 0xe6, 0xfa, 0x30, 0xd2, 0x56, 0xc7, 0x7a, 0x7e, 0xb4, 0x6f, 0x58, 0xf3, 0xb1, 0x41,
-	0xdc, 0x77, 0x8a, 0xdb, 0x57, 0x70, 0xfe, 0x56, 0x9f, 0xf4, 0x47, 0x17, 0xc5, 0xfb, 0x22, 0x78,
-	0x02, 0xdf, 0x0c, 0x18, 0xf6, 0x14, 0x3a, 0x7d, 0xcf, 0xf0, 0xbf, 0x02, 0xb8, 0xf4, 0x99, 0x4b,
-	0x59, 0x10, 0xba, 0x6d, 0xec, 0x59, 0xe4, 0x21, 0x0e, 0x1d, 0x8a, 0x7e, 0x06, 0xf0, 0x42, 0xbb,
-	0xdf, 0xed, 0x7b, 0x98, 0xb9, 0x0f, 0x88, 0xdd, 0xf7, 0x5d, 0x66, 0x87, 0x72, 0x4f, 0xfd, 0xb4,
-	0xfc, 0xf7, 0x4c, 0x3f, 0x50, 0xe7, 0x5b, 0xdd, 0xb1, 0xa7, 0x40, 0x4d, 0x3d, 0xd6, 0x57, 0x4f,
-	0x80, 0x0e, 0x7c, 0x97, 0x29, 0xb6, 0xaa, 0x92, 0xc7, 0x00, 0xa2, 0x9b, 0x7d, 0x46, 0x19, 0xf6,
-	0x1d, 0xd7, 0xef, 0xc4, 0xa5, 0xdc, 0x87, 0x73, 0xd3, 0x30, 0xdf, 0xe4, 0xcc, 0xa7, 0xe5, 0x15,
-	0x67, 0x90, 0x4c, 0x36, 0xbe, 0x01, 0x30, 0x15, 0xdf, 0xe2, 0x68, 0x03, 0xae, 0x36, 0xae, 0x6f,
-	0xdf, 0xb0, 0x9b, 0x77, 0x1a, 0x35, 0xfb, 0xe0, 0xc6, 0x7e, 0xa3, 0xb6, 0x53, 0xdf, 0xad, 0xd7,
-	0xaa, 0xd9, 0x44, 0x7e, 0xf1, 0xf0, 0x48, 0xcf, 0xc4, 0x8e, 0x37, 0x5c, 0x0f, 0x15, 0x61, 0xf6,
-	0xc4, 0xb7, 0x71, 0x60, 0x5e, 0xaf, 0xef, 0x64, 0x41, 0x1e, 0x1d, 0x1e, 0xe9, 0x0b, 0xb1, 0x5b,
-	0xa3, 0xdf, 0xf2, 0xdc, 0x36, 0xda, 0x80, 0x4b, 0x63, 0x9e, 0x56, 0xfd, 0xf3, 0xed, 0x66, 0x2d,
-	0x3b, 0x93, 0x5f, 0x3e, 0x3c, 0xd2, 0x17, 0x47, 0xae, 0xf2, 0x2f, 0x2f, 0x9f, 0x7c, 0xf2, 0x53,
-	0x21, 0xb1, 0x31, 0x80, 0x19, 0x75, 0x5d, 0x0b, 0x5a, 0xd7, 0xe0, 0xea, 0x76, 0xb5, 0x6a, 0xd5,
-	0xf6, 0xf7, 0x25, 0xc6, 0x66, 0xc5, 0x36, 0xef, 0x34, 0x6b, 0xfb, 0xd9, 0x44, 0x7e, 0xed, 0xf0,
-	0x48, 0x47, 0x63, 0xbe, 0x9b, 0x15, 0x73, 0xc0, 0x08, 0x7d, 0x2b, 0xa4, 0x72, 0x55, 0x85, 0x80,
-	0xb7, 0x42, 0x2a, 0x57, 0x45, 0x88, 0x4c, 0x6d, 0xee, 0xbd, 0x78, 0x5d, 0x00, 0x2f, 0x5f, 0x17,
-	0xc0, 0x5f, 0xaf, 0x0b, 0xe0, 0xe9, 0x71, 0x21, 0xf1, 0xf2, 0xb8, 0x90, 0xf8, 0xe3, 0xb8, 0x90,
-	0xf8, 0xf2, 0xca, 0x98, 0xca, 0x13, 0x7e, 0xf4, 0x1f, 0x8d, 0xde, 0x84, 0xe0, 0xad, 0x59, 0x71,
-	0x99, 0x6d, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0xba, 0xcd, 0x71, 0x31, 0x15, 0x0c, 0x00, 0x00,
+	// 1950 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x77, 0x3b, 0x8e, 0x63, 0x57, 0x12, 0xdb, 0xa9, 0x7c, 0x8c, 0xe3, 0xdd, 0x71, 0x5b, 0x8d,
+	0x76, 0x15, 0x66, 0x35, 0xce, 0x4e, 0x06, 0x09, 0x29, 0x17, 0x88, 0xe3, 0x64, 0x26, 0x10, 0x66,
+	0xbd, 0x15, 0x87, 0x65, 0x17, 0xa1, 0x56, 0xb9, 0xbb, 0xe2, 0xb4, 0xd2, 0x5f, 0xd3, 0xd5, 0x9e,
+	0x89, 0xef, 0xa0, 0x1d, 0x8d, 0x38, 0xac, 0x10, 0x87, 0x45, 0x68, 0xa4, 0x15, 0xdc, 0x96, 0x2b,
+	0x12, 0x12, 0x37, 0x6e, 0x7b, 0x1c, 0x38, 0x20, 0xc4, 0xc1, 0x8b, 0x32, 0xff, 0x81, 0x4f, 0x1c,
+	0x38, 0xa0, 0xfa, 0x68, 0xbb, 0xe3, 0x38, 0x72, 0xac, 0x19, 0x0e, 0x68, 0x4f, 0x76, 0xbd, 0x8f,
+	0x5f, 0xbd, 0xf7, 0xea, 0xbd, 0x57, 0xaf, 0x1a, 0x6c, 0x84, 0xc4, 0x35, 0x49, 0xe0, 0x58, 0x6e,
+	0xb8, 0x79, 0x82, 0xd9, 0x6f, 0x7b, 0xf3, 0xc9, 0xbd, 0x16, 0x09, 0xf1, 0xbd, 0x68, 0x5d, 0xf5,
+	0x03, 0x2f, 0xf4, 0xe0, 0x9a, 0xe1, 0x51, 0xc7, 0xa3, 0xd5, 0x88, 0x2a, 0xa5, 0x4a, 0x2b, 0x6d,
+	0xaf, 0xed, 0x71, 0x91, 0x4d, 0xf6, 0x4f, 0x48, 0x97, 0xd6, 0x85, 0xb4, 0x2e, 0x18, 0x52, 0x55,
+	0xb0, 0xca, 0x62, 0xb5, 0xd9, 0xc2, 0x94, 0x0c, 0xf6, 0x32, 0x3c, 0xcb, 0x95, 0x7c, 0xb5, 0xed,
+	0x79, 0x6d, 0x9b, 0x6c, 0xf2, 0x55, 0xab, 0x73, 0xb2, 0x19, 0x5a, 0x0e, 0xa1, 0x21, 0x76, 0xfc,
+	0x08, 0x60, 0x54, 0xc0, 0xec, 0x04, 0x38, 0xb4, 0x3c, 0x09, 0xa0, 0xfd, 0x67, 0x0e, 0xa4, 0x1b,
+	0x38, 0xc0, 0x0e, 0x85, 0x5f, 0x2a, 0x60, 0xdd, 0x0f, 0xac, 0x27, 0x38, 0x24, 0xba, 0x6f, 0x63,
+	0x57, 0x37, 0x02, 0xc2, 0x45, 0xf5, 0x13, 0x42, 0x8a, 0x4a, 0x65, 0x66, 0x63, 0x7e, 0x6b, 0xbd,
+	0x2a, 0xcd, 0x63, 0x06, 0x45, 0x6e, 0x55, 0x77, 0x3d, 0xcb, 0xad, 0x35, 0xbf, 0xea, 0xa9, 0x89,
+	0x7e, 0x4f, 0xad, 0x74, 0xb1, 0x63, 0x6f, 0x6b, 0xd7, 0x22, 0x69, 0x5f, 0x7e, 0xad, 0x6e, 0xb4,
+	0xad, 0xf0, 0xb4, 0xd3, 0xaa, 0x1a, 0x9e, 0x23, 0xfd, 0x95, 0x3f, 0x77, 0xa9, 0x79, 0xb6, 0x19,
+	0x76, 0x7d, 0x42, 0x39, 0x28, 0x45, 0x6b, 0x12, 0xa7, 0x61, 0x63, 0x77, 0x57, 0xa2, 0xec, 0x13,
+	0x02, 0x9b, 0x60, 0x55, 0x06, 0x97, 0x61, 0xea, 0x86, 0x67, 0xdb, 0xc4, 0x08, 0xbd, 0xa0, 0x38,
+	0x53, 0x51, 0x36, 0xb2, 0xb5, 0x4a, 0xbf, 0xa7, 0xbe, 0x2d, 0x0c, 0x19, 0x2b, 0xa6, 0xa1, 0x65,
+	0x49, 0xdf, 0x27, 0x64, 0x37, 0xa2, 0xc2, 0x4f, 0x15, 0x70, 0xcb, 0x24, 0x36, 0xee, 0x12, 0x53,
+	0xa7, 0x21, 0x3e, 0x63, 0x7a, 0x6d, 0x4c, 0x79, 0x00, 0x52, 0x15, 0x65, 0x23, 0x55, 0x6b, 0x30,
+	0x2f, 0xff, 0xd9, 0x53, 0xdf, 0xbd, 0x81, 0x07, 0x0f, 0x30, 0xed, 0xf7, 0xd4, 0xb2, 0x30, 0xe3,
+	0x1a, 0x58, 0x0d, 0xad, 0x48, 0xce, 0x91, 0x60, 0x3c, 0xc0, 0x94, 0xf9, 0x77, 0x08, 0xa0, 0x8f,
+	0x83, 0xd0, 0xc2, 0xb6, 0x8e, 0x6d, 0xdb, 0x33, 0xb8, 0xe3, 0xc5, 0xd9, 0x8a, 0xb2, 0x91, 0xa9,
+	0xdd, 0xee, 0xf7, 0xd4, 0x75, 0x19, 0xe5, 0x2b, 0x32, 0x1a, 0x5a, 0x92, 0xc4, 0x9d, 0x01, 0x0d,
+	0x3e, 0x06, 0xcb, 0x2e, 0x39, 0x0f, 0x75, 0xe2, 0x7b, 0xc6, 0xa9, 0x1e, 0xa5, 0x40, 0x31, 0x5d,
+	0x51, 0xf8, 0x99, 0x8a, 0x1c, 0xa9, 0x46, 0x39, 0x52, 0xad, 0x4b, 0x81, 0xda, 0xbb, 0xf2, 0x4c,
+	0x4b, 0x62, 0xb7, 0x31, 0x18, 0xda, 0xe7, 0x5f, 0xab, 0x0a, 0x5a, 0x62, 0x9c, 0x3d, 0xc6, 0x88,
+	0x54, 0xe1, 0x8f, 0xc0, 0xb2, 0x83, 0xcf, 0x75, 0x03, 0x87, 0xc6, 0xa9, 0xde, 0xf1, 0x85, 0x1a,
+	0x2d, 0xce, 0x55, 0x94, 0x8d, 0xc5, 0x5a, 0x79, 0x88, 0x39, 0x46, 0x48, 0x43, 0x05, 0x07, 0x9f,
+	0xef, 0x32, 0xe2, 0xb1, 0xcf, 0x51, 0x29, 0xfc, 0x04, 0x00, 0xdb, 0x33, 0xce, 0xf4, 0xd0, 0x22,
+	0x01, 0x2d, 0x66, 0x78, 0x32, 0x56, 0xaa, 0xe3, 0xcb, 0xac, 0x7a, 0xe8, 0x19, 0x67, 0x4d, 0x8b,
+	0x04, 0xb5, 0x75, 0x69, 0xff, 0x92, 0xd8, 0x6b, 0x88, 0xa0, 0xa1, 0xac, 0x2d, 0x85, 0x28, 0xb4,
+	0x40, 0xa1, 0xe3, 0xb6, 0x3c, 0xd7, 0x64, 0xe7, 0xe2, 0x93, 0xc0, 0xf2, 0xcc, 0x62, 0x76, 0x52,
+	0x68, 0xbe, 0x25, 0xa1, 0x6f, 0x09, 0xe8, 0x51, 0x00, 0x11, 0x97, 0xfc, 0x80, 0xdc, 0xe0, 0x54,
+	0xe8, 0x80, 0x32, 0x73, 0x38, 0x3c, 0xb5, 0x02, 0x53, 0x67, 0xe7, 0xd4, 0x1d, 0x24, 0x84, 0x49,
+	0x5c, 0xcf, 0xa1, 0x45, 0xc0, 0x03, 0xf4, 0xed, 0x7e, 0x4f, 0x7d, 0x67, 0x18, 0xa0, 0xeb, 0xe5,
+	0x35, 0x54, 0x72, 0xf0, 0x79, 0x93, 0xf1, 0x1b, 0x8c, 0x2d, 0xb3, 0xa8, 0xce, 0x99, 0xdb, 0x99,
+	0x67, 0x5f, 0xa8, 0x89, 0xcf, 0xbf, 0x50, 0x13, 0x3f, 0x48, 0x65, 0x92, 0x85, 0x19, 0x94, 0x8f,
+	0x9f, 0x20, 0xee, 0x52, 0xed, 0xb7, 0x0a, 0xc8, 0x44, 0xd1, 0x82, 0xdf, 0x03, 0x99, 0x41, 0x6a,
+	0x28, 0x93, 0xfc, 0xcf, 0x30, 0xff, 0xb9, 0x93, 0x03, 0x25, 0xf8, 0x08, 0x00, 0xa7, 0x63, 0x87,
+	0x96, 0x6f, 0x5b, 0x24, 0x28, 0x26, 0x79, 0x25, 0x56, 0xa7, 0x28, 0x98, 0x3a, 0x31, 0x50, 0x0c,
+	0x41, 0xfb, 0x79, 0x06, 0x64, 0x6a, 0x98, 0xf2, 0xe2, 0x87, 0x39, 0x90, 0xb4, 0x4c, 0x6e, 0x57,
+	0x0a, 0x25, 0x2d, 0x13, 0x42, 0x90, 0x72, 0xb1, 0x43, 0xc4, 0x36, 0x88, 0xff, 0x87, 0xdf, 0x01,
+	0x29, 0x86, 0xc4, 0x9b, 0x40, 0xee, 0xfa, 0xfc, 0x60, 0x78, 0xcd, 0xae, 0x4f, 0x10, 0x97, 0x86,
+	0x1f, 0x82, 0x95, 0xa8, 0x49, 0xf8, 0x9e, 0x67, 0xeb, 0xd8, 0x34, 0x03, 0x42, 0x29, 0xaf, 0xf8,
+	0x6c, 0x4d, 0xed, 0xf7, 0xd4, 0xb7, 0x2e, 0xb7, 0x92, 0xb8, 0x94, 0x86, 0xa0, 0x24, 0x37, 0x3c,
+	0xcf, 0xde, 0x11, 0x44, 0xf8, 0x01, 0x58, 0x0e, 0xf9, 0x4d, 0x21, 0xda, 0x5e, 0x84, 0x38, 0xcb,
+	0x11, 0x63, 0xd9, 0x3f, 0x46, 0x48, 0x43, 0x30, 0x46, 0x8d, 0x00, 0x7f, 0xa7, 0x80, 0x95, 0xe8,
+	0xe4, 0x59, 0xff, 0xd7, 0x9f, 0x12, 0xab, 0x7d, 0x1a, 0xd2, 0x62, 0x9a, 0x97, 0xc2, 0xdb, 0x63,
+	0xfb, 0x72, 0x9d, 0x18, 0xbc, 0x35, 0x23, 0x99, 0xab, 0xd2, 0x8d, 0x71, 0x38, 0xac, 0x2b, 0xbf,
+	0x77, 0xb3, 0x23, 0x12, 0x8d, 0x19, 0x4a, 0x14, 0xb6, 0xfa, 0x48, 0x60, 0xc0, 0x9f, 0x00, 0x40,
+	0x43, 0x1c, 0x84, 0x3a, 0xbb, 0x85, 0x78, 0xa9, 0xcf, 0x6f, 0x95, 0xae, 0xa4, 0x50, 0x33, 0xba,
+	0xa2, 0x6a, 0xb7, 0x2f, 0x97, 0xe7, 0x50, 0x57, 0xfb, 0x8c, 0x25, 0x56, 0x96, 0x13, 0x98, 0x38,
+	0x44, 0x20, 0x43, 0x5c, 0x53, 0xe0, 0x66, 0x26, 0xe2, 0xbe, 0x25, 0x71, 0xf3, 0x02, 0x37, 0xd2,
+	0x14, 0xa8, 0x73, 0xc4, 0x35, 0x39, 0x66, 0x19, 0x80, 0x28, 0xd0, 0x44, 0x14, 0x7c, 0x06, 0xc5,
+	0x28, 0xf0, 0x29, 0x58, 0xb3, 0x31, 0x0d, 0x75, 0xd3, 0xa2, 0x61, 0x60, 0xb5, 0x3a, 0xfc, 0x90,
+	0xb8, 0x05, 0x60, 0xa2, 0x05, 0xef, 0xf4, 0x7b, 0xea, 0x6d, 0xd9, 0x74, 0xc6, 0x62, 0x08, 0x5b,
+	0x56, 0x18, 0xb3, 0x1e, 0xe3, 0x71, 0xc3, 0x7e, 0xad, 0x80, 0xa5, 0x81, 0x02, 0x31, 0xf9, 0x39,
+	0xd1, 0xe2, 0xfc, 0xa4, 0x0b, 0xf8, 0x50, 0x7a, 0x5d, 0x94, 0x17, 0xce, 0x28, 0xc2, 0x74, 0x17,
+	0x6f, 0x21, 0xa6, 0xcf, 0x29, 0xf0, 0x14, 0x2c, 0x71, 0x5f, 0xe8, 0x99, 0xe5, 0xfb, 0x44, 0x1e,
+	0xc6, 0xc2, 0xc4, 0x50, 0x54, 0x86, 0x26, 0x5d, 0x51, 0x17, 0x51, 0xc8, 0x33, 0xfa, 0x91, 0x20,
+	0x33, 0xbd, 0xed, 0x45, 0xd6, 0xb6, 0xfe, 0xf6, 0xc7, 0xbb, 0xb3, 0xac, 0x50, 0x0f, 0xb4, 0x7f,
+	0x2b, 0x20, 0xbf, 0x6f, 0x9d, 0x13, 0x73, 0xc7, 0xf1, 0x3a, 0x6e, 0xc8, 0x88, 0xf0, 0x23, 0x90,
+	0x65, 0x11, 0xe0, 0xe3, 0x85, 0x6c, 0x56, 0xd7, 0x96, 0x7b, 0xd4, 0x42, 0x6a, 0xc5, 0x97, 0x3d,
+	0x55, 0xe9, 0xf7, 0xd4, 0x82, 0x30, 0x67, 0x00, 0xa0, 0xa1, 0x4c, 0x2b, 0x6a, 0x33, 0xbf, 0x50,
+	0xc0, 0x82, 0x68, 0x90, 0x98, 0xef, 0x56, 0x4c, 0x4e, 0x8a, 0xfb, 0x03, 0x19, 0xf7, 0x65, 0x99,
+	0x6d, 0x31, 0xe5, 0xe9, 0x42, 0x3e, 0xcf, 0x55, 0x85, 0x93, 0xdb, 0x29, 0x16, 0x03, 0xed, 0xaf,
+	0x0a, 0xc8, 0x22, 0xd6, 0x08, 0xfe, 0xb7, 0x4e, 0x13, 0x20, 0xf6, 0xd6, 0x79, 0x23, 0x97, 0x9d,
+	0xbb, 0x3e, 0x5d, 0xe7, 0xee, 0xf7, 0x54, 0x18, 0x8f, 0x00, 0x87, 0xd2, 0x10, 0xe0, 0x2b, 0xee,
+	0x83, 0xf4, 0xe9, 0xcf, 0x29, 0xb0, 0x50, 0x27, 0x06, 0xee, 0xb2, 0x9e, 0xf9, 0x4d, 0x38, 0x4b,
+	0x78, 0x0a, 0x16, 0x4c, 0xe6, 0xb0, 0x7e, 0x82, 0x63, 0x33, 0xea, 0xde, 0xd4, 0xf1, 0x5d, 0x8e,
+	0x46, 0xc9, 0x21, 0x96, 0x86, 0xe6, 0xf9, 0x72, 0x9f, 0xaf, 0xe0, 0x76, 0xb4, 0x93, 0x1c, 0xb7,
+	0x52, 0x7c, 0x9a, 0xb8, 0x35, 0xaa, 0x1b, 0xcd, 0x59, 0x42, 0x57, 0x8e, 0x58, 0x3f, 0x05, 0x62,
+	0xc9, 0x2b, 0x93, 0xdd, 0x55, 0x33, 0x13, 0x2a, 0xbb, 0x2c, 0x83, 0x05, 0xe3, 0xd0, 0x5c, 0x59,
+	0xd4, 0x35, 0xe0, 0x14, 0x2e, 0x0f, 0xbf, 0x0f, 0x72, 0xc4, 0xc6, 0x3e, 0x25, 0x66, 0x64, 0x5a,
+	0x9a, 0xcf, 0xd3, 0xeb, 0xfd, 0x9e, 0xba, 0x2a, 0x83, 0x7d, 0x89, 0xaf, 0xa1, 0x45, 0x49, 0x10,
+	0xe6, 0xc9, 0xe4, 0xf9, 0x8d, 0x02, 0xe6, 0xe4, 0x8c, 0x03, 0xf7, 0x41, 0x5a, 0x9e, 0xab, 0x32,
+	0xf5, 0xa8, 0x71, 0xe0, 0x86, 0x48, 0x6a, 0x33, 0xdb, 0xf8, 0x4d, 0xc3, 0xee, 0x44, 0xbe, 0x79,
+	0x31, 0x39, 0x6a, 0xdb, 0x65, 0xbe, 0x86, 0x16, 0x23, 0x02, 0x37, 0x4e, 0xda, 0xf6, 0x33, 0xb0,
+	0xf8, 0x61, 0x87, 0x74, 0x88, 0xf9, 0x86, 0x0d, 0x1c, 0xc2, 0x37, 0xbd, 0x10, 0xdb, 0x12, 0x9d,
+	0xbe, 0x61, 0xf8, 0xbf, 0xcc, 0x80, 0x45, 0x36, 0x0a, 0x0e, 0xcd, 0x1f, 0x9d, 0xb8, 0xd6, 0x40,
+	0x9a, 0x95, 0x63, 0x34, 0xda, 0x21, 0xb9, 0x82, 0x3f, 0x04, 0xf0, 0xd2, 0x48, 0xc1, 0x27, 0x53,
+	0x99, 0xe4, 0xb1, 0xb7, 0xca, 0x55, 0x19, 0x0d, 0x15, 0x62, 0x53, 0x04, 0x9f, 0x59, 0x63, 0x4e,
+	0xa5, 0x5e, 0xeb, 0x50, 0x2f, 0xcf, 0xa2, 0xb3, 0xaf, 0x3b, 0x8b, 0x8e, 0x49, 0x92, 0xf4, 0x74,
+	0x49, 0xc2, 0xea, 0xab, 0xe3, 0xca, 0x27, 0xc8, 0x8d, 0xc6, 0xa3, 0x91, 0xfa, 0x8a, 0x29, 0xcb,
+	0xfa, 0x12, 0x14, 0x7e, 0x65, 0x8a, 0x33, 0xfc, 0xbb, 0x02, 0x96, 0x79, 0x8e, 0x5c, 0x3a, 0xc8,
+	0x37, 0x96, 0x29, 0xf0, 0x31, 0xc8, 0x8b, 0x79, 0x91, 0x98, 0xc3, 0x96, 0xca, 0x00, 0x1f, 0x4e,
+	0xdd, 0xcb, 0xd6, 0x84, 0x53, 0x23, 0x70, 0x1a, 0xca, 0x45, 0x94, 0x4b, 0xf7, 0xe0, 0x9f, 0x92,
+	0x20, 0x7b, 0x1c, 0xbd, 0xa5, 0xfe, 0xbf, 0x13, 0xb3, 0x0d, 0xf2, 0x86, 0xe7, 0xf8, 0x36, 0x19,
+	0xce, 0x93, 0xb3, 0x13, 0x53, 0x41, 0x93, 0xa9, 0x20, 0xa3, 0x36, 0x02, 0x20, 0xd2, 0x21, 0x37,
+	0xa4, 0xc6, 0x52, 0xe2, 0x22, 0x09, 0x72, 0x75, 0xe2, 0x7b, 0xd4, 0x0a, 0x11, 0x79, 0xdc, 0x21,
+	0x34, 0xbc, 0x71, 0xf8, 0xde, 0x03, 0x73, 0xfc, 0xa5, 0x63, 0x99, 0x3c, 0x66, 0xa9, 0x1a, 0xec,
+	0xf7, 0xd4, 0x9c, 0xfc, 0xf0, 0x20, 0x18, 0x1a, 0x4a, 0xb3, 0x7f, 0x07, 0x26, 0xbc, 0x07, 0xb2,
+	0x0e, 0x6d, 0xeb, 0x96, 0x6b, 0x92, 0x73, 0xf9, 0xad, 0x64, 0x65, 0x78, 0x3d, 0x0f, 0x58, 0x1a,
+	0xca, 0x38, 0xb4, 0x7d, 0xc0, 0xfe, 0xc2, 0x67, 0x0a, 0x58, 0x34, 0x85, 0x69, 0x72, 0xc6, 0x9d,
+	0x9d, 0x74, 0x3f, 0x3f, 0x94, 0x71, 0x58, 0x89, 0xae, 0x9c, 0x98, 0xf6, 0x74, 0x17, 0xf4, 0x82,
+	0xd4, 0xe5, 0x2b, 0xf8, 0x5d, 0x30, 0xdf, 0xe2, 0x1f, 0x21, 0x84, 0xfd, 0xa2, 0xb4, 0xd7, 0x86,
+	0xb5, 0x17, 0x63, 0x6a, 0x08, 0xf0, 0x15, 0xf7, 0x41, 0x06, 0xf9, 0x97, 0x49, 0xb0, 0xf4, 0xd0,
+	0xa2, 0xa1, 0x17, 0x58, 0x06, 0xb6, 0x11, 0x79, 0x8a, 0x03, 0x93, 0xc2, 0x3f, 0x28, 0xe0, 0x96,
+	0xd1, 0x71, 0x3a, 0x36, 0x0e, 0xad, 0x27, 0x44, 0xef, 0xb8, 0x56, 0xa8, 0x07, 0x82, 0x57, 0x54,
+	0x6e, 0xf0, 0x6c, 0x3b, 0x96, 0xce, 0xca, 0x2f, 0x48, 0xd7, 0x40, 0x4d, 0xfd, 0x72, 0x5b, 0x1d,
+	0x02, 0x1d, 0xbb, 0x2c, 0x2b, 0x84, 0xb5, 0xbb, 0x20, 0x1f, 0x90, 0x13, 0x12, 0x10, 0xd7, 0x60,
+	0x1f, 0xca, 0xa2, 0xda, 0x5e, 0xac, 0x95, 0x86, 0x79, 0x37, 0x22, 0xa0, 0xa1, 0xdc, 0x80, 0xb2,
+	0x1b, 0xab, 0xd6, 0x4f, 0x15, 0x00, 0x3f, 0xe8, 0x84, 0x34, 0xc4, 0xbc, 0x5e, 0xa3, 0x1d, 0xce,
+	0xc0, 0xdc, 0x34, 0xee, 0xdf, 0x67, 0xee, 0x4f, 0xeb, 0x5c, 0xb4, 0x83, 0xb0, 0xe4, 0xce, 0xaf,
+	0x14, 0x90, 0x89, 0x5e, 0xfb, 0xf0, 0x0e, 0x58, 0x6d, 0x1c, 0xee, 0x3c, 0xd2, 0x9b, 0x1f, 0x37,
+	0xf6, 0xf4, 0xe3, 0x47, 0x47, 0x8d, 0xbd, 0xdd, 0x83, 0xfd, 0x83, 0xbd, 0x7a, 0x21, 0x51, 0xca,
+	0x3f, 0x7f, 0x51, 0x99, 0x8f, 0x04, 0x1f, 0x59, 0x36, 0xdc, 0x00, 0x85, 0xa1, 0x6c, 0xe3, 0xb8,
+	0x76, 0x78, 0xb0, 0x5b, 0x50, 0x4a, 0xf0, 0xf9, 0x8b, 0x4a, 0x2e, 0x12, 0x6b, 0x74, 0x5a, 0xb6,
+	0x65, 0xc0, 0x3b, 0x60, 0x29, 0x26, 0x89, 0x0e, 0x7e, 0xbc, 0xd3, 0xdc, 0x2b, 0x24, 0x4b, 0xcb,
+	0xcf, 0x5f, 0x54, 0xf2, 0x03, 0x51, 0xf1, 0x11, 0xb3, 0x94, 0x7a, 0xf6, 0xfb, 0x72, 0xe2, 0x4e,
+	0x17, 0xcc, 0xcb, 0x67, 0x3d, 0x37, 0xeb, 0x1e, 0x58, 0xdd, 0xa9, 0xd7, 0xd1, 0xde, 0xd1, 0x91,
+	0xc0, 0xb8, 0xbf, 0xa5, 0xd7, 0x3e, 0x6e, 0xee, 0x1d, 0x15, 0x12, 0xa5, 0xb5, 0xe7, 0x2f, 0x2a,
+	0x30, 0x26, 0x7b, 0x7f, 0xab, 0xd6, 0x0d, 0x09, 0xbd, 0xa2, 0xb2, 0xf5, 0xbe, 0x54, 0x51, 0xae,
+	0xa8, 0x6c, 0xbd, 0xcf, 0x55, 0xc4, 0xd6, 0xb5, 0x07, 0x5f, 0x5d, 0x94, 0x95, 0x97, 0x17, 0x65,
+	0xe5, 0x5f, 0x17, 0x65, 0xe5, 0xb3, 0x57, 0xe5, 0xc4, 0xcb, 0x57, 0xe5, 0xc4, 0x3f, 0x5e, 0x95,
+	0x13, 0x9f, 0xdc, 0x8d, 0x45, 0x79, 0xcc, 0x77, 0xee, 0xf3, 0xc1, 0x3f, 0x1e, 0xf0, 0x56, 0x9a,
+	0x37, 0xa9, 0xfb, 0xff, 0x1d, 0x00, 0xcf, 0xf1, 0xca, 0xcd, 0x14, 0x17, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -597,6 +953,56 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxThirdPartyStakingDenoms != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.MaxThirdPartyStakingDenoms))
+		i--
+		dAtA[i] = 0x50
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintFarming(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.LockTiers) > 0 {
+		for iNdEx := len(m.LockTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.MaxCatchUpEpochs != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.MaxCatchUpEpochs))
+		i--
+		dAtA[i] = 0x38
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.NextEpochDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.NextEpochDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintFarming(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	if m.PartialAllocation {
+		i--
+		if m.PartialAllocation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.DelayedStakingGasFee != 0 {
 		i = encodeVarintFarming(dAtA, i, uint64(m.DelayedStakingGasFee))
 		i--
@@ -609,11 +1015,6 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.NextEpochDays != 0 {
-		i = encodeVarintFarming(dAtA, i, uint64(m.NextEpochDays))
-		i--
-		dAtA[i] = 0x10
-	}
 	if len(m.PrivatePlanCreationFee) > 0 {
 		for iNdEx := len(m.PrivatePlanCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -631,6 +1032,47 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LockTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintFarming(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *BasePlan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -651,6 +1093,16 @@ func (m *BasePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastSkippedTime != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastSkippedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSkippedTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintFarming(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.DistributedCoins) > 0 {
 		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -666,12 +1118,12 @@ func (m *BasePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.LastDistributionTime != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDistributionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDistributionTime):])
-		if err1 != nil {
-			return 0, err1
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDistributionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDistributionTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n1
-		i = encodeVarintFarming(dAtA, i, uint64(n1))
+		i -= n5
+		i = encodeVarintFarming(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -685,20 +1137,20 @@ func (m *BasePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x48
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err2 != nil {
-		return 0, err2
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n2
-	i = encodeVarintFarming(dAtA, i, uint64(n2))
+	i -= n6
+	i = encodeVarintFarming(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x42
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err3 != nil {
-		return 0, err3
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n3
-	i = encodeVarintFarming(dAtA, i, uint64(n3))
+	i -= n7
+	i = encodeVarintFarming(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x3a
 	if len(m.StakingCoinWeights) > 0 {
@@ -843,7 +1295,7 @@ func (m *RatioPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Staking) Marshal() (dAtA []byte, err error) {
+func (m *DecayingPlan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -853,42 +1305,123 @@ func (m *Staking) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Staking) MarshalTo(dAtA []byte) (int, error) {
+func (m *DecayingPlan) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Staking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DecayingPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.StartingEpoch != 0 {
-		i = encodeVarintFarming(dAtA, i, uint64(m.StartingEpoch))
+	if m.ElapsedEpochs != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.ElapsedEpochs))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x30
+	}
+	if len(m.DecayTimes) > 0 {
+		for iNdEx := len(m.DecayTimes) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DecayTimes[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DecayTimes[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintFarming(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.DecayEpochs != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.DecayEpochs))
+		i--
+		dAtA[i] = 0x20
 	}
 	{
-		size := m.Amount.Size()
+		size := m.DecayFactor.Size()
 		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.DecayFactor.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintFarming(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *QueuedStaking) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	dAtA[i] = 0x1a
+	if len(m.EpochAmount) > 0 {
+		for iNdEx := len(m.EpochAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BasePlan != nil {
+		{
+			size, err := m.BasePlan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFarming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Staking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Staking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Staking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartingEpoch != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.StartingEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedStaking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
 func (m *QueuedStaking) MarshalTo(dAtA []byte) (int, error) {
@@ -947,7 +1480,7 @@ func (m *TotalStakings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *HistoricalRewards) Marshal() (dAtA []byte, err error) {
+func (m *LockedStaking) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -957,34 +1490,72 @@ func (m *HistoricalRewards) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HistoricalRewards) MarshalTo(dAtA []byte) (int, error) {
+func (m *LockedStaking) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HistoricalRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LockedStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CumulativeUnitRewards) > 0 {
-		for iNdEx := len(m.CumulativeUnitRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CumulativeUnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFarming(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnlockTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintFarming(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x3a
+	if m.StartingEpoch != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.StartingEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintFarming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintFarming(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintFarming(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OutstandingRewards) Marshal() (dAtA []byte, err error) {
+func (m *TotalLockedStakings) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -994,96 +1565,334 @@ func (m *OutstandingRewards) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OutstandingRewards) MarshalTo(dAtA []byte) (int, error) {
+func (m *TotalLockedStakings) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OutstandingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TotalLockedStakings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFarming(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size := m.WeightedAmount.Size()
+		i -= size
+		if _, err := m.WeightedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintFarming(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintFarming(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFarming(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Unbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Unbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Unbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PrivatePlanCreationFee) > 0 {
-		for _, e := range m.PrivatePlanCreationFee {
-			l = e.Size()
-			n += 1 + l + sovFarming(uint64(l))
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintFarming(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintFarming(dAtA, i, uint64(size))
 	}
-	if m.NextEpochDays != 0 {
-		n += 1 + sovFarming(uint64(m.NextEpochDays))
+	i--
+	dAtA[i] = 0x22
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintFarming(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.FarmingFeeCollector)
-	if l > 0 {
-		n += 1 + l + sovFarming(uint64(l))
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintFarming(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.DelayedStakingGasFee != 0 {
-		n += 1 + sovFarming(uint64(m.DelayedStakingGasFee))
+	if m.Id != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BasePlan) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovFarming(uint64(m.Id))
+	if m.BatchIndex != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.BatchIndex))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovFarming(uint64(l))
+	if len(m.DepositCoins) > 0 {
+		for iNdEx := len(m.DepositCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.Type != 0 {
-		n += 1 + sovFarming(uint64(m.Type))
+	if m.MsgIndex != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.FarmingPoolAddress)
-	if l > 0 {
-		n += 1 + l + sovFarming(uint64(l))
+	if m.PoolId != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.TerminationAddress)
-	if l > 0 {
-		n += 1 + l + sovFarming(uint64(l))
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintFarming(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.StakingCoinWeights) > 0 {
-		for _, e := range m.StakingCoinWeights {
-			l = e.Size()
+	if m.Id != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoricalRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReferenceCount != 0 {
+		i = encodeVarintFarming(dAtA, i, uint64(m.ReferenceCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CumulativeUnitRewards) > 0 {
+		for iNdEx := len(m.CumulativeUnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeUnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutstandingRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutstandingRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutstandingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFarming(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFarming(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PrivatePlanCreationFee) > 0 {
+		for _, e := range m.PrivatePlanCreationFee {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	l = len(m.FarmingFeeCollector)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	if m.DelayedStakingGasFee != 0 {
+		n += 1 + sovFarming(uint64(m.DelayedStakingGasFee))
+	}
+	if m.PartialAllocation {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.NextEpochDuration)
+	n += 1 + l + sovFarming(uint64(l))
+	if m.MaxCatchUpEpochs != 0 {
+		n += 1 + sovFarming(uint64(m.MaxCatchUpEpochs))
+	}
+	if len(m.LockTiers) > 0 {
+		for _, e := range m.LockTiers {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingPeriod)
+	n += 1 + l + sovFarming(uint64(l))
+	if m.MaxThirdPartyStakingDenoms != 0 {
+		n += 1 + sovFarming(uint64(m.MaxThirdPartyStakingDenoms))
+	}
+	return n
+}
+
+func (m *LockTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovFarming(uint64(l))
+	l = m.Multiplier.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	return n
+}
+
+func (m *BasePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovFarming(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovFarming(uint64(m.Type))
+	}
+	l = len(m.FarmingPoolAddress)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	l = len(m.TerminationAddress)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	if len(m.StakingCoinWeights) > 0 {
+		for _, e := range m.StakingCoinWeights {
+			l = e.Size()
 			n += 1 + l + sovFarming(uint64(l))
 		}
 	}
@@ -1104,6 +1913,10 @@ func (m *BasePlan) Size() (n int) {
 			n += 1 + l + sovFarming(uint64(l))
 		}
 	}
+	if m.LastSkippedTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSkippedTime)
+		n += 1 + l + sovFarming(uint64(l))
+	}
 	return n
 }
 
@@ -1141,6 +1954,39 @@ func (m *RatioPlan) Size() (n int) {
 	return n
 }
 
+func (m *DecayingPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BasePlan != nil {
+		l = m.BasePlan.Size()
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	if len(m.EpochAmount) > 0 {
+		for _, e := range m.EpochAmount {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	l = m.DecayFactor.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	if m.DecayEpochs != 0 {
+		n += 1 + sovFarming(uint64(m.DecayEpochs))
+	}
+	if len(m.DecayTimes) > 0 {
+		for _, e := range m.DecayTimes {
+			l = github_com_gogo_protobuf_types.SizeOfStdTime(e)
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	if m.ElapsedEpochs != 0 {
+		n += 1 + sovFarming(uint64(m.ElapsedEpochs))
+	}
+	return n
+}
+
 func (m *Staking) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1177,43 +2023,1203 @@ func (m *TotalStakings) Size() (n int) {
 	return n
 }
 
-func (m *HistoricalRewards) Size() (n int) {
+func (m *LockedStaking) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.CumulativeUnitRewards) > 0 {
-		for _, e := range m.CumulativeUnitRewards {
-			l = e.Size()
-			n += 1 + l + sovFarming(uint64(l))
+	if m.Id != 0 {
+		n += 1 + sovFarming(uint64(m.Id))
+	}
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	l = m.Multiplier.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	if m.StartingEpoch != 0 {
+		n += 1 + sovFarming(uint64(m.StartingEpoch))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnlockTime)
+	n += 1 + l + sovFarming(uint64(l))
+	return n
+}
+
+func (m *TotalLockedStakings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	l = m.WeightedAmount.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	return n
+}
+
+func (m *Unbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovFarming(uint64(m.Id))
+	}
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovFarming(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovFarming(uint64(l))
+	return n
+}
+
+func (m *DepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovFarming(uint64(m.Id))
+	}
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovFarming(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovFarming(uint64(m.PoolId))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovFarming(uint64(m.MsgIndex))
+	}
+	if len(m.DepositCoins) > 0 {
+		for _, e := range m.DepositCoins {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	if m.BatchIndex != 0 {
+		n += 1 + sovFarming(uint64(m.BatchIndex))
+	}
+	return n
+}
+
+func (m *HistoricalRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CumulativeUnitRewards) > 0 {
+		for _, e := range m.CumulativeUnitRewards {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	if m.ReferenceCount != 0 {
+		n += 1 + sovFarming(uint64(m.ReferenceCount))
+	}
+	return n
+}
+
+func (m *OutstandingRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovFarming(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFarming(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFarming(x uint64) (n int) {
+	return sovFarming(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivatePlanCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrivatePlanCreationFee = append(m.PrivatePlanCreationFee, types.Coin{})
+			if err := m.PrivatePlanCreationFee[len(m.PrivatePlanCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingFeeCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FarmingFeeCollector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedStakingGasFee", wireType)
+			}
+			m.DelayedStakingGasFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayedStakingGasFee |= github_com_cosmos_cosmos_sdk_types.Gas(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialAllocation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PartialAllocation = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.NextEpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCatchUpEpochs", wireType)
+			}
+			m.MaxCatchUpEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCatchUpEpochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockTiers = append(m.LockTiers, LockTier{})
+			if err := m.LockTiers[len(m.LockTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxThirdPartyStakingDenoms", wireType)
+			}
+			m.MaxThirdPartyStakingDenoms = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxThirdPartyStakingDenoms |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BasePlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasePlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PlanType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FarmingPoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TerminationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinWeights = append(m.StakingCoinWeights, types.DecCoin{})
+			if err := m.StakingCoinWeights[len(m.StakingCoinWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Terminated = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDistributionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastDistributionTime == nil {
+				m.LastDistributionTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastDistributionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedCoins = append(m.DistributedCoins, types.Coin{})
+			if err := m.DistributedCoins[len(m.DistributedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSkippedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastSkippedTime == nil {
+				m.LastSkippedTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastSkippedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FixedAmountPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FixedAmountPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FixedAmountPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePlan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BasePlan == nil {
+				m.BasePlan = &BasePlan{}
+			}
+			if err := m.BasePlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochAmount = append(m.EpochAmount, types.Coin{})
+			if err := m.EpochAmount[len(m.EpochAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RatioPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RatioPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RatioPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePlan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BasePlan == nil {
+				m.BasePlan = &BasePlan{}
+			}
+			if err := m.BasePlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *OutstandingRewards) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovFarming(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovFarming(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFarming(x uint64) (n int) {
-	return sovFarming(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DecayingPlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1236,15 +3242,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecayingPlan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecayingPlan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrivatePlanCreationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePlan", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1271,16 +3277,18 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrivatePlanCreationFee = append(m.PrivatePlanCreationFee, types.Coin{})
-			if err := m.PrivatePlanCreationFee[len(m.PrivatePlanCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BasePlan == nil {
+				m.BasePlan = &BasePlan{}
+			}
+			if err := m.BasePlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochDays", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochAmount", wireType)
 			}
-			m.NextEpochDays = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1290,14 +3298,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextEpochDays |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochAmount = append(m.EpochAmount, types.Coin{})
+			if err := m.EpochAmount[len(m.EpochAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FarmingFeeCollector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayFactor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1325,13 +3348,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FarmingFeeCollector = string(dAtA[iNdEx:postIndex])
+			if err := m.DecayFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelayedStakingGasFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayEpochs", wireType)
 			}
-			m.DelayedStakingGasFee = 0
+			m.DecayEpochs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1341,7 +3366,60 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DelayedStakingGasFee |= github_com_cosmos_cosmos_sdk_types.Gas(b&0x7F) << shift
+				m.DecayEpochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecayTimes = append(m.DecayTimes, time.Time{})
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&(m.DecayTimes[len(m.DecayTimes)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElapsedEpochs", wireType)
+			}
+			m.ElapsedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElapsedEpochs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1367,7 +3445,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BasePlan) Unmarshal(dAtA []byte) error {
+func (m *Staking) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1390,34 +3468,15 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BasePlan: wiretype end group for non-group")
+			return fmt.Errorf("proto: Staking: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BasePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Staking: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFarming
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1445,13 +3504,15 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingEpoch", wireType)
 			}
-			m.Type = 0
+			m.StartingEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1461,14 +3522,64 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= PlanType(b&0x7F) << shift
+				m.StartingEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedStaking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedStaking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedStaking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1496,11 +3607,63 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FarmingPoolAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalStakings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalStakings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalStakings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TerminationAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1528,13 +3691,65 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TerminationAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinWeights", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockedStaking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockedStaking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockedStaking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1544,31 +3759,16 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFarming
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFarming
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakingCoinWeights = append(m.StakingCoinWeights, types.DecCoin{})
-			if err := m.StakingCoinWeights[len(m.StakingCoinWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1578,30 +3778,29 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFarming
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFarming
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1611,50 +3810,29 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFarming
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFarming
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFarming
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Terminated = bool(v != 0)
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDistributionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1664,33 +3842,31 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFarming
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFarming
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastDistributionTime == nil {
-				m.LastDistributionTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastDistributionTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1700,81 +3876,31 @@ func (m *BasePlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFarming
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFarming
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DistributedCoins = append(m.DistributedCoins, types.Coin{})
-			if err := m.DistributedCoins[len(m.DistributedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFarming(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthFarming
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FixedAmountPlan) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFarming
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FixedAmountPlan: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FixedAmountPlan: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasePlan", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingEpoch", wireType)
 			}
-			var msglen int
+			m.StartingEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1784,31 +3910,14 @@ func (m *FixedAmountPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StartingEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFarming
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFarming
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BasePlan == nil {
-				m.BasePlan = &BasePlan{}
-			}
-			if err := m.BasePlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1835,8 +3944,7 @@ func (m *FixedAmountPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EpochAmount = append(m.EpochAmount, types.Coin{})
-			if err := m.EpochAmount[len(m.EpochAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1861,7 +3969,7 @@ func (m *FixedAmountPlan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RatioPlan) Unmarshal(dAtA []byte) error {
+func (m *TotalLockedStakings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1884,17 +3992,17 @@ func (m *RatioPlan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RatioPlan: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalLockedStakings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RatioPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalLockedStakings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasePlan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -1904,31 +4012,29 @@ func (m *RatioPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFarming
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFarming
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BasePlan == nil {
-				m.BasePlan = &BasePlan{}
-			}
-			if err := m.BasePlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1956,7 +4062,7 @@ func (m *RatioPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EpochRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WeightedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1981,7 +4087,7 @@ func (m *RatioPlan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Staking) Unmarshal(dAtA []byte) error {
+func (m *Unbonding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2004,15 +4110,34 @@ func (m *Staking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Staking: wiretype end group for non-group")
+			return fmt.Errorf("proto: Unbonding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Staking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Unbonding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2040,15 +4165,13 @@ func (m *Staking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingEpoch", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
 			}
-			m.StartingEpoch = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarming
@@ -2058,62 +4181,25 @@ func (m *Staking) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartingEpoch |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFarming(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFarming
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueuedStaking) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFarming
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueuedStaking: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueuedStaking: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -2147,6 +4233,39 @@ func (m *QueuedStaking) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFarming(dAtA[iNdEx:])
@@ -2168,7 +4287,7 @@ func (m *QueuedStaking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalStakings) Unmarshal(dAtA []byte) error {
+func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2191,15 +4310,34 @@ func (m *TotalStakings) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalStakings: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepositRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalStakings: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2227,10 +4365,99 @@ func (m *TotalStakings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositCoins = append(m.DepositCoins, types.Coin{})
+			if err := m.DepositCoins[len(m.DepositCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchIndex", wireType)
+			}
+			m.BatchIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFarming(dAtA[iNdEx:])
@@ -2315,6 +4542,25 @@ func (m *HistoricalRewards) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
+			}
+			m.ReferenceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferenceCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFarming(dAtA[iNdEx:])
@@ -12,20 +12,29 @@ func NewGenesisState(
 	params Params, plans []PlanRecord, stakings []StakingRecord, queuedStakings []QueuedStakingRecord, totalStakings []TotalStakingsRecord,
 	historicalRewards []HistoricalRewardsRecord, outstandingRewards []OutstandingRewardsRecord,
 	currentEpochs []CurrentEpochRecord, rewardPoolCoins sdk.Coins,
-	lastEpochTime *time.Time, currentEpochDays uint32,
+	lastEpochTime *time.Time, currentEpochDuration time.Duration, globalEpoch uint64, lockedStakings []LockedStaking,
+	unbondings []Unbonding, rewardsWithdrawAddresses []RewardsWithdrawAddressRecord, depositRequests []DepositRequest,
+	allowedStakingDenoms []string, queuedLockedStakings []LockedStaking,
 ) *GenesisState {
 	return &GenesisState{
-		Params:                    params,
-		PlanRecords:               plans,
-		StakingRecords:            stakings,
-		QueuedStakingRecords:      queuedStakings,
-		TotalStakingsRecords:      totalStakings,
-		HistoricalRewardsRecords:  historicalRewards,
-		OutstandingRewardsRecords: outstandingRewards,
-		CurrentEpochRecords:       currentEpochs,
-		RewardPoolCoins:           rewardPoolCoins,
-		LastEpochTime:             lastEpochTime,
-		CurrentEpochDays:          currentEpochDays,
+		Params:                        params,
+		PlanRecords:                   plans,
+		StakingRecords:                stakings,
+		QueuedStakingRecords:          queuedStakings,
+		TotalStakingsRecords:          totalStakings,
+		HistoricalRewardsRecords:      historicalRewards,
+		OutstandingRewardsRecords:     outstandingRewards,
+		CurrentEpochRecords:           currentEpochs,
+		RewardPoolCoins:               rewardPoolCoins,
+		LastEpochTime:                 lastEpochTime,
+		CurrentEpochDuration:          currentEpochDuration,
+		GlobalEpoch:                   globalEpoch,
+		LockedStakings:                lockedStakings,
+		Unbondings:                    unbondings,
+		RewardsWithdrawAddressRecords: rewardsWithdrawAddresses,
+		DepositRequests:               depositRequests,
+		AllowedStakingDenoms:          allowedStakingDenoms,
+		QueuedLockedStakings:          queuedLockedStakings,
 	}
 }
 
@@ -42,7 +51,14 @@ func DefaultGenesisState() *GenesisState {
 		[]CurrentEpochRecord{},
 		sdk.Coins{},
 		nil,
-		DefaultCurrentEpochDays,
+		DefaultCurrentEpochDuration,
+		0,
+		[]LockedStaking{},
+		[]Unbonding{},
+		[]RewardsWithdrawAddressRecord{},
+		[]DepositRequest{},
+		[]string{},
+		[]LockedStaking{},
 	)
 }
 
@@ -107,12 +123,81 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	id = 0
+	lockedStakingIds := map[uint64]bool{}
+	for _, lockedStaking := range data.LockedStakings {
+		if err := lockedStaking.Validate(); err != nil {
+			return err
+		}
+		if lockedStaking.Id <= id {
+			return fmt.Errorf("locked stakings must be sorted by id without duplicates")
+		}
+		id = lockedStaking.Id
+		lockedStakingIds[id] = true
+	}
+
+	id = 0
+	for _, lockedStaking := range data.QueuedLockedStakings {
+		if err := lockedStaking.Validate(); err != nil {
+			return err
+		}
+		if lockedStaking.Id <= id {
+			return fmt.Errorf("queued locked stakings must be sorted by id without duplicates")
+		}
+		if lockedStakingIds[lockedStaking.Id] {
+			return fmt.Errorf("queued locked staking %d has the same id as a locked staking", lockedStaking.Id)
+		}
+		id = lockedStaking.Id
+	}
+
+	id = 0
+	for _, unbonding := range data.Unbondings {
+		if err := unbonding.Validate(); err != nil {
+			return err
+		}
+		if unbonding.Id <= id {
+			return fmt.Errorf("unbondings must be sorted by id without duplicates")
+		}
+		id = unbonding.Id
+	}
+
+	farmers := map[string]bool{}
+	for _, record := range data.RewardsWithdrawAddressRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if farmers[record.Farmer] {
+			return fmt.Errorf("duplicate rewards withdraw address record for farmer %s", record.Farmer)
+		}
+		farmers[record.Farmer] = true
+	}
+
+	id = 0
+	for _, req := range data.DepositRequests {
+		if err := req.Validate(); err != nil {
+			return err
+		}
+		if req.Id <= id {
+			return fmt.Errorf("deposit requests must be sorted by id without duplicates")
+		}
+		id = req.Id
+	}
+
+	for i, denom := range data.AllowedStakingDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if i > 0 && denom <= data.AllowedStakingDenoms[i-1] {
+			return fmt.Errorf("allowed staking denoms must be sorted without duplicates")
+		}
+	}
+
 	if err := data.RewardPoolCoins.Validate(); err != nil {
 		return err
 	}
 
-	if data.CurrentEpochDays == 0 {
-		return fmt.Errorf("current epoch days must be positive")
+	if data.CurrentEpochDuration <= 0 {
+		return fmt.Errorf("current epoch duration must be positive: %s", data.CurrentEpochDuration)
 	}
 
 	return nil
@@ -183,6 +268,9 @@ func (record HistoricalRewardsRecord) Validate() error {
 	if err := record.HistoricalRewards.CumulativeUnitRewards.Validate(); err != nil {
 		return err
 	}
+	if record.HistoricalRewards.ReferenceCount == 0 {
+		return fmt.Errorf("historical rewards reference count must be positive")
+	}
 	return nil
 }
 
@@ -204,3 +292,14 @@ func (record CurrentEpochRecord) Validate() error {
 	}
 	return nil
 }
+
+// Validate validates RewardsWithdrawAddressRecord.
+func (record RewardsWithdrawAddressRecord) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(record.Farmer); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(record.WithdrawAddress); err != nil {
+		return err
+	}
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -48,8 +49,24 @@ type GenesisState struct {
 	RewardPoolCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=reward_pool_coins,json=rewardPoolCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_pool_coins" yaml:"reward_pool_coins"`
 	// last_epoch_time specifies the last executed epoch time of the plans
 	LastEpochTime *time.Time `protobuf:"bytes,10,opt,name=last_epoch_time,json=lastEpochTime,proto3,stdtime" json:"last_epoch_time,omitempty" yaml:"last_epoch_time"`
-	// current_epoch_days specifies the epoch used when allocating farming rewards in end blocker
-	CurrentEpochDays uint32 `protobuf:"varint,11,opt,name=current_epoch_days,json=currentEpochDays,proto3" json:"current_epoch_days,omitempty"`
+	// current_epoch_duration specifies the epoch length used when allocating farming rewards in end blocker
+	CurrentEpochDuration time.Duration `protobuf:"bytes,12,opt,name=current_epoch_duration,json=currentEpochDuration,proto3,stdduration" json:"current_epoch_duration" yaml:"current_epoch_duration"`
+	// global_epoch specifies the number of epochs that have ended so far
+	GlobalEpoch uint64 `protobuf:"varint,13,opt,name=global_epoch,json=globalEpoch,proto3" json:"global_epoch,omitempty" yaml:"global_epoch"`
+	// locked_stakings specifies the locked stakings, sorted by id
+	LockedStakings []LockedStaking `protobuf:"bytes,14,rep,name=locked_stakings,json=lockedStakings,proto3" json:"locked_stakings" yaml:"locked_stakings"`
+	// unbondings specifies the unbondings, sorted by id
+	Unbondings []Unbonding `protobuf:"bytes,15,rep,name=unbondings,proto3" json:"unbondings"`
+	// rewards_withdraw_address_records specifies the farmers that withdraw rewards to another address
+	RewardsWithdrawAddressRecords []RewardsWithdrawAddressRecord `protobuf:"bytes,16,rep,name=rewards_withdraw_address_records,json=rewardsWithdrawAddressRecords,proto3" json:"rewards_withdraw_address_records" yaml:"rewards_withdraw_address_records"`
+	// deposit_requests specifies the deposit requests waiting for their deposit batches to be executed, sorted by id
+	DepositRequests []DepositRequest `protobuf:"bytes,17,rep,name=deposit_requests,json=depositRequests,proto3" json:"deposit_requests" yaml:"deposit_requests"`
+	// allowed_staking_denoms specifies the denoms allowed to be staked, sorted without duplicates
+	// any denom can be staked when it is empty
+	AllowedStakingDenoms []string `protobuf:"bytes,18,rep,name=allowed_staking_denoms,json=allowedStakingDenoms,proto3" json:"allowed_staking_denoms,omitempty" yaml:"allowed_staking_denoms"`
+	// queued_locked_stakings specifies the locked stakings waiting in the queue
+	// to be staked at the end of the current epoch, sorted by id
+	QueuedLockedStakings []LockedStaking `protobuf:"bytes,19,rep,name=queued_locked_stakings,json=queuedLockedStakings,proto3" json:"queued_locked_stakings" yaml:"queued_locked_stakings"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -210,7 +227,8 @@ var xxx_messageInfo_QueuedStakingRecord proto.InternalMessageInfo
 // TotalStakingsRecord is used for import/export via genesis json.
 type TotalStakingsRecord struct {
 	StakingCoinDenom string `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty" yaml:"staking_coin_denom"`
-	// amount specifies total amount of the staking for the staking coin denom except queued staking
+	// amount specifies total amount of the staking for the staking coin denom except queued staking,
+	// including locked staking
 	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 	// staking_reserve_coins specifies balance of the staking reserve account where staking and queued staking for the
 	// staking coin denom is stored this param is needed for import/export validation
@@ -368,6 +386,45 @@ func (m *CurrentEpochRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CurrentEpochRecord proto.InternalMessageInfo
 
+// RewardsWithdrawAddressRecord is used for import/export via genesis json.
+type RewardsWithdrawAddressRecord struct {
+	Farmer          string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty" yaml:"withdraw_address"`
+}
+
+func (m *RewardsWithdrawAddressRecord) Reset()         { *m = RewardsWithdrawAddressRecord{} }
+func (m *RewardsWithdrawAddressRecord) String() string { return proto.CompactTextString(m) }
+func (*RewardsWithdrawAddressRecord) ProtoMessage()    {}
+func (*RewardsWithdrawAddressRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c67612b66bcd2967, []int{8}
+}
+func (m *RewardsWithdrawAddressRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardsWithdrawAddressRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardsWithdrawAddressRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardsWithdrawAddressRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardsWithdrawAddressRecord.Merge(m, src)
+}
+func (m *RewardsWithdrawAddressRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardsWithdrawAddressRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardsWithdrawAddressRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardsWithdrawAddressRecord proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.farming.v1beta1.GenesisState")
 	proto.RegisterType((*PlanRecord)(nil), "cosmos.farming.v1beta1.PlanRecord")
@@ -377,6 +434,7 @@ func init() {
 	proto.RegisterType((*HistoricalRewardsRecord)(nil), "cosmos.farming.v1beta1.HistoricalRewardsRecord")
 	proto.RegisterType((*OutstandingRewardsRecord)(nil), "cosmos.farming.v1beta1.OutstandingRewardsRecord")
 	proto.RegisterType((*CurrentEpochRecord)(nil), "cosmos.farming.v1beta1.CurrentEpochRecord")
+	proto.RegisterType((*RewardsWithdrawAddressRecord)(nil), "cosmos.farming.v1beta1.RewardsWithdrawAddressRecord")
 }
 
 func init() {
@@ -384,74 +442,92 @@ func init() {
 }
 
 var fileDescriptor_c67612b66bcd2967 = []byte{
-	// 1065 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x38, 0x89, 0xdb, 0x4e, 0xe2, 0x26, 0x1d, 0x3b, 0x61, 0xed, 0x92, 0xdd, 0x74, 0x44,
-	0x90, 0x5b, 0xc8, 0x9a, 0x96, 0x03, 0x52, 0x05, 0x42, 0x2c, 0xe5, 0x47, 0x55, 0x10, 0x61, 0xda,
-	0x13, 0x17, 0x6b, 0x6c, 0x4f, 0x1d, 0x2b, 0xf6, 0x8e, 0xbb, 0x33, 0x2e, 0x58, 0x1c, 0x38, 0xc0,
-	0xa1, 0xc7, 0x4a, 0x48, 0x88, 0x03, 0x12, 0x3d, 0x42, 0xcf, 0xdc, 0xb9, 0x56, 0x9c, 0x7a, 0x42,
-	0x88, 0x43, 0x8a, 0x92, 0x4b, 0xaf, 0xe4, 0x2f, 0x40, 0x3b, 0x33, 0xb6, 0x77, 0xbd, 0xbb, 0x69,
-	0x22, 0x45, 0x9c, 0xbc, 0x3f, 0xde, 0xfb, 0xbe, 0xef, 0xbd, 0x99, 0xf9, 0xde, 0x1a, 0xd6, 0x24,
-	0xf3, 0xdb, 0x2c, 0xe8, 0x77, 0x7d, 0x59, 0xbf, 0x4b, 0xc3, 0xdf, 0x4e, 0xfd, 0xfe, 0xd5, 0x26,
-	0x93, 0xf4, 0x6a, 0xbd, 0xc3, 0x7c, 0x26, 0xba, 0xc2, 0x1d, 0x04, 0x5c, 0x72, 0xb4, 0xd6, 0xe2,
-	0xa2, 0xcf, 0x85, 0x6b, 0xa2, 0x5c, 0x13, 0x55, 0xad, 0x74, 0x38, 0xef, 0xf4, 0x58, 0x5d, 0x45,
-	0x35, 0x87, 0x77, 0xeb, 0xd4, 0x1f, 0xe9, 0x94, 0x6a, 0xb9, 0xc3, 0x3b, 0x5c, 0x5d, 0xd6, 0xc3,
-	0x2b, 0xf3, 0xb4, 0xa2, 0x81, 0x1a, 0xfa, 0x85, 0x41, 0xd5, 0xaf, 0x6c, 0x7d, 0x57, 0x6f, 0x52,
-	0xc1, 0x26, 0x32, 0x5a, 0xbc, 0xeb, 0x9b, 0xf7, 0x47, 0xa9, 0x1d, 0xeb, 0xd2, 0x91, 0xce, 0xac,
-	0x2a, 0xd9, 0xed, 0x33, 0x21, 0x69, 0x7f, 0xa0, 0x03, 0xf0, 0xaf, 0x10, 0x2e, 0x7d, 0xa4, 0x0b,
-	0xbc, 0x2d, 0xa9, 0x64, 0xe8, 0x6d, 0x58, 0x18, 0xd0, 0x80, 0xf6, 0x85, 0x05, 0x36, 0x40, 0x6d,
-	0xf1, 0x9a, 0xed, 0xa6, 0x17, 0xec, 0x6e, 0xab, 0x28, 0x6f, 0xfe, 0xc9, 0x9e, 0x93, 0x23, 0x26,
-	0x07, 0x35, 0xe1, 0xd2, 0xa0, 0x47, 0xfd, 0x46, 0xc0, 0x5a, 0x3c, 0x68, 0x0b, 0x2b, 0xbf, 0x31,
-	0x57, 0x5b, 0xbc, 0x86, 0x33, 0x31, 0x7a, 0xd4, 0x27, 0x2a, 0xd4, 0xbb, 0x18, 0xe2, 0x1c, 0xee,
-	0x39, 0xa5, 0x11, 0xed, 0xf7, 0xae, 0xe3, 0x28, 0x0a, 0x26, 0x8b, 0x83, 0x49, 0xa0, 0x40, 0x3e,
-	0x5c, 0x16, 0x92, 0xee, 0x76, 0xfd, 0xce, 0x84, 0x66, 0x4e, 0xd1, 0x6c, 0x66, 0xd1, 0xdc, 0xd6,
-	0xe1, 0x86, 0xc9, 0x36, 0x4c, 0x6b, 0x9a, 0x69, 0x06, 0x0b, 0x93, 0xf3, 0x22, 0x1a, 0x2e, 0xd0,
-	0x03, 0x00, 0xd7, 0xee, 0x0d, 0xd9, 0x90, 0xb5, 0x1b, 0xb3, 0xbc, 0xf3, 0x8a, 0xf7, 0xb5, 0x2c,
-	0xde, 0xcf, 0x55, 0x56, 0x9c, 0x7d, 0xd3, 0xb0, 0xaf, 0x6b, 0xf6, 0x74, 0x60, 0x4c, 0xca, 0xf7,
-	0x92, 0xb9, 0x02, 0xfd, 0x08, 0x60, 0x75, 0xa7, 0x2b, 0x24, 0x0f, 0xba, 0x2d, 0xda, 0x6b, 0x04,
-	0xec, 0x4b, 0x1a, 0xb4, 0xc5, 0x44, 0xce, 0x82, 0x92, 0x53, 0xcf, 0x92, 0xf3, 0xf1, 0x24, 0x93,
-	0xe8, 0x44, 0x23, 0xe9, 0xb2, 0x91, 0x74, 0x49, 0x4b, 0xca, 0x26, 0xc0, 0xc4, 0xda, 0x49, 0xc7,
-	0x10, 0xe8, 0x27, 0x00, 0x2f, 0xf2, 0xa1, 0x14, 0x92, 0xfa, 0x6d, 0x5d, 0x49, 0x5c, 0x5b, 0x41,
-	0x69, 0x7b, 0x23, 0x4b, 0xdb, 0x67, 0xd3, 0xd4, 0xb8, 0xb8, 0x2b, 0x46, 0x1c, 0xd6, 0xe2, 0x8e,
-	0xa0, 0xc0, 0xa4, 0xc2, 0x33, 0x50, 0x04, 0xfa, 0x0e, 0xc0, 0xd5, 0xd6, 0x30, 0x08, 0x98, 0x2f,
-	0x1b, 0x6c, 0xc0, 0x5b, 0x3b, 0x13, 0x61, 0x67, 0x94, 0xb0, 0x2b, 0x59, 0xc2, 0xde, 0xd7, 0x49,
-	0x1f, 0x84, 0x39, 0x46, 0xd2, 0x2b, 0x46, 0xd2, 0xcb, 0x5a, 0x52, 0x2a, 0x2c, 0x26, 0xa5, 0x56,
-	0x22, 0x53, 0xef, 0x25, 0xc9, 0x25, 0xed, 0x8d, 0x57, 0x7c, 0xda, 0xa0, 0xb3, 0x47, 0xef, 0xa5,
-	0x3b, 0x61, 0x96, 0xd9, 0x0e, 0x22, 0x7d, 0x2f, 0xa5, 0x03, 0x63, 0x52, 0x96, 0xc9, 0x5c, 0x81,
-	0xbe, 0x07, 0xf0, 0x82, 0xee, 0x60, 0x63, 0xc0, 0x79, 0xaf, 0x11, 0xfa, 0x8b, 0xb0, 0xce, 0x29,
-	0x15, 0x95, 0xb1, 0x8a, 0xd0, 0x81, 0xa6, 0xad, 0xe0, 0x5d, 0xdf, 0xfb, 0xc4, 0x70, 0x5a, 0x9a,
-	0x33, 0x81, 0x80, 0x1f, 0x3f, 0x73, 0x6a, 0x9d, 0xae, 0xdc, 0x19, 0x36, 0xdd, 0x16, 0xef, 0x1b,
-	0x63, 0x33, 0x3f, 0x5b, 0xa2, 0xbd, 0x5b, 0x97, 0xa3, 0x01, 0x13, 0x0a, 0x4c, 0x90, 0x65, 0x9d,
-	0xbf, 0xcd, 0x79, 0x4f, 0x3d, 0x40, 0x4d, 0xb8, 0xdc, 0xa3, 0x62, 0xdc, 0xcc, 0xd0, 0xad, 0x2c,
-	0xa8, 0x7c, 0xa8, 0xea, 0x6a, 0x2b, 0x73, 0xc7, 0x56, 0xe6, 0xde, 0x19, 0x5b, 0x99, 0x67, 0x4f,
-	0x4f, 0xf3, 0x4c, 0x32, 0x7e, 0xf8, 0xcc, 0x01, 0xa4, 0x18, 0x3e, 0x55, 0xeb, 0x10, 0xe6, 0xa0,
-	0xd7, 0x21, 0x8a, 0xaf, 0x59, 0x9b, 0x8e, 0x84, 0xb5, 0xb8, 0x01, 0x6a, 0x45, 0xb2, 0x12, 0x5d,
-	0xb5, 0x1b, 0x74, 0x24, 0xae, 0x9f, 0x7d, 0xf0, 0xc8, 0xc9, 0x3d, 0x7f, 0xe4, 0xe4, 0xf0, 0x73,
-	0x00, 0xe1, 0xd4, 0xb1, 0xd0, 0x5b, 0x70, 0x3e, 0xb4, 0x25, 0xe3, 0x93, 0xe5, 0x84, 0xbe, 0xf7,
-	0xfc, 0x91, 0x57, 0x0c, 0xbb, 0xf5, 0xc7, 0x6f, 0x5b, 0x0b, 0x61, 0xde, 0x4d, 0xa2, 0x12, 0xd0,
-	0x0f, 0x00, 0x22, 0xb3, 0xbc, 0xd1, 0xd6, 0xe7, 0x5f, 0xd4, 0xfa, 0x4f, 0x4d, 0xeb, 0x2b, 0xba,
-	0xd4, 0x24, 0xc4, 0xc9, 0x7a, 0xbf, 0x62, 0x00, 0x26, 0xcd, 0x8f, 0x94, 0xfa, 0x3b, 0x80, 0xc5,
-	0x98, 0xf7, 0xa0, 0x5b, 0x10, 0x8d, 0x4d, 0x2a, 0xe4, 0x6a, 0xb4, 0x99, 0xcf, 0xfb, 0xaa, 0xf6,
-	0x73, 0xde, 0xfa, 0x54, 0x54, 0x32, 0x06, 0x93, 0x15, 0xf3, 0x30, 0x24, 0xb9, 0x11, 0x3e, 0x42,
-	0x6b, 0xb0, 0x10, 0x92, 0xb3, 0xc0, 0xca, 0x87, 0x00, 0xc4, 0xdc, 0xa1, 0x77, 0xe1, 0x19, 0x13,
-	0x6b, 0xcd, 0xa9, 0xae, 0x3a, 0x2f, 0xb0, 0x74, 0x33, 0x7e, 0xc6, 0x59, 0x91, 0x0a, 0xfe, 0x05,
-	0xb0, 0x94, 0xe2, 0xbf, 0xff, 0x4f, 0x1d, 0xbb, 0xf0, 0x7c, 0xdc, 0xd8, 0x4d, 0x39, 0x9b, 0xc7,
-	0x9a, 0x14, 0xde, 0xba, 0x59, 0xe8, 0xd5, 0xb4, 0x19, 0x81, 0x49, 0x31, 0x36, 0x1b, 0x22, 0x35,
-	0xff, 0x99, 0x87, 0xa5, 0x14, 0x9f, 0x38, 0xdd, 0x9a, 0x3f, 0x84, 0x05, 0xda, 0xe7, 0x43, 0x5f,
-	0xea, 0x9a, 0x3d, 0x37, 0x14, 0xfb, 0xf7, 0x9e, 0xf3, 0xea, 0x31, 0x36, 0xde, 0x4d, 0x5f, 0x12,
-	0x93, 0x8d, 0x7e, 0x06, 0x70, 0x75, 0x3a, 0xf6, 0x04, 0x0b, 0xee, 0xb3, 0xe3, 0x7a, 0xd0, 0x76,
-	0xdc, 0x80, 0x53, 0x51, 0x4e, 0x76, 0x16, 0x4a, 0x93, 0x99, 0xaf, 0x20, 0x66, 0x8f, 0xc3, 0xb7,
-	0x79, 0xf8, 0x52, 0xc6, 0xf4, 0x3c, 0xdd, 0xe6, 0x96, 0xe1, 0x82, 0xb2, 0x24, 0xd5, 0xdb, 0x79,
-	0xa2, 0x6f, 0xd0, 0xd7, 0x10, 0x25, 0x87, 0xb2, 0xd9, 0x52, 0x97, 0x8f, 0x3d, 0xed, 0xbd, 0x4b,
-	0x71, 0xff, 0x48, 0x42, 0x62, 0x72, 0x21, 0x31, 0xdf, 0x23, 0x5d, 0x38, 0x04, 0xd0, 0xca, 0x9a,
-	0xd3, 0xa7, 0xdb, 0x86, 0x6f, 0x60, 0x29, 0x65, 0xd0, 0xab, 0xa6, 0x1c, 0x31, 0xaa, 0x93, 0xda,
-	0x3c, 0x6c, 0x4a, 0xae, 0x66, 0x7e, 0x3d, 0x60, 0x82, 0x92, 0x5f, 0x0d, 0x91, 0xa2, 0x1f, 0x03,
-	0x88, 0x92, 0xdf, 0x00, 0xa7, 0x5b, 0xee, 0x3b, 0xb0, 0x18, 0x1b, 0x48, 0x7a, 0xf5, 0x3d, 0xeb,
-	0x70, 0xcf, 0x29, 0xa7, 0x7c, 0x63, 0x60, 0xb2, 0x14, 0x9d, 0x52, 0x53, 0xb1, 0xde, 0xad, 0x5f,
-	0xf6, 0x6d, 0xf0, 0x64, 0xdf, 0x06, 0x4f, 0xf7, 0x6d, 0xf0, 0xcf, 0xbe, 0x0d, 0x1e, 0x1e, 0xd8,
-	0xb9, 0xa7, 0x07, 0x76, 0xee, 0xaf, 0x03, 0x3b, 0xf7, 0xc5, 0x56, 0xe4, 0x38, 0xa4, 0xfc, 0x83,
-	0xf8, 0x6a, 0x72, 0xa5, 0x4e, 0x46, 0xb3, 0xa0, 0x26, 0xd9, 0x9b, 0xff, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0x9f, 0xd8, 0xc2, 0x6a, 0x1c, 0x0d, 0x00, 0x00,
+	// 1357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x8f, 0x14, 0xc5,
+	0x1b, 0xde, 0xde, 0x5d, 0x16, 0xa8, 0xfd, 0xae, 0x19, 0x86, 0x9e, 0x85, 0x9d, 0xd9, 0xad, 0xfc,
+	0xe0, 0x37, 0x60, 0x98, 0x11, 0x34, 0x31, 0x21, 0x1a, 0x43, 0x8b, 0x20, 0x82, 0x11, 0x0b, 0x0c,
+	0x89, 0x97, 0x49, 0xcd, 0x74, 0x31, 0xdb, 0xd9, 0x9e, 0xae, 0xa1, 0xab, 0x86, 0x75, 0xf5, 0x60,
+	0xa2, 0x1e, 0xf0, 0x46, 0x62, 0x62, 0x38, 0x98, 0xc8, 0xd1, 0x70, 0xf0, 0xe4, 0xdd, 0x2b, 0xf1,
+	0xc4, 0xc9, 0x18, 0x0f, 0x8b, 0x59, 0x2e, 0x5c, 0xe5, 0x2f, 0x30, 0x5d, 0x55, 0x3d, 0xfd, 0x3d,
+	0x2c, 0xc9, 0xc6, 0xd3, 0x4e, 0x77, 0xbf, 0xef, 0xf3, 0x3c, 0xef, 0x5b, 0x55, 0x6f, 0x3d, 0x59,
+	0xd0, 0x10, 0xd4, 0xb3, 0xa9, 0xdf, 0x77, 0x3c, 0xd1, 0xba, 0x4d, 0x82, 0xbf, 0xbd, 0xd6, 0xdd,
+	0xb3, 0x1d, 0x2a, 0xc8, 0xd9, 0x56, 0x8f, 0x7a, 0x94, 0x3b, 0xbc, 0x39, 0xf0, 0x99, 0x60, 0xb0,
+	0xd2, 0x65, 0xbc, 0xcf, 0x78, 0x53, 0x47, 0x35, 0x75, 0xd4, 0x4a, 0xb5, 0xc7, 0x58, 0xcf, 0xa5,
+	0x2d, 0x19, 0xd5, 0x19, 0xde, 0x6e, 0x11, 0x6f, 0x5b, 0xa5, 0xac, 0x94, 0x7b, 0xac, 0xc7, 0xe4,
+	0xcf, 0x56, 0xf0, 0x4b, 0xbf, 0xad, 0x2a, 0xa0, 0xb6, 0xfa, 0xa0, 0x51, 0xd5, 0xa7, 0x9a, 0x7a,
+	0x6a, 0x75, 0x08, 0xa7, 0x23, 0x19, 0x5d, 0xe6, 0x78, 0xfa, 0xfb, 0x38, 0xb5, 0xa1, 0x2e, 0x15,
+	0x59, 0x4f, 0xab, 0x12, 0x4e, 0x9f, 0x72, 0x41, 0xfa, 0x83, 0x90, 0x2a, 0x1d, 0x60, 0x0f, 0x7d,
+	0x22, 0x1c, 0xa6, 0xa9, 0xd0, 0x77, 0xcb, 0x60, 0xee, 0xb2, 0x6a, 0xc0, 0x0d, 0x41, 0x04, 0x85,
+	0x6f, 0x83, 0x99, 0x01, 0xf1, 0x49, 0x9f, 0x9b, 0xc6, 0x9a, 0xd1, 0x98, 0x3d, 0x57, 0x6b, 0xe6,
+	0x37, 0xa4, 0x79, 0x5d, 0x46, 0x59, 0xd3, 0x8f, 0x77, 0xea, 0x13, 0x58, 0xe7, 0xc0, 0x0e, 0x98,
+	0x1b, 0xb8, 0xc4, 0x6b, 0xfb, 0xb4, 0xcb, 0x7c, 0x9b, 0x9b, 0x93, 0x6b, 0x53, 0x8d, 0xd9, 0x73,
+	0xa8, 0x10, 0xc3, 0x25, 0x1e, 0x96, 0xa1, 0xd6, 0xb1, 0x00, 0xe7, 0xc5, 0x4e, 0xbd, 0xb4, 0x4d,
+	0xfa, 0xee, 0x79, 0x14, 0x47, 0x41, 0x78, 0x76, 0x30, 0x0a, 0xe4, 0xd0, 0x03, 0x8b, 0x5c, 0x90,
+	0x4d, 0xc7, 0xeb, 0x8d, 0x68, 0xa6, 0x24, 0xcd, 0x89, 0x22, 0x9a, 0x1b, 0x2a, 0x5c, 0x33, 0xd5,
+	0x34, 0x53, 0x45, 0x31, 0xa5, 0xb0, 0x10, 0x5e, 0xe0, 0xf1, 0x70, 0x0e, 0xef, 0x19, 0xa0, 0x72,
+	0x67, 0x48, 0x87, 0xd4, 0x6e, 0xa7, 0x79, 0xa7, 0x25, 0xef, 0x6b, 0x45, 0xbc, 0x9f, 0xc8, 0xac,
+	0x24, 0xfb, 0x09, 0xcd, 0xbe, 0xaa, 0xd8, 0xf3, 0x81, 0x11, 0x2e, 0xdf, 0xc9, 0xe6, 0x72, 0xf8,
+	0xc0, 0x00, 0x2b, 0x1b, 0x0e, 0x17, 0xcc, 0x77, 0xba, 0xc4, 0x6d, 0xfb, 0x74, 0x8b, 0xf8, 0x36,
+	0x1f, 0xc9, 0x39, 0x20, 0xe5, 0xb4, 0x8a, 0xe4, 0x7c, 0x30, 0xca, 0xc4, 0x2a, 0x51, 0x4b, 0x3a,
+	0xa5, 0x25, 0xad, 0x2b, 0x49, 0xc5, 0x04, 0x08, 0x9b, 0x1b, 0xf9, 0x18, 0x1c, 0xfe, 0x68, 0x80,
+	0x63, 0x6c, 0x28, 0xb8, 0x20, 0x9e, 0xad, 0x2a, 0x49, 0x6a, 0x9b, 0x91, 0xda, 0x5e, 0x2f, 0xd2,
+	0xf6, 0x71, 0x94, 0x9a, 0x14, 0x77, 0x5a, 0x8b, 0x43, 0x4a, 0xdc, 0x18, 0x0a, 0x84, 0xab, 0xac,
+	0x00, 0x85, 0xc3, 0x6f, 0x0d, 0x70, 0xa4, 0x3b, 0xf4, 0x7d, 0xea, 0x89, 0x36, 0x1d, 0xb0, 0xee,
+	0xc6, 0x48, 0xd8, 0x41, 0x29, 0xec, 0x74, 0x91, 0xb0, 0xf7, 0x54, 0xd2, 0xfb, 0x41, 0x8e, 0x96,
+	0xf4, 0x3f, 0x2d, 0xe9, 0xb8, 0x92, 0x94, 0x0b, 0x8b, 0x70, 0xa9, 0x9b, 0xc9, 0x54, 0x7b, 0x49,
+	0x30, 0x41, 0xdc, 0x70, 0xc5, 0xa3, 0x06, 0x1d, 0x1a, 0xbf, 0x97, 0x6e, 0x06, 0x59, 0x7a, 0x3b,
+	0xf0, 0xfc, 0xbd, 0x94, 0x0f, 0x8c, 0x70, 0x59, 0x64, 0x73, 0x39, 0xfc, 0xde, 0x00, 0xcb, 0xaa,
+	0x83, 0xed, 0x01, 0x63, 0x6e, 0x3b, 0x98, 0x3f, 0xdc, 0x3c, 0x2c, 0x55, 0x54, 0x43, 0x15, 0xc1,
+	0x84, 0x8a, 0x5a, 0xc1, 0x1c, 0xcf, 0xba, 0xa6, 0x39, 0x4d, 0xc5, 0x99, 0x41, 0x40, 0x8f, 0x9e,
+	0xd6, 0x1b, 0x3d, 0x47, 0x6c, 0x0c, 0x3b, 0xcd, 0x2e, 0xeb, 0xeb, 0xc1, 0xa7, 0xff, 0x9c, 0xe1,
+	0xf6, 0x66, 0x4b, 0x6c, 0x0f, 0x28, 0x97, 0x60, 0x1c, 0x2f, 0xaa, 0xfc, 0xeb, 0x8c, 0xb9, 0xf2,
+	0x05, 0xec, 0x80, 0x45, 0x97, 0xf0, 0xb0, 0x99, 0xc1, 0x34, 0x33, 0x81, 0x9c, 0x43, 0x2b, 0x4d,
+	0x35, 0xc9, 0x9a, 0xe1, 0x24, 0x6b, 0xde, 0x0c, 0x47, 0x9d, 0x55, 0x8b, 0x4e, 0x73, 0x2a, 0x19,
+	0xdd, 0x7f, 0x5a, 0x37, 0xf0, 0x7c, 0xf0, 0x56, 0xae, 0x43, 0x90, 0x03, 0xbf, 0x00, 0x95, 0xe4,
+	0x9a, 0x85, 0x33, 0xd1, 0x9c, 0x93, 0x54, 0xd5, 0x0c, 0xd5, 0x45, 0x1d, 0x60, 0x9d, 0x4a, 0x76,
+	0x3c, 0x1f, 0x06, 0x3d, 0x08, 0x48, 0xcb, 0xf1, 0xf5, 0x0f, 0x01, 0xe0, 0x79, 0x30, 0xd7, 0x73,
+	0x59, 0x87, 0xb8, 0x2a, 0xc7, 0x9c, 0x5f, 0x33, 0x1a, 0xd3, 0xd6, 0xd1, 0x68, 0xf0, 0xc5, 0xbf,
+	0x22, 0x3c, 0xab, 0x1e, 0x25, 0x46, 0x30, 0xf8, 0x5c, 0xd6, 0xdd, 0x8c, 0xc6, 0x05, 0x37, 0x17,
+	0xc6, 0x0f, 0xbe, 0x6b, 0x32, 0x5c, 0xaf, 0x7c, 0x7a, 0xf0, 0xa5, 0xb0, 0x10, 0x5e, 0x70, 0xe3,
+	0xe1, 0x1c, 0x5e, 0x06, 0x60, 0xe8, 0x75, 0x98, 0x3c, 0x4e, 0xdc, 0x5c, 0x94, 0x54, 0xeb, 0x45,
+	0x54, 0x9f, 0x86, 0x91, 0xfa, 0x46, 0x88, 0xa5, 0xc2, 0x5f, 0x0c, 0xb0, 0x16, 0x1e, 0xd6, 0x2d,
+	0x47, 0x6c, 0xd8, 0x3e, 0xd9, 0x6a, 0x13, 0xdb, 0xf6, 0x29, 0x8f, 0xf6, 0xff, 0x92, 0xc4, 0x7f,
+	0xb3, 0x08, 0x5f, 0x9f, 0xe7, 0x5b, 0x3a, 0xfd, 0x82, 0xca, 0xd6, 0x07, 0xa1, 0xa5, 0x2b, 0xfb,
+	0x7f, 0x7c, 0x53, 0x16, 0x73, 0x21, 0xbc, 0xea, 0x8f, 0x81, 0xe3, 0xd0, 0x07, 0x4b, 0x36, 0x1d,
+	0x30, 0xee, 0x88, 0xb6, 0x4f, 0xef, 0x0c, 0x29, 0x17, 0xdc, 0x5c, 0x96, 0xfa, 0x4e, 0x16, 0xe9,
+	0xbb, 0xa8, 0xe2, 0xb1, 0x0a, 0xb7, 0xea, 0x5a, 0xd1, 0x51, 0xa5, 0x28, 0x8d, 0x86, 0xf0, 0xa2,
+	0x9d, 0x48, 0xe0, 0xf0, 0x16, 0xa8, 0x10, 0xd7, 0x65, 0x5b, 0xb1, 0xdb, 0xc0, 0xa6, 0x1e, 0xeb,
+	0x73, 0x13, 0xae, 0x4d, 0x35, 0x0e, 0x5b, 0xeb, 0xd1, 0xb6, 0xcb, 0x8f, 0x43, 0xb8, 0xac, 0x3f,
+	0xe8, 0x15, 0xbc, 0x28, 0x5f, 0xc3, 0xaf, 0xa3, 0xfb, 0x2b, 0xbd, 0x7d, 0x4a, 0xaf, 0xb2, 0x7d,
+	0xf2, 0x6f, 0xae, 0xcc, 0x2e, 0xd2, 0x37, 0x57, 0x22, 0x97, 0x9f, 0x3f, 0x74, 0xef, 0x61, 0x7d,
+	0xe2, 0xf9, 0xc3, 0xfa, 0xc4, 0x87, 0xd3, 0x87, 0x66, 0x97, 0xe6, 0x30, 0x4c, 0x1d, 0x1d, 0xb2,
+	0xcd, 0xd1, 0x73, 0x03, 0x80, 0xc8, 0x11, 0xc0, 0xb7, 0xc0, 0x74, 0x70, 0xed, 0x6b, 0x1f, 0x52,
+	0xce, 0x1c, 0xca, 0x0b, 0xde, 0xb6, 0x35, 0x1f, 0x68, 0xfa, 0xfd, 0xd7, 0x33, 0x07, 0x82, 0xbc,
+	0x2b, 0x58, 0x26, 0xc0, 0x1f, 0x0c, 0x00, 0x75, 0x29, 0xf1, 0xd1, 0x36, 0xf9, 0xb2, 0xd1, 0xf6,
+	0x91, 0x2e, 0xb0, 0xaa, 0x0a, 0xcc, 0x42, 0xbc, 0xda, 0x6c, 0x5b, 0xd2, 0x00, 0xa3, 0xe1, 0x16,
+	0x35, 0x01, 0xfd, 0x66, 0x80, 0xf9, 0xc4, 0xdd, 0x0e, 0xaf, 0x02, 0x18, 0x2e, 0x67, 0xc0, 0xa5,
+	0xd6, 0x54, 0xd6, 0x7e, 0xd8, 0x5a, 0x8d, 0x44, 0x65, 0x63, 0x10, 0x5e, 0xd2, 0x2f, 0x03, 0x12,
+	0xb9, 0xe6, 0xb0, 0x02, 0x66, 0x02, 0x72, 0xea, 0x9b, 0x93, 0x01, 0x00, 0xd6, 0x4f, 0xf0, 0x5d,
+	0x70, 0x50, 0xc7, 0x9a, 0x53, 0xb2, 0xab, 0xf5, 0x97, 0x58, 0x26, 0x7d, 0x98, 0xc3, 0xac, 0x58,
+	0x05, 0xff, 0x18, 0xa0, 0x94, 0xe3, 0x6f, 0xfe, 0x9b, 0x3a, 0x36, 0xc1, 0x42, 0xd2, 0x38, 0xe9,
+	0x72, 0x4e, 0xec, 0xc9, 0x89, 0x59, 0xab, 0x7a, 0xa1, 0x8f, 0xe4, 0x79, 0x30, 0x84, 0xe7, 0x13,
+	0xde, 0x2b, 0x56, 0xf3, 0x1f, 0x93, 0xa0, 0x94, 0x73, 0x0f, 0xef, 0x6f, 0xcd, 0x97, 0xc0, 0x0c,
+	0xe9, 0xb3, 0xa1, 0x27, 0x54, 0xcd, 0x56, 0x33, 0x10, 0xfb, 0xd7, 0x4e, 0xfd, 0xe4, 0x1e, 0x36,
+	0xde, 0x15, 0x4f, 0x60, 0x9d, 0x0d, 0x7f, 0x32, 0xc0, 0x91, 0xc8, 0x56, 0x72, 0xea, 0xdf, 0xa5,
+	0x7b, 0xbd, 0xe3, 0xaf, 0x27, 0x0d, 0x4e, 0x2e, 0xca, 0xab, 0x9d, 0x85, 0xd2, 0xc8, 0x53, 0x4b,
+	0x88, 0xf4, 0x71, 0xf8, 0x66, 0x12, 0x1c, 0x2d, 0x70, 0xa7, 0xfb, 0xdb, 0xdc, 0x32, 0x38, 0xa0,
+	0xee, 0xdd, 0xa0, 0xb7, 0xd3, 0x58, 0x3d, 0xc0, 0x2f, 0x01, 0xcc, 0x9a, 0x5e, 0xbd, 0xa5, 0x4e,
+	0xed, 0xd9, 0x4d, 0x5b, 0xeb, 0xc9, 0xf9, 0x91, 0x85, 0x44, 0x78, 0x39, 0xe3, 0x9f, 0x63, 0x5d,
+	0x78, 0x61, 0x00, 0xb3, 0xc8, 0x07, 0xef, 0x6f, 0x1b, 0xbe, 0x02, 0xa5, 0x1c, 0x23, 0x2d, 0x9b,
+	0x32, 0xc6, 0x0a, 0x67, 0xb5, 0x59, 0x48, 0x97, 0xbc, 0x52, 0xe8, 0xce, 0x11, 0x86, 0x59, 0x57,
+	0x1e, 0x2b, 0xfa, 0x91, 0x01, 0x60, 0xd6, 0x63, 0xef, 0x6f, 0xb9, 0xef, 0x80, 0xf9, 0xc4, 0x75,
+	0xa3, 0x56, 0xdf, 0x32, 0x5f, 0xec, 0xd4, 0xcb, 0x39, 0x46, 0x0e, 0xe1, 0xb9, 0xb8, 0x77, 0x8b,
+	0x89, 0xbd, 0x67, 0x80, 0xe3, 0xe3, 0x8c, 0x48, 0x6c, 0x60, 0x19, 0x89, 0x81, 0x75, 0x09, 0x2c,
+	0xa5, 0xcd, 0x88, 0x3e, 0xde, 0xc7, 0x22, 0x93, 0x90, 0x8e, 0x40, 0x78, 0x71, 0x2b, 0xc9, 0x12,
+	0x49, 0xb1, 0xae, 0xfe, 0xbc, 0x5b, 0x33, 0x1e, 0xef, 0xd6, 0x8c, 0x27, 0xbb, 0x35, 0xe3, 0xef,
+	0xdd, 0x9a, 0x71, 0xff, 0x59, 0x6d, 0xe2, 0xc9, 0xb3, 0xda, 0xc4, 0x9f, 0xcf, 0x6a, 0x13, 0x9f,
+	0x9d, 0x89, 0x9d, 0xcc, 0x9c, 0x7f, 0x26, 0x7c, 0x3e, 0xfa, 0x25, 0x0f, 0x69, 0x67, 0x46, 0x5e,
+	0xaa, 0x6f, 0xfc, 0x3b, 0x00, 0x05, 0xfe, 0x54, 0x82, 0x27, 0x11, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -474,18 +550,113 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.CurrentEpochDays != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentEpochDays))
+	if len(m.QueuedLockedStakings) > 0 {
+		for iNdEx := len(m.QueuedLockedStakings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedLockedStakings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.AllowedStakingDenoms) > 0 {
+		for iNdEx := len(m.AllowedStakingDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedStakingDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedStakingDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedStakingDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.DepositRequests) > 0 {
+		for iNdEx := len(m.DepositRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.RewardsWithdrawAddressRecords) > 0 {
+		for iNdEx := len(m.RewardsWithdrawAddressRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsWithdrawAddressRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.LockedStakings) > 0 {
+		for iNdEx := len(m.LockedStakings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedStakings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.GlobalEpoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GlobalEpoch))
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x68
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CurrentEpochDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CurrentEpochDuration):])
+	if err1 != nil {
+		return 0, err1
 	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x62
 	if m.LastEpochTime != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastEpochTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastEpochTime):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastEpochTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastEpochTime):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintGenesis(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintGenesis(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -929,6 +1100,43 @@ func (m *CurrentEpochRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RewardsWithdrawAddressRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardsWithdrawAddressRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardsWithdrawAddressRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -1000,8 +1208,46 @@ func (m *GenesisState) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastEpochTime)
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if m.CurrentEpochDays != 0 {
-		n += 1 + sovGenesis(uint64(m.CurrentEpochDays))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CurrentEpochDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.GlobalEpoch != 0 {
+		n += 1 + sovGenesis(uint64(m.GlobalEpoch))
+	}
+	if len(m.LockedStakings) > 0 {
+		for _, e := range m.LockedStakings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardsWithdrawAddressRecords) > 0 {
+		for _, e := range m.RewardsWithdrawAddressRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DepositRequests) > 0 {
+		for _, e := range m.DepositRequests {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllowedStakingDenoms) > 0 {
+		for _, s := range m.AllowedStakingDenoms {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QueuedLockedStakings) > 0 {
+		for _, e := range m.QueuedLockedStakings {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
 	return n
 }
@@ -1131,6 +1377,23 @@ func (m *CurrentEpochRecord) Size() (n int) {
 	return n
 }
 
+func (m *RewardsWithdrawAddressRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1507,11 +1770,97 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CurrentEpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochDays", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalEpoch", wireType)
+			}
+			m.GlobalEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedStakings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.CurrentEpochDays = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedStakings = append(m.LockedStakings, LockedStaking{})
+			if err := m.LockedStakings[len(m.LockedStakings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1521,11 +1870,160 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentEpochDays |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbondings = append(m.Unbondings, Unbonding{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsWithdrawAddressRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsWithdrawAddressRecords = append(m.RewardsWithdrawAddressRecords, RewardsWithdrawAddressRecord{})
+			if err := m.RewardsWithdrawAddressRecords[len(m.RewardsWithdrawAddressRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositRequests = append(m.DepositRequests, DepositRequest{})
+			if err := m.DepositRequests[len(m.DepositRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedStakingDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedStakingDenoms = append(m.AllowedStakingDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedLockedStakings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedLockedStakings = append(m.QueuedLockedStakings, LockedStaking{})
+			if err := m.QueuedLockedStakings[len(m.QueuedLockedStakings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2458,6 +2956,120 @@ func (m *CurrentEpochRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RewardsWithdrawAddressRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardsWithdrawAddressRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardsWithdrawAddressRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
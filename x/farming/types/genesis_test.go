@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"fmt"
 	"testing"
 
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -13,6 +14,7 @@ import (
 
 func TestValidateGenesis(t *testing.T) {
 	validAcc := sdk.AccAddress(crypto.AddressHash([]byte("validAcc")))
+	validAcc2 := sdk.AccAddress(crypto.AddressHash([]byte("validAcc2")))
 	validStakingCoinDenom := "denom1"
 	validPlan := types.NewRatioPlan(
 		types.NewBasePlan(
@@ -38,10 +40,34 @@ func TestValidateGenesis(t *testing.T) {
 	}
 	validHistoricalRewards := types.HistoricalRewards{
 		CumulativeUnitRewards: sdk.NewDecCoins(sdk.NewInt64DecCoin("denom3", 100000)),
+		ReferenceCount:        1,
 	}
 	validOutstandingRewards := types.OutstandingRewards{
 		Rewards: sdk.NewDecCoins(sdk.NewInt64DecCoin("denom3", 1000000)),
 	}
+	validLockedStaking := types.LockedStaking{
+		Id:               1,
+		Farmer:           validAcc.String(),
+		StakingCoinDenom: validStakingCoinDenom,
+		Amount:           sdk.NewInt(1000000),
+		Multiplier:       sdk.NewDec(2),
+		StartingEpoch:    1,
+		UnlockTime:       types.ParseTime("2021-09-01T00:00:00Z"),
+	}
+	validUnbonding := types.Unbonding{
+		Id:               1,
+		Farmer:           validAcc.String(),
+		StakingCoinDenom: validStakingCoinDenom,
+		Amount:           sdk.NewInt(1000000),
+		CompletionTime:   types.ParseTime("2021-09-01T00:00:00Z"),
+	}
+	validDepositRequest := types.DepositRequest{
+		Id:           1,
+		Farmer:       validAcc.String(),
+		PoolId:       1,
+		MsgIndex:     1,
+		DepositCoins: sdk.NewCoins(sdk.NewInt64Coin("denom1", 1000000), sdk.NewInt64Coin("denom2", 1000000)),
+	}
 
 	testCases := []struct {
 		name        string
@@ -57,13 +83,13 @@ func TestValidateGenesis(t *testing.T) {
 			"",
 		},
 		{
-			"invalid NextEpochDays case",
+			"invalid NextEpochDuration case",
 			func(genState *types.GenesisState) {
 				params := types.DefaultParams()
-				params.NextEpochDays = 0
+				params.NextEpochDuration = 0
 				genState.Params = params
 			},
-			"next epoch days must be positive: 0",
+			"next epoch duration must be positive: 0s",
 		},
 		{
 			"invalid plan",
@@ -349,6 +375,21 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			"coin 0.000000000000000000denom3 amount is not positive",
 		},
+		{
+			"invalid historical rewards records - zero reference count",
+			func(genState *types.GenesisState) {
+				genState.HistoricalRewardsRecords = []types.HistoricalRewardsRecord{
+					{
+						StakingCoinDenom: validStakingCoinDenom,
+						Epoch:            0,
+						HistoricalRewards: types.HistoricalRewards{
+							CumulativeUnitRewards: sdk.NewDecCoins(sdk.NewInt64DecCoin("denom3", 100000)),
+						},
+					},
+				}
+			},
+			"historical rewards reference count must be positive",
+		},
 		{
 			"invalid outstanding rewards records - invalid staking coin denom",
 			func(genState *types.GenesisState) {
@@ -387,6 +428,223 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			"invalid denom: !",
 		},
+		{
+			"valid locked stakings",
+			func(genState *types.GenesisState) {
+				lockedStaking2 := validLockedStaking
+				lockedStaking2.Id = 3
+				genState.LockedStakings = []types.LockedStaking{validLockedStaking, lockedStaking2}
+			},
+			"",
+		},
+		{
+			"invalid locked stakings - unsorted",
+			func(genState *types.GenesisState) {
+				lockedStaking2 := validLockedStaking
+				lockedStaking2.Id = 3
+				genState.LockedStakings = []types.LockedStaking{lockedStaking2, validLockedStaking}
+			},
+			"locked stakings must be sorted by id without duplicates",
+		},
+		{
+			"invalid locked stakings - duplicate id",
+			func(genState *types.GenesisState) {
+				genState.LockedStakings = []types.LockedStaking{validLockedStaking, validLockedStaking}
+			},
+			"locked stakings must be sorted by id without duplicates",
+		},
+		{
+			"invalid locked stakings - zero id",
+			func(genState *types.GenesisState) {
+				lockedStaking := validLockedStaking
+				lockedStaking.Id = 0
+				genState.LockedStakings = []types.LockedStaking{lockedStaking}
+			},
+			"locked staking id must not be 0",
+		},
+		{
+			"invalid locked stakings - invalid amount",
+			func(genState *types.GenesisState) {
+				lockedStaking := validLockedStaking
+				lockedStaking.Amount = sdk.ZeroInt()
+				genState.LockedStakings = []types.LockedStaking{lockedStaking}
+			},
+			"locked staking amount must be positive: 0",
+		},
+		{
+			"invalid locked stakings - invalid multiplier",
+			func(genState *types.GenesisState) {
+				lockedStaking := validLockedStaking
+				lockedStaking.Multiplier = sdk.NewDecWithPrec(5, 1)
+				genState.LockedStakings = []types.LockedStaking{lockedStaking}
+			},
+			"locked staking multiplier must not be less than 1: 0.500000000000000000",
+		},
+		{
+			"valid queued locked stakings",
+			func(genState *types.GenesisState) {
+				queuedLockedStaking := validLockedStaking
+				queuedLockedStaking.Id = 3
+				queuedLockedStaking.StartingEpoch = 0
+				genState.LockedStakings = []types.LockedStaking{validLockedStaking}
+				genState.QueuedLockedStakings = []types.LockedStaking{queuedLockedStaking}
+			},
+			"",
+		},
+		{
+			"invalid queued locked stakings - unsorted",
+			func(genState *types.GenesisState) {
+				queuedLockedStaking := validLockedStaking
+				queuedLockedStaking.Id = 3
+				genState.QueuedLockedStakings = []types.LockedStaking{queuedLockedStaking, validLockedStaking}
+			},
+			"queued locked stakings must be sorted by id without duplicates",
+		},
+		{
+			"invalid queued locked stakings - same id as a locked staking",
+			func(genState *types.GenesisState) {
+				genState.LockedStakings = []types.LockedStaking{validLockedStaking}
+				genState.QueuedLockedStakings = []types.LockedStaking{validLockedStaking}
+			},
+			fmt.Sprintf("queued locked staking %d has the same id as a locked staking", validLockedStaking.Id),
+		},
+		{
+			"valid unbondings",
+			func(genState *types.GenesisState) {
+				unbonding2 := validUnbonding
+				unbonding2.Id = 2
+				genState.Unbondings = []types.Unbonding{validUnbonding, unbonding2}
+			},
+			"",
+		},
+		{
+			"invalid unbondings - unsorted",
+			func(genState *types.GenesisState) {
+				unbonding2 := validUnbonding
+				unbonding2.Id = 2
+				genState.Unbondings = []types.Unbonding{unbonding2, validUnbonding}
+			},
+			"unbondings must be sorted by id without duplicates",
+		},
+		{
+			"invalid unbondings - zero id",
+			func(genState *types.GenesisState) {
+				unbonding := validUnbonding
+				unbonding.Id = 0
+				genState.Unbondings = []types.Unbonding{unbonding}
+			},
+			"unbonding id must not be 0",
+		},
+		{
+			"invalid unbondings - invalid farmer",
+			func(genState *types.GenesisState) {
+				unbonding := validUnbonding
+				unbonding.Farmer = "invalid"
+				genState.Unbondings = []types.Unbonding{unbonding}
+			},
+			"decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			"invalid unbondings - invalid amount",
+			func(genState *types.GenesisState) {
+				unbonding := validUnbonding
+				unbonding.Amount = sdk.ZeroInt()
+				genState.Unbondings = []types.Unbonding{unbonding}
+			},
+			"unbonding amount must be positive: 0",
+		},
+		{
+			"valid deposit requests",
+			func(genState *types.GenesisState) {
+				req2 := validDepositRequest
+				req2.Id = 2
+				genState.DepositRequests = []types.DepositRequest{validDepositRequest, req2}
+			},
+			"",
+		},
+		{
+			"invalid deposit requests - unsorted",
+			func(genState *types.GenesisState) {
+				req2 := validDepositRequest
+				req2.Id = 2
+				genState.DepositRequests = []types.DepositRequest{req2, validDepositRequest}
+			},
+			"deposit requests must be sorted by id without duplicates",
+		},
+		{
+			"invalid deposit requests - zero pool id",
+			func(genState *types.GenesisState) {
+				req := validDepositRequest
+				req.PoolId = 0
+				genState.DepositRequests = []types.DepositRequest{req}
+			},
+			"pool id must not be 0",
+		},
+		{
+			"invalid deposit requests - empty deposit coins",
+			func(genState *types.GenesisState) {
+				req := validDepositRequest
+				req.DepositCoins = sdk.Coins{}
+				genState.DepositRequests = []types.DepositRequest{req}
+			},
+			"deposit coins must not be zero",
+		},
+		{
+			"valid allowed staking denoms",
+			func(genState *types.GenesisState) {
+				genState.AllowedStakingDenoms = []string{"denom1", "denom2"}
+			},
+			"",
+		},
+		{
+			"invalid allowed staking denoms - invalid denom",
+			func(genState *types.GenesisState) {
+				genState.AllowedStakingDenoms = []string{"!"}
+			},
+			"invalid denom: !",
+		},
+		{
+			"invalid allowed staking denoms - unsorted",
+			func(genState *types.GenesisState) {
+				genState.AllowedStakingDenoms = []string{"denom2", "denom1"}
+			},
+			"allowed staking denoms must be sorted without duplicates",
+		},
+		{
+			"invalid allowed staking denoms - duplicate",
+			func(genState *types.GenesisState) {
+				genState.AllowedStakingDenoms = []string{"denom1", "denom1"}
+			},
+			"allowed staking denoms must be sorted without duplicates",
+		},
+		{
+			"valid rewards withdraw address records",
+			func(genState *types.GenesisState) {
+				genState.RewardsWithdrawAddressRecords = []types.RewardsWithdrawAddressRecord{
+					{Farmer: validAcc.String(), WithdrawAddress: validAcc2.String()},
+				}
+			},
+			"",
+		},
+		{
+			"invalid rewards withdraw address records - invalid withdraw address",
+			func(genState *types.GenesisState) {
+				genState.RewardsWithdrawAddressRecords = []types.RewardsWithdrawAddressRecord{
+					{Farmer: validAcc.String(), WithdrawAddress: "invalid"},
+				}
+			},
+			"decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			"invalid rewards withdraw address records - duplicate farmer",
+			func(genState *types.GenesisState) {
+				genState.RewardsWithdrawAddressRecords = []types.RewardsWithdrawAddressRecord{
+					{Farmer: validAcc.String(), WithdrawAddress: validAcc2.String()},
+					{Farmer: validAcc.String(), WithdrawAddress: validAcc2.String()},
+				}
+			},
+			fmt.Sprintf("duplicate rewards withdraw address record for farmer %s", validAcc),
+		},
 		{
 			"invalid reward pool coins",
 			func(genState *types.GenesisState) {
@@ -395,11 +653,11 @@ func TestValidateGenesis(t *testing.T) {
 			"coin 0denom1 amount is not positive",
 		},
 		{
-			"invalid current epoch days",
+			"invalid current epoch duration",
 			func(genState *types.GenesisState) {
-				genState.CurrentEpochDays = 0
+				genState.CurrentEpochDuration = 0
 			},
-			"current epoch days must be positive",
+			"current epoch duration must be positive: 0s",
 		},
 	}
 	for _, tc := range testCases {
@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ FarmingHooks = MultiFarmingHooks{}
+
+// MultiFarmingHooks combines multiple farming hooks, all hook functions are run in array sequence.
+type MultiFarmingHooks []FarmingHooks
+
+// NewMultiFarmingHooks returns a new MultiFarmingHooks.
+func NewMultiFarmingHooks(hooks ...FarmingHooks) MultiFarmingHooks {
+	return hooks
+}
+
+// AfterStaked is called after coins are staked(queued) by a farmer.
+func (h MultiFarmingHooks) AfterStaked(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoins sdk.Coins) {
+	for i := range h {
+		h[i].AfterStaked(ctx, farmerAcc, stakingCoins)
+	}
+}
+
+// BeforeUnstaked is called before coins are unstaked by a farmer.
+func (h MultiFarmingHooks) BeforeUnstaked(ctx sdk.Context, farmerAcc sdk.AccAddress, unstakingCoins sdk.Coins) {
+	for i := range h {
+		h[i].BeforeUnstaked(ctx, farmerAcc, unstakingCoins)
+	}
+}
+
+// AfterRewardsWithdrawn is called after rewards are withdrawn to a farmer.
+func (h MultiFarmingHooks) AfterRewardsWithdrawn(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, rewards sdk.Coins) {
+	for i := range h {
+		h[i].AfterRewardsWithdrawn(ctx, farmerAcc, stakingCoinDenom, rewards)
+	}
+}
+
+// AfterQueuedStakingProcessed is called after a queued staking or a queued
+// locked staking becomes staked.
+func (h MultiFarmingHooks) AfterQueuedStakingProcessed(ctx sdk.Context, farmerAcc sdk.AccAddress, stakingCoinDenom string, amount sdk.Int) {
+	for i := range h {
+		h[i].AfterQueuedStakingProcessed(ctx, farmerAcc, stakingCoinDenom, amount)
+	}
+}
+
+// AfterEpochAdvanced is called after an epoch is advanced.
+func (h MultiFarmingHooks) AfterEpochAdvanced(ctx sdk.Context) {
+	for i := range h {
+		h[i].AfterEpochAdvanced(ctx)
+	}
+}
+
+// AfterPlanTerminated is called after a plan is terminated.
+func (h MultiFarmingHooks) AfterPlanTerminated(ctx sdk.Context, plan PlanI) {
+	for i := range h {
+		h[i].AfterPlanTerminated(ctx, plan)
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -23,11 +24,17 @@ const (
 
 // keys for farming store prefixes
 var (
-	GlobalPlanIdKey     = []byte("globalPlanId")
-	LastEpochTimeKey    = []byte("lastEpochTime")
-	CurrentEpochDaysKey = []byte("currentEpochDays")
+	GlobalPlanIdKey           = []byte("globalPlanId")
+	LastEpochTimeKey          = []byte("lastEpochTime")
+	CurrentEpochDurationKey   = []byte("currentEpochDuration")
+	GlobalEpochKey            = []byte("globalEpoch")
+	GlobalLockedStakingIdKey  = []byte("globalLockedStakingId")
+	GlobalUnbondingIdKey      = []byte("globalUnbondingId")
+	GlobalDepositRequestIdKey = []byte("globalDepositRequestId")
 
-	PlanKeyPrefix = []byte{0x11}
+	PlanKeyPrefix             = []byte{0x11}
+	PlanEndTimeIndexKeyPrefix = []byte{0x12}
+	ActivePlanIndexKeyPrefix  = []byte{0x13}
 
 	StakingKeyPrefix            = []byte{0x21}
 	StakingIndexKeyPrefix       = []byte{0x22}
@@ -35,9 +42,29 @@ var (
 	QueuedStakingIndexKeyPrefix = []byte{0x24}
 	TotalStakingKeyPrefix       = []byte{0x25}
 
+	LockedStakingKeyPrefix                   = []byte{0x26}
+	LockedStakingIndexKeyPrefix              = []byte{0x27}
+	TotalLockedStakingsKeyPrefix             = []byte{0x28}
+	LockedStakingUnlockQueueKeyPrefix        = []byte{0x29}
+	QueuedLockedStakingKeyPrefix             = []byte{0x2f}
+	QueuedLockedStakingIndexKeyPrefix        = []byte{0x30}
+	LockedStakingByDenomIndexKeyPrefix       = []byte{0x36}
+	QueuedLockedStakingByDenomIndexKeyPrefix = []byte{0x37}
+
+	UnbondingKeyPrefix      = []byte{0x2a}
+	UnbondingIndexKeyPrefix = []byte{0x2b}
+	UnbondingQueueKeyPrefix = []byte{0x2c}
+
+	DepositRequestKeyPrefix      = []byte{0x2d}
+	DepositRequestIndexKeyPrefix = []byte{0x35}
+
+	AllowedStakingDenomKeyPrefix = []byte{0x2e}
+
 	HistoricalRewardsKeyPrefix  = []byte{0x31}
 	CurrentEpochKeyPrefix       = []byte{0x32}
 	OutstandingRewardsKeyPrefix = []byte{0x33}
+
+	RewardsWithdrawAddressKeyPrefix = []byte{0x34}
 )
 
 // GetPlanKey returns kv indexing key of the plan
@@ -45,6 +72,24 @@ func GetPlanKey(planID uint64) []byte {
 	return append(PlanKeyPrefix, sdk.Uint64ToBigEndian(planID)...)
 }
 
+// GetPlanEndTimeIndexKey returns an indexing key for a plan that is not
+// terminated, ordered by the end time of the plan.
+func GetPlanEndTimeIndexKey(endTime time.Time, planId uint64) []byte {
+	return append(GetPlanEndTimeIndexTimePrefix(endTime), sdk.Uint64ToBigEndian(planId)...)
+}
+
+// GetPlanEndTimeIndexTimePrefix returns a key prefix of the plan end time
+// index for the given end time.
+func GetPlanEndTimeIndexTimePrefix(endTime time.Time) []byte {
+	return append(PlanEndTimeIndexKeyPrefix, sdk.FormatTimeBytes(endTime)...)
+}
+
+// GetActivePlanIndexKey returns an indexing key for a plan that is not
+// terminated.
+func GetActivePlanIndexKey(planId uint64) []byte {
+	return append(ActivePlanIndexKeyPrefix, sdk.Uint64ToBigEndian(planId)...)
+}
+
 // GetStakingKey returns a key for staking of corresponding the id
 func GetStakingKey(stakingCoinDenom string, farmerAcc sdk.AccAddress) []byte {
 	return append(append(StakingKeyPrefix, LengthPrefixString(stakingCoinDenom)...), farmerAcc...)
@@ -61,6 +106,12 @@ func GetStakingsByFarmerPrefix(farmerAcc sdk.AccAddress) []byte {
 	return append(StakingIndexKeyPrefix, address.MustLengthPrefix(farmerAcc)...)
 }
 
+// GetStakingsByDenomPrefix returns a key prefix used to iterate
+// stakings by a staking coin denom.
+func GetStakingsByDenomPrefix(stakingCoinDenom string) []byte {
+	return append(StakingKeyPrefix, LengthPrefixString(stakingCoinDenom)...)
+}
+
 // GetQueuedStakingKey returns a key for a queued staking.
 func GetQueuedStakingKey(stakingCoinDenom string, farmerAcc sdk.AccAddress) []byte {
 	return append(append(QueuedStakingKeyPrefix, LengthPrefixString(stakingCoinDenom)...), farmerAcc...)
@@ -77,11 +128,159 @@ func GetQueuedStakingByFarmerPrefix(farmerAcc sdk.AccAddress) []byte {
 	return append(QueuedStakingIndexKeyPrefix, address.MustLengthPrefix(farmerAcc)...)
 }
 
+// GetQueuedStakingsByDenomPrefix returns a key prefix used to iterate
+// queued stakings by a staking coin denom.
+func GetQueuedStakingsByDenomPrefix(stakingCoinDenom string) []byte {
+	return append(QueuedStakingKeyPrefix, LengthPrefixString(stakingCoinDenom)...)
+}
+
 // GetTotalStakingsKey returns a key for a total stakings info.
 func GetTotalStakingsKey(stakingCoinDenom string) []byte {
 	return append(TotalStakingKeyPrefix, []byte(stakingCoinDenom)...)
 }
 
+// GetLockedStakingKey returns a key for a locked staking.
+func GetLockedStakingKey(id uint64) []byte {
+	return append(LockedStakingKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetQueuedLockedStakingKey returns a key for a queued locked staking.
+func GetQueuedLockedStakingKey(id uint64) []byte {
+	return append(QueuedLockedStakingKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetLockedStakingIndexKey returns an indexing key for a locked staking.
+func GetLockedStakingIndexKey(farmerAcc sdk.AccAddress, stakingCoinDenom string, id uint64) []byte {
+	return append(GetLockedStakingsByFarmerAndDenomPrefix(farmerAcc, stakingCoinDenom), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetLockedStakingsByFarmerPrefix returns a key prefix used to iterate
+// locked stakings by a farmer.
+func GetLockedStakingsByFarmerPrefix(farmerAcc sdk.AccAddress) []byte {
+	return append(LockedStakingIndexKeyPrefix, address.MustLengthPrefix(farmerAcc)...)
+}
+
+// GetLockedStakingsByFarmerAndDenomPrefix returns a key prefix used to iterate
+// locked stakings by a farmer and a staking coin denom.
+func GetLockedStakingsByFarmerAndDenomPrefix(farmerAcc sdk.AccAddress, stakingCoinDenom string) []byte {
+	return append(GetLockedStakingsByFarmerPrefix(farmerAcc), LengthPrefixString(stakingCoinDenom)...)
+}
+
+// GetQueuedLockedStakingIndexKey returns an indexing key for a queued locked
+// staking.
+func GetQueuedLockedStakingIndexKey(farmerAcc sdk.AccAddress, stakingCoinDenom string, id uint64) []byte {
+	return append(GetQueuedLockedStakingsByFarmerAndDenomPrefix(farmerAcc, stakingCoinDenom), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetQueuedLockedStakingsByFarmerPrefix returns a key prefix used to iterate
+// queued locked stakings by a farmer.
+func GetQueuedLockedStakingsByFarmerPrefix(farmerAcc sdk.AccAddress) []byte {
+	return append(QueuedLockedStakingIndexKeyPrefix, address.MustLengthPrefix(farmerAcc)...)
+}
+
+// GetQueuedLockedStakingsByFarmerAndDenomPrefix returns a key prefix used to
+// iterate queued locked stakings by a farmer for a staking coin denom.
+func GetQueuedLockedStakingsByFarmerAndDenomPrefix(farmerAcc sdk.AccAddress, stakingCoinDenom string) []byte {
+	return append(GetQueuedLockedStakingsByFarmerPrefix(farmerAcc), LengthPrefixString(stakingCoinDenom)...)
+}
+
+// GetLockedStakingByDenomIndexKey returns an indexing key for a locked
+// staking by its staking coin denom.
+func GetLockedStakingByDenomIndexKey(stakingCoinDenom string, id uint64) []byte {
+	return append(GetLockedStakingsByDenomPrefix(stakingCoinDenom), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetLockedStakingsByDenomPrefix returns a key prefix used to iterate
+// locked stakings by a staking coin denom.
+func GetLockedStakingsByDenomPrefix(stakingCoinDenom string) []byte {
+	return append(LockedStakingByDenomIndexKeyPrefix, LengthPrefixString(stakingCoinDenom)...)
+}
+
+// GetQueuedLockedStakingByDenomIndexKey returns an indexing key for a queued
+// locked staking by its staking coin denom.
+func GetQueuedLockedStakingByDenomIndexKey(stakingCoinDenom string, id uint64) []byte {
+	return append(GetQueuedLockedStakingsByDenomPrefix(stakingCoinDenom), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetQueuedLockedStakingsByDenomPrefix returns a key prefix used to iterate
+// queued locked stakings by a staking coin denom.
+func GetQueuedLockedStakingsByDenomPrefix(stakingCoinDenom string) []byte {
+	return append(QueuedLockedStakingByDenomIndexKeyPrefix, LengthPrefixString(stakingCoinDenom)...)
+}
+
+// GetTotalLockedStakingsKey returns a key for a total locked stakings info.
+func GetTotalLockedStakingsKey(stakingCoinDenom string) []byte {
+	return append(TotalLockedStakingsKeyPrefix, []byte(stakingCoinDenom)...)
+}
+
+// GetLockedStakingUnlockQueueKey returns a key for a locked staking
+// in the unlock queue.
+func GetLockedStakingUnlockQueueKey(unlockTime time.Time, id uint64) []byte {
+	return append(GetLockedStakingUnlockQueueTimePrefix(unlockTime), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetLockedStakingUnlockQueueTimePrefix returns a key prefix of the unlock
+// queue for the given unlock time.
+func GetLockedStakingUnlockQueueTimePrefix(unlockTime time.Time) []byte {
+	return append(LockedStakingUnlockQueueKeyPrefix, sdk.FormatTimeBytes(unlockTime)...)
+}
+
+// GetUnbondingKey returns a key for an unbonding.
+func GetUnbondingKey(id uint64) []byte {
+	return append(UnbondingKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetUnbondingIndexKey returns an indexing key for an unbonding.
+func GetUnbondingIndexKey(farmerAcc sdk.AccAddress, stakingCoinDenom string, id uint64) []byte {
+	return append(GetUnbondingsByFarmerAndDenomPrefix(farmerAcc, stakingCoinDenom), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetUnbondingsByFarmerPrefix returns a key prefix used to iterate
+// unbondings by a farmer.
+func GetUnbondingsByFarmerPrefix(farmerAcc sdk.AccAddress) []byte {
+	return append(UnbondingIndexKeyPrefix, address.MustLengthPrefix(farmerAcc)...)
+}
+
+// GetUnbondingsByFarmerAndDenomPrefix returns a key prefix used to iterate
+// unbondings by a farmer and a staking coin denom.
+func GetUnbondingsByFarmerAndDenomPrefix(farmerAcc sdk.AccAddress, stakingCoinDenom string) []byte {
+	return append(GetUnbondingsByFarmerPrefix(farmerAcc), LengthPrefixString(stakingCoinDenom)...)
+}
+
+// GetUnbondingQueueKey returns a key for an unbonding in the unbonding queue.
+func GetUnbondingQueueKey(completionTime time.Time, id uint64) []byte {
+	return append(GetUnbondingQueueTimePrefix(completionTime), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetUnbondingQueueTimePrefix returns a key prefix of the unbonding queue
+// for the given completion time.
+func GetUnbondingQueueTimePrefix(completionTime time.Time) []byte {
+	return append(UnbondingQueueKeyPrefix, sdk.FormatTimeBytes(completionTime)...)
+}
+
+// GetDepositRequestKey returns a key for a deposit request.
+func GetDepositRequestKey(id uint64) []byte {
+	return append(DepositRequestKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetDepositRequestIndexKey returns an indexing key for a deposit request,
+// ordered by the pool and the batch the deposit is executed in.
+func GetDepositRequestIndexKey(poolId, batchIndex, id uint64) []byte {
+	return append(GetDepositRequestsByPoolBatchPrefix(poolId, batchIndex), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetDepositRequestsByPoolPrefix returns a key prefix used to iterate
+// deposit requests for a pool.
+func GetDepositRequestsByPoolPrefix(poolId uint64) []byte {
+	return append(DepositRequestIndexKeyPrefix, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetDepositRequestsByPoolBatchPrefix returns a key prefix used to iterate
+// deposit requests for a pool batch.
+func GetDepositRequestsByPoolBatchPrefix(poolId, batchIndex uint64) []byte {
+	return append(GetDepositRequestsByPoolPrefix(poolId), sdk.Uint64ToBigEndian(batchIndex)...)
+}
+
 // GetHistoricalRewardsKey returns a key for a historical rewards record.
 func GetHistoricalRewardsKey(stakingCoinDenom string, epoch uint64) []byte {
 	return append(append(HistoricalRewardsKeyPrefix, LengthPrefixString(stakingCoinDenom)...), sdk.Uint64ToBigEndian(epoch)...)
@@ -103,6 +302,17 @@ func GetOutstandingRewardsKey(stakingCoinDenom string) []byte {
 	return append(OutstandingRewardsKeyPrefix, []byte(stakingCoinDenom)...)
 }
 
+// GetRewardsWithdrawAddressKey returns a key for the rewards withdraw
+// address of a farmer.
+func GetRewardsWithdrawAddressKey(farmerAcc sdk.AccAddress) []byte {
+	return append(RewardsWithdrawAddressKeyPrefix, address.MustLengthPrefix(farmerAcc)...)
+}
+
+// GetAllowedStakingDenomKey returns a key for an allowed staking denom.
+func GetAllowedStakingDenomKey(denom string) []byte {
+	return append(AllowedStakingDenomKeyPrefix, []byte(denom)...)
+}
+
 // ParseStakingKey parses a staking key.
 func ParseStakingKey(key []byte) (stakingCoinDenom string, farmerAcc sdk.AccAddress) {
 	if !bytes.HasPrefix(key, StakingKeyPrefix) {
@@ -156,6 +366,128 @@ func ParseTotalStakingsKey(key []byte) (stakingCoinDenom string) {
 	return
 }
 
+// ParseLockedStakingIndexKey parses a locked staking index key.
+func ParseLockedStakingIndexKey(key []byte) (farmerAcc sdk.AccAddress, stakingCoinDenom string, id uint64) {
+	if !bytes.HasPrefix(key, LockedStakingIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	addrLen := key[1]
+	farmerAcc = key[2 : 2+addrLen]
+	denomLen := key[2+addrLen]
+	stakingCoinDenom = string(key[3+addrLen : 3+addrLen+denomLen])
+	id = sdk.BigEndianToUint64(key[3+addrLen+denomLen:])
+	return
+}
+
+// ParseQueuedLockedStakingIndexKey parses a queued locked staking index key.
+func ParseQueuedLockedStakingIndexKey(key []byte) (farmerAcc sdk.AccAddress, stakingCoinDenom string, id uint64) {
+	if !bytes.HasPrefix(key, QueuedLockedStakingIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	addrLen := key[1]
+	farmerAcc = key[2 : 2+addrLen]
+	denomLen := key[2+addrLen]
+	stakingCoinDenom = string(key[3+addrLen : 3+addrLen+denomLen])
+	id = sdk.BigEndianToUint64(key[3+addrLen+denomLen:])
+	return
+}
+
+// ParseLockedStakingByDenomIndexKey parses a locked staking by denom index key.
+func ParseLockedStakingByDenomIndexKey(key []byte) (stakingCoinDenom string, id uint64) {
+	if !bytes.HasPrefix(key, LockedStakingByDenomIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	denomLen := key[1]
+	stakingCoinDenom = string(key[2 : 2+denomLen])
+	id = sdk.BigEndianToUint64(key[2+denomLen:])
+	return
+}
+
+// ParseQueuedLockedStakingByDenomIndexKey parses a queued locked staking by
+// denom index key.
+func ParseQueuedLockedStakingByDenomIndexKey(key []byte) (stakingCoinDenom string, id uint64) {
+	if !bytes.HasPrefix(key, QueuedLockedStakingByDenomIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	denomLen := key[1]
+	stakingCoinDenom = string(key[2 : 2+denomLen])
+	id = sdk.BigEndianToUint64(key[2+denomLen:])
+	return
+}
+
+// ParseTotalLockedStakingsKey parses a total locked stakings key.
+func ParseTotalLockedStakingsKey(key []byte) (stakingCoinDenom string) {
+	if !bytes.HasPrefix(key, TotalLockedStakingsKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	stakingCoinDenom = string(key[1:])
+	return
+}
+
+// ParsePlanEndTimeIndexKey parses a plan end time index key.
+func ParsePlanEndTimeIndexKey(key []byte) (endTime time.Time, planId uint64) {
+	if !bytes.HasPrefix(key, PlanEndTimeIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	timeLen := len(key) - 1 - 8
+	endTime, err := sdk.ParseTimeBytes(key[1 : 1+timeLen])
+	if err != nil {
+		panic(err)
+	}
+	planId = sdk.BigEndianToUint64(key[1+timeLen:])
+	return
+}
+
+// ParseActivePlanIndexKey parses an active plan index key.
+func ParseActivePlanIndexKey(key []byte) (planId uint64) {
+	if !bytes.HasPrefix(key, ActivePlanIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	planId = sdk.BigEndianToUint64(key[1:])
+	return
+}
+
+// ParseLockedStakingUnlockQueueKey parses a locked staking unlock queue key.
+func ParseLockedStakingUnlockQueueKey(key []byte) (unlockTime time.Time, id uint64) {
+	if !bytes.HasPrefix(key, LockedStakingUnlockQueueKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	timeLen := len(key) - 1 - 8
+	unlockTime, err := sdk.ParseTimeBytes(key[1 : 1+timeLen])
+	if err != nil {
+		panic(err)
+	}
+	id = sdk.BigEndianToUint64(key[1+timeLen:])
+	return
+}
+
+// ParseUnbondingIndexKey parses an unbonding index key.
+func ParseUnbondingIndexKey(key []byte) (farmerAcc sdk.AccAddress, stakingCoinDenom string, id uint64) {
+	if !bytes.HasPrefix(key, UnbondingIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	addrLen := key[1]
+	farmerAcc = key[2 : 2+addrLen]
+	denomLen := key[2+addrLen]
+	stakingCoinDenom = string(key[3+addrLen : 3+addrLen+denomLen])
+	id = sdk.BigEndianToUint64(key[3+addrLen+denomLen:])
+	return
+}
+
+// ParseUnbondingQueueKey parses an unbonding queue key.
+func ParseUnbondingQueueKey(key []byte) (completionTime time.Time, id uint64) {
+	if !bytes.HasPrefix(key, UnbondingQueueKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	timeLen := len(key) - 1 - 8
+	completionTime, err := sdk.ParseTimeBytes(key[1 : 1+timeLen])
+	if err != nil {
+		panic(err)
+	}
+	id = sdk.BigEndianToUint64(key[1+timeLen:])
+	return
+}
+
 // ParseHistoricalRewardsKey parses a historical rewards key.
 func ParseHistoricalRewardsKey(key []byte) (stakingCoinDenom string, epoch uint64) {
 	if !bytes.HasPrefix(key, HistoricalRewardsKeyPrefix) {
@@ -185,6 +517,36 @@ func ParseOutstandingRewardsKey(key []byte) (stakingCoinDenom string) {
 	return
 }
 
+// ParseRewardsWithdrawAddressKey parses a rewards withdraw address key.
+func ParseRewardsWithdrawAddressKey(key []byte) (farmerAcc sdk.AccAddress) {
+	if !bytes.HasPrefix(key, RewardsWithdrawAddressKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	addrLen := key[1]
+	farmerAcc = key[2 : 2+addrLen]
+	return
+}
+
+// ParseAllowedStakingDenomKey parses an allowed staking denom key.
+func ParseAllowedStakingDenomKey(key []byte) (denom string) {
+	if !bytes.HasPrefix(key, AllowedStakingDenomKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	denom = string(key[1:])
+	return
+}
+
+// ParseDepositRequestIndexKey parses a deposit request index key.
+func ParseDepositRequestIndexKey(key []byte) (poolId, batchIndex, id uint64) {
+	if !bytes.HasPrefix(key, DepositRequestIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	poolId = sdk.BigEndianToUint64(key[1:9])
+	batchIndex = sdk.BigEndianToUint64(key[9:17])
+	id = sdk.BigEndianToUint64(key[17:])
+	return
+}
+
 // LengthPrefixString returns length-prefixed bytes representation
 // of a string.
 func LengthPrefixString(s string) []byte {
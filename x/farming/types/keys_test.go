@@ -1,7 +1,9 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -25,6 +27,27 @@ func (s *keysTestSuite) TestGetPlanKey() {
 	s.Require().Equal([]byte{0x11, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetPlanKey(10))
 }
 
+func (s *keysTestSuite) TestGetPlanEndTimeIndexKey() {
+	endTime := types.ParseTime("2021-09-01T00:00:00Z")
+
+	key := types.GetPlanEndTimeIndexKey(endTime, 3)
+	s.Require().True(bytes.HasPrefix(key, types.GetPlanEndTimeIndexTimePrefix(endTime)))
+	s.Require().Equal(append([]byte{0x12}, append([]byte("2021-09-01T00:00:00.000000000"), 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x3)...), key)
+
+	parsedEndTime, planId := types.ParsePlanEndTimeIndexKey(key)
+	s.Require().True(endTime.Equal(parsedEndTime))
+	s.Require().Equal(uint64(3), planId)
+
+	// Keys are sorted by end time first.
+	s.Require().Equal(-1, bytes.Compare(key, types.GetPlanEndTimeIndexKey(endTime.Add(time.Second), 1)))
+}
+
+func (s *keysTestSuite) TestGetActivePlanIndexKey() {
+	key := types.GetActivePlanIndexKey(3)
+	s.Require().Equal([]byte{0x13, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x3}, key)
+	s.Require().Equal(uint64(3), types.ParseActivePlanIndexKey(key))
+}
+
 func (s *keysTestSuite) TestGetStakingKey() {
 	testCases := []struct {
 		stakingCoinDenom string
@@ -113,6 +136,14 @@ func (s *keysTestSuite) TestGetStakingsByFarmerPrefix() {
 		0x63, 0x4a, 0xfe, 0xeb, 0x8, 0xc0, 0x4a, 0x53, 0x25, 0x2c, 0x9f}, types.GetStakingsByFarmerPrefix(farmer3))
 }
 
+func (s *keysTestSuite) TestGetStakingsByDenomPrefix() {
+	farmer := sdk.AccAddress(crypto.AddressHash([]byte("farmer1")))
+	prefix := types.GetStakingsByDenomPrefix("denom1")
+	s.Require().Equal([]byte{0x21, 0x6, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x31}, prefix)
+	s.Require().True(bytes.HasPrefix(types.GetStakingKey("denom1", farmer), prefix))
+	s.Require().False(bytes.HasPrefix(types.GetStakingKey("denom10", farmer), prefix))
+}
+
 func (s *keysTestSuite) TestGetQueuedStakingKey() {
 	testCases := []struct {
 		stakingCoinDenom string
@@ -204,6 +235,14 @@ func (s *keysTestSuite) TestGetQueuedStakingByFarmerPrefix() {
 		0x53, 0x25, 0x2c, 0x9f}, types.GetQueuedStakingByFarmerPrefix(farmer3))
 }
 
+func (s *keysTestSuite) TestGetQueuedStakingsByDenomPrefix() {
+	farmer := sdk.AccAddress(crypto.AddressHash([]byte("farmer1")))
+	prefix := types.GetQueuedStakingsByDenomPrefix("denom1")
+	s.Require().Equal([]byte{0x23, 0x6, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x31}, prefix)
+	s.Require().True(bytes.HasPrefix(types.GetQueuedStakingKey("denom1", farmer), prefix))
+	s.Require().False(bytes.HasPrefix(types.GetQueuedStakingKey("denom10", farmer), prefix))
+}
+
 func (s *keysTestSuite) TestGetTotalStakingsKey() {
 	for _, tc := range []struct {
 		stakingCoinDenom string
@@ -226,6 +265,145 @@ func (s *keysTestSuite) TestGetTotalStakingsKey() {
 	}
 }
 
+func (s *keysTestSuite) TestGetLockedStakingKey() {
+	s.Require().Equal([]byte{0x26, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1}, types.GetLockedStakingKey(1))
+	s.Require().Equal([]byte{0x26, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x0}, types.GetLockedStakingKey(256))
+}
+
+func (s *keysTestSuite) TestGetLockedStakingIndexKey() {
+	farmerAcc := sdk.AccAddress(crypto.AddressHash([]byte("farmer1")))
+
+	key := types.GetLockedStakingIndexKey(farmerAcc, sdk.DefaultBondDenom, 2)
+	s.Require().Equal([]byte{0x27, 0x14, 0xd3, 0x7a, 0x85, 0xec, 0x75, 0xf, 0x3, 0xaa, 0xe5,
+		0x36, 0xcf, 0x1b, 0xb7, 0x59, 0xb7, 0xbc, 0xbd, 0x5c, 0xfe, 0x3d, 0x5, 0x73, 0x74, 0x61,
+		0x6b, 0x65, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x2}, key)
+	s.Require().True(bytes.HasPrefix(key, types.GetLockedStakingsByFarmerAndDenomPrefix(farmerAcc, sdk.DefaultBondDenom)))
+	s.Require().True(bytes.HasPrefix(key, types.GetLockedStakingsByFarmerPrefix(farmerAcc)))
+
+	parsedFarmerAcc, stakingCoinDenom, id := types.ParseLockedStakingIndexKey(key)
+	s.Require().Equal(farmerAcc, parsedFarmerAcc)
+	s.Require().Equal(sdk.DefaultBondDenom, stakingCoinDenom)
+	s.Require().Equal(uint64(2), id)
+}
+
+func (s *keysTestSuite) TestGetQueuedLockedStakingIndexKey() {
+	farmerAcc := sdk.AccAddress(crypto.AddressHash([]byte("farmer1")))
+
+	key := types.GetQueuedLockedStakingIndexKey(farmerAcc, sdk.DefaultBondDenom, 2)
+	s.Require().Equal([]byte{0x30, 0x14, 0xd3, 0x7a, 0x85, 0xec, 0x75, 0xf, 0x3, 0xaa, 0xe5,
+		0x36, 0xcf, 0x1b, 0xb7, 0x59, 0xb7, 0xbc, 0xbd, 0x5c, 0xfe, 0x3d, 0x5, 0x73, 0x74, 0x61,
+		0x6b, 0x65, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x2}, key)
+	s.Require().True(bytes.HasPrefix(key, types.GetQueuedLockedStakingsByFarmerAndDenomPrefix(farmerAcc, sdk.DefaultBondDenom)))
+	s.Require().True(bytes.HasPrefix(key, types.GetQueuedLockedStakingsByFarmerPrefix(farmerAcc)))
+
+	parsedFarmerAcc, stakingCoinDenom, id := types.ParseQueuedLockedStakingIndexKey(key)
+	s.Require().Equal(farmerAcc, parsedFarmerAcc)
+	s.Require().Equal(sdk.DefaultBondDenom, stakingCoinDenom)
+	s.Require().Equal(uint64(2), id)
+}
+
+func (s *keysTestSuite) TestGetLockedStakingByDenomIndexKey() {
+	key := types.GetLockedStakingByDenomIndexKey(sdk.DefaultBondDenom, 2)
+	s.Require().Equal([]byte{0x36, 0x5, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x2}, key)
+	s.Require().True(bytes.HasPrefix(key, types.GetLockedStakingsByDenomPrefix(sdk.DefaultBondDenom)))
+
+	stakingCoinDenom, id := types.ParseLockedStakingByDenomIndexKey(key)
+	s.Require().Equal(sdk.DefaultBondDenom, stakingCoinDenom)
+	s.Require().Equal(uint64(2), id)
+
+	key = types.GetQueuedLockedStakingByDenomIndexKey(sdk.DefaultBondDenom, 3)
+	s.Require().Equal([]byte{0x37, 0x5, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x3}, key)
+	s.Require().True(bytes.HasPrefix(key, types.GetQueuedLockedStakingsByDenomPrefix(sdk.DefaultBondDenom)))
+
+	stakingCoinDenom, id = types.ParseQueuedLockedStakingByDenomIndexKey(key)
+	s.Require().Equal(sdk.DefaultBondDenom, stakingCoinDenom)
+	s.Require().Equal(uint64(3), id)
+}
+
+func (s *keysTestSuite) TestGetTotalLockedStakingsKey() {
+	key := types.GetTotalLockedStakingsKey(sdk.DefaultBondDenom)
+	s.Require().Equal([]byte{0x28, 0x73, 0x74, 0x61, 0x6b, 0x65}, key)
+	s.Require().Equal(sdk.DefaultBondDenom, types.ParseTotalLockedStakingsKey(key))
+}
+
+func (s *keysTestSuite) TestGetLockedStakingUnlockQueueKey() {
+	unlockTime := types.ParseTime("2021-09-01T00:00:00Z")
+
+	key := types.GetLockedStakingUnlockQueueKey(unlockTime, 3)
+	s.Require().True(bytes.HasPrefix(key, types.GetLockedStakingUnlockQueueTimePrefix(unlockTime)))
+	s.Require().Equal(append([]byte{0x29}, append([]byte("2021-09-01T00:00:00.000000000"), 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x3)...), key)
+
+	parsedUnlockTime, id := types.ParseLockedStakingUnlockQueueKey(key)
+	s.Require().True(unlockTime.Equal(parsedUnlockTime))
+	s.Require().Equal(uint64(3), id)
+
+	// Keys are sorted by unlock time first.
+	s.Require().Equal(-1, bytes.Compare(key, types.GetLockedStakingUnlockQueueKey(unlockTime.Add(time.Second), 1)))
+}
+
+func (s *keysTestSuite) TestGetUnbondingKey() {
+	s.Require().Equal([]byte{0x2a, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1}, types.GetUnbondingKey(1))
+	s.Require().Equal([]byte{0x2a, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x0}, types.GetUnbondingKey(256))
+}
+
+func (s *keysTestSuite) TestGetDepositRequestKey() {
+	s.Require().Equal([]byte{0x2d, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1}, types.GetDepositRequestKey(1))
+	s.Require().Equal([]byte{0x2d, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x0}, types.GetDepositRequestKey(256))
+}
+
+func (s *keysTestSuite) TestGetDepositRequestIndexKey() {
+	key := types.GetDepositRequestIndexKey(1, 2, 3)
+	s.Require().Equal([]byte{0x35, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x2, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x3}, key)
+	s.Require().True(bytes.HasPrefix(key, types.GetDepositRequestsByPoolBatchPrefix(1, 2)))
+	s.Require().True(bytes.HasPrefix(key, types.GetDepositRequestsByPoolPrefix(1)))
+
+	poolId, batchIndex, id := types.ParseDepositRequestIndexKey(key)
+	s.Require().Equal(uint64(1), poolId)
+	s.Require().Equal(uint64(2), batchIndex)
+	s.Require().Equal(uint64(3), id)
+
+	// Keys of the same pool are sorted by batch index first.
+	s.Require().Equal(-1, bytes.Compare(key, types.GetDepositRequestIndexKey(1, 3, 1)))
+}
+
+func (s *keysTestSuite) TestGetAllowedStakingDenomKey() {
+	key := types.GetAllowedStakingDenomKey(sdk.DefaultBondDenom)
+	s.Require().Equal([]byte{0x2e, 0x73, 0x74, 0x61, 0x6b, 0x65}, key)
+	s.Require().Equal(sdk.DefaultBondDenom, types.ParseAllowedStakingDenomKey(key))
+}
+
+func (s *keysTestSuite) TestGetUnbondingIndexKey() {
+	farmerAcc := sdk.AccAddress(crypto.AddressHash([]byte("farmer1")))
+
+	key := types.GetUnbondingIndexKey(farmerAcc, sdk.DefaultBondDenom, 2)
+	s.Require().Equal([]byte{0x2b, 0x14, 0xd3, 0x7a, 0x85, 0xec, 0x75, 0xf, 0x3, 0xaa, 0xe5,
+		0x36, 0xcf, 0x1b, 0xb7, 0x59, 0xb7, 0xbc, 0xbd, 0x5c, 0xfe, 0x3d, 0x5, 0x73, 0x74, 0x61,
+		0x6b, 0x65, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x2}, key)
+	s.Require().True(bytes.HasPrefix(key, types.GetUnbondingsByFarmerAndDenomPrefix(farmerAcc, sdk.DefaultBondDenom)))
+	s.Require().True(bytes.HasPrefix(key, types.GetUnbondingsByFarmerPrefix(farmerAcc)))
+
+	parsedFarmerAcc, stakingCoinDenom, id := types.ParseUnbondingIndexKey(key)
+	s.Require().Equal(farmerAcc, parsedFarmerAcc)
+	s.Require().Equal(sdk.DefaultBondDenom, stakingCoinDenom)
+	s.Require().Equal(uint64(2), id)
+}
+
+func (s *keysTestSuite) TestGetUnbondingQueueKey() {
+	completionTime := types.ParseTime("2021-09-01T00:00:00Z")
+
+	key := types.GetUnbondingQueueKey(completionTime, 3)
+	s.Require().True(bytes.HasPrefix(key, types.GetUnbondingQueueTimePrefix(completionTime)))
+	s.Require().Equal(append([]byte{0x2c}, append([]byte("2021-09-01T00:00:00.000000000"), 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x3)...), key)
+
+	parsedCompletionTime, id := types.ParseUnbondingQueueKey(key)
+	s.Require().True(completionTime.Equal(parsedCompletionTime))
+	s.Require().Equal(uint64(3), id)
+
+	// Keys are sorted by completion time first.
+	s.Require().Equal(-1, bytes.Compare(key, types.GetUnbondingQueueKey(completionTime.Add(time.Second), 1)))
+}
+
 func (s *keysTestSuite) TestGetHistoricalRewardsKey() {
 	testCases := []struct {
 		stakingCoinDenom string
@@ -272,6 +450,15 @@ func (s *keysTestSuite) TestGetCurrentEpochKey() {
 	s.Require().Equal(stakingCoinDenom, stakingCoinDenom1)
 }
 
+func (s *keysTestSuite) TestGetRewardsWithdrawAddressKey() {
+	farmerAcc := sdk.AccAddress(crypto.AddressHash([]byte("farmer1")))
+
+	key := types.GetRewardsWithdrawAddressKey(farmerAcc)
+	s.Require().Equal([]byte{0x34, 0x14, 0xd3, 0x7a, 0x85, 0xec, 0x75, 0xf, 0x3, 0xaa, 0xe5,
+		0x36, 0xcf, 0x1b, 0xb7, 0x59, 0xb7, 0xbc, 0xbd, 0x5c, 0xfe, 0x3d}, key)
+	s.Require().Equal(farmerAcc, types.ParseRewardsWithdrawAddressKey(key))
+}
+
 func (s *keysTestSuite) TestLengthPrefix() {
 	denom0 := sdk.DefaultBondDenom
 	denom1 := "uatom"
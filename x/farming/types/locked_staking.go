@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetFarmer returns the farmer address of the locked staking.
+func (lockedStaking LockedStaking) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(lockedStaking.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// Weight returns the weight of the locked staking in the reward calculation,
+// which is its amount multiplied by its multiplier.
+func (lockedStaking LockedStaking) Weight() sdk.Dec {
+	return lockedStaking.Amount.ToDec().Mul(lockedStaking.Multiplier)
+}
+
+// Validate validates LockedStaking.
+func (lockedStaking LockedStaking) Validate() error {
+	if lockedStaking.Id == 0 {
+		return fmt.Errorf("locked staking id must not be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(lockedStaking.Farmer); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(lockedStaking.StakingCoinDenom); err != nil {
+		return err
+	}
+	if !lockedStaking.Amount.IsPositive() {
+		return fmt.Errorf("locked staking amount must be positive: %s", lockedStaking.Amount)
+	}
+	if lockedStaking.Multiplier.IsNil() || lockedStaking.Multiplier.LT(sdk.OneDec()) {
+		return fmt.Errorf("locked staking multiplier must not be less than 1: %s", lockedStaking.Multiplier)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
@@ -11,20 +12,36 @@ import (
 var (
 	_ sdk.Msg = (*MsgCreateFixedAmountPlan)(nil)
 	_ sdk.Msg = (*MsgCreateRatioPlan)(nil)
+	_ sdk.Msg = (*MsgCreateDecayingPlan)(nil)
 	_ sdk.Msg = (*MsgStake)(nil)
 	_ sdk.Msg = (*MsgUnstake)(nil)
 	_ sdk.Msg = (*MsgHarvest)(nil)
 	_ sdk.Msg = (*MsgAdvanceEpoch)(nil)
+	_ sdk.Msg = (*MsgModifyPrivatePlan)(nil)
+	_ sdk.Msg = (*MsgTerminatePrivatePlan)(nil)
+	_ sdk.Msg = (*MsgFundPrivatePlan)(nil)
+	_ sdk.Msg = (*MsgCancelUnbonding)(nil)
+	_ sdk.Msg = (*MsgSetRewardsWithdrawAddress)(nil)
+	_ sdk.Msg = (*MsgDepositAndStake)(nil)
+	_ sdk.Msg = (*MsgUnstakeAndWithdraw)(nil)
 )
 
 // Message types for the farming module
 const (
-	TypeMsgCreateFixedAmountPlan = "create_fixed_amount_plan"
-	TypeMsgCreateRatioPlan       = "create_ratio_plan"
-	TypeMsgStake                 = "stake"
-	TypeMsgUnstake               = "unstake"
-	TypeMsgHarvest               = "harvest"
-	TypeMsgAdvanceEpoch          = "advance_epoch"
+	TypeMsgCreateFixedAmountPlan     = "create_fixed_amount_plan"
+	TypeMsgCreateRatioPlan           = "create_ratio_plan"
+	TypeMsgCreateDecayingPlan        = "create_decaying_plan"
+	TypeMsgStake                     = "stake"
+	TypeMsgUnstake                   = "unstake"
+	TypeMsgHarvest                   = "harvest"
+	TypeMsgAdvanceEpoch              = "advance_epoch"
+	TypeMsgModifyPrivatePlan         = "modify_private_plan"
+	TypeMsgTerminatePrivatePlan      = "terminate_private_plan"
+	TypeMsgFundPrivatePlan           = "fund_private_plan"
+	TypeMsgCancelUnbonding           = "cancel_unbonding"
+	TypeMsgSetRewardsWithdrawAddress = "set_rewards_withdraw_address"
+	TypeMsgDepositAndStake           = "deposit_and_stake"
+	TypeMsgUnstakeAndWithdraw        = "unstake_and_withdraw"
 )
 
 // NewMsgCreateFixedAmountPlan creates a new MsgCreateFixedAmountPlan.
@@ -151,6 +168,74 @@ func (msg MsgCreateRatioPlan) GetCreator() sdk.AccAddress {
 	return addr
 }
 
+// NewMsgCreateDecayingPlan creates a new MsgCreateDecayingPlan.
+func NewMsgCreateDecayingPlan(
+	name string,
+	creatorAcc sdk.AccAddress,
+	stakingCoinWeights sdk.DecCoins,
+	startTime time.Time,
+	endTime time.Time,
+	epochAmount sdk.Coins,
+	decayFactor sdk.Dec,
+	decayEpochs uint32,
+	decayTimes []time.Time,
+) *MsgCreateDecayingPlan {
+	return &MsgCreateDecayingPlan{
+		Name:               name,
+		Creator:            creatorAcc.String(),
+		StakingCoinWeights: stakingCoinWeights,
+		StartTime:          startTime,
+		EndTime:            endTime,
+		EpochAmount:        epochAmount,
+		DecayFactor:        decayFactor,
+		DecayEpochs:        decayEpochs,
+		DecayTimes:         decayTimes,
+	}
+}
+
+func (msg MsgCreateDecayingPlan) Route() string { return RouterKey }
+
+func (msg MsgCreateDecayingPlan) Type() string { return TypeMsgCreateDecayingPlan }
+
+func (msg MsgCreateDecayingPlan) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address %q: %v", msg.Creator, err)
+	}
+	if !msg.EndTime.After(msg.StartTime) {
+		return sdkerrors.Wrapf(ErrInvalidPlanEndTime, "end time %s must be greater than start time %s", msg.EndTime.Format(time.RFC3339), msg.StartTime.Format(time.RFC3339))
+	}
+	if err := ValidateStakingCoinTotalWeights(msg.StakingCoinWeights); err != nil {
+		return err
+	}
+	if msg.EpochAmount.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "epoch amount must not be empty")
+	}
+	if err := msg.EpochAmount.Validate(); err != nil {
+		return err
+	}
+	return ValidateDecaySchedule(msg.DecayFactor, msg.DecayEpochs, msg.DecayTimes)
+}
+
+func (msg MsgCreateDecayingPlan) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateDecayingPlan) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCreateDecayingPlan) GetCreator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 // NewMsgStake creates a new MsgStake.
 func NewMsgStake(
 	farmer sdk.AccAddress,
@@ -162,6 +247,20 @@ func NewMsgStake(
 	}
 }
 
+// NewMsgStakeFor creates a new MsgStake that the payer pays the staking
+// coins for the farmer.
+func NewMsgStakeFor(
+	payer sdk.AccAddress,
+	farmer sdk.AccAddress,
+	stakingCoins sdk.Coins,
+) *MsgStake {
+	return &MsgStake{
+		Farmer:       farmer.String(),
+		StakingCoins: stakingCoins,
+		Payer:        payer.String(),
+	}
+}
+
 func (msg MsgStake) Route() string { return RouterKey }
 
 func (msg MsgStake) Type() string { return TypeMsgStake }
@@ -170,12 +269,23 @@ func (msg MsgStake) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Farmer); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid farmer address %q: %v", msg.Farmer, err)
 	}
+	if msg.Payer != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Payer); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payer address %q: %v", msg.Payer, err)
+		}
+	}
 	if ok := msg.StakingCoins.IsZero(); ok {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "staking coins must not be zero")
 	}
 	if err := msg.StakingCoins.Validate(); err != nil {
 		return err
 	}
+	if msg.LockDuration < 0 {
+		return sdkerrors.Wrapf(ErrInvalidLockDuration, "lock duration must not be negative: %s", msg.LockDuration)
+	}
+	if msg.LockDuration != 0 && msg.Payer != "" && msg.Payer != msg.Farmer {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "staking coins can't be locked on behalf of another farmer")
+	}
 	return nil
 }
 
@@ -184,15 +294,24 @@ func (msg MsgStake) GetSignBytes() []byte {
 }
 
 func (msg MsgStake) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.GetPayer()}
+}
+
+func (msg MsgStake) GetFarmer() sdk.AccAddress {
 	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
 	if err != nil {
 		panic(err)
 	}
-	return []sdk.AccAddress{addr}
+	return addr
 }
 
-func (msg MsgStake) GetFarmer() sdk.AccAddress {
-	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+// GetPayer returns the address that pays the staking coins, which is the
+// farmer itself if the payer is not set.
+func (msg MsgStake) GetPayer() sdk.AccAddress {
+	if msg.Payer == "" {
+		return msg.GetFarmer()
+	}
+	addr, err := sdk.AccAddressFromBech32(msg.Payer)
 	if err != nil {
 		panic(err)
 	}
@@ -326,3 +445,396 @@ func (msg MsgAdvanceEpoch) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgModifyPrivatePlan creates a new MsgModifyPrivatePlan.
+func NewMsgModifyPrivatePlan(
+	planId uint64,
+	terminationAcc sdk.AccAddress,
+	name string,
+	stakingCoinWeights sdk.DecCoins,
+	startTime *time.Time,
+	endTime *time.Time,
+	epochAmount sdk.Coins,
+	epochRatio sdk.Dec,
+) *MsgModifyPrivatePlan {
+	return &MsgModifyPrivatePlan{
+		PlanId:             planId,
+		TerminationAddress: terminationAcc.String(),
+		Name:               name,
+		StakingCoinWeights: stakingCoinWeights,
+		StartTime:          startTime,
+		EndTime:            endTime,
+		EpochAmount:        epochAmount,
+		EpochRatio:         epochRatio,
+	}
+}
+
+func (msg MsgModifyPrivatePlan) Route() string { return RouterKey }
+
+func (msg MsgModifyPrivatePlan) Type() string { return TypeMsgModifyPrivatePlan }
+
+func (msg MsgModifyPrivatePlan) ValidateBasic() error {
+	if msg.PlanId == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid plan id: %d", msg.PlanId)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.TerminationAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid termination address %q: %v", msg.TerminationAddress, err)
+	}
+	if strings.Contains(msg.Name, AccNameSplitter) {
+		return sdkerrors.Wrapf(ErrInvalidPlanName, "plan name cannot contain %s", AccNameSplitter)
+	}
+	if len(msg.Name) > MaxNameLength {
+		return sdkerrors.Wrapf(ErrInvalidPlanName, "plan name cannot be longer than max length of %d", MaxNameLength)
+	}
+	if msg.StakingCoinWeights != nil {
+		if err := ValidateStakingCoinTotalWeights(msg.StakingCoinWeights); err != nil {
+			return err
+		}
+	}
+	if msg.StartTime != nil && msg.EndTime != nil {
+		if !msg.EndTime.After(*msg.StartTime) {
+			return sdkerrors.Wrapf(ErrInvalidPlanEndTime, "end time %s must be greater than start time %s", msg.EndTime.Format(time.RFC3339), msg.StartTime.Format(time.RFC3339))
+		}
+	}
+	isForFixedAmountPlan := msg.IsForFixedAmountPlan()
+	isForRatioPlan := msg.IsForRatioPlan()
+	switch {
+	case msg.IsForDecayingPlan():
+		return ValidateDecayingPlanRequest(msg.EpochAmount, msg.EpochRatio, msg.DecayFactor, msg.DecayEpochs, msg.DecayTimes)
+	case msg.DecayEpochs > 0 || len(msg.DecayTimes) > 0:
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "decay factor must be provided along with decay schedule")
+	case isForFixedAmountPlan && isForRatioPlan:
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "at most one of epoch amount or epoch ratio must be provided")
+	case isForFixedAmountPlan:
+		if err := ValidateEpochAmount(msg.EpochAmount); err != nil {
+			return err
+		}
+	case isForRatioPlan:
+		if err := ValidateEpochRatio(msg.EpochRatio); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (msg MsgModifyPrivatePlan) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgModifyPrivatePlan) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.TerminationAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgModifyPrivatePlan) GetTerminationAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.TerminationAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// IsForFixedAmountPlan returns true if the message changes the plan
+// to a fixed amount plan.
+// It checks if EpochAmount is not zero and DecayFactor is zero.
+func (msg MsgModifyPrivatePlan) IsForFixedAmountPlan() bool {
+	return !msg.EpochAmount.Empty() && !msg.IsForDecayingPlan()
+}
+
+// IsForRatioPlan returns true if the message changes the plan
+// to a ratio plan.
+// It checks if EpochRatio is not zero.
+func (msg MsgModifyPrivatePlan) IsForRatioPlan() bool {
+	return !msg.EpochRatio.IsNil() && !msg.EpochRatio.IsZero()
+}
+
+// IsForDecayingPlan returns true if the message changes the plan
+// to a decaying plan.
+// It checks if DecayFactor is not zero.
+func (msg MsgModifyPrivatePlan) IsForDecayingPlan() bool {
+	return !msg.DecayFactor.IsNil() && !msg.DecayFactor.IsZero()
+}
+
+// NewMsgTerminatePrivatePlan creates a new MsgTerminatePrivatePlan.
+func NewMsgTerminatePrivatePlan(planId uint64, terminationAcc sdk.AccAddress) *MsgTerminatePrivatePlan {
+	return &MsgTerminatePrivatePlan{
+		PlanId:             planId,
+		TerminationAddress: terminationAcc.String(),
+	}
+}
+
+func (msg MsgTerminatePrivatePlan) Route() string { return RouterKey }
+
+func (msg MsgTerminatePrivatePlan) Type() string { return TypeMsgTerminatePrivatePlan }
+
+func (msg MsgTerminatePrivatePlan) ValidateBasic() error {
+	if msg.PlanId == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid plan id: %d", msg.PlanId)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.TerminationAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid termination address %q: %v", msg.TerminationAddress, err)
+	}
+	return nil
+}
+
+func (msg MsgTerminatePrivatePlan) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgTerminatePrivatePlan) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.TerminationAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgTerminatePrivatePlan) GetTerminationAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.TerminationAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgFundPrivatePlan creates a new MsgFundPrivatePlan.
+func NewMsgFundPrivatePlan(planId uint64, terminationAcc sdk.AccAddress, amount sdk.Coins) *MsgFundPrivatePlan {
+	return &MsgFundPrivatePlan{
+		PlanId:             planId,
+		TerminationAddress: terminationAcc.String(),
+		Amount:             amount,
+	}
+}
+
+func (msg MsgFundPrivatePlan) Route() string { return RouterKey }
+
+func (msg MsgFundPrivatePlan) Type() string { return TypeMsgFundPrivatePlan }
+
+func (msg MsgFundPrivatePlan) ValidateBasic() error {
+	if msg.PlanId == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid plan id: %d", msg.PlanId)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.TerminationAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid termination address %q: %v", msg.TerminationAddress, err)
+	}
+	if ok := msg.Amount.IsZero(); ok {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "amount must not be zero")
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg MsgFundPrivatePlan) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgFundPrivatePlan) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.TerminationAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgFundPrivatePlan) GetTerminationAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.TerminationAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgCancelUnbonding creates a new MsgCancelUnbonding.
+func NewMsgCancelUnbonding(farmer sdk.AccAddress, unbondingId uint64) *MsgCancelUnbonding {
+	return &MsgCancelUnbonding{
+		Farmer:      farmer.String(),
+		UnbondingId: unbondingId,
+	}
+}
+
+func (msg MsgCancelUnbonding) Route() string { return RouterKey }
+
+func (msg MsgCancelUnbonding) Type() string { return TypeMsgCancelUnbonding }
+
+func (msg MsgCancelUnbonding) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Farmer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid farmer address %q: %v", msg.Farmer, err)
+	}
+	if msg.UnbondingId == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid unbonding id: %d", msg.UnbondingId)
+	}
+	return nil
+}
+
+func (msg MsgCancelUnbonding) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelUnbonding) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCancelUnbonding) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgSetRewardsWithdrawAddress creates a new MsgSetRewardsWithdrawAddress.
+func NewMsgSetRewardsWithdrawAddress(farmer, withdrawAddr sdk.AccAddress) *MsgSetRewardsWithdrawAddress {
+	return &MsgSetRewardsWithdrawAddress{
+		Farmer:          farmer.String(),
+		WithdrawAddress: withdrawAddr.String(),
+	}
+}
+
+func (msg MsgSetRewardsWithdrawAddress) Route() string { return RouterKey }
+
+func (msg MsgSetRewardsWithdrawAddress) Type() string { return TypeMsgSetRewardsWithdrawAddress }
+
+func (msg MsgSetRewardsWithdrawAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Farmer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid farmer address %q: %v", msg.Farmer, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.WithdrawAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid withdraw address %q: %v", msg.WithdrawAddress, err)
+	}
+	return nil
+}
+
+func (msg MsgSetRewardsWithdrawAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetRewardsWithdrawAddress) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgSetRewardsWithdrawAddress) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func (msg MsgSetRewardsWithdrawAddress) GetWithdrawAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.WithdrawAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgDepositAndStake creates a new MsgDepositAndStake.
+func NewMsgDepositAndStake(farmer sdk.AccAddress, poolId uint64, depositCoins sdk.Coins) *MsgDepositAndStake {
+	return &MsgDepositAndStake{
+		Farmer:       farmer.String(),
+		PoolId:       poolId,
+		DepositCoins: depositCoins,
+	}
+}
+
+func (msg MsgDepositAndStake) Route() string { return RouterKey }
+
+func (msg MsgDepositAndStake) Type() string { return TypeMsgDepositAndStake }
+
+func (msg MsgDepositAndStake) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Farmer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid farmer address %q: %v", msg.Farmer, err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid pool id: %d", msg.PoolId)
+	}
+	if ok := msg.DepositCoins.IsZero(); ok {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "deposit coins must not be zero")
+	}
+	if err := msg.DepositCoins.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg MsgDepositAndStake) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgDepositAndStake) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgDepositAndStake) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgUnstakeAndWithdraw creates a new MsgUnstakeAndWithdraw.
+func NewMsgUnstakeAndWithdraw(farmer sdk.AccAddress, poolId uint64, poolCoin sdk.Coin) *MsgUnstakeAndWithdraw {
+	return &MsgUnstakeAndWithdraw{
+		Farmer:   farmer.String(),
+		PoolId:   poolId,
+		PoolCoin: poolCoin,
+	}
+}
+
+func (msg MsgUnstakeAndWithdraw) Route() string { return RouterKey }
+
+func (msg MsgUnstakeAndWithdraw) Type() string { return TypeMsgUnstakeAndWithdraw }
+
+func (msg MsgUnstakeAndWithdraw) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Farmer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid farmer address %q: %v", msg.Farmer, err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid pool id: %d", msg.PoolId)
+	}
+	if err := msg.PoolCoin.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if !msg.PoolCoin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool coin must be positive")
+	}
+	return nil
+}
+
+func (msg MsgUnstakeAndWithdraw) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUnstakeAndWithdraw) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgUnstakeAndWithdraw) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
@@ -145,8 +145,106 @@ func TestMsgCreateRatioPlan(t *testing.T) {
 	}
 }
 
+func TestMsgCreateDecayingPlan(t *testing.T) {
+	name := "test"
+	creatorAddr := sdk.AccAddress(crypto.AddressHash([]byte("creatorAddr")))
+	stakingCoinWeights := sdk.NewDecCoins(sdk.DecCoin{Denom: "farmingCoinDenom", Amount: sdk.MustNewDecFromStr("1.0")})
+	startTime, _ := time.Parse(time.RFC3339, "2021-11-01T22:08:41+00:00") // needs to be deterministic for test
+	endTime := startTime.AddDate(1, 0, 0)
+	epochAmount := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))
+	decayFactor := sdk.NewDecWithPrec(5, 1)
+	decayTimes := []time.Time{startTime.AddDate(0, 6, 0), startTime.AddDate(0, 9, 0)}
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgCreateDecayingPlan
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgCreateDecayingPlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, endTime, epochAmount, decayFactor, 30, nil,
+			),
+		},
+		{
+			"",
+			types.NewMsgCreateDecayingPlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, endTime, epochAmount, decayFactor, 0, decayTimes,
+			),
+		},
+		{
+			"invalid creator address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgCreateDecayingPlan(
+				name, sdk.AccAddress{}, stakingCoinWeights,
+				startTime, endTime, epochAmount, decayFactor, 30, nil,
+			),
+		},
+		{
+			"epoch amount must not be empty: invalid request",
+			types.NewMsgCreateDecayingPlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, endTime, sdk.Coins{}, decayFactor, 30, nil,
+			),
+		},
+		{
+			"decay factor must be positive: 0.000000000000000000: invalid request",
+			types.NewMsgCreateDecayingPlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, endTime, epochAmount, sdk.ZeroDec(), 30, nil,
+			),
+		},
+		{
+			"decay factor must not be greater than 1: 1.500000000000000000: invalid request",
+			types.NewMsgCreateDecayingPlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, endTime, epochAmount, sdk.NewDecWithPrec(15, 1), 30, nil,
+			),
+		},
+		{
+			"exactly one of decay epochs or decay times must be provided: invalid request",
+			types.NewMsgCreateDecayingPlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, endTime, epochAmount, decayFactor, 30, decayTimes,
+			),
+		},
+		{
+			"exactly one of decay epochs or decay times must be provided: invalid request",
+			types.NewMsgCreateDecayingPlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, endTime, epochAmount, decayFactor, 0, nil,
+			),
+		},
+		{
+			"decay times must be in increasing order: 2022-05-01T22:08:41Z: invalid request",
+			types.NewMsgCreateDecayingPlan(
+				name, creatorAddr, stakingCoinWeights,
+				startTime, endTime, epochAmount, decayFactor, 0, []time.Time{decayTimes[1], decayTimes[0]},
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgCreateDecayingPlan{}, tc.msg)
+		require.Equal(t, types.TypeMsgCreateDecayingPlan, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetCreator(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
 func TestMsgStake(t *testing.T) {
 	farmingPoolAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmingPoolAddr")))
+	payerAddr := sdk.AccAddress(crypto.AddressHash([]byte("payerAddr")))
 	stakingCoins := sdk.NewCoins(sdk.NewCoin("farmingCoinDenom", sdk.NewInt(1)))
 
 	testCases := []struct {
@@ -165,6 +263,26 @@ func TestMsgStake(t *testing.T) {
 			"staking coins must not be zero: invalid request",
 			types.NewMsgStake(farmingPoolAddr, sdk.NewCoins(sdk.NewCoin("farmingCoinDenom", sdk.NewInt(0)))),
 		},
+		{
+			"", // empty means no error expected
+			&types.MsgStake{Farmer: farmingPoolAddr.String(), StakingCoins: stakingCoins, LockDuration: 30 * 24 * time.Hour},
+		},
+		{
+			"lock duration must not be negative: -1s: invalid lock duration",
+			&types.MsgStake{Farmer: farmingPoolAddr.String(), StakingCoins: stakingCoins, LockDuration: -time.Second},
+		},
+		{
+			"", // empty means no error expected
+			types.NewMsgStakeFor(payerAddr, farmingPoolAddr, stakingCoins),
+		},
+		{
+			"invalid payer address \"invalid\": decoding bech32 failed: invalid bech32 string length 7: invalid address",
+			&types.MsgStake{Farmer: farmingPoolAddr.String(), StakingCoins: stakingCoins, Payer: "invalid"},
+		},
+		{
+			"staking coins can't be locked on behalf of another farmer: invalid request",
+			&types.MsgStake{Farmer: farmingPoolAddr.String(), StakingCoins: stakingCoins, LockDuration: 30 * 24 * time.Hour, Payer: payerAddr.String()},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -178,11 +296,16 @@ func TestMsgStake(t *testing.T) {
 			require.Nil(t, err)
 			signers := tc.msg.GetSigners()
 			require.Len(t, signers, 1)
-			require.Equal(t, tc.msg.GetFarmer(), signers[0])
+			require.Equal(t, tc.msg.GetPayer(), signers[0])
 		} else {
 			require.EqualError(t, err, tc.expectedErr)
 		}
 	}
+
+	msg := types.NewMsgStakeFor(payerAddr, farmingPoolAddr, stakingCoins)
+	require.Equal(t, farmingPoolAddr, msg.GetFarmer())
+	require.Equal(t, []sdk.AccAddress{payerAddr}, msg.GetSigners())
+	require.Equal(t, farmingPoolAddr, types.NewMsgStake(farmingPoolAddr, stakingCoins).GetPayer())
 }
 
 func TestMsgUnstake(t *testing.T) {
@@ -264,3 +387,319 @@ func TestMsgHarvest(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgModifyPrivatePlan(t *testing.T) {
+	terminationAddr := sdk.AccAddress(crypto.AddressHash([]byte("terminationAddr")))
+	stakingCoinWeights := sdk.NewDecCoins(sdk.DecCoin{Denom: "farmingCoinDenom", Amount: sdk.MustNewDecFromStr("1.0")})
+	startTime, _ := time.Parse(time.RFC3339, "2021-11-01T22:08:41+00:00") // needs to be deterministic for test
+	endTime := startTime.AddDate(1, 0, 0)
+	pastTime := startTime.AddDate(-1, 0, 0)
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgModifyPrivatePlan
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgModifyPrivatePlan(
+				1, terminationAddr, "new-name", stakingCoinWeights,
+				&startTime, &endTime, sdk.Coins{sdk.NewCoin("uatom", sdk.NewInt(1))}, sdk.Dec{},
+			),
+		},
+		{
+			"", // only end time is modified
+			types.NewMsgModifyPrivatePlan(1, terminationAddr, "", nil, nil, &endTime, nil, sdk.Dec{}),
+		},
+		{
+			"invalid plan id: 0: invalid request",
+			types.NewMsgModifyPrivatePlan(0, terminationAddr, "", nil, nil, &endTime, nil, sdk.Dec{}),
+		},
+		{
+			"invalid termination address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgModifyPrivatePlan(1, sdk.AccAddress{}, "", nil, nil, &endTime, nil, sdk.Dec{}),
+		},
+		{
+			"end time 2020-11-01T22:08:41Z must be greater than start time 2021-11-01T22:08:41Z: invalid plan end time",
+			types.NewMsgModifyPrivatePlan(1, terminationAddr, "", nil, &startTime, &pastTime, nil, sdk.Dec{}),
+		},
+		{
+			"staking coin weights must not be empty: invalid staking coin weights",
+			types.NewMsgModifyPrivatePlan(1, terminationAddr, "", sdk.NewDecCoins(), nil, nil, nil, sdk.Dec{}),
+		},
+		{
+			"at most one of epoch amount or epoch ratio must be provided: invalid request",
+			types.NewMsgModifyPrivatePlan(
+				1, terminationAddr, "", nil, nil, nil,
+				sdk.Coins{sdk.NewCoin("uatom", sdk.NewInt(1))}, sdk.NewDecWithPrec(1, 1),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgModifyPrivatePlan{}, tc.msg)
+		require.Equal(t, types.TypeMsgModifyPrivatePlan, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetTerminationAddress(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgTerminatePrivatePlan(t *testing.T) {
+	terminationAddr := sdk.AccAddress(crypto.AddressHash([]byte("terminationAddr")))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgTerminatePrivatePlan
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgTerminatePrivatePlan(1, terminationAddr),
+		},
+		{
+			"invalid plan id: 0: invalid request",
+			types.NewMsgTerminatePrivatePlan(0, terminationAddr),
+		},
+		{
+			"invalid termination address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgTerminatePrivatePlan(1, sdk.AccAddress{}),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgTerminatePrivatePlan{}, tc.msg)
+		require.Equal(t, types.TypeMsgTerminatePrivatePlan, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetTerminationAddress(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgFundPrivatePlan(t *testing.T) {
+	terminationAddr := sdk.AccAddress(crypto.AddressHash([]byte("terminationAddr")))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgFundPrivatePlan
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgFundPrivatePlan(1, terminationAddr, amount),
+		},
+		{
+			"invalid plan id: 0: invalid request",
+			types.NewMsgFundPrivatePlan(0, terminationAddr, amount),
+		},
+		{
+			"invalid termination address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgFundPrivatePlan(1, sdk.AccAddress{}, amount),
+		},
+		{
+			"amount must not be zero: invalid request",
+			types.NewMsgFundPrivatePlan(1, terminationAddr, sdk.Coins{}),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgFundPrivatePlan{}, tc.msg)
+		require.Equal(t, types.TypeMsgFundPrivatePlan, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetTerminationAddress(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgCancelUnbonding(t *testing.T) {
+	farmerAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmer")))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgCancelUnbonding
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgCancelUnbonding(farmerAddr, 1),
+		},
+		{
+			"invalid farmer address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgCancelUnbonding(sdk.AccAddress{}, 1),
+		},
+		{
+			"invalid unbonding id: 0: invalid request",
+			types.NewMsgCancelUnbonding(farmerAddr, 0),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgCancelUnbonding{}, tc.msg)
+		require.Equal(t, types.TypeMsgCancelUnbonding, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetFarmer(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgSetRewardsWithdrawAddress(t *testing.T) {
+	farmerAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmer")))
+	withdrawAddr := sdk.AccAddress(crypto.AddressHash([]byte("withdraw")))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgSetRewardsWithdrawAddress
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgSetRewardsWithdrawAddress(farmerAddr, withdrawAddr),
+		},
+		{
+			"invalid farmer address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgSetRewardsWithdrawAddress(sdk.AccAddress{}, withdrawAddr),
+		},
+		{
+			"invalid withdraw address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgSetRewardsWithdrawAddress(farmerAddr, sdk.AccAddress{}),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgSetRewardsWithdrawAddress{}, tc.msg)
+		require.Equal(t, types.TypeMsgSetRewardsWithdrawAddress, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetFarmer(), signers[0])
+			require.Equal(t, withdrawAddr, tc.msg.GetWithdrawAddress())
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgDepositAndStake(t *testing.T) {
+	farmerAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmer")))
+	depositCoins := sdk.NewCoins(sdk.NewInt64Coin("denom1", 1000000), sdk.NewInt64Coin("denom2", 1000000))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgDepositAndStake
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgDepositAndStake(farmerAddr, 1, depositCoins),
+		},
+		{
+			"invalid farmer address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgDepositAndStake(sdk.AccAddress{}, 1, depositCoins),
+		},
+		{
+			"invalid pool id: 0: invalid request",
+			types.NewMsgDepositAndStake(farmerAddr, 0, depositCoins),
+		},
+		{
+			"deposit coins must not be zero: invalid request",
+			types.NewMsgDepositAndStake(farmerAddr, 1, sdk.NewCoins()),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgDepositAndStake{}, tc.msg)
+		require.Equal(t, types.TypeMsgDepositAndStake, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetFarmer(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgUnstakeAndWithdraw(t *testing.T) {
+	farmerAddr := sdk.AccAddress(crypto.AddressHash([]byte("farmer")))
+	poolCoin := sdk.NewInt64Coin("pool1", 1000000)
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgUnstakeAndWithdraw
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgUnstakeAndWithdraw(farmerAddr, 1, poolCoin),
+		},
+		{
+			"invalid farmer address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgUnstakeAndWithdraw(sdk.AccAddress{}, 1, poolCoin),
+		},
+		{
+			"invalid pool id: 0: invalid request",
+			types.NewMsgUnstakeAndWithdraw(farmerAddr, 0, poolCoin),
+		},
+		{
+			"pool coin must be positive: invalid request",
+			types.NewMsgUnstakeAndWithdraw(farmerAddr, 1, sdk.NewInt64Coin("pool1", 0)),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgUnstakeAndWithdraw{}, tc.msg)
+		require.Equal(t, types.TypeMsgUnstakeAndWithdraw, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetFarmer(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"gopkg.in/yaml.v2"
 
@@ -12,16 +13,26 @@ import (
 
 // Parameter store keys
 var (
-	KeyPrivatePlanCreationFee = []byte("PrivatePlanCreationFee")
-	KeyNextEpochDays          = []byte("NextEpochDays")
-	KeyFarmingFeeCollector    = []byte("FarmingFeeCollector")
-	KeyDelayedStakingGasFee   = []byte("DelayedStakingGasFee")
-
-	DefaultPrivatePlanCreationFee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100_000_000)))
-	DefaultCurrentEpochDays       = uint32(1)
-	DefaultNextEpochDays          = uint32(1)
-	DefaultFarmingFeeCollector    = sdk.AccAddress(address.Module(ModuleName, []byte("FarmingFeeCollectorAcc"))).String()
-	DefaultDelayedStakingGasFee   = sdk.Gas(60000) // See https://github.com/tendermint/farming/issues/102 for details.
+	KeyPrivatePlanCreationFee     = []byte("PrivatePlanCreationFee")
+	KeyNextEpochDuration          = []byte("NextEpochDuration")
+	KeyMaxCatchUpEpochs           = []byte("MaxCatchUpEpochs")
+	KeyFarmingFeeCollector        = []byte("FarmingFeeCollector")
+	KeyDelayedStakingGasFee       = []byte("DelayedStakingGasFee")
+	KeyPartialAllocation          = []byte("PartialAllocation")
+	KeyLockTiers                  = []byte("LockTiers")
+	KeyUnbondingPeriod            = []byte("UnbondingPeriod")
+	KeyMaxThirdPartyStakingDenoms = []byte("MaxThirdPartyStakingDenoms")
+
+	DefaultPrivatePlanCreationFee     = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100_000_000)))
+	DefaultCurrentEpochDuration       = 24 * time.Hour
+	DefaultNextEpochDuration          = 24 * time.Hour
+	DefaultFarmingFeeCollector        = sdk.AccAddress(address.Module(ModuleName, []byte("FarmingFeeCollectorAcc"))).String()
+	DefaultDelayedStakingGasFee       = sdk.Gas(60000) // See https://github.com/tendermint/farming/issues/102 for details.
+	DefaultPartialAllocation          = false
+	DefaultMaxCatchUpEpochs           = uint32(0)
+	DefaultLockTiers                  = []LockTier{}
+	DefaultUnbondingPeriod            = time.Duration(0)
+	DefaultMaxThirdPartyStakingDenoms = uint32(5)
 
 	// ReserveAddressType is an address type of reserve accounts for staking or rewards.
 	// The module uses the address type of 32 bytes length, but it can be changed depending on Cosmos SDK's direction.
@@ -41,10 +52,15 @@ func ParamKeyTable() paramstypes.KeyTable {
 // DefaultParams returns the default farming module parameters.
 func DefaultParams() Params {
 	return Params{
-		PrivatePlanCreationFee: DefaultPrivatePlanCreationFee,
-		NextEpochDays:          DefaultNextEpochDays,
-		FarmingFeeCollector:    DefaultFarmingFeeCollector,
-		DelayedStakingGasFee:   DefaultDelayedStakingGasFee,
+		PrivatePlanCreationFee:     DefaultPrivatePlanCreationFee,
+		FarmingFeeCollector:        DefaultFarmingFeeCollector,
+		DelayedStakingGasFee:       DefaultDelayedStakingGasFee,
+		PartialAllocation:          DefaultPartialAllocation,
+		NextEpochDuration:          DefaultNextEpochDuration,
+		MaxCatchUpEpochs:           DefaultMaxCatchUpEpochs,
+		LockTiers:                  DefaultLockTiers,
+		UnbondingPeriod:            DefaultUnbondingPeriod,
+		MaxThirdPartyStakingDenoms: DefaultMaxThirdPartyStakingDenoms,
 	}
 }
 
@@ -52,9 +68,14 @@ func DefaultParams() Params {
 func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 	return paramstypes.ParamSetPairs{
 		paramstypes.NewParamSetPair(KeyPrivatePlanCreationFee, &p.PrivatePlanCreationFee, validatePrivatePlanCreationFee),
-		paramstypes.NewParamSetPair(KeyNextEpochDays, &p.NextEpochDays, validateNextEpochDays),
 		paramstypes.NewParamSetPair(KeyFarmingFeeCollector, &p.FarmingFeeCollector, validateFarmingFeeCollector),
 		paramstypes.NewParamSetPair(KeyDelayedStakingGasFee, &p.DelayedStakingGasFee, validateDelayedStakingGas),
+		paramstypes.NewParamSetPair(KeyPartialAllocation, &p.PartialAllocation, validatePartialAllocation),
+		paramstypes.NewParamSetPair(KeyNextEpochDuration, &p.NextEpochDuration, validateNextEpochDuration),
+		paramstypes.NewParamSetPair(KeyMaxCatchUpEpochs, &p.MaxCatchUpEpochs, validateMaxCatchUpEpochs),
+		paramstypes.NewParamSetPair(KeyLockTiers, &p.LockTiers, validateLockTiers),
+		paramstypes.NewParamSetPair(KeyUnbondingPeriod, &p.UnbondingPeriod, validateUnbondingPeriod),
+		paramstypes.NewParamSetPair(KeyMaxThirdPartyStakingDenoms, &p.MaxThirdPartyStakingDenoms, validateMaxThirdPartyStakingDenoms),
 	}
 }
 
@@ -71,9 +92,14 @@ func (p Params) Validate() error {
 		validator func(interface{}) error
 	}{
 		{p.PrivatePlanCreationFee, validatePrivatePlanCreationFee},
-		{p.NextEpochDays, validateNextEpochDays},
 		{p.FarmingFeeCollector, validateFarmingFeeCollector},
 		{p.DelayedStakingGasFee, validateDelayedStakingGas},
+		{p.PartialAllocation, validatePartialAllocation},
+		{p.NextEpochDuration, validateNextEpochDuration},
+		{p.MaxCatchUpEpochs, validateMaxCatchUpEpochs},
+		{p.LockTiers, validateLockTiers},
+		{p.UnbondingPeriod, validateUnbondingPeriod},
+		{p.MaxThirdPartyStakingDenoms, validateMaxThirdPartyStakingDenoms},
 	} {
 		if err := v.validator(v.value); err != nil {
 			return err
@@ -95,39 +121,123 @@ func validatePrivatePlanCreationFee(i interface{}) error {
 	return nil
 }
 
-func validateNextEpochDays(i interface{}) error {
-	v, ok := i.(uint32)
+func validateFarmingFeeCollector(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == "" {
+		return fmt.Errorf("farming fee collector address must not be empty")
+	}
+
+	_, err := sdk.AccAddressFromBech32(v)
+	if err != nil {
+		return fmt.Errorf("invalid account address: %v", v)
+	}
+
+	return nil
+}
+
+func validateDelayedStakingGas(i interface{}) error {
+	_, ok := i.(sdk.Gas)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePartialAllocation(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateNextEpochDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
 	if v <= 0 {
-		return fmt.Errorf("next epoch days must be positive: %d", v)
+		return fmt.Errorf("next epoch duration must be positive: %s", v)
+	}
+
+	if v%time.Second != 0 {
+		return fmt.Errorf("next epoch duration must be a multiple of a second: %s", v)
 	}
 
 	return nil
 }
 
-func validateFarmingFeeCollector(i interface{}) error {
-	v, ok := i.(string)
+func validateMaxCatchUpEpochs(i interface{}) error {
+	_, ok := i.(uint32)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if v == "" {
-		return fmt.Errorf("farming fee collector address must not be empty")
+	return nil
+}
+
+// LockTier returns the lock tier with the given lock duration.
+func (p Params) LockTier(duration time.Duration) (LockTier, bool) {
+	for _, tier := range p.LockTiers {
+		if tier.Duration == duration {
+			return tier, true
+		}
 	}
+	return LockTier{}, false
+}
 
-	_, err := sdk.AccAddressFromBech32(v)
-	if err != nil {
-		return fmt.Errorf("invalid account address: %v", v)
+func validateLockTiers(i interface{}) error {
+	v, ok := i.([]LockTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	durations := map[time.Duration]bool{}
+	for _, tier := range v {
+		if tier.Duration <= 0 {
+			return fmt.Errorf("lock tier duration must be positive: %s", tier.Duration)
+		}
+		if tier.Duration%time.Second != 0 {
+			return fmt.Errorf("lock tier duration must be a multiple of a second: %s", tier.Duration)
+		}
+		if durations[tier.Duration] {
+			return fmt.Errorf("duplicate lock tier duration: %s", tier.Duration)
+		}
+		durations[tier.Duration] = true
+		if tier.Multiplier.IsNil() || tier.Multiplier.LT(sdk.OneDec()) {
+			return fmt.Errorf("lock tier multiplier must not be less than 1: %s", tier.Multiplier)
+		}
 	}
 
 	return nil
 }
 
-func validateDelayedStakingGas(i interface{}) error {
-	_, ok := i.(sdk.Gas)
+func validateUnbondingPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("unbonding period must not be negative: %s", v)
+	}
+
+	if v%time.Second != 0 {
+		return fmt.Errorf("unbonding period must be a multiple of a second: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxThirdPartyStakingDenoms(i interface{}) error {
+	_, ok := i.(uint32)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
@@ -3,6 +3,7 @@ package types_test
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -20,9 +21,14 @@ func TestParams(t *testing.T) {
 	paramsStr := `private_plan_creation_fee:
 - denom: stake
   amount: "100000000"
-next_epoch_days: 1
 farming_fee_collector: cosmos1h292smhhttwy0rl3qr4p6xsvpvxc4v05s6rxtczwq3cs6qc462mqejwy8x
 delayed_staking_gas_fee: 60000
+partial_allocation: false
+next_epoch_duration: 24h0m0s
+max_catch_up_epochs: 0
+lock_tiers: []
+unbonding_period: 0s
+max_third_party_staking_denoms: 5
 `
 	require.Equal(t, paramsStr, defaultParams.String())
 }
@@ -43,11 +49,18 @@ func TestParamsValidate(t *testing.T) {
 			"",
 		},
 		{
-			"ZeroNextEpochDays",
+			"ZeroNextEpochDuration",
 			func(params *types.Params) {
-				params.NextEpochDays = uint32(0)
+				params.NextEpochDuration = 0
 			},
-			"next epoch days must be positive: 0",
+			"next epoch duration must be positive: 0s",
+		},
+		{
+			"SubSecondNextEpochDuration",
+			func(params *types.Params) {
+				params.NextEpochDuration = time.Hour + time.Millisecond
+			},
+			"next epoch duration must be a multiple of a second: 1h0m0.001s",
 		},
 		{
 			"EmptyFarmingFeeCollector",
@@ -56,6 +69,68 @@ func TestParamsValidate(t *testing.T) {
 			},
 			"farming fee collector address must not be empty",
 		},
+		{
+			"LockTiers",
+			func(params *types.Params) {
+				params.LockTiers = []types.LockTier{
+					{Duration: 30 * 24 * time.Hour, Multiplier: sdk.NewDecWithPrec(15, 1)},
+					{Duration: 90 * 24 * time.Hour, Multiplier: sdk.NewDec(2)},
+				}
+			},
+			"",
+		},
+		{
+			"ZeroLockTierDuration",
+			func(params *types.Params) {
+				params.LockTiers = []types.LockTier{{Duration: 0, Multiplier: sdk.NewDec(2)}}
+			},
+			"lock tier duration must be positive: 0s",
+		},
+		{
+			"SubSecondLockTierDuration",
+			func(params *types.Params) {
+				params.LockTiers = []types.LockTier{{Duration: time.Hour + time.Millisecond, Multiplier: sdk.NewDec(2)}}
+			},
+			"lock tier duration must be a multiple of a second: 1h0m0.001s",
+		},
+		{
+			"DuplicateLockTierDuration",
+			func(params *types.Params) {
+				params.LockTiers = []types.LockTier{
+					{Duration: time.Hour, Multiplier: sdk.NewDec(2)},
+					{Duration: time.Hour, Multiplier: sdk.NewDec(3)},
+				}
+			},
+			"duplicate lock tier duration: 1h0m0s",
+		},
+		{
+			"LockTierMultiplierLessThanOne",
+			func(params *types.Params) {
+				params.LockTiers = []types.LockTier{{Duration: time.Hour, Multiplier: sdk.NewDecWithPrec(5, 1)}}
+			},
+			"lock tier multiplier must not be less than 1: 0.500000000000000000",
+		},
+		{
+			"UnbondingPeriod",
+			func(params *types.Params) {
+				params.UnbondingPeriod = 7 * 24 * time.Hour
+			},
+			"",
+		},
+		{
+			"NegativeUnbondingPeriod",
+			func(params *types.Params) {
+				params.UnbondingPeriod = -time.Hour
+			},
+			"unbonding period must not be negative: -1h0m0s",
+		},
+		{
+			"SubSecondUnbondingPeriod",
+			func(params *types.Params) {
+				params.UnbondingPeriod = time.Hour + time.Millisecond
+			},
+			"unbonding period must be a multiple of a second: 1h0m0.001s",
+		},
 	}
 
 	for _, tc := range testCases {
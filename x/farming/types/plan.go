@@ -16,12 +16,14 @@ const (
 	PrivatePlanFarmingPoolAccPrefix string = "PrivatePlan"
 	StakingReserveAccPrefix         string = "StakingReserveAcc"
 	RewardReserveAccPrefix          string = "RewardsReserveAcc"
+	DepositReserveAccPrefix         string = "DepositReserveAcc"
 	AccNameSplitter                 string = "|"
 )
 
 var (
 	_ PlanI = (*FixedAmountPlan)(nil)
 	_ PlanI = (*RatioPlan)(nil)
+	_ PlanI = (*DecayingPlan)(nil)
 )
 
 // NewBasePlan creates a new BasePlan object
@@ -39,6 +41,7 @@ func NewBasePlan(id uint64, name string, typ PlanType, farmingPoolAddr, terminat
 		Terminated:           false,
 		LastDistributionTime: nil,
 		DistributedCoins:     sdk.NewCoins(),
+		LastSkippedTime:      nil,
 	}
 	return basePlan
 }
@@ -144,6 +147,15 @@ func (plan *BasePlan) SetDistributedCoins(distributedCoins sdk.Coins) error {
 	return nil
 }
 
+func (plan *BasePlan) GetLastSkippedTime() *time.Time {
+	return plan.LastSkippedTime
+}
+
+func (plan *BasePlan) SetLastSkippedTime(t *time.Time) error {
+	plan.LastSkippedTime = t
+	return nil
+}
+
 func (plan BasePlan) GetBasePlan() *BasePlan {
 	return &BasePlan{
 		Id:                   plan.GetId(),
@@ -157,6 +169,7 @@ func (plan BasePlan) GetBasePlan() *BasePlan {
 		Terminated:           plan.GetTerminated(),
 		LastDistributionTime: plan.GetLastDistributionTime(),
 		DistributedCoins:     plan.GetDistributedCoins(),
+		LastSkippedTime:      plan.GetLastSkippedTime(),
 	}
 }
 
@@ -205,6 +218,65 @@ func NewRatioPlan(basePlan *BasePlan, epochRatio sdk.Dec) *RatioPlan {
 	}
 }
 
+// NewDecayingPlan returns a new decaying plan.
+func NewDecayingPlan(basePlan *BasePlan, epochAmount sdk.Coins, decayFactor sdk.Dec, decayEpochs uint32, decayTimes []time.Time) *DecayingPlan {
+	return &DecayingPlan{
+		BasePlan:      basePlan,
+		EpochAmount:   epochAmount,
+		DecayFactor:   decayFactor,
+		DecayEpochs:   decayEpochs,
+		DecayTimes:    decayTimes,
+		ElapsedEpochs: 0,
+	}
+}
+
+// ToDecayingPlan returns a decaying plan with the base plan of the given plan
+// and the given decay schedule.
+// If the given plan is already a decaying plan, the number of elapsed epochs
+// is preserved.
+func ToDecayingPlan(plan PlanI, epochAmount sdk.Coins, decayFactor sdk.Dec, decayEpochs uint32, decayTimes []time.Time) *DecayingPlan {
+	decayingPlan := NewDecayingPlan(plan.GetBasePlan(), epochAmount, decayFactor, decayEpochs, decayTimes)
+	if plan, ok := plan.(*DecayingPlan); ok {
+		decayingPlan.ElapsedEpochs = plan.ElapsedEpochs
+	}
+	return decayingPlan
+}
+
+// Validate checks for errors on the decaying plan fields.
+func (plan DecayingPlan) Validate() error {
+	if err := plan.BasePlan.Validate(); err != nil {
+		return err
+	}
+	if err := ValidateEpochAmount(plan.EpochAmount); err != nil {
+		return err
+	}
+	return ValidateDecaySchedule(plan.DecayFactor, plan.DecayEpochs, plan.DecayTimes)
+}
+
+// NumDecays returns how many times the epoch amount of the plan has
+// decayed at given time t.
+func (plan DecayingPlan) NumDecays(t time.Time) uint64 {
+	if plan.DecayEpochs > 0 {
+		return plan.ElapsedEpochs / uint64(plan.DecayEpochs)
+	}
+	var numDecays uint64
+	for _, decayTime := range plan.DecayTimes {
+		if decayTime.After(t) {
+			break
+		}
+		numDecays++
+	}
+	return numDecays
+}
+
+// EpochAmountAt returns the amount of coins the plan distributes for
+// an epoch ending at given time t.
+func (plan DecayingPlan) EpochAmountAt(t time.Time) sdk.Coins {
+	decayRate := plan.DecayFactor.Power(plan.NumDecays(t))
+	epochAmount, _ := sdk.NewDecCoinsFromCoins(plan.EpochAmount...).MulDecTruncate(decayRate).TruncateDecimal()
+	return epochAmount
+}
+
 // PlanI represents a farming plan.
 type PlanI interface {
 	proto.Message
@@ -242,6 +314,9 @@ type PlanI interface {
 	GetDistributedCoins() sdk.Coins
 	SetDistributedCoins(sdk.Coins) error
 
+	GetLastSkippedTime() *time.Time
+	SetLastSkippedTime(*time.Time) error
+
 	GetBasePlan() *BasePlan
 
 	Validate() error
@@ -287,6 +362,42 @@ func ValidateEpochRatio(epochRatio sdk.Dec) error {
 	return nil
 }
 
+// ValidateDecaySchedule validates a decay schedule of a decaying plan.
+// The decay factor must be positive and not greater than 1, and exactly one of
+// decay epochs or decay times must be provided.
+func ValidateDecaySchedule(decayFactor sdk.Dec, decayEpochs uint32, decayTimes []time.Time) error {
+	if decayFactor.IsNil() || !decayFactor.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "decay factor must be positive: %s", decayFactor)
+	}
+	if decayFactor.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "decay factor must not be greater than 1: %s", decayFactor)
+	}
+	if (decayEpochs > 0) == (len(decayTimes) > 0) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "exactly one of decay epochs or decay times must be provided")
+	}
+	for i := 1; i < len(decayTimes); i++ {
+		if !decayTimes[i].After(decayTimes[i-1]) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "decay times must be in increasing order: %s", decayTimes[i].Format(time.RFC3339))
+		}
+	}
+	return nil
+}
+
+// ValidateDecayingPlanRequest validates the fields of a request that creates
+// a decaying plan or changes a plan to a decaying plan.
+func ValidateDecayingPlanRequest(epochAmount sdk.Coins, epochRatio sdk.Dec, decayFactor sdk.Dec, decayEpochs uint32, decayTimes []time.Time) error {
+	if !epochRatio.IsNil() && !epochRatio.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "epoch ratio must not be provided for a decaying plan")
+	}
+	if epochAmount.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "epoch amount must be provided for a decaying plan")
+	}
+	if err := ValidateEpochAmount(epochAmount); err != nil {
+		return err
+	}
+	return ValidateDecaySchedule(decayFactor, decayEpochs, decayTimes)
+}
+
 // ValidateEpochAmount validate a epoch amount that must be valid coins.
 func ValidateEpochAmount(epochAmount sdk.Coins) error {
 	if err := epochAmount.Validate(); err != nil {
@@ -30,6 +30,7 @@ func TestPlanI(t *testing.T) {
 	)
 	plan := types.NewFixedAmountPlan(bp, sdk.NewCoins(sdk.NewInt64Coin("reward1", 10000000)))
 	lastDistributionTime := types.ParseTime("2021-11-01T00:00:00Z")
+	lastSkippedTime := types.ParseTime("2021-11-02T00:00:00Z")
 
 	require.Equal(t, bp, plan.GetBasePlan())
 
@@ -187,6 +188,26 @@ func TestPlanI(t *testing.T) {
 				return a.(sdk.Coins).IsEqual(b.(sdk.Coins))
 			},
 		},
+		{
+			"LastSkippedTime",
+			func() interface{} {
+				return plan.GetLastSkippedTime()
+			},
+			func(plan types.PlanI, val interface{}) error {
+				return plan.SetLastSkippedTime(val.(*time.Time))
+			},
+			(*time.Time)(nil), &lastSkippedTime,
+			func(a, b interface{}) bool {
+				at := a.(*time.Time)
+				bt := b.(*time.Time)
+				if at == nil && bt == nil {
+					return true
+				} else if (at == nil) != (bt == nil) {
+					return false
+				}
+				return (*at).Equal(*bt)
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			val := tc.get()
@@ -495,6 +516,59 @@ func TestPrivatePlanFarmingPoolAcc(t *testing.T) {
 	require.Equal(t, "cosmos172yhzhxwgwul3s8m6qpgw2ww3auedq4k3dt224543d0sd44fgx4spcjthr", testAcc2.String())
 }
 
+func TestDecayingPlanEpochAmountAt(t *testing.T) {
+	basePlan := types.NewBasePlan(
+		1,
+		"testPlan1",
+		types.PlanTypePrivate,
+		types.PrivatePlanFarmingPoolAcc("farmingPoolAddr1", 1).String(),
+		sdk.AccAddress("terminationAddr1").String(),
+		sdk.NewDecCoins(sdk.DecCoin{Denom: "testFarmStakingCoinDenom", Amount: sdk.MustNewDecFromStr("1.0")}),
+		types.ParseTime("2021-08-01T00:00:00Z"),
+		types.ParseTime("2022-08-01T00:00:00Z"),
+	)
+	epochAmount := sdk.NewCoins(sdk.NewInt64Coin("denom1", 1000), sdk.NewInt64Coin("denom2", 3))
+
+	t.Run("decay epochs", func(t *testing.T) {
+		plan := types.NewDecayingPlan(basePlan, epochAmount, sdk.NewDecWithPrec(5, 1), 2, nil)
+		require.NoError(t, plan.Validate())
+
+		for _, tc := range []struct {
+			elapsedEpochs uint64
+			expected      sdk.Coins
+		}{
+			{0, sdk.NewCoins(sdk.NewInt64Coin("denom1", 1000), sdk.NewInt64Coin("denom2", 3))},
+			{1, sdk.NewCoins(sdk.NewInt64Coin("denom1", 1000), sdk.NewInt64Coin("denom2", 3))},
+			{2, sdk.NewCoins(sdk.NewInt64Coin("denom1", 500), sdk.NewInt64Coin("denom2", 1))},
+			{5, sdk.NewCoins(sdk.NewInt64Coin("denom1", 250))},
+			{6, sdk.NewCoins(sdk.NewInt64Coin("denom1", 125))},
+		} {
+			plan.ElapsedEpochs = tc.elapsedEpochs
+			require.True(t, tc.expected.IsEqual(plan.EpochAmountAt(basePlan.StartTime)), "elapsed epochs: %d", tc.elapsedEpochs)
+		}
+	})
+
+	t.Run("decay times", func(t *testing.T) {
+		plan := types.NewDecayingPlan(basePlan, epochAmount, sdk.NewDecWithPrec(5, 1), 0, []time.Time{
+			types.ParseTime("2021-09-01T00:00:00Z"),
+			types.ParseTime("2021-10-01T00:00:00Z"),
+		})
+		require.NoError(t, plan.Validate())
+
+		for _, tc := range []struct {
+			t        time.Time
+			expected sdk.Coins
+		}{
+			{types.ParseTime("2021-08-31T23:59:59Z"), sdk.NewCoins(sdk.NewInt64Coin("denom1", 1000), sdk.NewInt64Coin("denom2", 3))},
+			{types.ParseTime("2021-09-01T00:00:00Z"), sdk.NewCoins(sdk.NewInt64Coin("denom1", 500), sdk.NewInt64Coin("denom2", 1))},
+			{types.ParseTime("2021-10-01T00:00:00Z"), sdk.NewCoins(sdk.NewInt64Coin("denom1", 250))},
+			{types.ParseTime("2022-01-01T00:00:00Z"), sdk.NewCoins(sdk.NewInt64Coin("denom1", 250))},
+		} {
+			require.True(t, tc.expected.IsEqual(plan.EpochAmountAt(tc.t)), "time: %s", tc.t)
+		}
+	})
+}
+
 func TestUnpackPlan(t *testing.T) {
 	plan := []types.PlanI{
 		types.NewRatioPlan(
@@ -11,15 +11,21 @@ import (
 )
 
 const (
-	ProposalTypePublicPlan string = "PublicPlan"
+	ProposalTypePublicPlan    string = "PublicPlan"
+	ProposalTypeStakingDenoms string = "StakingDenoms"
 )
 
 // Implements Proposal Interface
-var _ gov.Content = &PublicPlanProposal{}
+var (
+	_ gov.Content = &PublicPlanProposal{}
+	_ gov.Content = &StakingDenomsProposal{}
+)
 
 func init() {
 	gov.RegisterProposalType(ProposalTypePublicPlan)
 	gov.RegisterProposalTypeCodec(&PublicPlanProposal{}, "cosmos-sdk/PublicPlanProposal")
+	gov.RegisterProposalType(ProposalTypeStakingDenoms)
+	gov.RegisterProposalTypeCodec(&StakingDenomsProposal{}, "cosmos-sdk/StakingDenomsProposal")
 }
 
 // NewPublicPlanProposal creates a new PublicPlanProposal object.
@@ -82,6 +88,51 @@ func (p PublicPlanProposal) String() string {
 `, p.Title, p.Description, p.AddPlanRequests, p.ModifyPlanRequests, p.DeletePlanRequests)
 }
 
+// NewStakingDenomsProposal creates a new StakingDenomsProposal object.
+func NewStakingDenomsProposal(title string, description string, addDenoms []string, removeDenoms []string) *StakingDenomsProposal {
+	return &StakingDenomsProposal{
+		Title:        title,
+		Description:  description,
+		AddDenoms:    addDenoms,
+		RemoveDenoms: removeDenoms,
+	}
+}
+
+func (p *StakingDenomsProposal) GetTitle() string { return p.Title }
+
+func (p *StakingDenomsProposal) GetDescription() string { return p.Description }
+
+func (p *StakingDenomsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *StakingDenomsProposal) ProposalType() string { return ProposalTypeStakingDenoms }
+
+func (p *StakingDenomsProposal) ValidateBasic() error {
+	if len(p.AddDenoms) == 0 && len(p.RemoveDenoms) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposal request must not be empty")
+	}
+
+	denoms := map[string]bool{}
+	for _, denom := range append(append([]string{}, p.AddDenoms...), p.RemoveDenoms...) {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if denoms[denom] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate denom: %s", denom)
+		}
+		denoms[denom] = true
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p StakingDenomsProposal) String() string {
+	return fmt.Sprintf(`Staking Denoms Proposal:
+  Title:        %s
+  Description:  %s
+  AddDenoms:    %v
+  RemoveDenoms: %v
+`, p.Title, p.Description, p.AddDenoms, p.RemoveDenoms)
+}
+
 // NewAddPlanRequest creates a new AddPlanRequest object
 func NewAddPlanRequest(
 	name string,
@@ -107,9 +158,9 @@ func NewAddPlanRequest(
 
 // IsForFixedAmountPlan returns true if the request is for
 // fixed amount plan.
-// It checks if EpochAmount is not zero.
+// It checks if EpochAmount is not zero and DecayFactor is zero.
 func (p *AddPlanRequest) IsForFixedAmountPlan() bool {
-	return !p.EpochAmount.Empty()
+	return !p.EpochAmount.Empty() && !p.IsForDecayingPlan()
 }
 
 // IsForRatioPlan returns true if the request is for
@@ -119,6 +170,13 @@ func (p *AddPlanRequest) IsForRatioPlan() bool {
 	return !p.EpochRatio.IsNil() && !p.EpochRatio.IsZero()
 }
 
+// IsForDecayingPlan returns true if the request is for
+// decaying plan.
+// It checks if DecayFactor is not zero.
+func (p *AddPlanRequest) IsForDecayingPlan() bool {
+	return !p.DecayFactor.IsNil() && !p.DecayFactor.IsZero()
+}
+
 // Validate validates AddPlanRequest.
 func (p *AddPlanRequest) Validate() error {
 	if p.Name == "" {
@@ -146,6 +204,10 @@ func (p *AddPlanRequest) Validate() error {
 	isForFixedAmountPlan := p.IsForFixedAmountPlan()
 	isForRatioPlan := p.IsForRatioPlan()
 	switch {
+	case p.IsForDecayingPlan():
+		return ValidateDecayingPlanRequest(p.EpochAmount, p.EpochRatio, p.DecayFactor, p.DecayEpochs, p.DecayTimes)
+	case p.DecayEpochs > 0 || len(p.DecayTimes) > 0:
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "decay factor must be provided along with decay schedule")
 	case isForFixedAmountPlan == isForRatioPlan:
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "exactly one of epoch amount or epoch ratio must be provided")
 	case isForFixedAmountPlan:
@@ -187,9 +249,9 @@ func NewModifyPlanRequest(
 
 // IsForFixedAmountPlan returns true if the request is for
 // fixed amount plan.
-// It checks if EpochAmount is not zero.
+// It checks if EpochAmount is not zero and DecayFactor is zero.
 func (p *ModifyPlanRequest) IsForFixedAmountPlan() bool {
-	return !p.EpochAmount.Empty()
+	return !p.EpochAmount.Empty() && !p.IsForDecayingPlan()
 }
 
 // IsForRatioPlan returns true if the request is for
@@ -199,6 +261,13 @@ func (p *ModifyPlanRequest) IsForRatioPlan() bool {
 	return !p.EpochRatio.IsNil() && !p.EpochRatio.IsZero()
 }
 
+// IsForDecayingPlan returns true if the request is for
+// decaying plan.
+// It checks if DecayFactor is not zero.
+func (p *ModifyPlanRequest) IsForDecayingPlan() bool {
+	return !p.DecayFactor.IsNil() && !p.DecayFactor.IsZero()
+}
+
 // Validate validates ModifyPlanRequest.
 func (p *ModifyPlanRequest) Validate() error {
 	if p.PlanId == 0 {
@@ -233,6 +302,10 @@ func (p *ModifyPlanRequest) Validate() error {
 	isForFixedAmountPlan := p.IsForFixedAmountPlan()
 	isForRatioPlan := p.IsForRatioPlan()
 	switch {
+	case p.IsForDecayingPlan():
+		return ValidateDecayingPlanRequest(p.EpochAmount, p.EpochRatio, p.DecayFactor, p.DecayEpochs, p.DecayTimes)
+	case p.DecayEpochs > 0 || len(p.DecayTimes) > 0:
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "decay factor must be provided along with decay schedule")
 	case isForFixedAmountPlan && isForRatioPlan:
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "at most one of epoch amount or epoch ratio must be provided")
 	case isForFixedAmountPlan:
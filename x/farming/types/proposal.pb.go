@@ -79,6 +79,52 @@ func (m *PublicPlanProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PublicPlanProposal proto.InternalMessageInfo
 
+// StakingDenomsProposal defines a governance proposal that adds denoms to and removes denoms from
+// the allowed staking denoms. Once any denom is allowed, only the allowed denoms can be staked and
+// used in the staking coin weights of plans.
+type StakingDenomsProposal struct {
+	// title specifies the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description specifies the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// add_denoms specifies the denoms to be allowed to be staked
+	AddDenoms []string `protobuf:"bytes,3,rep,name=add_denoms,json=addDenoms,proto3" json:"add_denoms,omitempty" yaml:"add_denoms"`
+	// remove_denoms specifies the denoms to be removed from the allowed staking denoms
+	RemoveDenoms []string `protobuf:"bytes,4,rep,name=remove_denoms,json=removeDenoms,proto3" json:"remove_denoms,omitempty" yaml:"remove_denoms"`
+}
+
+func (m *StakingDenomsProposal) Reset()      { *m = StakingDenomsProposal{} }
+func (*StakingDenomsProposal) ProtoMessage() {}
+func (*StakingDenomsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4719b03c30c7910a, []int{1}
+}
+func (m *StakingDenomsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakingDenomsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakingDenomsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakingDenomsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingDenomsProposal.Merge(m, src)
+}
+func (m *StakingDenomsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakingDenomsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingDenomsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakingDenomsProposal proto.InternalMessageInfo
+
 // AddPlanRequest details a proposal for creating a public plan.
 type AddPlanRequest struct {
 	// name specifies the plan name for display
@@ -99,13 +145,20 @@ type AddPlanRequest struct {
 	EpochAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=epoch_amount,json=epochAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_amount" yaml:"epoch_amount"`
 	// epoch_ratio specifies the distributing amount by ratio
 	EpochRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=epoch_ratio,json=epochRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"epoch_ratio" yaml:"epoch_ratio"`
+	// decay_factor specifies the factor the epoch amount of a decaying plan is
+	// multiplied by on each decay
+	DecayFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=decay_factor,json=decayFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_factor" yaml:"decay_factor"`
+	// decay_epochs specifies the number of epochs between decays
+	DecayEpochs uint32 `protobuf:"varint,10,opt,name=decay_epochs,json=decayEpochs,proto3" json:"decay_epochs,omitempty" yaml:"decay_epochs"`
+	// decay_times specifies the times at which the epoch amount decays
+	DecayTimes []time.Time `protobuf:"bytes,11,rep,name=decay_times,json=decayTimes,proto3,stdtime" json:"decay_times" yaml:"decay_times"`
 }
 
 func (m *AddPlanRequest) Reset()         { *m = AddPlanRequest{} }
 func (m *AddPlanRequest) String() string { return proto.CompactTextString(m) }
 func (*AddPlanRequest) ProtoMessage()    {}
 func (*AddPlanRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4719b03c30c7910a, []int{1}
+	return fileDescriptor_4719b03c30c7910a, []int{2}
 }
 func (m *AddPlanRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,6 +236,20 @@ func (m *AddPlanRequest) GetEpochAmount() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+func (m *AddPlanRequest) GetDecayEpochs() uint32 {
+	if m != nil {
+		return m.DecayEpochs
+	}
+	return 0
+}
+
+func (m *AddPlanRequest) GetDecayTimes() []time.Time {
+	if m != nil {
+		return m.DecayTimes
+	}
+	return nil
+}
+
 // ModifyPlanRequest details a proposal for modifying the existing public plan.
 type ModifyPlanRequest struct {
 	// plan_id specifies index of the farming plan
@@ -205,13 +272,20 @@ type ModifyPlanRequest struct {
 	EpochAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=epoch_amount,json=epochAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_amount" yaml:"epoch_amount"`
 	// epoch_ratio specifies the distributing amount by ratio
 	EpochRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=epoch_ratio,json=epochRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"epoch_ratio" yaml:"epoch_ratio"`
+	// decay_factor specifies the factor the epoch amount of a decaying plan is
+	// multiplied by on each decay
+	DecayFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=decay_factor,json=decayFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_factor" yaml:"decay_factor"`
+	// decay_epochs specifies the number of epochs between decays
+	DecayEpochs uint32 `protobuf:"varint,11,opt,name=decay_epochs,json=decayEpochs,proto3" json:"decay_epochs,omitempty" yaml:"decay_epochs"`
+	// decay_times specifies the times at which the epoch amount decays
+	DecayTimes []time.Time `protobuf:"bytes,12,rep,name=decay_times,json=decayTimes,proto3,stdtime" json:"decay_times" yaml:"decay_times"`
 }
 
 func (m *ModifyPlanRequest) Reset()         { *m = ModifyPlanRequest{} }
 func (m *ModifyPlanRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyPlanRequest) ProtoMessage()    {}
 func (*ModifyPlanRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4719b03c30c7910a, []int{2}
+	return fileDescriptor_4719b03c30c7910a, []int{3}
 }
 func (m *ModifyPlanRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,6 +370,20 @@ func (m *ModifyPlanRequest) GetEpochAmount() github_com_cosmos_cosmos_sdk_types.
 	return nil
 }
 
+func (m *ModifyPlanRequest) GetDecayEpochs() uint32 {
+	if m != nil {
+		return m.DecayEpochs
+	}
+	return 0
+}
+
+func (m *ModifyPlanRequest) GetDecayTimes() []time.Time {
+	if m != nil {
+		return m.DecayTimes
+	}
+	return nil
+}
+
 // DeletePlanRequest details a proposal for deleting an existing public plan.
 type DeletePlanRequest struct {
 	// plan_id specifies index of the farming plan
@@ -306,7 +394,7 @@ func (m *DeletePlanRequest) Reset()         { *m = DeletePlanRequest{} }
 func (m *DeletePlanRequest) String() string { return proto.CompactTextString(m) }
 func (*DeletePlanRequest) ProtoMessage()    {}
 func (*DeletePlanRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4719b03c30c7910a, []int{3}
+	return fileDescriptor_4719b03c30c7910a, []int{4}
 }
 func (m *DeletePlanRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,6 +432,7 @@ func (m *DeletePlanRequest) GetPlanId() uint64 {
 
 func init() {
 	proto.RegisterType((*PublicPlanProposal)(nil), "cosmos.farming.v1beta1.PublicPlanProposal")
+	proto.RegisterType((*StakingDenomsProposal)(nil), "cosmos.farming.v1beta1.StakingDenomsProposal")
 	proto.RegisterType((*AddPlanRequest)(nil), "cosmos.farming.v1beta1.AddPlanRequest")
 	proto.RegisterType((*ModifyPlanRequest)(nil), "cosmos.farming.v1beta1.ModifyPlanRequest")
 	proto.RegisterType((*DeletePlanRequest)(nil), "cosmos.farming.v1beta1.DeletePlanRequest")
@@ -354,56 +443,66 @@ func init() {
 }
 
 var fileDescriptor_4719b03c30c7910a = []byte{
-	// 773 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x4f, 0xdb, 0x48,
-	0x14, 0xc7, 0x63, 0xf2, 0x93, 0xc9, 0x6a, 0x51, 0x86, 0x68, 0x37, 0x84, 0x5d, 0x3b, 0xf2, 0x4a,
-	0x28, 0x68, 0x17, 0x5b, 0xb0, 0x37, 0x6e, 0x64, 0x91, 0xd0, 0x1e, 0x56, 0x9b, 0x5a, 0x95, 0x5a,
-	0xf5, 0x62, 0x4d, 0x32, 0x43, 0xb0, 0xb0, 0x3d, 0xae, 0x67, 0xd2, 0x96, 0x5b, 0x2f, 0x95, 0xaa,
-	0x9e, 0x38, 0xf6, 0x88, 0x7a, 0xe8, 0xa1, 0x7f, 0x09, 0x47, 0xd4, 0x53, 0xd5, 0x43, 0xa8, 0xe0,
-	0x3f, 0xe0, 0x2f, 0xa8, 0x3c, 0x33, 0x4e, 0x43, 0x30, 0x34, 0x48, 0x54, 0xe2, 0x64, 0xbf, 0x99,
-	0xf7, 0xbe, 0xef, 0xcb, 0x7b, 0xfe, 0x88, 0x80, 0x55, 0x4e, 0x42, 0x4c, 0xe2, 0xc0, 0x0b, 0xb9,
-	0xbd, 0x8b, 0x92, 0xe7, 0xc0, 0x7e, 0xb6, 0xde, 0x23, 0x1c, 0xad, 0xdb, 0x51, 0x4c, 0x23, 0xca,
-	0x90, 0x6f, 0x45, 0x31, 0xe5, 0x14, 0xfe, 0xd2, 0xa7, 0x2c, 0xa0, 0xcc, 0x52, 0x69, 0x96, 0x4a,
-	0x6b, 0xd6, 0x07, 0x74, 0x40, 0x45, 0x8a, 0x9d, 0xbc, 0xc9, 0xec, 0xe6, 0x92, 0xcc, 0x76, 0xe5,
-	0x85, 0x2a, 0x95, 0x57, 0xba, 0x8c, 0xec, 0x1e, 0x62, 0x64, 0xdc, 0xac, 0x4f, 0xbd, 0x50, 0xdd,
-	0xb7, 0x6f, 0xf0, 0x94, 0x36, 0x97, 0x99, 0xc6, 0x80, 0xd2, 0x81, 0x4f, 0x6c, 0x11, 0xf5, 0x86,
-	0xbb, 0x36, 0xf7, 0x02, 0xc2, 0x38, 0x0a, 0x22, 0x99, 0x60, 0x7e, 0xcc, 0x03, 0xd8, 0x1d, 0xf6,
-	0x7c, 0xaf, 0xdf, 0xf5, 0x51, 0xd8, 0x55, 0x7f, 0x10, 0xac, 0x83, 0x22, 0xf7, 0xb8, 0x4f, 0x1a,
-	0x5a, 0x4b, 0x6b, 0xcf, 0x3b, 0x32, 0x80, 0x2d, 0x50, 0xc5, 0x84, 0xf5, 0x63, 0x2f, 0xe2, 0x1e,
-	0x0d, 0x1b, 0x73, 0xe2, 0x6e, 0xf2, 0x08, 0x72, 0x50, 0x43, 0x18, 0xbb, 0x91, 0x8f, 0x42, 0x37,
-	0x26, 0x4f, 0x87, 0x84, 0x71, 0xd6, 0xc8, 0xb7, 0xf2, 0xed, 0xea, 0xc6, 0x8a, 0x95, 0x3d, 0x1e,
-	0x6b, 0x0b, 0xe3, 0xa4, 0xb7, 0x23, 0xd3, 0x3b, 0xad, 0xe3, 0x91, 0x91, 0xbb, 0x18, 0x19, 0x8d,
-	0x03, 0x14, 0xf8, 0x9b, 0xe6, 0x15, 0x39, 0xd3, 0x59, 0x40, 0x97, 0x2a, 0x18, 0x7c, 0xa9, 0x81,
-	0x7a, 0x40, 0xb1, 0xb7, 0x7b, 0x30, 0xd5, 0xb9, 0x20, 0x3a, 0xaf, 0x5e, 0xd7, 0xf9, 0x3f, 0x51,
-	0x33, 0xd9, 0xfc, 0x0f, 0xd5, 0x7c, 0x59, 0x36, 0xcf, 0x12, 0x35, 0x1d, 0x18, 0x4c, 0xd7, 0x49,
-	0x0b, 0x98, 0xf8, 0x84, 0x93, 0x29, 0x0b, 0xc5, 0x9b, 0x2d, 0x6c, 0x8b, 0x9a, 0x1b, 0x2c, 0x64,
-	0x89, 0x9a, 0x0e, 0xc4, 0xd3, 0x75, 0x6c, 0xb3, 0xf2, 0xfa, 0xc8, 0xc8, 0xbd, 0x3d, 0x32, 0x72,
-	0xe6, 0x9b, 0x12, 0xf8, 0xf9, 0xf2, 0x54, 0x21, 0x04, 0x85, 0x10, 0x05, 0xe9, 0x3e, 0xc5, 0x3b,
-	0x7c, 0x00, 0xea, 0xca, 0x8e, 0x1b, 0x51, 0xea, 0xbb, 0x08, 0xe3, 0x98, 0x30, 0x26, 0xf7, 0xda,
-	0x31, 0xbe, 0x79, 0xc8, 0xca, 0x32, 0x1d, 0xa8, 0x8e, 0xbb, 0x94, 0xfa, 0x5b, 0xf2, 0x10, 0xfe,
-	0x0f, 0x16, 0xb9, 0xf8, 0x30, 0x51, 0xf2, 0x39, 0x8c, 0x15, 0xf3, 0x42, 0x51, 0xbf, 0x18, 0x19,
-	0x4d, 0xa9, 0x98, 0x91, 0x64, 0x3a, 0x70, 0xe2, 0x34, 0x15, 0x7c, 0xa7, 0x81, 0x3a, 0xe3, 0x68,
-	0x3f, 0x69, 0x9f, 0x10, 0xe0, 0x3e, 0x27, 0xde, 0x60, 0x6f, 0xbc, 0xda, 0xdf, 0xd2, 0xb9, 0x26,
-	0xa8, 0x4c, 0x0c, 0xb5, 0xff, 0x0f, 0xf5, 0xc2, 0x8e, 0x73, 0x79, 0x94, 0x59, 0x3a, 0xe6, 0x87,
-	0x53, 0xe3, 0xcf, 0x81, 0xc7, 0xf7, 0x86, 0x3d, 0xab, 0x4f, 0x03, 0xc5, 0xa1, 0x7a, 0xac, 0x31,
-	0xbc, 0x6f, 0xf3, 0x83, 0x88, 0xb0, 0x54, 0x92, 0x39, 0x50, 0xa9, 0x24, 0xd1, 0x23, 0xa9, 0x01,
-	0x1f, 0x03, 0xc0, 0x38, 0x8a, 0xb9, 0x9b, 0xd0, 0xd5, 0x28, 0xb6, 0xb4, 0x76, 0x75, 0xa3, 0x69,
-	0x49, 0xf4, 0xac, 0x14, 0x3d, 0xeb, 0x61, 0x8a, 0x5e, 0xe7, 0x77, 0xe5, 0xab, 0x36, 0xf6, 0xa5,
-	0x6a, 0xcd, 0xc3, 0x53, 0x43, 0x73, 0xe6, 0xc5, 0x41, 0x92, 0x0e, 0x1d, 0x50, 0x21, 0x21, 0x96,
-	0xba, 0xa5, 0xef, 0xea, 0x2e, 0x2b, 0xdd, 0x05, 0xa9, 0x9b, 0x56, 0x4a, 0xd5, 0x32, 0x09, 0xb1,
-	0xd0, 0x7c, 0xa5, 0x81, 0x9f, 0x48, 0x44, 0xfb, 0x7b, 0x2e, 0x0a, 0xe8, 0x30, 0xe4, 0x8d, 0xb2,
-	0x18, 0xe5, 0x52, 0xe6, 0x28, 0xc5, 0x1c, 0x77, 0x94, 0xee, 0xa2, 0xd2, 0x9d, 0x28, 0x4e, 0xe6,
-	0xd7, 0x9e, 0x61, 0x7e, 0x72, 0x78, 0x55, 0x51, 0xba, 0x25, 0x2a, 0x21, 0x01, 0x32, 0x74, 0xe3,
-	0x64, 0xe3, 0x8d, 0x8a, 0xf8, 0x46, 0xb6, 0x93, 0x56, 0x9f, 0x47, 0xc6, 0xca, 0x6c, 0x3b, 0xb9,
-	0x18, 0x19, 0x70, 0xd2, 0x94, 0x90, 0x32, 0x1d, 0x20, 0x22, 0x47, 0x04, 0xef, 0x4b, 0xa0, 0x76,
-	0x05, 0x74, 0xf8, 0x2b, 0x28, 0x0b, 0xa4, 0x3c, 0x2c, 0x90, 0x28, 0x38, 0xa5, 0x24, 0xfc, 0x17,
-	0x8f, 0x41, 0x99, 0x9b, 0x01, 0x94, 0xfc, 0x9d, 0x83, 0x52, 0xb8, 0x7b, 0x50, 0x8a, 0xf7, 0x16,
-	0x94, 0xd2, 0x4c, 0xa0, 0x68, 0xb7, 0x06, 0xa5, 0x3c, 0x13, 0x28, 0xda, 0xed, 0x41, 0xa9, 0xdc,
-	0x0b, 0x50, 0xe6, 0x7f, 0x10, 0x28, 0x7f, 0x81, 0xda, 0x95, 0xff, 0x46, 0xd7, 0x72, 0xd2, 0xd9,
-	0x39, 0x3e, 0xd3, 0xb5, 0x93, 0x33, 0x5d, 0xfb, 0x72, 0xa6, 0x6b, 0x87, 0xe7, 0x7a, 0xee, 0xe4,
-	0x5c, 0xcf, 0x7d, 0x3a, 0xd7, 0x73, 0x4f, 0xd6, 0x26, 0x1c, 0x65, 0xfc, 0x50, 0x79, 0x31, 0x7e,
-	0x13, 0xe6, 0x7a, 0x25, 0xb1, 0x9f, 0xbf, 0xbf, 0x06, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x2f, 0xf0,
-	0x0f, 0x69, 0x09, 0x00, 0x00,
+	// 938 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xd6, 0x4e, 0x62, 0x8f, 0x53, 0x2a, 0x4f, 0x5c, 0xba, 0x4d, 0x61, 0xd7, 0x5a, 0xa4,
+	0xca, 0x15, 0x74, 0xad, 0x16, 0x4e, 0x91, 0x38, 0xc4, 0xa4, 0x54, 0x1c, 0x10, 0x61, 0x41, 0x02,
+	0xc1, 0x61, 0x35, 0xde, 0x99, 0x38, 0xab, 0xee, 0xee, 0x2c, 0x3b, 0xe3, 0x82, 0x6f, 0x5c, 0x90,
+	0xb8, 0x20, 0xf5, 0xc8, 0xb1, 0xe2, 0x06, 0xbf, 0xa4, 0x27, 0x54, 0x71, 0x42, 0x1c, 0x5c, 0x94,
+	0xfc, 0x03, 0xff, 0x02, 0xb4, 0x6f, 0x66, 0x9d, 0xb5, 0xe3, 0xa6, 0x0e, 0x8d, 0xaa, 0x9e, 0xbc,
+	0x6f, 0xe6, 0x7d, 0xdf, 0xf7, 0xe6, 0xbd, 0x99, 0x4f, 0x46, 0xb7, 0x24, 0x4b, 0x28, 0xcb, 0xe2,
+	0x30, 0x91, 0xbd, 0x03, 0x92, 0xff, 0x0e, 0x7b, 0x0f, 0xef, 0x0c, 0x98, 0x24, 0x77, 0x7a, 0x69,
+	0xc6, 0x53, 0x2e, 0x48, 0xe4, 0xa6, 0x19, 0x97, 0x1c, 0xbf, 0x19, 0x70, 0x11, 0x73, 0xe1, 0xea,
+	0x34, 0x57, 0xa7, 0x6d, 0xb7, 0x87, 0x7c, 0xc8, 0x21, 0xa5, 0x97, 0x7f, 0xa9, 0xec, 0xed, 0xeb,
+	0x2a, 0xdb, 0x57, 0x1b, 0x1a, 0xaa, 0xb6, 0x2c, 0x15, 0xf5, 0x06, 0x44, 0xb0, 0x99, 0x58, 0xc0,
+	0xc3, 0x44, 0xef, 0x77, 0xcf, 0xa8, 0xa9, 0x10, 0x57, 0x99, 0xf6, 0x90, 0xf3, 0x61, 0xc4, 0x7a,
+	0x10, 0x0d, 0x46, 0x07, 0x3d, 0x19, 0xc6, 0x4c, 0x48, 0x12, 0xa7, 0x2a, 0xc1, 0xf9, 0xab, 0x8a,
+	0xf0, 0xfe, 0x68, 0x10, 0x85, 0xc1, 0x7e, 0x44, 0x92, 0x7d, 0x7d, 0x20, 0xdc, 0x46, 0x6b, 0x32,
+	0x94, 0x11, 0x33, 0x8d, 0x8e, 0xd1, 0x6d, 0x78, 0x2a, 0xc0, 0x1d, 0xd4, 0xa4, 0x4c, 0x04, 0x59,
+	0x98, 0xca, 0x90, 0x27, 0xe6, 0x25, 0xd8, 0x2b, 0x2f, 0x61, 0x89, 0x5a, 0x84, 0x52, 0x3f, 0x8d,
+	0x48, 0xe2, 0x67, 0xec, 0xbb, 0x11, 0x13, 0x52, 0x98, 0xd5, 0x4e, 0xb5, 0xdb, 0xbc, 0x7b, 0xd3,
+	0x5d, 0xde, 0x1e, 0x77, 0x97, 0xd2, 0x5c, 0xdb, 0x53, 0xe9, 0xfd, 0xce, 0x93, 0x89, 0x5d, 0x99,
+	0x4e, 0x6c, 0x73, 0x4c, 0xe2, 0x68, 0xc7, 0x39, 0x45, 0xe7, 0x78, 0x57, 0xc8, 0x1c, 0x42, 0xe0,
+	0x1f, 0x0d, 0xd4, 0x8e, 0x39, 0x0d, 0x0f, 0xc6, 0x0b, 0xca, 0x35, 0x50, 0xbe, 0xf5, 0x3c, 0xe5,
+	0x4f, 0x01, 0x53, 0x16, 0x7f, 0x47, 0x8b, 0xdf, 0x50, 0xe2, 0xcb, 0x48, 0x1d, 0x0f, 0xc7, 0x8b,
+	0x38, 0x55, 0x02, 0x65, 0x11, 0x93, 0x6c, 0xa1, 0x84, 0xb5, 0xb3, 0x4b, 0xd8, 0x03, 0xcc, 0x19,
+	0x25, 0x2c, 0x23, 0x75, 0x3c, 0x4c, 0x17, 0x71, 0x62, 0xa7, 0xfe, 0xf3, 0x63, 0xbb, 0xf2, 0xeb,
+	0x63, 0xbb, 0xe2, 0xfc, 0x69, 0xa0, 0xab, 0x5f, 0x48, 0xf2, 0x20, 0x4c, 0x86, 0x7b, 0x2c, 0xe1,
+	0xb1, 0x78, 0xe9, 0xb9, 0x7e, 0x80, 0x50, 0x3e, 0x08, 0x0a, 0x6c, 0x30, 0xd0, 0x46, 0xff, 0xea,
+	0x74, 0x62, 0xb7, 0x4e, 0x86, 0xa4, 0xf6, 0x1c, 0xaf, 0x41, 0x28, 0x55, 0xaa, 0xf8, 0x43, 0x74,
+	0x39, 0x63, 0x31, 0x7f, 0xc8, 0x0a, 0x60, 0x0d, 0x80, 0xe6, 0x74, 0x62, 0xb7, 0x15, 0x70, 0x6e,
+	0xdb, 0xf1, 0x36, 0x55, 0xac, 0xe0, 0xa5, 0x03, 0xfd, 0x52, 0x47, 0x6f, 0xcc, 0x5f, 0x13, 0x8c,
+	0x51, 0x2d, 0x21, 0x71, 0x71, 0x10, 0xf8, 0xc6, 0x9f, 0xa3, 0xb6, 0xee, 0xaf, 0x9f, 0x72, 0x1e,
+	0xf9, 0x84, 0xd2, 0x8c, 0x09, 0xa1, 0x0e, 0xd4, 0xb7, 0x4f, 0x9a, 0xba, 0x2c, 0xcb, 0xf1, 0xb0,
+	0x5e, 0xde, 0xe7, 0x3c, 0xda, 0x55, 0x8b, 0xf8, 0x33, 0xb4, 0x25, 0xe1, 0xa5, 0x91, 0xbc, 0x0f,
+	0x33, 0xc6, 0x2a, 0x30, 0x5a, 0xd3, 0x89, 0xbd, 0xad, 0x18, 0x97, 0x24, 0x39, 0x1e, 0x2e, 0xad,
+	0x16, 0x84, 0xbf, 0x19, 0xa8, 0x2d, 0xd4, 0x6c, 0xfc, 0xfc, 0x49, 0xfb, 0xdf, 0xb3, 0x70, 0x78,
+	0x38, 0xbb, 0xab, 0x6f, 0x15, 0x17, 0x25, 0x7f, 0xfb, 0xa5, 0x5b, 0x12, 0x7c, 0xc4, 0xc3, 0xa4,
+	0xef, 0xcd, 0xdf, 0x8d, 0x65, 0x3c, 0xce, 0x1f, 0xcf, 0xec, 0x77, 0x87, 0xa1, 0x3c, 0x1c, 0x0d,
+	0xdc, 0x80, 0xc7, 0xda, 0x58, 0xf4, 0xcf, 0x6d, 0x41, 0x1f, 0xf4, 0xe4, 0x38, 0x65, 0xa2, 0xa0,
+	0x14, 0x1e, 0xd6, 0x2c, 0x79, 0xf4, 0x95, 0xe2, 0xc0, 0x5f, 0x23, 0x24, 0x24, 0xc9, 0xa4, 0x9f,
+	0xdb, 0x85, 0xb9, 0xd6, 0x31, 0xba, 0xcd, 0xbb, 0xdb, 0xae, 0xf2, 0x12, 0xb7, 0xf0, 0x12, 0xf7,
+	0xcb, 0xc2, 0x4b, 0xfa, 0x6f, 0xeb, 0xba, 0x5a, 0xb3, 0xba, 0x34, 0xd6, 0x79, 0xf4, 0xcc, 0x36,
+	0xbc, 0x06, 0x2c, 0xe4, 0xe9, 0xd8, 0x43, 0x75, 0x96, 0x50, 0xc5, 0xbb, 0xfe, 0x42, 0xde, 0x1b,
+	0x9a, 0xf7, 0x8a, 0xe2, 0x2d, 0x90, 0x8a, 0x75, 0x83, 0x25, 0x14, 0x38, 0x7f, 0x32, 0xd0, 0x26,
+	0x4b, 0x79, 0x70, 0xe8, 0x93, 0x98, 0x8f, 0x12, 0x69, 0x6e, 0x40, 0x2b, 0xaf, 0x2f, 0x6d, 0x25,
+	0xf4, 0xf1, 0xbe, 0xe6, 0xdd, 0xd2, 0xbc, 0x25, 0x70, 0xde, 0xbf, 0xee, 0x0a, 0xfd, 0x53, 0xcd,
+	0x6b, 0x02, 0x74, 0x17, 0x90, 0x98, 0x21, 0x15, 0xfa, 0x59, 0x3e, 0x71, 0xb3, 0x0e, 0x77, 0x64,
+	0x2f, 0x97, 0xfa, 0x67, 0x62, 0xdf, 0x5c, 0x6d, 0x26, 0xd3, 0x89, 0x8d, 0xcb, 0x45, 0x01, 0x95,
+	0xe3, 0x21, 0x88, 0xbc, 0x3c, 0xc0, 0x87, 0x68, 0x93, 0xb2, 0x80, 0x8c, 0xfd, 0x03, 0x12, 0x48,
+	0x9e, 0x99, 0x0d, 0xd0, 0xb9, 0x77, 0x6e, 0x9d, 0xad, 0xc2, 0x60, 0x4e, 0xb8, 0x9c, 0xfc, 0xd5,
+	0x07, 0x64, 0xfc, 0x31, 0x44, 0x78, 0xa7, 0x50, 0x02, 0x75, 0x61, 0xa2, 0x8e, 0xd1, 0xbd, 0xdc,
+	0xbf, 0xb6, 0x88, 0x55, 0xbb, 0x05, 0xf6, 0x1e, 0x44, 0xf8, 0x5b, 0xa4, 0x42, 0x18, 0x98, 0x30,
+	0x9b, 0x9d, 0xea, 0x0b, 0x66, 0x6d, 0xe9, 0x99, 0xe0, 0x32, 0x35, 0x80, 0xd5, 0xb8, 0x11, 0xac,
+	0x40, 0xbe, 0xf3, 0x7b, 0x1d, 0xb5, 0x4e, 0x99, 0x37, 0xbe, 0x86, 0x36, 0xc0, 0x26, 0x43, 0x0a,
+	0xae, 0x50, 0xf3, 0xd6, 0xf3, 0xf0, 0x13, 0x3a, 0xf3, 0x8a, 0x4b, 0x2b, 0x78, 0x45, 0xf5, 0xc2,
+	0xbd, 0xa2, 0x76, 0xf1, 0x5e, 0xb1, 0xf6, 0xda, 0x7a, 0xc5, 0xfa, 0x4a, 0x5e, 0x61, 0x9c, 0xdb,
+	0x2b, 0x36, 0x56, 0xf2, 0x0a, 0xe3, 0xfc, 0x5e, 0x51, 0x7f, 0x2d, 0xbc, 0xa2, 0xf1, 0x8a, 0xbc,
+	0x02, 0xbd, 0x32, 0xaf, 0x68, 0xfe, 0x7f, 0xaf, 0xd8, 0xbc, 0x50, 0xaf, 0x78, 0x0f, 0xb5, 0x4e,
+	0xfd, 0xc9, 0x7a, 0xae, 0x55, 0xf4, 0xef, 0x3f, 0x39, 0xb2, 0x8c, 0xa7, 0x47, 0x96, 0xf1, 0xef,
+	0x91, 0x65, 0x3c, 0x3a, 0xb6, 0x2a, 0x4f, 0x8f, 0xad, 0xca, 0xdf, 0xc7, 0x56, 0xe5, 0x9b, 0xdb,
+	0xa5, 0x66, 0x2d, 0xf9, 0xff, 0xfd, 0xc3, 0xec, 0x0b, 0xfa, 0x36, 0x58, 0x87, 0xb2, 0xdf, 0xff,
+	0x6f, 0x00, 0xfd, 0xa8, 0x9c, 0x22, 0x40, 0x0c, 0x00, 0x00,
 }
 
 func (m *PublicPlanProposal) Marshal() (dAtA []byte, err error) {
@@ -485,6 +584,61 @@ func (m *PublicPlanProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StakingDenomsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakingDenomsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakingDenomsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoveDenoms) > 0 {
+		for iNdEx := len(m.RemoveDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveDenoms[iNdEx])
+			copy(dAtA[i:], m.RemoveDenoms[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.RemoveDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AddDenoms) > 0 {
+		for iNdEx := len(m.AddDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddDenoms[iNdEx])
+			copy(dAtA[i:], m.AddDenoms[iNdEx])
+			i = encodeVarintProposal(dAtA, i, uint64(len(m.AddDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AddPlanRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -505,6 +659,33 @@ func (m *AddPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DecayTimes) > 0 {
+		for iNdEx := len(m.DecayTimes) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DecayTimes[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DecayTimes[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintProposal(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.DecayEpochs != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.DecayEpochs))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.DecayFactor.Size()
+		i -= size
+		if _, err := m.DecayFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	{
 		size := m.EpochRatio.Size()
 		i -= size
@@ -603,6 +784,33 @@ func (m *ModifyPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DecayTimes) > 0 {
+		for iNdEx := len(m.DecayTimes) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DecayTimes[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DecayTimes[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintProposal(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.DecayEpochs != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.DecayEpochs))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.DecayFactor.Size()
+		i -= size
+		if _, err := m.DecayFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
 	{
 		size := m.EpochRatio.Size()
 		i -= size
@@ -764,6 +972,35 @@ func (m *PublicPlanProposal) Size() (n int) {
 	return n
 }
 
+func (m *StakingDenomsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.AddDenoms) > 0 {
+		for _, s := range m.AddDenoms {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	if len(m.RemoveDenoms) > 0 {
+		for _, s := range m.RemoveDenoms {
+			l = len(s)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *AddPlanRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -800,6 +1037,17 @@ func (m *AddPlanRequest) Size() (n int) {
 	}
 	l = m.EpochRatio.Size()
 	n += 1 + l + sovProposal(uint64(l))
+	l = m.DecayFactor.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	if m.DecayEpochs != 0 {
+		n += 1 + sovProposal(uint64(m.DecayEpochs))
+	}
+	if len(m.DecayTimes) > 0 {
+		for _, e := range m.DecayTimes {
+			l = github_com_gogo_protobuf_types.SizeOfStdTime(e)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -846,6 +1094,17 @@ func (m *ModifyPlanRequest) Size() (n int) {
 	}
 	l = m.EpochRatio.Size()
 	n += 1 + l + sovProposal(uint64(l))
+	l = m.DecayFactor.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	if m.DecayEpochs != 0 {
+		n += 1 + sovProposal(uint64(m.DecayEpochs))
+	}
+	if len(m.DecayTimes) > 0 {
+		for _, e := range m.DecayTimes {
+			l = github_com_gogo_protobuf_types.SizeOfStdTime(e)
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1083,6 +1342,184 @@ func (m *PublicPlanProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *StakingDenomsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakingDenomsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakingDenomsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddDenoms = append(m.AddDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveDenoms = append(m.RemoveDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AddPlanRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1376,6 +1813,93 @@ func (m *AddPlanRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayEpochs", wireType)
+			}
+			m.DecayEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecayEpochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecayTimes = append(m.DecayTimes, time.Time{})
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&(m.DecayTimes[len(m.DecayTimes)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -1715,6 +2239,93 @@ func (m *ModifyPlanRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayEpochs", wireType)
+			}
+			m.DecayEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecayEpochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecayTimes = append(m.DecayTimes, time.Time{})
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&(m.DecayTimes[len(m.DecayTimes)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -227,6 +227,46 @@ func TestAddPlanRequest_Validate(t *testing.T) {
 			},
 			"epoch ratio must be less than 1: 2.000000000000000000: invalid request",
 		},
+		{
+			"decaying plan",
+			func(req *types.AddPlanRequest) {
+				req.DecayFactor = sdk.NewDecWithPrec(5, 1)
+				req.DecayEpochs = 30
+			},
+			"",
+		},
+		{
+			"decaying plan without epoch amount",
+			func(req *types.AddPlanRequest) {
+				req.EpochAmount = nil
+				req.DecayFactor = sdk.NewDecWithPrec(5, 1)
+				req.DecayEpochs = 30
+			},
+			"epoch amount must be provided for a decaying plan: invalid request",
+		},
+		{
+			"decaying plan with epoch ratio",
+			func(req *types.AddPlanRequest) {
+				req.EpochRatio = sdk.NewDecWithPrec(1, 1)
+				req.DecayFactor = sdk.NewDecWithPrec(5, 1)
+				req.DecayEpochs = 30
+			},
+			"epoch ratio must not be provided for a decaying plan: invalid request",
+		},
+		{
+			"decay schedule without decay factor",
+			func(req *types.AddPlanRequest) {
+				req.DecayEpochs = 30
+			},
+			"decay factor must be provided along with decay schedule: invalid request",
+		},
+		{
+			"decaying plan without decay schedule",
+			func(req *types.AddPlanRequest) {
+				req.DecayFactor = sdk.NewDecWithPrec(5, 1)
+			},
+			"exactly one of decay epochs or decay times must be provided: invalid request",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			req := types.NewAddPlanRequest(
@@ -436,6 +476,46 @@ func TestModifyPlanRequest_Validate(t *testing.T) {
 			},
 			"epoch ratio must be less than 1: 2.000000000000000000: invalid request",
 		},
+		{
+			"decaying plan",
+			func(req *types.ModifyPlanRequest) {
+				req.DecayFactor = sdk.NewDecWithPrec(5, 1)
+				req.DecayEpochs = 30
+			},
+			"",
+		},
+		{
+			"decaying plan without epoch amount",
+			func(req *types.ModifyPlanRequest) {
+				req.EpochAmount = nil
+				req.DecayFactor = sdk.NewDecWithPrec(5, 1)
+				req.DecayEpochs = 30
+			},
+			"epoch amount must be provided for a decaying plan: invalid request",
+		},
+		{
+			"decaying plan with epoch ratio",
+			func(req *types.ModifyPlanRequest) {
+				req.EpochRatio = sdk.NewDecWithPrec(1, 1)
+				req.DecayFactor = sdk.NewDecWithPrec(5, 1)
+				req.DecayEpochs = 30
+			},
+			"epoch ratio must not be provided for a decaying plan: invalid request",
+		},
+		{
+			"decay schedule without decay factor",
+			func(req *types.ModifyPlanRequest) {
+				req.DecayEpochs = 30
+			},
+			"decay factor must be provided along with decay schedule: invalid request",
+		},
+		{
+			"decaying plan without decay schedule",
+			func(req *types.ModifyPlanRequest) {
+				req.DecayFactor = sdk.NewDecWithPrec(5, 1)
+			},
+			"exactly one of decay epochs or decay times must be provided: invalid request",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			req := types.NewModifyPlanRequest(
@@ -491,3 +571,71 @@ func TestDeletePlanRequest_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestStakingDenomsProposal_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(*types.StakingDenomsProposal)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(proposal *types.StakingDenomsProposal) {},
+			"",
+		},
+		{
+			"only remove denoms",
+			func(proposal *types.StakingDenomsProposal) {
+				proposal.AddDenoms = nil
+			},
+			"",
+		},
+		{
+			"empty proposal",
+			func(proposal *types.StakingDenomsProposal) {
+				proposal.AddDenoms = nil
+				proposal.RemoveDenoms = nil
+			},
+			"proposal request must not be empty: invalid request",
+		},
+		{
+			"invalid denom",
+			func(proposal *types.StakingDenomsProposal) {
+				proposal.AddDenoms = []string{"!"}
+			},
+			"invalid denom: !: invalid request",
+		},
+		{
+			"duplicate denom",
+			func(proposal *types.StakingDenomsProposal) {
+				proposal.AddDenoms = []string{"denom1", "denom1"}
+			},
+			"duplicate denom: denom1: invalid request",
+		},
+		{
+			"denom both added and removed",
+			func(proposal *types.StakingDenomsProposal) {
+				proposal.RemoveDenoms = []string{"denom1"}
+			},
+			"duplicate denom: denom1: invalid request",
+		},
+		{
+			"empty title",
+			func(proposal *types.StakingDenomsProposal) {
+				proposal.Title = ""
+			},
+			"proposal title cannot be blank: invalid proposal content",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			proposal := types.NewStakingDenomsProposal("title", "description", []string{"denom1"}, []string{"denom2"})
+			tc.malleate(proposal)
+			err := proposal.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
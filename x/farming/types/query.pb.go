@@ -13,21 +13,26 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "github.com/regen-network/cosmos-proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -453,23 +458,24 @@ func (m *QueryStakingsResponse) GetQueuedCoins() github_com_cosmos_cosmos_sdk_ty
 	return nil
 }
 
-// QueryTotalStakingsRequest is the request type for the Query/TotalStakings RPC method.
-type QueryTotalStakingsRequest struct {
-	StakingCoinDenom string `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+// QueryLockedStakingsRequest is the request type for the Query/LockedStakings RPC method.
+type QueryLockedStakingsRequest struct {
+	Farmer           string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	StakingCoinDenom string `protobuf:"bytes,2,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
 }
 
-func (m *QueryTotalStakingsRequest) Reset()         { *m = QueryTotalStakingsRequest{} }
-func (m *QueryTotalStakingsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalStakingsRequest) ProtoMessage()    {}
-func (*QueryTotalStakingsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryLockedStakingsRequest) Reset()         { *m = QueryLockedStakingsRequest{} }
+func (m *QueryLockedStakingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLockedStakingsRequest) ProtoMessage()    {}
+func (*QueryLockedStakingsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00c8db58c274b111, []int{8}
 }
-func (m *QueryTotalStakingsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryLockedStakingsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalStakingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLockedStakingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalStakingsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLockedStakingsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -479,42 +485,52 @@ func (m *QueryTotalStakingsRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalStakingsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalStakingsRequest.Merge(m, src)
+func (m *QueryLockedStakingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockedStakingsRequest.Merge(m, src)
 }
-func (m *QueryTotalStakingsRequest) XXX_Size() int {
+func (m *QueryLockedStakingsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalStakingsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalStakingsRequest.DiscardUnknown(m)
+func (m *QueryLockedStakingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockedStakingsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalStakingsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryLockedStakingsRequest proto.InternalMessageInfo
 
-func (m *QueryTotalStakingsRequest) GetStakingCoinDenom() string {
+func (m *QueryLockedStakingsRequest) GetFarmer() string {
+	if m != nil {
+		return m.Farmer
+	}
+	return ""
+}
+
+func (m *QueryLockedStakingsRequest) GetStakingCoinDenom() string {
 	if m != nil {
 		return m.StakingCoinDenom
 	}
 	return ""
 }
 
-// QueryTotalStakingsResponse is the response type for the Query/TotalStakings RPC method.
-type QueryTotalStakingsResponse struct {
-	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+// QueryLockedStakingsResponse is the response type for the Query/LockedStakings RPC method.
+type QueryLockedStakingsResponse struct {
+	LockedStakings []LockedStaking `protobuf:"bytes,1,rep,name=locked_stakings,json=lockedStakings,proto3" json:"locked_stakings"`
+	// queued_locked_stakings are the locked stakings that become staked and start
+	// earning rewards at the end of the current epoch.
+	QueuedLockedStakings []LockedStaking `protobuf:"bytes,2,rep,name=queued_locked_stakings,json=queuedLockedStakings,proto3" json:"queued_locked_stakings"`
 }
 
-func (m *QueryTotalStakingsResponse) Reset()         { *m = QueryTotalStakingsResponse{} }
-func (m *QueryTotalStakingsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalStakingsResponse) ProtoMessage()    {}
-func (*QueryTotalStakingsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryLockedStakingsResponse) Reset()         { *m = QueryLockedStakingsResponse{} }
+func (m *QueryLockedStakingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLockedStakingsResponse) ProtoMessage()    {}
+func (*QueryLockedStakingsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00c8db58c274b111, []int{9}
 }
-func (m *QueryTotalStakingsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryLockedStakingsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalStakingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLockedStakingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalStakingsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLockedStakingsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -524,36 +540,50 @@ func (m *QueryTotalStakingsResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalStakingsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalStakingsResponse.Merge(m, src)
+func (m *QueryLockedStakingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockedStakingsResponse.Merge(m, src)
 }
-func (m *QueryTotalStakingsResponse) XXX_Size() int {
+func (m *QueryLockedStakingsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalStakingsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalStakingsResponse.DiscardUnknown(m)
+func (m *QueryLockedStakingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockedStakingsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalStakingsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryLockedStakingsResponse proto.InternalMessageInfo
 
-// QueryRewardsRequest is the request type for the Query/Rewards RPC method.
-type QueryRewardsRequest struct {
+func (m *QueryLockedStakingsResponse) GetLockedStakings() []LockedStaking {
+	if m != nil {
+		return m.LockedStakings
+	}
+	return nil
+}
+
+func (m *QueryLockedStakingsResponse) GetQueuedLockedStakings() []LockedStaking {
+	if m != nil {
+		return m.QueuedLockedStakings
+	}
+	return nil
+}
+
+// QueryUnbondingsRequest is the request type for the Query/Unbondings RPC method.
+type QueryUnbondingsRequest struct {
 	Farmer           string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
 	StakingCoinDenom string `protobuf:"bytes,2,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
 }
 
-func (m *QueryRewardsRequest) Reset()         { *m = QueryRewardsRequest{} }
-func (m *QueryRewardsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryRewardsRequest) ProtoMessage()    {}
-func (*QueryRewardsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryUnbondingsRequest) Reset()         { *m = QueryUnbondingsRequest{} }
+func (m *QueryUnbondingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingsRequest) ProtoMessage()    {}
+func (*QueryUnbondingsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00c8db58c274b111, []int{10}
 }
-func (m *QueryRewardsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryUnbondingsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryUnbondingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRewardsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryUnbondingsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -563,49 +593,49 @@ func (m *QueryRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryRewardsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRewardsRequest.Merge(m, src)
+func (m *QueryUnbondingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingsRequest.Merge(m, src)
 }
-func (m *QueryRewardsRequest) XXX_Size() int {
+func (m *QueryUnbondingsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRewardsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRewardsRequest.DiscardUnknown(m)
+func (m *QueryUnbondingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRewardsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryUnbondingsRequest proto.InternalMessageInfo
 
-func (m *QueryRewardsRequest) GetFarmer() string {
+func (m *QueryUnbondingsRequest) GetFarmer() string {
 	if m != nil {
 		return m.Farmer
 	}
 	return ""
 }
 
-func (m *QueryRewardsRequest) GetStakingCoinDenom() string {
+func (m *QueryUnbondingsRequest) GetStakingCoinDenom() string {
 	if m != nil {
 		return m.StakingCoinDenom
 	}
 	return ""
 }
 
-// QueryRewardsResponse is the response type for the Query/Rewards RPC method.
-type QueryRewardsResponse struct {
-	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+// QueryUnbondingsResponse is the response type for the Query/Unbondings RPC method.
+type QueryUnbondingsResponse struct {
+	Unbondings []Unbonding `protobuf:"bytes,1,rep,name=unbondings,proto3" json:"unbondings"`
 }
 
-func (m *QueryRewardsResponse) Reset()         { *m = QueryRewardsResponse{} }
-func (m *QueryRewardsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryRewardsResponse) ProtoMessage()    {}
-func (*QueryRewardsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryUnbondingsResponse) Reset()         { *m = QueryUnbondingsResponse{} }
+func (m *QueryUnbondingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingsResponse) ProtoMessage()    {}
+func (*QueryUnbondingsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00c8db58c274b111, []int{11}
 }
-func (m *QueryRewardsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryUnbondingsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryUnbondingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRewardsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryUnbondingsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -615,41 +645,43 @@ func (m *QueryRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryRewardsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRewardsResponse.Merge(m, src)
+func (m *QueryUnbondingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingsResponse.Merge(m, src)
 }
-func (m *QueryRewardsResponse) XXX_Size() int {
+func (m *QueryUnbondingsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRewardsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRewardsResponse.DiscardUnknown(m)
+func (m *QueryUnbondingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRewardsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryUnbondingsResponse proto.InternalMessageInfo
 
-func (m *QueryRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QueryUnbondingsResponse) GetUnbondings() []Unbonding {
 	if m != nil {
-		return m.Rewards
+		return m.Unbondings
 	}
 	return nil
 }
 
-// QueryCurrentEpochDaysRequest is the request type for the Query/CurrentEpochDays RPC method.
-type QueryCurrentEpochDaysRequest struct {
+// QueryStakingsDetailRequest is the request type for the Query/StakingsDetail RPC method.
+type QueryStakingsDetailRequest struct {
+	Farmer           string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	StakingCoinDenom string `protobuf:"bytes,2,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
 }
 
-func (m *QueryCurrentEpochDaysRequest) Reset()         { *m = QueryCurrentEpochDaysRequest{} }
-func (m *QueryCurrentEpochDaysRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryCurrentEpochDaysRequest) ProtoMessage()    {}
-func (*QueryCurrentEpochDaysRequest) Descriptor() ([]byte, []int) {
+func (m *QueryStakingsDetailRequest) Reset()         { *m = QueryStakingsDetailRequest{} }
+func (m *QueryStakingsDetailRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingsDetailRequest) ProtoMessage()    {}
+func (*QueryStakingsDetailRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00c8db58c274b111, []int{12}
 }
-func (m *QueryCurrentEpochDaysRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryStakingsDetailRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCurrentEpochDaysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStakingsDetailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCurrentEpochDaysRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStakingsDetailRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -659,35 +691,53 @@ func (m *QueryCurrentEpochDaysRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryCurrentEpochDaysRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCurrentEpochDaysRequest.Merge(m, src)
+func (m *QueryStakingsDetailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingsDetailRequest.Merge(m, src)
 }
-func (m *QueryCurrentEpochDaysRequest) XXX_Size() int {
+func (m *QueryStakingsDetailRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCurrentEpochDaysRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCurrentEpochDaysRequest.DiscardUnknown(m)
+func (m *QueryStakingsDetailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingsDetailRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCurrentEpochDaysRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryStakingsDetailRequest proto.InternalMessageInfo
+
+func (m *QueryStakingsDetailRequest) GetFarmer() string {
+	if m != nil {
+		return m.Farmer
+	}
+	return ""
+}
+
+func (m *QueryStakingsDetailRequest) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
+	}
+	return ""
+}
 
-// QuerCurrentEpochDaysResponse is the response type for the Query/CurrentEpochDays RPC method.
-type QueryCurrentEpochDaysResponse struct {
-	CurrentEpochDays uint32 `protobuf:"varint,1,opt,name=current_epoch_days,json=currentEpochDays,proto3" json:"current_epoch_days,omitempty"`
+// QueryStakingsDetailResponse is the response type for the Query/StakingsDetail RPC method.
+type QueryStakingsDetailResponse struct {
+	Stakings       []StakingDetail       `protobuf:"bytes,1,rep,name=stakings,proto3" json:"stakings"`
+	QueuedStakings []QueuedStakingDetail `protobuf:"bytes,2,rep,name=queued_stakings,json=queuedStakings,proto3" json:"queued_stakings"`
+	// next_epoch_time is the expected time the queued stakings become staked.
+	// It is null when the first epoch has not started yet.
+	NextEpochTime *time.Time `protobuf:"bytes,3,opt,name=next_epoch_time,json=nextEpochTime,proto3,stdtime" json:"next_epoch_time,omitempty"`
 }
 
-func (m *QueryCurrentEpochDaysResponse) Reset()         { *m = QueryCurrentEpochDaysResponse{} }
-func (m *QueryCurrentEpochDaysResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryCurrentEpochDaysResponse) ProtoMessage()    {}
-func (*QueryCurrentEpochDaysResponse) Descriptor() ([]byte, []int) {
+func (m *QueryStakingsDetailResponse) Reset()         { *m = QueryStakingsDetailResponse{} }
+func (m *QueryStakingsDetailResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingsDetailResponse) ProtoMessage()    {}
+func (*QueryStakingsDetailResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00c8db58c274b111, []int{13}
 }
-func (m *QueryCurrentEpochDaysResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryStakingsDetailResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCurrentEpochDaysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStakingsDetailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCurrentEpochDaysResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStakingsDetailResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -697,1204 +747,11941 @@ func (m *QueryCurrentEpochDaysResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryCurrentEpochDaysResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCurrentEpochDaysResponse.Merge(m, src)
+func (m *QueryStakingsDetailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingsDetailResponse.Merge(m, src)
 }
-func (m *QueryCurrentEpochDaysResponse) XXX_Size() int {
+func (m *QueryStakingsDetailResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCurrentEpochDaysResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCurrentEpochDaysResponse.DiscardUnknown(m)
+func (m *QueryStakingsDetailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingsDetailResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCurrentEpochDaysResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryStakingsDetailResponse proto.InternalMessageInfo
 
-func (m *QueryCurrentEpochDaysResponse) GetCurrentEpochDays() uint32 {
+func (m *QueryStakingsDetailResponse) GetStakings() []StakingDetail {
 	if m != nil {
-		return m.CurrentEpochDays
+		return m.Stakings
 	}
-	return 0
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.farming.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.farming.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryPlansRequest)(nil), "cosmos.farming.v1beta1.QueryPlansRequest")
-	proto.RegisterType((*QueryPlansResponse)(nil), "cosmos.farming.v1beta1.QueryPlansResponse")
-	proto.RegisterType((*QueryPlanRequest)(nil), "cosmos.farming.v1beta1.QueryPlanRequest")
-	proto.RegisterType((*QueryPlanResponse)(nil), "cosmos.farming.v1beta1.QueryPlanResponse")
-	proto.RegisterType((*QueryStakingsRequest)(nil), "cosmos.farming.v1beta1.QueryStakingsRequest")
-	proto.RegisterType((*QueryStakingsResponse)(nil), "cosmos.farming.v1beta1.QueryStakingsResponse")
-	proto.RegisterType((*QueryTotalStakingsRequest)(nil), "cosmos.farming.v1beta1.QueryTotalStakingsRequest")
-	proto.RegisterType((*QueryTotalStakingsResponse)(nil), "cosmos.farming.v1beta1.QueryTotalStakingsResponse")
-	proto.RegisterType((*QueryRewardsRequest)(nil), "cosmos.farming.v1beta1.QueryRewardsRequest")
-	proto.RegisterType((*QueryRewardsResponse)(nil), "cosmos.farming.v1beta1.QueryRewardsResponse")
-	proto.RegisterType((*QueryCurrentEpochDaysRequest)(nil), "cosmos.farming.v1beta1.QueryCurrentEpochDaysRequest")
-	proto.RegisterType((*QueryCurrentEpochDaysResponse)(nil), "cosmos.farming.v1beta1.QueryCurrentEpochDaysResponse")
+func (m *QueryStakingsDetailResponse) GetQueuedStakings() []QueuedStakingDetail {
+	if m != nil {
+		return m.QueuedStakings
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("tendermint/farming/v1beta1/query.proto", fileDescriptor_00c8db58c274b111)
+func (m *QueryStakingsDetailResponse) GetNextEpochTime() *time.Time {
+	if m != nil {
+		return m.NextEpochTime
+	}
+	return nil
 }
 
-var fileDescriptor_00c8db58c274b111 = []byte{
-	// 1592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4b, 0x6c, 0x14, 0x47,
-	0x1a, 0xf6, 0x3c, 0x6c, 0x96, 0xf2, 0x22, 0x79, 0x0b, 0xc3, 0x9a, 0x16, 0x8c, 0x4b, 0xbd, 0x12,
-	0xd8, 0xc6, 0xd3, 0x6d, 0x1b, 0xac, 0xdd, 0x35, 0xcb, 0x61, 0x0c, 0x36, 0x98, 0x05, 0xe4, 0x1d,
-	0xb8, 0x2c, 0xb0, 0x9a, 0xad, 0xe9, 0x2e, 0x8f, 0x3b, 0xf4, 0x54, 0x35, 0xdd, 0xd5, 0x86, 0x11,
-	0x71, 0x5e, 0x8a, 0x38, 0x24, 0x97, 0xc4, 0xe4, 0x1c, 0xe5, 0x9a, 0xc7, 0x31, 0xb7, 0x9c, 0x23,
-	0x21, 0x0e, 0x11, 0x51, 0x24, 0x84, 0x72, 0x20, 0x09, 0xce, 0x3d, 0xb9, 0x91, 0x63, 0x54, 0x8f,
-	0x1e, 0xcf, 0x8c, 0x67, 0xc6, 0xb6, 0x1c, 0x24, 0x9f, 0x3c, 0xdd, 0xf5, 0xd7, 0xff, 0x7f, 0xf5,
-	0x7d, 0x5f, 0x57, 0xfd, 0x65, 0x70, 0x9c, 0x13, 0xea, 0x92, 0xb0, 0xea, 0x51, 0x6e, 0x2f, 0x61,
-	0xf1, 0xb7, 0x62, 0xaf, 0x4c, 0x96, 0x09, 0xc7, 0x93, 0xf6, 0x9d, 0x98, 0x84, 0x35, 0x2b, 0x08,
-	0x19, 0x67, 0xf0, 0xb0, 0xc3, 0xa2, 0x2a, 0x8b, 0x2c, 0x1d, 0x63, 0xe9, 0x18, 0x63, 0xa4, 0xcb,
-	0xfc, 0x24, 0x56, 0x66, 0x30, 0x8e, 0xa8, 0x0c, 0x25, 0xf9, 0x64, 0xeb, 0x74, 0x6a, 0x68, 0x4c,
-	0x3d, 0xd9, 0x65, 0x1c, 0x11, 0x55, 0xb5, 0x9e, 0x23, 0xc0, 0x15, 0x8f, 0x62, 0xee, 0x31, 0xaa,
-	0x63, 0x73, 0x8d, 0xb1, 0x49, 0x94, 0xc3, 0xbc, 0x64, 0x7c, 0xb0, 0xc2, 0x2a, 0x4c, 0xd5, 0x10,
-	0xbf, 0x92, 0xe2, 0x15, 0xc6, 0x2a, 0x3e, 0xb1, 0xe5, 0x53, 0x39, 0x5e, 0xb2, 0x31, 0xd5, 0x2b,
-	0x33, 0x8e, 0xea, 0x21, 0x1c, 0x78, 0x36, 0xa6, 0x94, 0x71, 0x59, 0x2d, 0x81, 0xa6, 0xfe, 0x38,
-	0xf9, 0x0a, 0xa1, 0x79, 0x16, 0x10, 0x8a, 0x03, 0x6f, 0x65, 0xca, 0x66, 0x81, 0x8c, 0xd9, 0x1c,
-	0x6f, 0x0e, 0x02, 0xf8, 0x1f, 0xb1, 0x80, 0x45, 0x1c, 0xe2, 0x6a, 0x54, 0x24, 0x77, 0x62, 0x12,
-	0x71, 0xf3, 0x1a, 0x38, 0xd8, 0xf4, 0x36, 0x0a, 0x18, 0x8d, 0x08, 0xfc, 0x17, 0xe8, 0x0b, 0xe4,
-	0x9b, 0xa1, 0x14, 0x4a, 0x8d, 0xf4, 0x4f, 0xe5, 0xac, 0xf6, 0x2c, 0x5b, 0x6a, 0xde, 0x6c, 0xf6,
-	0xd1, 0xf3, 0xe1, 0x9e, 0xa2, 0x9e, 0x63, 0x7e, 0x92, 0x06, 0x7f, 0x51, 0x59, 0x7d, 0x4c, 0x93,
-	0x52, 0x10, 0x82, 0x2c, 0xaf, 0x05, 0x44, 0x66, 0xdc, 0x5f, 0x94, 0xbf, 0xe1, 0x04, 0x18, 0xd4,
-	0x19, 0x4b, 0x01, 0x63, 0x7e, 0x09, 0xbb, 0x6e, 0x48, 0xa2, 0x68, 0x28, 0x2d, 0x63, 0xa0, 0x1e,
-	0x5b, 0x64, 0xcc, 0x2f, 0xa8, 0x11, 0x68, 0x83, 0x83, 0x5c, 0xaa, 0x2a, 0x17, 0x57, 0x9f, 0x90,
-	0x51, 0x13, 0x1a, 0x86, 0x92, 0x09, 0xe3, 0x00, 0x46, 0x1c, 0xdf, 0x16, 0x25, 0x84, 0x18, 0x25,
-	0x97, 0x50, 0x56, 0x1d, 0xca, 0xca, 0xf8, 0x01, 0x3d, 0x72, 0x8e, 0x79, 0xf4, 0xbc, 0x78, 0x0f,
-	0x73, 0x00, 0x24, 0x39, 0x88, 0x3b, 0xd4, 0x2b, 0xa3, 0x1a, 0xde, 0xc0, 0x79, 0x00, 0x36, 0x84,
-	0x1f, 0xea, 0x93, 0xe4, 0x1c, 0x4f, 0xc8, 0x11, 0xca, 0x5b, 0xca, 0x9b, 0x1b, 0xfc, 0x54, 0x88,
-	0x26, 0xa0, 0xd8, 0x30, 0xd3, 0xfc, 0x28, 0x95, 0xc8, 0xa1, 0x28, 0xd2, 0xbc, 0x4f, 0x83, 0xde,
-	0x40, 0xbc, 0x18, 0x4a, 0xa1, 0xcc, 0x48, 0xff, 0xd4, 0xa0, 0xa5, 0x2c, 0x60, 0x25, 0xee, 0xb0,
-	0x0a, 0xb4, 0x36, 0xbb, 0xff, 0xf1, 0x97, 0xf9, 0x5e, 0x31, 0x6f, 0xa1, 0xa8, 0xa2, 0xe1, 0x85,
-	0x26, 0x54, 0x69, 0x89, 0xea, 0xc4, 0x96, 0xa8, 0x54, 0xcd, 0x26, 0x58, 0x27, 0xc1, 0x40, 0x1d,
-	0x55, 0xa2, 0xdb, 0x5f, 0xc1, 0x3e, 0x51, 0xa5, 0xe4, 0xb9, 0x52, 0xba, 0x6c, 0xb1, 0x4f, 0x3c,
-	0x2e, 0xb8, 0xe6, 0xc5, 0x06, 0x95, 0xeb, 0x2b, 0x38, 0x05, 0xb2, 0x62, 0x58, 0xfb, 0x66, 0xcb,
-	0x05, 0xc8, 0x60, 0xf3, 0x16, 0x18, 0x94, 0x99, 0xae, 0x29, 0x39, 0xea, 0x96, 0x39, 0x0c, 0xfa,
-	0x84, 0x05, 0x48, 0xa8, 0x4d, 0xa3, 0x9f, 0x3a, 0x68, 0x9a, 0x6e, 0xaf, 0xa9, 0xf9, 0x32, 0x05,
-	0x0e, 0xb5, 0xa4, 0xd7, 0x60, 0x29, 0xf8, 0xb3, 0x88, 0x26, 0xae, 0x4c, 0x93, 0xb0, 0x7e, 0xa4,
-	0x89, 0xb9, 0x84, 0x33, 0x91, 0x6f, 0x76, 0x42, 0xf8, 0xfc, 0xb3, 0x1f, 0x86, 0x47, 0x2a, 0x1e,
-	0x5f, 0x8e, 0xcb, 0x96, 0xc3, 0xaa, 0x7a, 0xc3, 0xd0, 0x7f, 0xf2, 0x91, 0x7b, 0xdb, 0x16, 0xd6,
-	0x8e, 0xe4, 0x84, 0xa8, 0xd8, 0xaf, 0x0a, 0xc8, 0x07, 0x51, 0xef, 0x4e, 0x4c, 0xe2, 0x7a, 0xbd,
-	0xf4, 0x2b, 0xa8, 0xa7, 0x0a, 0xc8, 0x07, 0x73, 0x01, 0x1c, 0x91, 0x0b, 0xbf, 0xce, 0x38, 0xf6,
-	0x5b, 0xc9, 0x6d, 0x4f, 0x62, 0xaa, 0x03, 0x89, 0x2e, 0x30, 0xda, 0xa5, 0xd2, 0x44, 0xce, 0x83,
-	0x3e, 0x5c, 0x65, 0x31, 0xe5, 0x6a, 0xfe, 0xac, 0x25, 0x70, 0x7f, 0xff, 0x7c, 0xf8, 0xf8, 0x36,
-	0x70, 0x2f, 0x50, 0x5e, 0xd4, 0xb3, 0xcd, 0x9b, 0x7a, 0x3b, 0x2a, 0x92, 0xbb, 0x38, 0x74, 0xff,
-	0x60, 0x1f, 0xac, 0x6a, 0x97, 0xd5, 0x93, 0x6b, 0xf0, 0x04, 0xec, 0x0b, 0xd5, 0xab, 0x57, 0x61,
-	0x80, 0x24, 0xb7, 0x99, 0x03, 0x47, 0x65, 0xf9, 0x73, 0x71, 0x18, 0x12, 0xca, 0xe7, 0x02, 0xe6,
-	0x2c, 0x9f, 0xc7, 0xb5, 0xfa, 0x56, 0x7c, 0x05, 0x1c, 0xeb, 0x30, 0xae, 0x71, 0x8e, 0x03, 0xe8,
-	0xa8, 0xb1, 0x12, 0x11, 0x83, 0x25, 0x17, 0xd7, 0xd4, 0x06, 0x7d, 0xa0, 0x38, 0xe0, 0xb4, 0xcc,
-	0x9a, 0xfa, 0xf4, 0x28, 0xe8, 0x95, 0xf9, 0xe0, 0x17, 0x69, 0xd0, 0xa7, 0xf6, 0x69, 0x38, 0xd6,
-	0x69, 0x1f, 0xdf, 0x7c, 0x34, 0x18, 0x27, 0xb7, 0x15, 0xab, 0xb0, 0x99, 0x8f, 0x52, 0x6b, 0x85,
-	0x8f, 0x53, 0x46, 0xbe, 0x48, 0x78, 0x1c, 0xd2, 0x08, 0x61, 0xdf, 0x47, 0xf2, 0x34, 0x20, 0x9c,
-	0x84, 0x11, 0x62, 0x4b, 0x88, 0x2f, 0x13, 0xa4, 0x33, 0xa1, 0x2a, 0x73, 0x63, 0x9f, 0x58, 0x66,
-	0x15, 0xe4, 0xe6, 0x3d, 0xea, 0x22, 0x16, 0x73, 0x54, 0x65, 0x21, 0x41, 0xb8, 0x2c, 0x7e, 0x8a,
-	0x50, 0x75, 0x94, 0xc0, 0x7f, 0x2f, 0x73, 0x1e, 0x44, 0x33, 0xb6, 0xdd, 0xc0, 0x77, 0x9b, 0x83,
-	0xbd, 0xec, 0xb3, 0xb2, 0x5d, 0xc5, 0x1e, 0xb5, 0xef, 0xd5, 0xdf, 0x45, 0x01, 0x71, 0xec, 0x89,
-	0xbf, 0x97, 0x54, 0x26, 0xab, 0xea, 0xbe, 0xf3, 0xdd, 0xcf, 0x0f, 0xd3, 0x08, 0xe6, 0x12, 0xc1,
-	0x5a, 0xbb, 0x02, 0x5d, 0xf2, 0x59, 0x16, 0xc8, 0xcd, 0x29, 0x82, 0xa3, 0xdd, 0x19, 0x68, 0x38,
-	0xdc, 0x8c, 0xb1, 0xed, 0x84, 0x6a, 0xae, 0x5e, 0x66, 0xd6, 0x0a, 0xdf, 0x64, 0x8c, 0x33, 0x75,
-	0xae, 0x90, 0xef, 0x45, 0x5c, 0x70, 0x24, 0x58, 0x4b, 0x38, 0x92, 0x3b, 0x3b, 0xba, 0xeb, 0xf1,
-	0x65, 0xb4, 0xb1, 0x41, 0xa3, 0x90, 0x44, 0xb1, 0xcf, 0x2d, 0x73, 0x05, 0xe4, 0x3b, 0x31, 0x27,
-	0xb7, 0x7a, 0x84, 0xa9, 0x8b, 0x48, 0x18, 0xb2, 0x10, 0x39, 0xcc, 0x25, 0x11, 0x9c, 0xdb, 0x1e,
-	0x91, 0x3c, 0x24, 0x44, 0x11, 0xe9, 0x32, 0x27, 0xb2, 0x2f, 0xb2, 0xbb, 0xf9, 0xeb, 0xcc, 0x76,
-	0x7c, 0xef, 0x6f, 0x72, 0x0d, 0x97, 0x1e, 0xa6, 0x40, 0xe6, 0xf4, 0xc4, 0x04, 0x7c, 0x3f, 0x05,
-	0xfa, 0x67, 0xb1, 0x8b, 0x12, 0xf3, 0xbe, 0x0e, 0x06, 0x70, 0x10, 0xf8, 0x9e, 0x23, 0x61, 0xda,
-	0xaf, 0x45, 0x8c, 0xc2, 0xe5, 0xfb, 0xa6, 0xa8, 0x6d, 0xce, 0x9c, 0x1a, 0x37, 0xab, 0x24, 0x8a,
-	0x70, 0x85, 0x98, 0x33, 0x66, 0x18, 0x38, 0x0a, 0xd8, 0x8c, 0x44, 0x86, 0xce, 0xa2, 0x05, 0xba,
-	0x82, 0x7d, 0xcf, 0x2d, 0x84, 0x95, 0xb8, 0x4a, 0x28, 0x47, 0x2e, 0x89, 0x1c, 0x74, 0x16, 0x79,
-	0xea, 0xb5, 0x24, 0x02, 0x89, 0x2f, 0x0a, 0x2d, 0x5e, 0x2e, 0x5c, 0x2d, 0x5d, 0xff, 0xef, 0xe2,
-	0x9c, 0x39, 0x6e, 0xba, 0x84, 0x63, 0xcf, 0x8f, 0xcc, 0x99, 0x9b, 0xff, 0x5b, 0xbd, 0xf4, 0x56,
-	0x0a, 0x64, 0xa6, 0x27, 0x26, 0x60, 0x0d, 0x1c, 0x5a, 0xa0, 0x9c, 0x84, 0x14, 0xfb, 0xe8, 0x1a,
-	0x09, 0x57, 0x48, 0x88, 0xe6, 0x44, 0x29, 0xf3, 0xff, 0x6d, 0xe0, 0x5d, 0x4e, 0xe0, 0x4d, 0x6e,
-	0x89, 0x4f, 0xa7, 0xd4, 0xc0, 0xe4, 0x68, 0x0b, 0x04, 0xe9, 0xad, 0x61, 0x78, 0xac, 0xa3, 0xb7,
-	0xa4, 0xa1, 0x9e, 0xf6, 0x82, 0xac, 0xe0, 0x11, 0x8e, 0x6c, 0x69, 0x97, 0xc4, 0x58, 0xa3, 0xdb,
-	0x88, 0xd4, 0xbe, 0xfa, 0x2d, 0xbb, 0x56, 0xf8, 0x3a, 0x6b, 0xfc, 0x33, 0xf1, 0x55, 0xe3, 0x17,
-	0xa7, 0x48, 0x5c, 0xc6, 0x1c, 0x39, 0x2c, 0x0c, 0xe5, 0x0c, 0x37, 0x42, 0x9c, 0xa9, 0x6f, 0x4d,
-	0x1d, 0xef, 0x96, 0x19, 0xef, 0xd4, 0x55, 0xe7, 0x77, 0xeb, 0x2a, 0x51, 0xfa, 0xd2, 0xbb, 0xda,
-	0x54, 0xab, 0xcd, 0x9e, 0xa2, 0x6d, 0x44, 0xbb, 0xb1, 0x3b, 0x4f, 0x91, 0x6a, 0xc0, 0x6b, 0x28,
-	0xd4, 0x05, 0x5a, 0x5c, 0xf4, 0x40, 0xc2, 0x38, 0x0d, 0xdf, 0x6c, 0x86, 0x11, 0xb4, 0x81, 0x71,
-	0x2b, 0x81, 0x31, 0xdd, 0x1d, 0xc6, 0x55, 0xc6, 0xe7, 0x59, 0x4c, 0xdd, 0xa4, 0xbe, 0x94, 0x41,
-	0xd3, 0x8d, 0x28, 0xe3, 0x68, 0x49, 0x8c, 0xee, 0x51, 0x3b, 0x8f, 0xc2, 0x13, 0x5d, 0xed, 0x6c,
-	0xdf, 0xd7, 0x2b, 0x59, 0x85, 0xbf, 0x66, 0xc0, 0x9f, 0x92, 0xa6, 0x00, 0x8e, 0x77, 0xb5, 0x6c,
-	0x4b, 0x1b, 0x62, 0xe4, 0xb7, 0x19, 0xad, 0x4d, 0xfe, 0x20, 0xb3, 0x56, 0xf8, 0x36, 0x6d, 0x5c,
-	0x69, 0x3c, 0x68, 0xf4, 0x49, 0x1f, 0xa1, 0x11, 0xd5, 0x6c, 0x49, 0x9b, 0xaa, 0x3e, 0x08, 0xc9,
-	0x46, 0x6b, 0xb4, 0xa3, 0xf5, 0x55, 0x23, 0x61, 0xd6, 0x76, 0x6a, 0xfc, 0x8b, 0xbb, 0x35, 0x7e,
-	0x82, 0x79, 0x8f, 0x98, 0x5f, 0x0a, 0x7e, 0x12, 0x8e, 0x76, 0x12, 0x3c, 0x81, 0x6b, 0xdf, 0x57,
-	0x8c, 0xad, 0xc2, 0xf7, 0xb2, 0xe0, 0x40, 0x53, 0x33, 0x08, 0x27, 0xbb, 0x2a, 0xd9, 0xae, 0x07,
-	0x35, 0xa6, 0x76, 0x32, 0x45, 0x3b, 0xe0, 0xc3, 0xcc, 0x5a, 0xe1, 0x71, 0xda, 0x28, 0xd4, 0xb7,
-	0x39, 0x11, 0xb5, 0xe1, 0x81, 0x4e, 0x4a, 0x6f, 0x6e, 0x14, 0xcd, 0x37, 0x76, 0xaa, 0xfa, 0x95,
-	0xdd, 0xaa, 0x2e, 0xb1, 0xee, 0x45, 0xe9, 0xcf, 0xc2, 0x33, 0x9d, 0xa4, 0x97, 0x98, 0x4b, 0x1b,
-	0x06, 0xd8, 0x4c, 0xe4, 0x2a, 0x7c, 0x9a, 0x01, 0xfb, 0x74, 0x5b, 0x0d, 0xbb, 0xf7, 0x8d, 0xcd,
-	0x9d, 0xbd, 0x31, 0xbe, 0xbd, 0x60, 0x2d, 0xfd, 0x2f, 0xe9, 0xb5, 0xc2, 0x57, 0x69, 0xe3, 0x1f,
-	0x8d, 0x1f, 0xbf, 0x6e, 0xaf, 0xd5, 0x87, 0xbe, 0xd5, 0x77, 0x7e, 0x6f, 0xa7, 0x8a, 0x5f, 0xd8,
-	0xad, 0xe2, 0x1a, 0xde, 0x5e, 0xd2, 0x7a, 0x0c, 0x8e, 0x74, 0xd2, 0x5a, 0xa3, 0xdd, 0xf8, 0xca,
-	0xd7, 0x33, 0x60, 0xa0, 0xf5, 0x42, 0x02, 0x4f, 0x77, 0x15, 0xad, 0xc3, 0xfd, 0xc6, 0x98, 0xde,
-	0xe1, 0x2c, 0xad, 0xf9, 0x4f, 0xe9, 0xb5, 0xc2, 0xe7, 0x69, 0x23, 0xd7, 0xd8, 0xd5, 0xe8, 0xcb,
-	0x0e, 0x92, 0xd7, 0x20, 0x24, 0xae, 0x41, 0xe6, 0xdb, 0xa9, 0x9d, 0x4a, 0xbb, 0xb8, 0x5b, 0x69,
-	0x35, 0x0a, 0x09, 0x42, 0x60, 0xd8, 0x4b, 0x1a, 0x8f, 0xc3, 0xb1, 0x4e, 0x1a, 0x6f, 0xbe, 0x43,
-	0xce, 0x5e, 0x78, 0xf4, 0x22, 0x97, 0x7a, 0xf2, 0x22, 0x97, 0xfa, 0xf1, 0x45, 0x2e, 0xf5, 0xc1,
-	0x7a, 0xae, 0xe7, 0xc9, 0x7a, 0xae, 0xe7, 0xd9, 0x7a, 0xae, 0xe7, 0x46, 0xbe, 0x3b, 0x39, 0x1b,
-	0xb7, 0x2d, 0x79, 0xe5, 0x2d, 0xf7, 0xc9, 0xff, 0xf3, 0x9c, 0xfa, 0x3d, 0x00, 0x00, 0xff, 0xff,
-	0x83, 0xbd, 0x86, 0x7b, 0xbd, 0x15, 0x00, 0x00,
+// QueryQueuedStakingsRequest is the request type for the Query/QueuedStakings RPC method.
+type QueryQueuedStakingsRequest struct {
+	Farmer           string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	StakingCoinDenom string `protobuf:"bytes,2,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryQueuedStakingsRequest) Reset()         { *m = QueryQueuedStakingsRequest{} }
+func (m *QueryQueuedStakingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedStakingsRequest) ProtoMessage()    {}
+func (*QueryQueuedStakingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{14}
+}
+func (m *QueryQueuedStakingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedStakingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedStakingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedStakingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedStakingsRequest.Merge(m, src)
+}
+func (m *QueryQueuedStakingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedStakingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedStakingsRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryQueuedStakingsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns parameters of the farming module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Plans returns all plans.
-	Plans(ctx context.Context, in *QueryPlansRequest, opts ...grpc.CallOption) (*QueryPlansResponse, error)
-	// Plan returns a specific plan.
-	Plan(ctx context.Context, in *QueryPlanRequest, opts ...grpc.CallOption) (*QueryPlanResponse, error)
-	// Stakings returns all stakings by a farmer.
-	Stakings(ctx context.Context, in *QueryStakingsRequest, opts ...grpc.CallOption) (*QueryStakingsResponse, error)
-	// TotalStakings returns total staking amount for a staking coin denom
-	TotalStakings(ctx context.Context, in *QueryTotalStakingsRequest, opts ...grpc.CallOption) (*QueryTotalStakingsResponse, error)
-	// Rewards returns rewards for a farmer
-	Rewards(ctx context.Context, in *QueryRewardsRequest, opts ...grpc.CallOption) (*QueryRewardsResponse, error)
-	// CurrentEpochDays returns current epoch days.
-	CurrentEpochDays(ctx context.Context, in *QueryCurrentEpochDaysRequest, opts ...grpc.CallOption) (*QueryCurrentEpochDaysResponse, error)
+func (m *QueryQueuedStakingsRequest) GetFarmer() string {
+	if m != nil {
+		return m.Farmer
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryQueuedStakingsRequest) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
+	}
+	return ""
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryQueuedStakingsResponse is the response type for the Query/QueuedStakings RPC method.
+type QueryQueuedStakingsResponse struct {
+	QueuedStakings []QueuedStakingDetail `protobuf:"bytes,1,rep,name=queued_stakings,json=queuedStakings,proto3" json:"queued_stakings"`
+	// next_epoch_time is the expected time the queued stakings become staked.
+	// It is null when the first epoch has not started yet.
+	NextEpochTime *time.Time `protobuf:"bytes,2,opt,name=next_epoch_time,json=nextEpochTime,proto3,stdtime" json:"next_epoch_time,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryQueuedStakingsResponse) Reset()         { *m = QueryQueuedStakingsResponse{} }
+func (m *QueryQueuedStakingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedStakingsResponse) ProtoMessage()    {}
+func (*QueryQueuedStakingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{15}
+}
+func (m *QueryQueuedStakingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedStakingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedStakingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryQueuedStakingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedStakingsResponse.Merge(m, src)
+}
+func (m *QueryQueuedStakingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedStakingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedStakingsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Plans(ctx context.Context, in *QueryPlansRequest, opts ...grpc.CallOption) (*QueryPlansResponse, error) {
-	out := new(QueryPlansResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Plans", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryQueuedStakingsResponse proto.InternalMessageInfo
+
+func (m *QueryQueuedStakingsResponse) GetQueuedStakings() []QueuedStakingDetail {
+	if m != nil {
+		return m.QueuedStakings
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Plan(ctx context.Context, in *QueryPlanRequest, opts ...grpc.CallOption) (*QueryPlanResponse, error) {
-	out := new(QueryPlanResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Plan", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryQueuedStakingsResponse) GetNextEpochTime() *time.Time {
+	if m != nil {
+		return m.NextEpochTime
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Stakings(ctx context.Context, in *QueryStakingsRequest, opts ...grpc.CallOption) (*QueryStakingsResponse, error) {
-	out := new(QueryStakingsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Stakings", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// StakingDetail defines a farmer's staking for a staking coin denom, with its epoch information.
+type StakingDetail struct {
+	StakingCoinDenom string                                 `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	StartingEpoch    uint64                                 `protobuf:"varint,3,opt,name=starting_epoch,json=startingEpoch,proto3" json:"starting_epoch,omitempty"`
+	CurrentEpoch     uint64                                 `protobuf:"varint,4,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
 }
 
-func (c *queryClient) TotalStakings(ctx context.Context, in *QueryTotalStakingsRequest, opts ...grpc.CallOption) (*QueryTotalStakingsResponse, error) {
-	out := new(QueryTotalStakingsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/TotalStakings", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StakingDetail) Reset()         { *m = StakingDetail{} }
+func (m *StakingDetail) String() string { return proto.CompactTextString(m) }
+func (*StakingDetail) ProtoMessage()    {}
+func (*StakingDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{16}
+}
+func (m *StakingDetail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakingDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakingDetail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *StakingDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingDetail.Merge(m, src)
+}
+func (m *StakingDetail) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakingDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingDetail.DiscardUnknown(m)
 }
 
-func (c *queryClient) Rewards(ctx context.Context, in *QueryRewardsRequest, opts ...grpc.CallOption) (*QueryRewardsResponse, error) {
-	out := new(QueryRewardsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Rewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StakingDetail proto.InternalMessageInfo
+
+func (m *StakingDetail) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) CurrentEpochDays(ctx context.Context, in *QueryCurrentEpochDaysRequest, opts ...grpc.CallOption) (*QueryCurrentEpochDaysResponse, error) {
-	out := new(QueryCurrentEpochDaysResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/CurrentEpochDays", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StakingDetail) GetStartingEpoch() uint64 {
+	if m != nil {
+		return m.StartingEpoch
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns parameters of the farming module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Plans returns all plans.
-	Plans(context.Context, *QueryPlansRequest) (*QueryPlansResponse, error)
-	// Plan returns a specific plan.
-	Plan(context.Context, *QueryPlanRequest) (*QueryPlanResponse, error)
-	// Stakings returns all stakings by a farmer.
-	Stakings(context.Context, *QueryStakingsRequest) (*QueryStakingsResponse, error)
-	// TotalStakings returns total staking amount for a staking coin denom
-	TotalStakings(context.Context, *QueryTotalStakingsRequest) (*QueryTotalStakingsResponse, error)
-	// Rewards returns rewards for a farmer
-	Rewards(context.Context, *QueryRewardsRequest) (*QueryRewardsResponse, error)
-	// CurrentEpochDays returns current epoch days.
-	CurrentEpochDays(context.Context, *QueryCurrentEpochDaysRequest) (*QueryCurrentEpochDaysResponse, error)
+func (m *StakingDetail) GetCurrentEpoch() uint64 {
+	if m != nil {
+		return m.CurrentEpoch
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueuedStakingDetail defines a farmer's queued staking for a staking coin denom.
+type QueuedStakingDetail struct {
+	StakingCoinDenom string                                 `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueuedStakingDetail) Reset()         { *m = QueuedStakingDetail{} }
+func (m *QueuedStakingDetail) String() string { return proto.CompactTextString(m) }
+func (*QueuedStakingDetail) ProtoMessage()    {}
+func (*QueuedStakingDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{17}
 }
-func (*UnimplementedQueryServer) Plans(ctx context.Context, req *QueryPlansRequest) (*QueryPlansResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Plans not implemented")
+func (m *QueuedStakingDetail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Plan(ctx context.Context, req *QueryPlanRequest) (*QueryPlanResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Plan not implemented")
+func (m *QueuedStakingDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedStakingDetail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Stakings(ctx context.Context, req *QueryStakingsRequest) (*QueryStakingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Stakings not implemented")
+func (m *QueuedStakingDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedStakingDetail.Merge(m, src)
 }
-func (*UnimplementedQueryServer) TotalStakings(ctx context.Context, req *QueryTotalStakingsRequest) (*QueryTotalStakingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalStakings not implemented")
+func (m *QueuedStakingDetail) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Rewards(ctx context.Context, req *QueryRewardsRequest) (*QueryRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Rewards not implemented")
+func (m *QueuedStakingDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedStakingDetail.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) CurrentEpochDays(ctx context.Context, req *QueryCurrentEpochDaysRequest) (*QueryCurrentEpochDaysResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpochDays not implemented")
+
+var xxx_messageInfo_QueuedStakingDetail proto.InternalMessageInfo
+
+func (m *QueuedStakingDetail) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
+	}
+	return ""
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryStakingsByDenomRequest is the request type for the Query/StakingsByDenom RPC method.
+type QueryStakingsByDenomRequest struct {
+	StakingCoinDenom string             `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryStakingsByDenomRequest) Reset()         { *m = QueryStakingsByDenomRequest{} }
+func (m *QueryStakingsByDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingsByDenomRequest) ProtoMessage()    {}
+func (*QueryStakingsByDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{18}
+}
+func (m *QueryStakingsByDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingsByDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingsByDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryStakingsByDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingsByDenomRequest.Merge(m, src)
+}
+func (m *QueryStakingsByDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingsByDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingsByDenomRequest.DiscardUnknown(m)
 }
 
-func _Query_Plans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPlansRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Plans(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Query/Plans",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Plans(ctx, req.(*QueryPlansRequest))
+var xxx_messageInfo_QueryStakingsByDenomRequest proto.InternalMessageInfo
+
+func (m *QueryStakingsByDenomRequest) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Plan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPlanRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Plan(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Query/Plan",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Plan(ctx, req.(*QueryPlanRequest))
+func (m *QueryStakingsByDenomRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Stakings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStakingsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Stakings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Query/Stakings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Stakings(ctx, req.(*QueryStakingsRequest))
+// QueryStakingsByDenomResponse is the response type for the Query/StakingsByDenom RPC method.
+type QueryStakingsByDenomResponse struct {
+	Stakings []FarmerStaking `protobuf:"bytes,1,rep,name=stakings,proto3" json:"stakings"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// locked_stakings are the locked stakings for the staking coin denom.
+	// They are not paginated.
+	LockedStakings []LockedStaking `protobuf:"bytes,3,rep,name=locked_stakings,json=lockedStakings,proto3" json:"locked_stakings"`
+	// queued_locked_stakings are the locked stakings for the staking coin denom
+	// made in the current epoch, which don't earn rewards until the end of the epoch.
+	// They are not paginated.
+	QueuedLockedStakings []LockedStaking `protobuf:"bytes,4,rep,name=queued_locked_stakings,json=queuedLockedStakings,proto3" json:"queued_locked_stakings"`
+}
+
+func (m *QueryStakingsByDenomResponse) Reset()         { *m = QueryStakingsByDenomResponse{} }
+func (m *QueryStakingsByDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingsByDenomResponse) ProtoMessage()    {}
+func (*QueryStakingsByDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{19}
+}
+func (m *QueryStakingsByDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingsByDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingsByDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryStakingsByDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingsByDenomResponse.Merge(m, src)
+}
+func (m *QueryStakingsByDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingsByDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingsByDenomResponse.DiscardUnknown(m)
 }
 
-func _Query_TotalStakings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalStakingsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalStakings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Query/TotalStakings",
+var xxx_messageInfo_QueryStakingsByDenomResponse proto.InternalMessageInfo
+
+func (m *QueryStakingsByDenomResponse) GetStakings() []FarmerStaking {
+	if m != nil {
+		return m.Stakings
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalStakings(ctx, req.(*QueryTotalStakingsRequest))
+	return nil
+}
+
+func (m *QueryStakingsByDenomResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Rewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryStakingsByDenomResponse) GetLockedStakings() []LockedStaking {
+	if m != nil {
+		return m.LockedStakings
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Rewards(ctx, in)
+	return nil
+}
+
+func (m *QueryStakingsByDenomResponse) GetQueuedLockedStakings() []LockedStaking {
+	if m != nil {
+		return m.QueuedLockedStakings
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Query/Rewards",
+	return nil
+}
+
+// FarmerStaking defines a farmer's staking for a staking coin denom.
+type FarmerStaking struct {
+	Farmer        string                                 `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	Amount        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	StartingEpoch uint64                                 `protobuf:"varint,3,opt,name=starting_epoch,json=startingEpoch,proto3" json:"starting_epoch,omitempty"`
+}
+
+func (m *FarmerStaking) Reset()         { *m = FarmerStaking{} }
+func (m *FarmerStaking) String() string { return proto.CompactTextString(m) }
+func (*FarmerStaking) ProtoMessage()    {}
+func (*FarmerStaking) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{20}
+}
+func (m *FarmerStaking) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FarmerStaking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FarmerStaking.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Rewards(ctx, req.(*QueryRewardsRequest))
+}
+func (m *FarmerStaking) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FarmerStaking.Merge(m, src)
+}
+func (m *FarmerStaking) XXX_Size() int {
+	return m.Size()
+}
+func (m *FarmerStaking) XXX_DiscardUnknown() {
+	xxx_messageInfo_FarmerStaking.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FarmerStaking proto.InternalMessageInfo
+
+func (m *FarmerStaking) GetFarmer() string {
+	if m != nil {
+		return m.Farmer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_CurrentEpochDays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCurrentEpochDaysRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *FarmerStaking) GetStartingEpoch() uint64 {
+	if m != nil {
+		return m.StartingEpoch
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).CurrentEpochDays(ctx, in)
+	return 0
+}
+
+// QueryQueuedStakingsByDenomRequest is the request type for the Query/QueuedStakingsByDenom RPC method.
+type QueryQueuedStakingsByDenomRequest struct {
+	StakingCoinDenom string             `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryQueuedStakingsByDenomRequest) Reset()         { *m = QueryQueuedStakingsByDenomRequest{} }
+func (m *QueryQueuedStakingsByDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedStakingsByDenomRequest) ProtoMessage()    {}
+func (*QueryQueuedStakingsByDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{21}
+}
+func (m *QueryQueuedStakingsByDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedStakingsByDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedStakingsByDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Query/CurrentEpochDays",
+}
+func (m *QueryQueuedStakingsByDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedStakingsByDenomRequest.Merge(m, src)
+}
+func (m *QueryQueuedStakingsByDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedStakingsByDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedStakingsByDenomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedStakingsByDenomRequest proto.InternalMessageInfo
+
+func (m *QueryQueuedStakingsByDenomRequest) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CurrentEpochDays(ctx, req.(*QueryCurrentEpochDaysRequest))
+	return ""
+}
+
+func (m *QueryQueuedStakingsByDenomRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.farming.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Plans",
-			Handler:    _Query_Plans_Handler,
-		},
-		{
-			MethodName: "Plan",
-			Handler:    _Query_Plan_Handler,
-		},
-		{
-			MethodName: "Stakings",
-			Handler:    _Query_Stakings_Handler,
-		},
-		{
-			MethodName: "TotalStakings",
-			Handler:    _Query_TotalStakings_Handler,
-		},
-		{
-			MethodName: "Rewards",
-			Handler:    _Query_Rewards_Handler,
-		},
-		{
-			MethodName: "CurrentEpochDays",
-			Handler:    _Query_CurrentEpochDays_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "tendermint/farming/v1beta1/query.proto",
+// QueryQueuedStakingsByDenomResponse is the response type for the Query/QueuedStakingsByDenom RPC method.
+type QueryQueuedStakingsByDenomResponse struct {
+	QueuedStakings []FarmerQueuedStaking `protobuf:"bytes,1,rep,name=queued_stakings,json=queuedStakings,proto3" json:"queued_stakings"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryQueuedStakingsByDenomResponse) Reset()         { *m = QueryQueuedStakingsByDenomResponse{} }
+func (m *QueryQueuedStakingsByDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedStakingsByDenomResponse) ProtoMessage()    {}
+func (*QueryQueuedStakingsByDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{22}
+}
+func (m *QueryQueuedStakingsByDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedStakingsByDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedStakingsByDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryQueuedStakingsByDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedStakingsByDenomResponse.Merge(m, src)
+}
+func (m *QueryQueuedStakingsByDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedStakingsByDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedStakingsByDenomResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryQueuedStakingsByDenomResponse proto.InternalMessageInfo
+
+func (m *QueryQueuedStakingsByDenomResponse) GetQueuedStakings() []FarmerQueuedStaking {
+	if m != nil {
+		return m.QueuedStakings
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryQueuedStakingsByDenomResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// FarmerQueuedStaking defines a farmer's queued staking for a staking coin denom.
+type FarmerQueuedStaking struct {
+	Farmer string                                 `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *FarmerQueuedStaking) Reset()         { *m = FarmerQueuedStaking{} }
+func (m *FarmerQueuedStaking) String() string { return proto.CompactTextString(m) }
+func (*FarmerQueuedStaking) ProtoMessage()    {}
+func (*FarmerQueuedStaking) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{23}
+}
+func (m *FarmerQueuedStaking) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FarmerQueuedStaking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FarmerQueuedStaking.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *FarmerQueuedStaking) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FarmerQueuedStaking.Merge(m, src)
+}
+func (m *FarmerQueuedStaking) XXX_Size() int {
+	return m.Size()
+}
+func (m *FarmerQueuedStaking) XXX_DiscardUnknown() {
+	xxx_messageInfo_FarmerQueuedStaking.DiscardUnknown(m)
 }
 
-func (m *QueryPlansRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FarmerQueuedStaking proto.InternalMessageInfo
+
+func (m *FarmerQueuedStaking) GetFarmer() string {
+	if m != nil {
+		return m.Farmer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPlansRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTotalStakingsRequest is the request type for the Query/TotalStakings RPC method.
+type QueryTotalStakingsRequest struct {
+	StakingCoinDenom string `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
 }
 
-func (m *QueryPlansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTotalStakingsRequest) Reset()         { *m = QueryTotalStakingsRequest{} }
+func (m *QueryTotalStakingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalStakingsRequest) ProtoMessage()    {}
+func (*QueryTotalStakingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{24}
+}
+func (m *QueryTotalStakingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalStakingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalStakingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Terminated) > 0 {
-		i -= len(m.Terminated)
-		copy(dAtA[i:], m.Terminated)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Terminated)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.StakingCoinDenom) > 0 {
-		i -= len(m.StakingCoinDenom)
-		copy(dAtA[i:], m.StakingCoinDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TerminationAddress) > 0 {
-		i -= len(m.TerminationAddress)
-		copy(dAtA[i:], m.TerminationAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TerminationAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.FarmingPoolAddress) > 0 {
-		i -= len(m.FarmingPoolAddress)
-		copy(dAtA[i:], m.FarmingPoolAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FarmingPoolAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTotalStakingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalStakingsRequest.Merge(m, src)
+}
+func (m *QueryTotalStakingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalStakingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalStakingsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPlansResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTotalStakingsRequest proto.InternalMessageInfo
+
+func (m *QueryTotalStakingsRequest) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPlansResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTotalStakingsResponse is the response type for the Query/TotalStakings RPC method.
+type QueryTotalStakingsResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func (m *QueryPlansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTotalStakingsResponse) Reset()         { *m = QueryTotalStakingsResponse{} }
+func (m *QueryTotalStakingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalStakingsResponse) ProtoMessage()    {}
+func (*QueryTotalStakingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{25}
+}
+func (m *QueryTotalStakingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalStakingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalStakingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Plans) > 0 {
-		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTotalStakingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalStakingsResponse.Merge(m, src)
+}
+func (m *QueryTotalStakingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalStakingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalStakingsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPlanRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_QueryTotalStakingsResponse proto.InternalMessageInfo
+
+// QueryRewardsRequest is the request type for the Query/Rewards RPC method.
+type QueryRewardsRequest struct {
+	Farmer           string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	StakingCoinDenom string `protobuf:"bytes,2,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
 }
 
-func (m *QueryPlanRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRewardsRequest) Reset()         { *m = QueryRewardsRequest{} }
+func (m *QueryRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsRequest) ProtoMessage()    {}
+func (*QueryRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{26}
+}
+func (m *QueryRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsRequest.Merge(m, src)
+}
+func (m *QueryRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PlanId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PlanId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryRewardsRequest) GetFarmer() string {
+	if m != nil {
+		return m.Farmer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryPlanResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRewardsRequest) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPlanResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRewardsResponse is the response type for the Query/Rewards RPC method.
+type QueryRewardsResponse struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
 }
 
-func (m *QueryPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Plan != nil {
-		{
-			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRewardsResponse) Reset()         { *m = QueryRewardsResponse{} }
+func (m *QueryRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsResponse) ProtoMessage()    {}
+func (*QueryRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{27}
+}
+func (m *QueryRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsResponse.Merge(m, src)
+}
+func (m *QueryRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStakingsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryStakingsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRewardsWithdrawAddressRequest is the request type for the Query/RewardsWithdrawAddress RPC method.
+type QueryRewardsWithdrawAddressRequest struct {
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
 }
 
-func (m *QueryStakingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StakingCoinDenom) > 0 {
-		i -= len(m.StakingCoinDenom)
-		copy(dAtA[i:], m.StakingCoinDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Farmer) > 0 {
-		i -= len(m.Farmer)
-		copy(dAtA[i:], m.Farmer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryRewardsWithdrawAddressRequest) Reset()         { *m = QueryRewardsWithdrawAddressRequest{} }
+func (m *QueryRewardsWithdrawAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsWithdrawAddressRequest) ProtoMessage()    {}
+func (*QueryRewardsWithdrawAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{28}
+}
+func (m *QueryRewardsWithdrawAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsWithdrawAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsWithdrawAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardsWithdrawAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsWithdrawAddressRequest.Merge(m, src)
+}
+func (m *QueryRewardsWithdrawAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsWithdrawAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsWithdrawAddressRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStakingsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardsWithdrawAddressRequest proto.InternalMessageInfo
+
+func (m *QueryRewardsWithdrawAddressRequest) GetFarmer() string {
+	if m != nil {
+		return m.Farmer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryStakingsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRewardsWithdrawAddressResponse is the response type for the Query/RewardsWithdrawAddress RPC method.
+type QueryRewardsWithdrawAddressResponse struct {
+	WithdrawAddress string `protobuf:"bytes,1,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
 }
 
-func (m *QueryStakingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.QueuedCoins) > 0 {
-		for iNdEx := len(m.QueuedCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.QueuedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *QueryRewardsWithdrawAddressResponse) Reset()         { *m = QueryRewardsWithdrawAddressResponse{} }
+func (m *QueryRewardsWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsWithdrawAddressResponse) ProtoMessage()    {}
+func (*QueryRewardsWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{29}
+}
+func (m *QueryRewardsWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.StakedCoins) > 0 {
-		for iNdEx := len(m.StakedCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StakedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryRewardsWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsWithdrawAddressResponse.Merge(m, src)
+}
+func (m *QueryRewardsWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsWithdrawAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardsWithdrawAddressResponse proto.InternalMessageInfo
+
+func (m *QueryRewardsWithdrawAddressResponse) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryTotalStakingsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QueryCurrentEpochDurationRequest is the request type for the Query/CurrentEpochDuration RPC method.
+type QueryCurrentEpochDurationRequest struct {
+}
+
+func (m *QueryCurrentEpochDurationRequest) Reset()         { *m = QueryCurrentEpochDurationRequest{} }
+func (m *QueryCurrentEpochDurationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentEpochDurationRequest) ProtoMessage()    {}
+func (*QueryCurrentEpochDurationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{30}
+}
+func (m *QueryCurrentEpochDurationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentEpochDurationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentEpochDurationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryCurrentEpochDurationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentEpochDurationRequest.Merge(m, src)
+}
+func (m *QueryCurrentEpochDurationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentEpochDurationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentEpochDurationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTotalStakingsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryCurrentEpochDurationRequest proto.InternalMessageInfo
+
+// QueryCurrentEpochDurationResponse is the response type for the Query/CurrentEpochDuration RPC method.
+type QueryCurrentEpochDurationResponse struct {
+	CurrentEpochDuration time.Duration `protobuf:"bytes,1,opt,name=current_epoch_duration,json=currentEpochDuration,proto3,stdduration" json:"current_epoch_duration"`
 }
 
-func (m *QueryTotalStakingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StakingCoinDenom) > 0 {
-		i -= len(m.StakingCoinDenom)
-		copy(dAtA[i:], m.StakingCoinDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryCurrentEpochDurationResponse) Reset()         { *m = QueryCurrentEpochDurationResponse{} }
+func (m *QueryCurrentEpochDurationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentEpochDurationResponse) ProtoMessage()    {}
+func (*QueryCurrentEpochDurationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{31}
+}
+func (m *QueryCurrentEpochDurationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentEpochDurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentEpochDurationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCurrentEpochDurationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentEpochDurationResponse.Merge(m, src)
+}
+func (m *QueryCurrentEpochDurationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentEpochDurationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentEpochDurationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTotalStakingsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCurrentEpochDurationResponse proto.InternalMessageInfo
+
+func (m *QueryCurrentEpochDurationResponse) GetCurrentEpochDuration() time.Duration {
+	if m != nil {
+		return m.CurrentEpochDuration
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryTotalStakingsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryHistoricalRewardsRequest is the request type for the Query/HistoricalRewards RPC method.
+type QueryHistoricalRewardsRequest struct {
+	StakingCoinDenom string `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+	// starting_epoch is the first epoch of the range, inclusive.
+	StartingEpoch uint64 `protobuf:"varint,2,opt,name=starting_epoch,json=startingEpoch,proto3" json:"starting_epoch,omitempty"`
+	// ending_epoch is the last epoch of the range, inclusive.
+	// Zero means there is no upper bound.
+	EndingEpoch uint64             `protobuf:"varint,3,opt,name=ending_epoch,json=endingEpoch,proto3" json:"ending_epoch,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTotalStakingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryHistoricalRewardsRequest) Reset()         { *m = QueryHistoricalRewardsRequest{} }
+func (m *QueryHistoricalRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalRewardsRequest) ProtoMessage()    {}
+func (*QueryHistoricalRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{32}
+}
+func (m *QueryHistoricalRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricalRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricalRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryHistoricalRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalRewardsRequest.Merge(m, src)
+}
+func (m *QueryHistoricalRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricalRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalRewardsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHistoricalRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryHistoricalRewardsRequest) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHistoricalRewardsRequest) GetStartingEpoch() uint64 {
+	if m != nil {
+		return m.StartingEpoch
+	}
+	return 0
 }
 
-func (m *QueryRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StakingCoinDenom) > 0 {
-		i -= len(m.StakingCoinDenom)
-		copy(dAtA[i:], m.StakingCoinDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Farmer) > 0 {
-		i -= len(m.Farmer)
-		copy(dAtA[i:], m.Farmer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryHistoricalRewardsRequest) GetEndingEpoch() uint64 {
+	if m != nil {
+		return m.EndingEpoch
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryHistoricalRewardsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryHistoricalRewardsResponse is the response type for the Query/HistoricalRewards RPC method.
+type QueryHistoricalRewardsResponse struct {
+	HistoricalRewards []HistoricalRewardsResponse `protobuf:"bytes,1,rep,name=historical_rewards,json=historicalRewards,proto3" json:"historical_rewards"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryHistoricalRewardsResponse) Reset()         { *m = QueryHistoricalRewardsResponse{} }
+func (m *QueryHistoricalRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalRewardsResponse) ProtoMessage()    {}
+func (*QueryHistoricalRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{33}
+}
+func (m *QueryHistoricalRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricalRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricalRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryHistoricalRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalRewardsResponse.Merge(m, src)
+}
+func (m *QueryHistoricalRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricalRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCurrentEpochDaysRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHistoricalRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryHistoricalRewardsResponse) GetHistoricalRewards() []HistoricalRewardsResponse {
+	if m != nil {
+		return m.HistoricalRewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryCurrentEpochDaysRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHistoricalRewardsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryCurrentEpochDaysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// HistoricalRewardsResponse defines historical rewards of an epoch for a staking coin denom.
+type HistoricalRewardsResponse struct {
+	Epoch                 uint64                                      `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	CumulativeUnitRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=cumulative_unit_rewards,json=cumulativeUnitRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_unit_rewards"`
+	ReferenceCount        uint32                                      `protobuf:"varint,3,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty"`
 }
 
-func (m *QueryCurrentEpochDaysResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *HistoricalRewardsResponse) Reset()         { *m = HistoricalRewardsResponse{} }
+func (m *HistoricalRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*HistoricalRewardsResponse) ProtoMessage()    {}
+func (*HistoricalRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{34}
+}
+func (m *HistoricalRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoricalRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoricalRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryCurrentEpochDaysResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *HistoricalRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricalRewardsResponse.Merge(m, src)
+}
+func (m *HistoricalRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoricalRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricalRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCurrentEpochDaysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CurrentEpochDays != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpochDays))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_HistoricalRewardsResponse proto.InternalMessageInfo
+
+func (m *HistoricalRewardsResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *HistoricalRewardsResponse) GetCumulativeUnitRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.CumulativeUnitRewards
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *HistoricalRewardsResponse) GetReferenceCount() uint32 {
+	if m != nil {
+		return m.ReferenceCount
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryCurrentEpochRequest is the request type for the Query/CurrentEpoch RPC method.
+type QueryCurrentEpochRequest struct {
+	StakingCoinDenom string `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
 }
 
-func (m *QueryPlansRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.FarmingPoolAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TerminationAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.StakingCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Terminated)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryCurrentEpochRequest) Reset()         { *m = QueryCurrentEpochRequest{} }
+func (m *QueryCurrentEpochRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentEpochRequest) ProtoMessage()    {}
+func (*QueryCurrentEpochRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{35}
 }
-
-func (m *QueryPlansResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Plans) > 0 {
-		for _, e := range m.Plans {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryCurrentEpochRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentEpochRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentEpochRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryCurrentEpochRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentEpochRequest.Merge(m, src)
+}
+func (m *QueryCurrentEpochRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentEpochRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentEpochRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPlanRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PlanId != 0 {
-		n += 1 + sovQuery(uint64(m.PlanId))
+var xxx_messageInfo_QueryCurrentEpochRequest proto.InternalMessageInfo
+
+func (m *QueryCurrentEpochRequest) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
 	}
-	return n
+	return ""
 }
 
-func (m *QueryPlanResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Plan != nil {
-		l = m.Plan.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryCurrentEpochResponse is the response type for the Query/CurrentEpoch RPC method.
+type QueryCurrentEpochResponse struct {
+	CurrentEpoch uint64 `protobuf:"varint,1,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
 }
 
-func (m *QueryStakingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Farmer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.StakingCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryCurrentEpochResponse) Reset()         { *m = QueryCurrentEpochResponse{} }
+func (m *QueryCurrentEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentEpochResponse) ProtoMessage()    {}
+func (*QueryCurrentEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{36}
+}
+func (m *QueryCurrentEpochResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentEpochResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryCurrentEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentEpochResponse.Merge(m, src)
+}
+func (m *QueryCurrentEpochResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentEpochResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStakingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.StakedCoins) > 0 {
-		for _, e := range m.StakedCoins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+var xxx_messageInfo_QueryCurrentEpochResponse proto.InternalMessageInfo
+
+func (m *QueryCurrentEpochResponse) GetCurrentEpoch() uint64 {
+	if m != nil {
+		return m.CurrentEpoch
 	}
-	if len(m.QueuedCoins) > 0 {
-		for _, e := range m.QueuedCoins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+// QueryOutstandingRewardsRequest is the request type for the Query/OutstandingRewards RPC method.
+type QueryOutstandingRewardsRequest struct {
+	StakingCoinDenom string `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+}
+
+func (m *QueryOutstandingRewardsRequest) Reset()         { *m = QueryOutstandingRewardsRequest{} }
+func (m *QueryOutstandingRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOutstandingRewardsRequest) ProtoMessage()    {}
+func (*QueryOutstandingRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{37}
+}
+func (m *QueryOutstandingRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutstandingRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutstandingRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryOutstandingRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutstandingRewardsRequest.Merge(m, src)
+}
+func (m *QueryOutstandingRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutstandingRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutstandingRewardsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTotalStakingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StakingCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryOutstandingRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryOutstandingRewardsRequest) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
 	}
-	return n
+	return ""
 }
 
-func (m *QueryTotalStakingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryOutstandingRewardsResponse is the response type for the Query/OutstandingRewards RPC method.
+type QueryOutstandingRewardsResponse struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
 }
 
-func (m *QueryRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Farmer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.StakingCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryOutstandingRewardsResponse) Reset()         { *m = QueryOutstandingRewardsResponse{} }
+func (m *QueryOutstandingRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOutstandingRewardsResponse) ProtoMessage()    {}
+func (*QueryOutstandingRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{38}
+}
+func (m *QueryOutstandingRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutstandingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutstandingRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryOutstandingRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutstandingRewardsResponse.Merge(m, src)
+}
+func (m *QueryOutstandingRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutstandingRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutstandingRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryOutstandingRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryOutstandingRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Rewards
 	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryAnnualRewardsRequest is the request type for the Query/AnnualRewards RPC method.
+type QueryAnnualRewardsRequest struct {
+	StakingCoinDenom string `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+	PlanId           uint64 `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+}
+
+func (m *QueryAnnualRewardsRequest) Reset()         { *m = QueryAnnualRewardsRequest{} }
+func (m *QueryAnnualRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAnnualRewardsRequest) ProtoMessage()    {}
+func (*QueryAnnualRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{39}
+}
+func (m *QueryAnnualRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAnnualRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnnualRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryAnnualRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnnualRewardsRequest.Merge(m, src)
+}
+func (m *QueryAnnualRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnnualRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnnualRewardsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCurrentEpochDaysRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAnnualRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryAnnualRewardsRequest) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryCurrentEpochDaysResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAnnualRewardsRequest) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
+	}
+	return 0
+}
+
+// QueryAnnualRewardsResponse is the response type for the Query/AnnualRewards RPC method.
+type QueryAnnualRewardsResponse struct {
+	AnnualRewards []StakingCoinAnnualRewards `protobuf:"bytes,1,rep,name=annual_rewards,json=annualRewards,proto3" json:"annual_rewards"`
+	// epochs_per_year is the number of epochs in a year, based on the current epoch days.
+	EpochsPerYear github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=epochs_per_year,json=epochsPerYear,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"epochs_per_year"`
+}
+
+func (m *QueryAnnualRewardsResponse) Reset()         { *m = QueryAnnualRewardsResponse{} }
+func (m *QueryAnnualRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAnnualRewardsResponse) ProtoMessage()    {}
+func (*QueryAnnualRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{40}
+}
+func (m *QueryAnnualRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAnnualRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnnualRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAnnualRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnnualRewardsResponse.Merge(m, src)
+}
+func (m *QueryAnnualRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnnualRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnnualRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAnnualRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryAnnualRewardsResponse) GetAnnualRewards() []StakingCoinAnnualRewards {
+	if m != nil {
+		return m.AnnualRewards
+	}
+	return nil
+}
+
+// StakingCoinAnnualRewards defines estimated rewards per unit of a staking coin denom.
+type StakingCoinAnnualRewards struct {
+	StakingCoinDenom string `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+	// total_staking_amount is the total amount of the staking coin currently staked.
+	TotalStakingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_staking_amount,json=totalStakingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_staking_amount"`
+	// epoch_unit_rewards is the rewards per unit of the staking coin for the next epoch,
+	// aggregated over all plans.
+	EpochUnitRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=epoch_unit_rewards,json=epochUnitRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"epoch_unit_rewards"`
+	// annual_unit_rewards is the epoch_unit_rewards multiplied by the number of epochs in a year.
+	AnnualUnitRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=annual_unit_rewards,json=annualUnitRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"annual_unit_rewards"`
+	// plans are the estimated rewards by each plan for the staking coin denom.
+	Plans []PlanAnnualRewards `protobuf:"bytes,5,rep,name=plans,proto3" json:"plans"`
+}
+
+func (m *StakingCoinAnnualRewards) Reset()         { *m = StakingCoinAnnualRewards{} }
+func (m *StakingCoinAnnualRewards) String() string { return proto.CompactTextString(m) }
+func (*StakingCoinAnnualRewards) ProtoMessage()    {}
+func (*StakingCoinAnnualRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{41}
+}
+func (m *StakingCoinAnnualRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakingCoinAnnualRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakingCoinAnnualRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakingCoinAnnualRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingCoinAnnualRewards.Merge(m, src)
+}
+func (m *StakingCoinAnnualRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakingCoinAnnualRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingCoinAnnualRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakingCoinAnnualRewards proto.InternalMessageInfo
+
+func (m *StakingCoinAnnualRewards) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
+	}
+	return ""
+}
+
+func (m *StakingCoinAnnualRewards) GetEpochUnitRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.EpochUnitRewards
+	}
+	return nil
+}
+
+func (m *StakingCoinAnnualRewards) GetAnnualUnitRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.AnnualUnitRewards
+	}
+	return nil
+}
+
+func (m *StakingCoinAnnualRewards) GetPlans() []PlanAnnualRewards {
+	if m != nil {
+		return m.Plans
+	}
+	return nil
+}
+
+// PlanAnnualRewards defines estimated rewards per unit of a staking coin denom by a plan.
+type PlanAnnualRewards struct {
+	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// epoch_rewards is the amount of coins the plan allocates to the staking coin denom for the next epoch.
+	EpochRewards      github_com_cosmos_cosmos_sdk_types.Coins    `protobuf:"bytes,2,rep,name=epoch_rewards,json=epochRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_rewards"`
+	EpochUnitRewards  github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=epoch_unit_rewards,json=epochUnitRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"epoch_unit_rewards"`
+	AnnualUnitRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=annual_unit_rewards,json=annualUnitRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"annual_unit_rewards"`
+}
+
+func (m *PlanAnnualRewards) Reset()         { *m = PlanAnnualRewards{} }
+func (m *PlanAnnualRewards) String() string { return proto.CompactTextString(m) }
+func (*PlanAnnualRewards) ProtoMessage()    {}
+func (*PlanAnnualRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{42}
+}
+func (m *PlanAnnualRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlanAnnualRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlanAnnualRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlanAnnualRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanAnnualRewards.Merge(m, src)
+}
+func (m *PlanAnnualRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlanAnnualRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanAnnualRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanAnnualRewards proto.InternalMessageInfo
+
+func (m *PlanAnnualRewards) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
+	}
+	return 0
+}
+
+func (m *PlanAnnualRewards) GetEpochRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EpochRewards
+	}
+	return nil
+}
+
+func (m *PlanAnnualRewards) GetEpochUnitRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.EpochUnitRewards
+	}
+	return nil
+}
+
+func (m *PlanAnnualRewards) GetAnnualUnitRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.AnnualUnitRewards
+	}
+	return nil
+}
+
+// QueryAllocationPreviewRequest is the request type for the Query/AllocationPreview RPC method.
+type QueryAllocationPreviewRequest struct {
+}
+
+func (m *QueryAllocationPreviewRequest) Reset()         { *m = QueryAllocationPreviewRequest{} }
+func (m *QueryAllocationPreviewRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllocationPreviewRequest) ProtoMessage()    {}
+func (*QueryAllocationPreviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{43}
+}
+func (m *QueryAllocationPreviewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllocationPreviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllocationPreviewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllocationPreviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllocationPreviewRequest.Merge(m, src)
+}
+func (m *QueryAllocationPreviewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllocationPreviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllocationPreviewRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllocationPreviewRequest proto.InternalMessageInfo
+
+// QueryAllocationPreviewResponse is the response type for the Query/AllocationPreview RPC method.
+type QueryAllocationPreviewResponse struct {
+	// allocations are the plans that will allocate rewards.
+	Allocations []PlanAllocationPreview `protobuf:"bytes,1,rep,name=allocations,proto3" json:"allocations"`
+	// unit_rewards are the increases of unit rewards for each staking coin denom.
+	UnitRewards []UnitRewardsPreview `protobuf:"bytes,2,rep,name=unit_rewards,json=unitRewards,proto3" json:"unit_rewards"`
+	// skipped_plans are the plans that will not allocate rewards.
+	SkippedPlans []SkippedPlanPreview `protobuf:"bytes,3,rep,name=skipped_plans,json=skippedPlans,proto3" json:"skipped_plans"`
+}
+
+func (m *QueryAllocationPreviewResponse) Reset()         { *m = QueryAllocationPreviewResponse{} }
+func (m *QueryAllocationPreviewResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllocationPreviewResponse) ProtoMessage()    {}
+func (*QueryAllocationPreviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{44}
+}
+func (m *QueryAllocationPreviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllocationPreviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllocationPreviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllocationPreviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllocationPreviewResponse.Merge(m, src)
+}
+func (m *QueryAllocationPreviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllocationPreviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllocationPreviewResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllocationPreviewResponse proto.InternalMessageInfo
+
+func (m *QueryAllocationPreviewResponse) GetAllocations() []PlanAllocationPreview {
+	if m != nil {
+		return m.Allocations
+	}
+	return nil
+}
+
+func (m *QueryAllocationPreviewResponse) GetUnitRewards() []UnitRewardsPreview {
+	if m != nil {
+		return m.UnitRewards
+	}
+	return nil
+}
+
+func (m *QueryAllocationPreviewResponse) GetSkippedPlans() []SkippedPlanPreview {
+	if m != nil {
+		return m.SkippedPlans
+	}
+	return nil
+}
+
+// PlanAllocationPreview defines the rewards a plan will allocate.
+type PlanAllocationPreview struct {
+	PlanId             uint64                                   `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	FarmingPoolAddress string                                   `protobuf:"bytes,2,opt,name=farming_pool_address,json=farmingPoolAddress,proto3" json:"farming_pool_address,omitempty"`
+	Amount             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *PlanAllocationPreview) Reset()         { *m = PlanAllocationPreview{} }
+func (m *PlanAllocationPreview) String() string { return proto.CompactTextString(m) }
+func (*PlanAllocationPreview) ProtoMessage()    {}
+func (*PlanAllocationPreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{45}
+}
+func (m *PlanAllocationPreview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlanAllocationPreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlanAllocationPreview.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlanAllocationPreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanAllocationPreview.Merge(m, src)
+}
+func (m *PlanAllocationPreview) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlanAllocationPreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanAllocationPreview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanAllocationPreview proto.InternalMessageInfo
+
+func (m *PlanAllocationPreview) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
+	}
+	return 0
+}
+
+func (m *PlanAllocationPreview) GetFarmingPoolAddress() string {
+	if m != nil {
+		return m.FarmingPoolAddress
+	}
+	return ""
+}
+
+func (m *PlanAllocationPreview) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// UnitRewardsPreview defines the increase of unit rewards for a staking coin denom.
+type UnitRewardsPreview struct {
+	StakingCoinDenom string                                      `protobuf:"bytes,1,opt,name=staking_coin_denom,json=stakingCoinDenom,proto3" json:"staking_coin_denom,omitempty"`
+	UnitRewards      github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=unit_rewards,json=unitRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"unit_rewards"`
+}
+
+func (m *UnitRewardsPreview) Reset()         { *m = UnitRewardsPreview{} }
+func (m *UnitRewardsPreview) String() string { return proto.CompactTextString(m) }
+func (*UnitRewardsPreview) ProtoMessage()    {}
+func (*UnitRewardsPreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{46}
+}
+func (m *UnitRewardsPreview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnitRewardsPreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnitRewardsPreview.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnitRewardsPreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnitRewardsPreview.Merge(m, src)
+}
+func (m *UnitRewardsPreview) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnitRewardsPreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnitRewardsPreview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnitRewardsPreview proto.InternalMessageInfo
+
+func (m *UnitRewardsPreview) GetStakingCoinDenom() string {
+	if m != nil {
+		return m.StakingCoinDenom
+	}
+	return ""
+}
+
+func (m *UnitRewardsPreview) GetUnitRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.UnitRewards
+	}
+	return nil
+}
+
+// SkippedPlanPreview defines a plan that will not allocate rewards, with the reason.
+type SkippedPlanPreview struct {
+	PlanId             uint64                                   `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	FarmingPoolAddress string                                   `protobuf:"bytes,2,opt,name=farming_pool_address,json=farmingPoolAddress,proto3" json:"farming_pool_address,omitempty"`
+	Amount             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Reason             string                                   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *SkippedPlanPreview) Reset()         { *m = SkippedPlanPreview{} }
+func (m *SkippedPlanPreview) String() string { return proto.CompactTextString(m) }
+func (*SkippedPlanPreview) ProtoMessage()    {}
+func (*SkippedPlanPreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{47}
+}
+func (m *SkippedPlanPreview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SkippedPlanPreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SkippedPlanPreview.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SkippedPlanPreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SkippedPlanPreview.Merge(m, src)
+}
+func (m *SkippedPlanPreview) XXX_Size() int {
+	return m.Size()
+}
+func (m *SkippedPlanPreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_SkippedPlanPreview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SkippedPlanPreview proto.InternalMessageInfo
+
+func (m *SkippedPlanPreview) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
+	}
+	return 0
+}
+
+func (m *SkippedPlanPreview) GetFarmingPoolAddress() string {
+	if m != nil {
+		return m.FarmingPoolAddress
+	}
+	return ""
+}
+
+func (m *SkippedPlanPreview) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *SkippedPlanPreview) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// QueryEpochInfoRequest is the request type for the Query/EpochInfo RPC method.
+type QueryEpochInfoRequest struct {
+}
+
+func (m *QueryEpochInfoRequest) Reset()         { *m = QueryEpochInfoRequest{} }
+func (m *QueryEpochInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochInfoRequest) ProtoMessage()    {}
+func (*QueryEpochInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{48}
+}
+func (m *QueryEpochInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochInfoRequest.Merge(m, src)
+}
+func (m *QueryEpochInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochInfoRequest proto.InternalMessageInfo
+
+// QueryEpochInfoResponse is the response type for the Query/EpochInfo RPC method.
+type QueryEpochInfoResponse struct {
+	// global_epoch is the number of epochs that have ended so far.
+	GlobalEpoch uint64 `protobuf:"varint,1,opt,name=global_epoch,json=globalEpoch,proto3" json:"global_epoch,omitempty"`
+	// last_epoch_time is the time the last epoch ended.
+	// It is empty before the first epoch has started.
+	LastEpochTime *time.Time `protobuf:"bytes,2,opt,name=last_epoch_time,json=lastEpochTime,proto3,stdtime" json:"last_epoch_time,omitempty"`
+	// current_epoch_duration is the length of the current epoch.
+	CurrentEpochDuration time.Duration `protobuf:"bytes,3,opt,name=current_epoch_duration,json=currentEpochDuration,proto3,stdduration" json:"current_epoch_duration"`
+	// next_epoch_duration is the length of the epochs after the current epoch.
+	NextEpochDuration time.Duration `protobuf:"bytes,4,opt,name=next_epoch_duration,json=nextEpochDuration,proto3,stdduration" json:"next_epoch_duration"`
+	// next_epoch_time is the time the current epoch is expected to end.
+	// It is empty before the first epoch has started.
+	NextEpochTime *time.Time `protobuf:"bytes,5,opt,name=next_epoch_time,json=nextEpochTime,proto3,stdtime" json:"next_epoch_time,omitempty"`
+}
+
+func (m *QueryEpochInfoResponse) Reset()         { *m = QueryEpochInfoResponse{} }
+func (m *QueryEpochInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochInfoResponse) ProtoMessage()    {}
+func (*QueryEpochInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{49}
+}
+func (m *QueryEpochInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochInfoResponse.Merge(m, src)
+}
+func (m *QueryEpochInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochInfoResponse proto.InternalMessageInfo
+
+func (m *QueryEpochInfoResponse) GetGlobalEpoch() uint64 {
+	if m != nil {
+		return m.GlobalEpoch
+	}
+	return 0
+}
+
+func (m *QueryEpochInfoResponse) GetLastEpochTime() *time.Time {
+	if m != nil {
+		return m.LastEpochTime
+	}
+	return nil
+}
+
+func (m *QueryEpochInfoResponse) GetCurrentEpochDuration() time.Duration {
+	if m != nil {
+		return m.CurrentEpochDuration
+	}
+	return 0
+}
+
+func (m *QueryEpochInfoResponse) GetNextEpochDuration() time.Duration {
+	if m != nil {
+		return m.NextEpochDuration
+	}
+	return 0
+}
+
+func (m *QueryEpochInfoResponse) GetNextEpochTime() *time.Time {
+	if m != nil {
+		return m.NextEpochTime
+	}
+	return nil
+}
+
+// QueryPlanFundingRequest is the request type for the Query/PlanFunding RPC method.
+type QueryPlanFundingRequest struct {
+	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+}
+
+func (m *QueryPlanFundingRequest) Reset()         { *m = QueryPlanFundingRequest{} }
+func (m *QueryPlanFundingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPlanFundingRequest) ProtoMessage()    {}
+func (*QueryPlanFundingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{50}
+}
+func (m *QueryPlanFundingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlanFundingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlanFundingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPlanFundingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlanFundingRequest.Merge(m, src)
+}
+func (m *QueryPlanFundingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlanFundingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlanFundingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPlanFundingRequest proto.InternalMessageInfo
+
+func (m *QueryPlanFundingRequest) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
+	}
+	return 0
+}
+
+// QueryPlanFundingResponse is the response type for the Query/PlanFunding RPC method.
+type QueryPlanFundingResponse struct {
+	PlanId             uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	FarmingPoolAddress string `protobuf:"bytes,2,opt,name=farming_pool_address,json=farmingPoolAddress,proto3" json:"farming_pool_address,omitempty"`
+	// budgets are the budgets of the budget module that have the farming pool as their destination.
+	Budgets []FundingBudget `protobuf:"bytes,3,rep,name=budgets,proto3" json:"budgets"`
+	// projected_inflow is the amount of coins the budgets are projected to send to the farming pool per epoch.
+	ProjectedInflow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=projected_inflow,json=projectedInflow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"projected_inflow"`
+	// epoch_outflow is the amount of coins the plan allocates from the farming pool per epoch.
+	EpochOutflow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=epoch_outflow,json=epochOutflow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_outflow"`
+	// total_epoch_outflow is the amount of coins all active plans sharing the farming pool allocate per epoch.
+	TotalEpochOutflow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=total_epoch_outflow,json=totalEpochOutflow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_epoch_outflow"`
+	// sufficient is whether the projected inflow covers the total epoch outflow.
+	Sufficient bool `protobuf:"varint,7,opt,name=sufficient,proto3" json:"sufficient,omitempty"`
+}
+
+func (m *QueryPlanFundingResponse) Reset()         { *m = QueryPlanFundingResponse{} }
+func (m *QueryPlanFundingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPlanFundingResponse) ProtoMessage()    {}
+func (*QueryPlanFundingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{51}
+}
+func (m *QueryPlanFundingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlanFundingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlanFundingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPlanFundingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlanFundingResponse.Merge(m, src)
+}
+func (m *QueryPlanFundingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlanFundingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlanFundingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPlanFundingResponse proto.InternalMessageInfo
+
+func (m *QueryPlanFundingResponse) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
+	}
+	return 0
+}
+
+func (m *QueryPlanFundingResponse) GetFarmingPoolAddress() string {
+	if m != nil {
+		return m.FarmingPoolAddress
+	}
+	return ""
+}
+
+func (m *QueryPlanFundingResponse) GetBudgets() []FundingBudget {
+	if m != nil {
+		return m.Budgets
+	}
+	return nil
+}
+
+func (m *QueryPlanFundingResponse) GetProjectedInflow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ProjectedInflow
+	}
+	return nil
+}
+
+func (m *QueryPlanFundingResponse) GetEpochOutflow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EpochOutflow
+	}
+	return nil
+}
+
+func (m *QueryPlanFundingResponse) GetTotalEpochOutflow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalEpochOutflow
+	}
+	return nil
+}
+
+func (m *QueryPlanFundingResponse) GetSufficient() bool {
+	if m != nil {
+		return m.Sufficient
+	}
+	return false
+}
+
+// FundingBudget defines a budget that funds a farming pool.
+type FundingBudget struct {
+	Name          string                                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Rate          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	SourceAddress string                                 `protobuf:"bytes,3,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty"`
+	// total_collected_coins is the amount of coins the budget has collected so far.
+	TotalCollectedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_collected_coins,json=totalCollectedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_collected_coins"`
+	// projected_inflow is the amount of coins the budget is projected to collect per epoch,
+	// based on its average collection since its start time.
+	ProjectedInflow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=projected_inflow,json=projectedInflow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"projected_inflow"`
+}
+
+func (m *FundingBudget) Reset()         { *m = FundingBudget{} }
+func (m *FundingBudget) String() string { return proto.CompactTextString(m) }
+func (*FundingBudget) ProtoMessage()    {}
+func (*FundingBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{52}
+}
+func (m *FundingBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingBudget.Merge(m, src)
+}
+func (m *FundingBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingBudget proto.InternalMessageInfo
+
+func (m *FundingBudget) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FundingBudget) GetSourceAddress() string {
+	if m != nil {
+		return m.SourceAddress
+	}
+	return ""
+}
+
+func (m *FundingBudget) GetTotalCollectedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalCollectedCoins
+	}
+	return nil
+}
+
+func (m *FundingBudget) GetProjectedInflow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ProjectedInflow
+	}
+	return nil
+}
+
+// QueryAllowedStakingDenomsRequest is the request type for the Query/AllowedStakingDenoms RPC method.
+type QueryAllowedStakingDenomsRequest struct {
+}
+
+func (m *QueryAllowedStakingDenomsRequest) Reset()         { *m = QueryAllowedStakingDenomsRequest{} }
+func (m *QueryAllowedStakingDenomsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedStakingDenomsRequest) ProtoMessage()    {}
+func (*QueryAllowedStakingDenomsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{53}
+}
+func (m *QueryAllowedStakingDenomsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedStakingDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedStakingDenomsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedStakingDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedStakingDenomsRequest.Merge(m, src)
+}
+func (m *QueryAllowedStakingDenomsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedStakingDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedStakingDenomsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedStakingDenomsRequest proto.InternalMessageInfo
+
+// QueryAllowedStakingDenomsResponse is the response type for the Query/AllowedStakingDenoms RPC method.
+type QueryAllowedStakingDenomsResponse struct {
+	// denoms are the denoms allowed to be staked, sorted. Any denom can be staked when it is empty.
+	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *QueryAllowedStakingDenomsResponse) Reset()         { *m = QueryAllowedStakingDenomsResponse{} }
+func (m *QueryAllowedStakingDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedStakingDenomsResponse) ProtoMessage()    {}
+func (*QueryAllowedStakingDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00c8db58c274b111, []int{54}
+}
+func (m *QueryAllowedStakingDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedStakingDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedStakingDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedStakingDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedStakingDenomsResponse.Merge(m, src)
+}
+func (m *QueryAllowedStakingDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedStakingDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedStakingDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedStakingDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryAllowedStakingDenomsResponse) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.farming.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.farming.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryPlansRequest)(nil), "cosmos.farming.v1beta1.QueryPlansRequest")
+	proto.RegisterType((*QueryPlansResponse)(nil), "cosmos.farming.v1beta1.QueryPlansResponse")
+	proto.RegisterType((*QueryPlanRequest)(nil), "cosmos.farming.v1beta1.QueryPlanRequest")
+	proto.RegisterType((*QueryPlanResponse)(nil), "cosmos.farming.v1beta1.QueryPlanResponse")
+	proto.RegisterType((*QueryStakingsRequest)(nil), "cosmos.farming.v1beta1.QueryStakingsRequest")
+	proto.RegisterType((*QueryStakingsResponse)(nil), "cosmos.farming.v1beta1.QueryStakingsResponse")
+	proto.RegisterType((*QueryLockedStakingsRequest)(nil), "cosmos.farming.v1beta1.QueryLockedStakingsRequest")
+	proto.RegisterType((*QueryLockedStakingsResponse)(nil), "cosmos.farming.v1beta1.QueryLockedStakingsResponse")
+	proto.RegisterType((*QueryUnbondingsRequest)(nil), "cosmos.farming.v1beta1.QueryUnbondingsRequest")
+	proto.RegisterType((*QueryUnbondingsResponse)(nil), "cosmos.farming.v1beta1.QueryUnbondingsResponse")
+	proto.RegisterType((*QueryStakingsDetailRequest)(nil), "cosmos.farming.v1beta1.QueryStakingsDetailRequest")
+	proto.RegisterType((*QueryStakingsDetailResponse)(nil), "cosmos.farming.v1beta1.QueryStakingsDetailResponse")
+	proto.RegisterType((*QueryQueuedStakingsRequest)(nil), "cosmos.farming.v1beta1.QueryQueuedStakingsRequest")
+	proto.RegisterType((*QueryQueuedStakingsResponse)(nil), "cosmos.farming.v1beta1.QueryQueuedStakingsResponse")
+	proto.RegisterType((*StakingDetail)(nil), "cosmos.farming.v1beta1.StakingDetail")
+	proto.RegisterType((*QueuedStakingDetail)(nil), "cosmos.farming.v1beta1.QueuedStakingDetail")
+	proto.RegisterType((*QueryStakingsByDenomRequest)(nil), "cosmos.farming.v1beta1.QueryStakingsByDenomRequest")
+	proto.RegisterType((*QueryStakingsByDenomResponse)(nil), "cosmos.farming.v1beta1.QueryStakingsByDenomResponse")
+	proto.RegisterType((*FarmerStaking)(nil), "cosmos.farming.v1beta1.FarmerStaking")
+	proto.RegisterType((*QueryQueuedStakingsByDenomRequest)(nil), "cosmos.farming.v1beta1.QueryQueuedStakingsByDenomRequest")
+	proto.RegisterType((*QueryQueuedStakingsByDenomResponse)(nil), "cosmos.farming.v1beta1.QueryQueuedStakingsByDenomResponse")
+	proto.RegisterType((*FarmerQueuedStaking)(nil), "cosmos.farming.v1beta1.FarmerQueuedStaking")
+	proto.RegisterType((*QueryTotalStakingsRequest)(nil), "cosmos.farming.v1beta1.QueryTotalStakingsRequest")
+	proto.RegisterType((*QueryTotalStakingsResponse)(nil), "cosmos.farming.v1beta1.QueryTotalStakingsResponse")
+	proto.RegisterType((*QueryRewardsRequest)(nil), "cosmos.farming.v1beta1.QueryRewardsRequest")
+	proto.RegisterType((*QueryRewardsResponse)(nil), "cosmos.farming.v1beta1.QueryRewardsResponse")
+	proto.RegisterType((*QueryRewardsWithdrawAddressRequest)(nil), "cosmos.farming.v1beta1.QueryRewardsWithdrawAddressRequest")
+	proto.RegisterType((*QueryRewardsWithdrawAddressResponse)(nil), "cosmos.farming.v1beta1.QueryRewardsWithdrawAddressResponse")
+	proto.RegisterType((*QueryCurrentEpochDurationRequest)(nil), "cosmos.farming.v1beta1.QueryCurrentEpochDurationRequest")
+	proto.RegisterType((*QueryCurrentEpochDurationResponse)(nil), "cosmos.farming.v1beta1.QueryCurrentEpochDurationResponse")
+	proto.RegisterType((*QueryHistoricalRewardsRequest)(nil), "cosmos.farming.v1beta1.QueryHistoricalRewardsRequest")
+	proto.RegisterType((*QueryHistoricalRewardsResponse)(nil), "cosmos.farming.v1beta1.QueryHistoricalRewardsResponse")
+	proto.RegisterType((*HistoricalRewardsResponse)(nil), "cosmos.farming.v1beta1.HistoricalRewardsResponse")
+	proto.RegisterType((*QueryCurrentEpochRequest)(nil), "cosmos.farming.v1beta1.QueryCurrentEpochRequest")
+	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "cosmos.farming.v1beta1.QueryCurrentEpochResponse")
+	proto.RegisterType((*QueryOutstandingRewardsRequest)(nil), "cosmos.farming.v1beta1.QueryOutstandingRewardsRequest")
+	proto.RegisterType((*QueryOutstandingRewardsResponse)(nil), "cosmos.farming.v1beta1.QueryOutstandingRewardsResponse")
+	proto.RegisterType((*QueryAnnualRewardsRequest)(nil), "cosmos.farming.v1beta1.QueryAnnualRewardsRequest")
+	proto.RegisterType((*QueryAnnualRewardsResponse)(nil), "cosmos.farming.v1beta1.QueryAnnualRewardsResponse")
+	proto.RegisterType((*StakingCoinAnnualRewards)(nil), "cosmos.farming.v1beta1.StakingCoinAnnualRewards")
+	proto.RegisterType((*PlanAnnualRewards)(nil), "cosmos.farming.v1beta1.PlanAnnualRewards")
+	proto.RegisterType((*QueryAllocationPreviewRequest)(nil), "cosmos.farming.v1beta1.QueryAllocationPreviewRequest")
+	proto.RegisterType((*QueryAllocationPreviewResponse)(nil), "cosmos.farming.v1beta1.QueryAllocationPreviewResponse")
+	proto.RegisterType((*PlanAllocationPreview)(nil), "cosmos.farming.v1beta1.PlanAllocationPreview")
+	proto.RegisterType((*UnitRewardsPreview)(nil), "cosmos.farming.v1beta1.UnitRewardsPreview")
+	proto.RegisterType((*SkippedPlanPreview)(nil), "cosmos.farming.v1beta1.SkippedPlanPreview")
+	proto.RegisterType((*QueryEpochInfoRequest)(nil), "cosmos.farming.v1beta1.QueryEpochInfoRequest")
+	proto.RegisterType((*QueryEpochInfoResponse)(nil), "cosmos.farming.v1beta1.QueryEpochInfoResponse")
+	proto.RegisterType((*QueryPlanFundingRequest)(nil), "cosmos.farming.v1beta1.QueryPlanFundingRequest")
+	proto.RegisterType((*QueryPlanFundingResponse)(nil), "cosmos.farming.v1beta1.QueryPlanFundingResponse")
+	proto.RegisterType((*FundingBudget)(nil), "cosmos.farming.v1beta1.FundingBudget")
+	proto.RegisterType((*QueryAllowedStakingDenomsRequest)(nil), "cosmos.farming.v1beta1.QueryAllowedStakingDenomsRequest")
+	proto.RegisterType((*QueryAllowedStakingDenomsResponse)(nil), "cosmos.farming.v1beta1.QueryAllowedStakingDenomsResponse")
+}
+
+func init() {
+	proto.RegisterFile("tendermint/farming/v1beta1/query.proto", fileDescriptor_00c8db58c274b111)
+}
+
+var fileDescriptor_00c8db58c274b111 = []byte{
+	// 4073 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x7f, 0x6c, 0x24, 0xd7,
+	0x5d, 0xcf, 0xec, 0x8c, 0x7d, 0xb9, 0xe7, 0xf3, 0xfd, 0x78, 0xe7, 0x73, 0x7c, 0xd3, 0xd4, 0x9e,
+	0x9b, 0xd0, 0xc4, 0x77, 0x67, 0xef, 0xfa, 0x7c, 0x49, 0xdb, 0xf3, 0x25, 0x90, 0xf1, 0x9d, 0xef,
+	0xce, 0x69, 0x7a, 0xb9, 0xec, 0xdd, 0x15, 0x25, 0x4d, 0xd9, 0xce, 0xce, 0x3c, 0xdb, 0xd3, 0x9b,
+	0x9d, 0x99, 0xcc, 0x0f, 0x3b, 0xe6, 0xea, 0xa6, 0x94, 0x36, 0x05, 0x8a, 0xaa, 0xb0, 0x41, 0x40,
+	0x05, 0xaa, 0x40, 0x01, 0x01, 0x05, 0x54, 0xa1, 0xe4, 0x0f, 0x44, 0xff, 0x41, 0x42, 0x15, 0xa1,
+	0x42, 0xa8, 0x51, 0x44, 0xa8, 0x10, 0x6a, 0x50, 0x02, 0x48, 0x48, 0xa0, 0xc0, 0x1f, 0x10, 0x10,
+	0x12, 0xa0, 0xf7, 0x63, 0x66, 0x67, 0x66, 0x67, 0x76, 0x77, 0xbc, 0xeb, 0xdc, 0x46, 0xe2, 0x2f,
+	0x7b, 0xe7, 0x7d, 0x7f, 0xbd, 0xef, 0xf7, 0xf3, 0xbe, 0xef, 0x3b, 0xef, 0x7d, 0x07, 0xdc, 0xef,
+	0x23, 0x4b, 0x47, 0x6e, 0xc3, 0xb0, 0xfc, 0xca, 0x9a, 0x8a, 0xff, 0xae, 0x57, 0x36, 0xcf, 0xd4,
+	0x91, 0xaf, 0x9e, 0xa9, 0x3c, 0x1b, 0x20, 0x77, 0xbb, 0xec, 0xb8, 0xb6, 0x6f, 0xc3, 0x49, 0xcd,
+	0xf6, 0x1a, 0xb6, 0x57, 0x66, 0x34, 0x65, 0x46, 0x23, 0xce, 0x76, 0xe0, 0x0f, 0x69, 0x89, 0x04,
+	0xf1, 0x38, 0x95, 0x50, 0x23, 0xbf, 0x2a, 0x4c, 0x1c, 0x1d, 0x3a, 0x45, 0x7f, 0x55, 0xea, 0xaa,
+	0x87, 0xa8, 0xd6, 0x48, 0x86, 0xa3, 0xae, 0x1b, 0x96, 0xea, 0x1b, 0xb6, 0xc5, 0x68, 0xa7, 0xe3,
+	0xb4, 0x21, 0x95, 0x66, 0x1b, 0xe1, 0xf8, 0xc4, 0xba, 0xbd, 0x6e, 0x53, 0x1d, 0xf8, 0xbf, 0x50,
+	0xf9, 0xba, 0x6d, 0xaf, 0x9b, 0xa8, 0x42, 0x7e, 0xd5, 0x83, 0xb5, 0x8a, 0x6a, 0xb1, 0x99, 0x89,
+	0x33, 0xe9, 0x21, 0xdf, 0x68, 0x20, 0xcf, 0x57, 0x1b, 0x4e, 0xa8, 0x31, 0x4d, 0xa0, 0x07, 0x6e,
+	0xdc, 0xa2, 0x7b, 0xd9, 0xb8, 0xea, 0x18, 0x15, 0xd5, 0xb2, 0x6c, 0x9f, 0x0c, 0x86, 0x73, 0xa3,
+	0x7f, 0xb4, 0xf9, 0x75, 0x64, 0xcd, 0xdb, 0x0e, 0xb2, 0x54, 0xc7, 0xd8, 0x5c, 0xac, 0xd8, 0x0e,
+	0xa1, 0x69, 0xa7, 0x97, 0x27, 0x00, 0x7c, 0x12, 0x7b, 0xe0, 0x9a, 0xea, 0xaa, 0x0d, 0xaf, 0x8a,
+	0x9e, 0x0d, 0x90, 0xe7, 0xcb, 0xd7, 0xc1, 0xd1, 0xc4, 0x53, 0xcf, 0xb1, 0x2d, 0x0f, 0xc1, 0x87,
+	0xc1, 0xa8, 0x43, 0x9e, 0x4c, 0x71, 0x12, 0x37, 0x3b, 0xb6, 0x38, 0x5d, 0xce, 0x0e, 0x53, 0x99,
+	0xf2, 0x2d, 0x0b, 0xaf, 0xfd, 0x70, 0xe6, 0xae, 0x2a, 0xe3, 0x91, 0x7f, 0xbd, 0x04, 0x8e, 0x50,
+	0xa9, 0xa6, 0x6a, 0x85, 0xaa, 0x20, 0x04, 0x82, 0xbf, 0xed, 0x20, 0x22, 0x71, 0x7f, 0x95, 0xfc,
+	0x0f, 0x17, 0xc0, 0x04, 0x93, 0x58, 0x73, 0x6c, 0xdb, 0xac, 0xa9, 0xba, 0xee, 0x22, 0xcf, 0x9b,
+	0x2a, 0x11, 0x1a, 0xc8, 0xc6, 0xae, 0xd9, 0xb6, 0xa9, 0xd0, 0x11, 0x58, 0x01, 0x47, 0x7d, 0x02,
+	0x0b, 0x32, 0xb9, 0x88, 0x81, 0xa7, 0x0c, 0xb1, 0xa1, 0x90, 0x61, 0x0e, 0x40, 0xcf, 0x57, 0x6f,
+	0x61, 0x15, 0x38, 0x9a, 0x35, 0x1d, 0x59, 0x76, 0x63, 0x4a, 0x20, 0xf4, 0x87, 0xd9, 0xc8, 0x05,
+	0xdb, 0xb0, 0x2e, 0xe2, 0xe7, 0x70, 0x1a, 0x80, 0x50, 0x06, 0xd2, 0xa7, 0x46, 0x08, 0x55, 0xec,
+	0x09, 0xbc, 0x04, 0x40, 0x0b, 0x39, 0x53, 0xa3, 0xc4, 0x39, 0xf7, 0x87, 0xce, 0xc1, 0xd0, 0x29,
+	0x53, 0x70, 0xb7, 0xfc, 0xb3, 0x8e, 0x98, 0x03, 0xaa, 0x31, 0x4e, 0xf9, 0x17, 0x39, 0x00, 0xe3,
+	0x2e, 0x62, 0x7e, 0x7f, 0x08, 0x8c, 0x38, 0xf8, 0xc1, 0x14, 0x27, 0xf1, 0xb3, 0x63, 0x8b, 0x13,
+	0x65, 0x0a, 0x81, 0x72, 0x08, 0x91, 0xb2, 0x62, 0x6d, 0x2f, 0xef, 0xff, 0xde, 0xab, 0xf3, 0x23,
+	0x98, 0x6f, 0xb5, 0x4a, 0xa9, 0xe1, 0xe5, 0x84, 0x55, 0x25, 0x62, 0xd5, 0x03, 0x5d, 0xad, 0xa2,
+	0x3a, 0x13, 0x66, 0x9d, 0x06, 0x87, 0x23, 0xab, 0xc2, 0xb8, 0xdd, 0x03, 0xf6, 0x61, 0x2d, 0x35,
+	0x43, 0x27, 0xa1, 0x13, 0xaa, 0xa3, 0xf8, 0xe7, 0xaa, 0x2e, 0x5f, 0x89, 0x45, 0x39, 0x9a, 0xc1,
+	0x59, 0x20, 0xe0, 0x61, 0x86, 0x9b, 0xae, 0x13, 0x20, 0xc4, 0xf2, 0x33, 0x60, 0x82, 0x48, 0xba,
+	0x4e, 0xc3, 0x11, 0x41, 0x66, 0x12, 0x8c, 0x62, 0x08, 0x20, 0x97, 0x81, 0x86, 0xfd, 0xca, 0x89,
+	0x69, 0x29, 0x3b, 0xa6, 0xf2, 0x7b, 0x1c, 0x38, 0x96, 0x12, 0xcf, 0x8c, 0xb5, 0xc0, 0x01, 0x4c,
+	0x8d, 0x74, 0x22, 0x26, 0xf4, 0xfa, 0xf1, 0x84, 0xe7, 0x42, 0x9f, 0x61, 0x79, 0xcb, 0x0b, 0x18,
+	0xe7, 0xdf, 0x7a, 0x6b, 0x66, 0x76, 0xdd, 0xf0, 0x37, 0x82, 0x7a, 0x59, 0xb3, 0x1b, 0x2c, 0xe3,
+	0xb0, 0x3f, 0xf3, 0x9e, 0x7e, 0xab, 0x82, 0xa1, 0xed, 0x11, 0x06, 0xaf, 0x3a, 0x46, 0x15, 0x90,
+	0x1f, 0x58, 0xdf, 0xb3, 0x01, 0x0a, 0x22, 0x7d, 0xa5, 0x3d, 0xd0, 0x47, 0x15, 0x90, 0x1f, 0x72,
+	0x1d, 0x88, 0x64, 0xe2, 0x8f, 0xdb, 0xda, 0x2d, 0xa4, 0xef, 0x8d, 0x77, 0xdf, 0xe4, 0xc0, 0x87,
+	0x32, 0x95, 0x30, 0x1f, 0xdf, 0x00, 0x87, 0x4c, 0x32, 0x52, 0x63, 0xac, 0xa1, 0x9b, 0x3f, 0x92,
+	0x97, 0x53, 0x12, 0x82, 0x58, 0x6a, 0x39, 0x68, 0x26, 0xa4, 0x43, 0x15, 0x4c, 0x32, 0x4f, 0xa6,
+	0x85, 0x97, 0x8a, 0x0b, 0x9f, 0xa0, 0xa2, 0x92, 0x13, 0x90, 0x7f, 0x02, 0x4c, 0x92, 0x79, 0xdd,
+	0xb4, 0xea, 0xb6, 0xa5, 0x0f, 0xde, 0x71, 0x75, 0x70, 0x4f, 0x9b, 0x7c, 0xe6, 0xb3, 0xcb, 0x00,
+	0x04, 0xd1, 0x53, 0xe6, 0xae, 0x13, 0x79, 0x33, 0x8a, 0xf8, 0xd9, 0x6c, 0x62, 0xac, 0x11, 0x00,
+	0xc2, 0x49, 0x5d, 0x44, 0xbe, 0x6a, 0x98, 0x83, 0x9d, 0xc7, 0xcf, 0x97, 0xc0, 0x87, 0x32, 0x95,
+	0x44, 0x93, 0xb9, 0xbb, 0xd7, 0xc8, 0x33, 0x09, 0x54, 0x00, 0x9b, 0x4e, 0xc4, 0x0c, 0x9f, 0x06,
+	0x87, 0x58, 0xcc, 0x53, 0xc1, 0x3e, 0x9d, 0x27, 0xef, 0x49, 0x42, 0x9e, 0x25, 0xf5, 0xe0, 0xb3,
+	0xf1, 0x21, 0x0f, 0x5e, 0x01, 0x87, 0x2c, 0xf4, 0x9c, 0x5f, 0x43, 0x8e, 0xad, 0x6d, 0xd4, 0xf0,
+	0x4e, 0x4d, 0xb6, 0x94, 0xb1, 0x45, 0xb1, 0x2d, 0x83, 0xdd, 0x08, 0xb7, 0xf1, 0x65, 0xe1, 0xc5,
+	0xb7, 0x66, 0xb8, 0xea, 0x38, 0x66, 0x5c, 0xc1, 0x7c, 0x78, 0x24, 0x72, 0x79, 0x42, 0xf7, 0x80,
+	0xa1, 0xf3, 0xa7, 0xe1, 0x9a, 0x4b, 0x2b, 0x61, 0x2e, 0xcf, 0xf0, 0x14, 0xb7, 0x87, 0x9e, 0x2a,
+	0xed, 0xce, 0x53, 0xaf, 0x73, 0x60, 0x3c, 0xa1, 0x31, 0xc7, 0x0b, 0x5c, 0xce, 0x5e, 0x7d, 0x09,
+	0x8c, 0xaa, 0x0d, 0x3b, 0xb0, 0x7c, 0xea, 0xa7, 0xe5, 0x32, 0xb6, 0xf7, 0x6f, 0x7e, 0x38, 0x73,
+	0x7f, 0x0f, 0xc9, 0x72, 0xd5, 0xf2, 0xab, 0x8c, 0x1b, 0x7e, 0x04, 0x1c, 0xf4, 0x7c, 0xd5, 0xf5,
+	0xb1, 0x5a, 0x32, 0x2b, 0x12, 0x7a, 0xa1, 0x3a, 0x1e, 0x3e, 0x25, 0x26, 0xc3, 0xfb, 0xc0, 0xb8,
+	0x16, 0xb8, 0x2e, 0xb2, 0xd8, 0xdc, 0x49, 0x0d, 0x21, 0x54, 0x0f, 0xb0, 0x87, 0x84, 0x48, 0xfe,
+	0x1a, 0x47, 0x0a, 0xaa, 0xb4, 0x2f, 0xef, 0xcc, 0xcc, 0xe4, 0x97, 0xb8, 0xd4, 0xd2, 0x5c, 0xde,
+	0x26, 0x0a, 0x42, 0x34, 0x16, 0xb5, 0xaa, 0xbd, 0xca, 0xd8, 0x4d, 0xed, 0xf3, 0x4f, 0x25, 0x70,
+	0x6f, 0xb6, 0x55, 0xc5, 0x33, 0xc6, 0x25, 0xb2, 0x7c, 0x92, 0xe9, 0xbc, 0x95, 0x31, 0x06, 0x55,
+	0x17, 0x65, 0x6d, 0x62, 0xfc, 0x5e, 0x6e, 0x62, 0xc2, 0xa0, 0x36, 0xb1, 0x5f, 0xe2, 0xc0, 0x78,
+	0xc2, 0x47, 0xb9, 0x19, 0xe8, 0xfd, 0x5d, 0x4d, 0xf2, 0x37, 0x38, 0x70, 0x22, 0x23, 0x85, 0x0d,
+	0x05, 0x40, 0xff, 0x9c, 0x03, 0x72, 0x27, 0xdb, 0x76, 0x9d, 0x65, 0x69, 0x24, 0x12, 0x52, 0x73,
+	0xb2, 0xec, 0xc0, 0x2a, 0xfa, 0x00, 0x1c, 0xcd, 0xd0, 0xba, 0xd7, 0x28, 0x90, 0x57, 0xc1, 0x71,
+	0xe2, 0xc1, 0x1b, 0xb6, 0xaf, 0x9a, 0xe9, 0x4d, 0xb0, 0x50, 0x54, 0x65, 0x1d, 0x88, 0x59, 0xa2,
+	0x58, 0x10, 0x5a, 0x06, 0x73, 0x7d, 0x19, 0xfc, 0x69, 0xf6, 0x22, 0x5c, 0x45, 0x5b, 0xaa, 0xab,
+	0x0f, 0x78, 0xbf, 0xde, 0x01, 0x13, 0x49, 0xe1, 0xcc, 0x78, 0x04, 0xf6, 0xb9, 0xf4, 0xd1, 0x5e,
+	0xbc, 0x7a, 0x84, 0xb2, 0xe5, 0x87, 0x81, 0x1c, 0x57, 0xff, 0xe3, 0x86, 0xbf, 0xa1, 0xbb, 0xea,
+	0x16, 0x7b, 0x43, 0xee, 0x32, 0x55, 0xf9, 0x1a, 0xb8, 0xaf, 0x23, 0x37, 0x9b, 0xcb, 0x49, 0x70,
+	0x78, 0x8b, 0x0d, 0x45, 0x6f, 0xe5, 0x54, 0xd0, 0xa1, 0xad, 0x24, 0x8b, 0x2c, 0x03, 0x89, 0x48,
+	0xbc, 0x10, 0xdb, 0x39, 0x2f, 0xb2, 0xb3, 0x8f, 0xf0, 0x60, 0xe2, 0x0b, 0xe0, 0x44, 0x07, 0x1a,
+	0xa6, 0xf3, 0x29, 0x30, 0x99, 0xd8, 0x92, 0x6b, 0xe1, 0x09, 0x0a, 0x7b, 0xfd, 0x3c, 0xde, 0x56,
+	0x92, 0x84, 0x22, 0x96, 0xef, 0xc6, 0xee, 0xfc, 0x15, 0x5c, 0x95, 0x4c, 0x68, 0x19, 0x2a, 0xe4,
+	0xb7, 0x38, 0xf0, 0x61, 0x62, 0xc0, 0x15, 0xc3, 0xf3, 0x6d, 0xd7, 0xd0, 0x54, 0x33, 0x05, 0x8d,
+	0x62, 0xb9, 0xa9, 0x3d, 0x2d, 0x96, 0xb2, 0x8a, 0x8c, 0x13, 0xe0, 0x00, 0xb2, 0xf4, 0x16, 0x11,
+	0xcd, 0x9d, 0x63, 0xf4, 0x19, 0x25, 0x49, 0x66, 0x39, 0x61, 0xd7, 0x59, 0xee, 0x75, 0x0e, 0x4c,
+	0xe7, 0xcd, 0x90, 0xf9, 0x77, 0x0d, 0xc0, 0x8d, 0x68, 0xb0, 0x96, 0x84, 0xea, 0x99, 0xbc, 0x24,
+	0x97, 0x2b, 0x8e, 0xa5, 0xba, 0x23, 0x1b, 0x69, 0x82, 0xc1, 0x65, 0xbb, 0x7f, 0xe4, 0xc0, 0xf1,
+	0xfc, 0xe9, 0x4c, 0x80, 0x11, 0xea, 0x55, 0x7a, 0x8e, 0x41, 0x7f, 0xc0, 0x9f, 0xe5, 0xc0, 0x3d,
+	0x5a, 0xd0, 0x08, 0x4c, 0xd5, 0x37, 0x36, 0x51, 0x2d, 0xb0, 0x0c, 0x3f, 0x9a, 0x2a, 0x7d, 0xbf,
+	0xb8, 0x37, 0x73, 0x55, 0x5e, 0x44, 0x1a, 0x59, 0x98, 0x67, 0xd9, 0xc2, 0x3c, 0xdd, 0xc3, 0xc2,
+	0x64, 0x3c, 0x5e, 0xf5, 0x58, 0x4b, 0xe3, 0x4d, 0xcb, 0xf0, 0x43, 0x47, 0x3c, 0x00, 0x0e, 0xb9,
+	0x68, 0x0d, 0xb9, 0xc8, 0xd2, 0x50, 0x4d, 0x23, 0x69, 0x0d, 0x23, 0x60, 0xbc, 0x7a, 0x30, 0x7a,
+	0x7c, 0x01, 0x3f, 0x95, 0xaf, 0x80, 0xa9, 0xb6, 0xe5, 0xb1, 0xbb, 0xf4, 0xfa, 0x28, 0x38, 0x9e,
+	0x21, 0x89, 0x79, 0xac, 0xad, 0xe6, 0xe5, 0x32, 0x6a, 0xde, 0xab, 0x0c, 0x47, 0x4f, 0x04, 0xbe,
+	0xe7, 0xab, 0x04, 0xa9, 0xfd, 0x2c, 0x15, 0xf9, 0xeb, 0x1c, 0x98, 0xc9, 0x15, 0xc8, 0x0c, 0xbb,
+	0x95, 0xce, 0x9c, 0x7b, 0x10, 0xa3, 0x28, 0x7f, 0xd6, 0x99, 0x8b, 0x14, 0xcb, 0x0a, 0xfa, 0x4c,
+	0x03, 0xb1, 0xc3, 0xb4, 0x52, 0xe2, 0x30, 0xed, 0x0d, 0x0e, 0x88, 0x59, 0x4a, 0xd8, 0x7c, 0x3f,
+	0x03, 0x0e, 0xaa, 0x64, 0x20, 0xb5, 0x0a, 0x17, 0xba, 0xbc, 0x4a, 0x63, 0xcd, 0x09, 0x89, 0x6c,
+	0x11, 0x8e, 0xab, 0xf1, 0x87, 0xf0, 0x53, 0xe0, 0x10, 0x89, 0xaf, 0x57, 0x73, 0x90, 0x5b, 0xdb,
+	0x46, 0xaa, 0xbb, 0x8b, 0xfd, 0xff, 0x22, 0xd2, 0xaa, 0xe3, 0x54, 0xcc, 0x35, 0xe4, 0x3e, 0x85,
+	0x54, 0x57, 0xfe, 0x0f, 0x1e, 0x4c, 0xe5, 0x59, 0x52, 0xd0, 0x73, 0x9f, 0x05, 0x13, 0x3e, 0xae,
+	0x00, 0xc2, 0x62, 0xab, 0xd6, 0x57, 0x9d, 0x02, 0xfd, 0x58, 0x35, 0xa1, 0xd0, 0xca, 0xf5, 0x79,
+	0x00, 0xe9, 0x2e, 0x92, 0x48, 0x01, 0xfc, 0x5e, 0xc1, 0xeb, 0x30, 0x51, 0x16, 0x5f, 0xfd, 0x3f,
+	0xc5, 0x81, 0xa3, 0x2c, 0xca, 0x09, 0x13, 0x84, 0xbd, 0x32, 0xe1, 0x08, 0xd5, 0x16, 0xb7, 0x61,
+	0x25, 0x3c, 0x81, 0x1e, 0x21, 0x4a, 0x4f, 0xe6, 0x1e, 0xfc, 0x9b, 0x6a, 0x26, 0xb0, 0x28, 0xb7,
+	0xfc, 0x32, 0x0f, 0x8e, 0xb4, 0x91, 0xe4, 0x1e, 0x25, 0x43, 0x07, 0x50, 0xe0, 0xa4, 0x12, 0xef,
+	0x40, 0xcb, 0xa1, 0x03, 0x88, 0xa6, 0x36, 0x6a, 0xca, 0xff, 0x07, 0x5b, 0xf7, 0xe4, 0x19, 0x56,
+	0xe3, 0x28, 0xa6, 0x69, 0x6b, 0x64, 0x07, 0xbd, 0xe6, 0xa2, 0x4d, 0x03, 0x6d, 0x85, 0x55, 0xd8,
+	0xcb, 0x25, 0x30, 0x9d, 0x47, 0xc1, 0x32, 0xd3, 0x4d, 0x30, 0xa6, 0x46, 0x83, 0x61, 0x5a, 0x9a,
+	0xef, 0x08, 0x9b, 0xb4, 0x2c, 0x06, 0x9d, 0xb8, 0x1c, 0x78, 0x1d, 0x1c, 0xc8, 0xd8, 0x89, 0x4f,
+	0xe5, 0x1f, 0x82, 0x46, 0xb3, 0x4a, 0x09, 0x0d, 0x62, 0x3e, 0xbf, 0x09, 0xc6, 0xbd, 0x5b, 0x86,
+	0xe3, 0x20, 0xbd, 0x46, 0x41, 0xce, 0x77, 0x96, 0x7a, 0x9d, 0x12, 0x63, 0xa3, 0x93, 0x52, 0x0f,
+	0x78, 0xad, 0x11, 0x4f, 0xfe, 0x33, 0x0e, 0x1c, 0xcb, 0x9c, 0x58, 0x3e, 0xe0, 0x8b, 0x5f, 0x7c,
+	0x69, 0xd1, 0x8b, 0x0e, 0x3f, 0xf8, 0xb5, 0x11, 0xbe, 0x05, 0xfd, 0x11, 0x07, 0x60, 0xbb, 0x2b,
+	0x0b, 0x66, 0x6a, 0x3f, 0x33, 0x74, 0x7b, 0x80, 0xe8, 0x78, 0x6c, 0xe5, 0xbf, 0xe5, 0x00, 0x6c,
+	0x8f, 0xd7, 0x07, 0x2d, 0x02, 0xf8, 0x2d, 0xcc, 0x45, 0xaa, 0xc7, 0x2a, 0xfb, 0xfd, 0x55, 0xf6,
+	0x4b, 0xbe, 0x87, 0xdd, 0x61, 0x91, 0x92, 0x6b, 0xd5, 0x5a, 0xb3, 0xc3, 0x25, 0xfa, 0xbf, 0x25,
+	0x30, 0x99, 0x1e, 0x61, 0x4b, 0xf3, 0x04, 0x38, 0xb0, 0x6e, 0xda, 0x75, 0xd5, 0x4c, 0x14, 0x6f,
+	0x63, 0xf4, 0x19, 0x21, 0xc7, 0xa7, 0xb9, 0xa6, 0xea, 0xed, 0xee, 0x34, 0x17, 0x33, 0x46, 0xa7,
+	0xb9, 0x1d, 0xde, 0xc5, 0xf8, 0x3e, 0xdf, 0xc5, 0xe0, 0x75, 0x70, 0x34, 0x76, 0xe4, 0x1c, 0xc9,
+	0x15, 0x7a, 0x97, 0x7b, 0x24, 0x3a, 0x79, 0x8e, 0x84, 0x66, 0x9c, 0x63, 0x8f, 0xec, 0xee, 0x1c,
+	0x7b, 0x91, 0x5d, 0xe4, 0x60, 0xd8, 0x5d, 0x0a, 0x58, 0xb9, 0xda, 0xe5, 0xee, 0xf4, 0x15, 0x01,
+	0x4c, 0xb5, 0x33, 0xb1, 0xb8, 0x0d, 0x10, 0xb3, 0x2b, 0x60, 0x5f, 0x3d, 0xd0, 0xd7, 0x91, 0xdf,
+	0xf5, 0xc0, 0x92, 0x19, 0xb1, 0x4c, 0xa8, 0x59, 0x9a, 0x0b, 0x79, 0xe1, 0x26, 0x38, 0xec, 0xb8,
+	0xf6, 0xe7, 0x90, 0xe6, 0x23, 0xbd, 0x66, 0x58, 0x6b, 0xa6, 0xbd, 0x35, 0x25, 0x0c, 0x7e, 0x11,
+	0x1c, 0x8a, 0x94, 0xac, 0x12, 0x1d, 0xad, 0xba, 0xc0, 0x0e, 0x7c, 0xa2, 0x74, 0x64, 0xaf, 0xea,
+	0x82, 0x27, 0xa8, 0x02, 0x78, 0x1b, 0x1c, 0xa5, 0x65, 0x66, 0x52, 0xef, 0xe8, 0xe0, 0xf5, 0x1e,
+	0x21, 0x7a, 0x56, 0xe2, 0xca, 0xa7, 0x01, 0xf0, 0x82, 0xb5, 0x35, 0x43, 0x33, 0x90, 0xe5, 0x4f,
+	0xed, 0x93, 0xb8, 0xd9, 0xbb, 0xab, 0xb1, 0x27, 0xf2, 0x57, 0x79, 0x30, 0x9e, 0x88, 0x13, 0x6e,
+	0xaa, 0xb0, 0xd4, 0x46, 0xd4, 0x54, 0x81, 0xff, 0x87, 0xcb, 0x40, 0x70, 0x55, 0x1f, 0xed, 0xb2,
+	0x82, 0x27, 0xbc, 0xe4, 0xb8, 0xc2, 0x0e, 0x5c, 0x0d, 0xa5, 0x3a, 0x2c, 0xc6, 0xe9, 0xd3, 0x10,
+	0x5e, 0xcf, 0x83, 0x63, 0xd4, 0x5b, 0x9a, 0x6d, 0x9a, 0x14, 0x1d, 0xf4, 0x66, 0x7b, 0x0f, 0xc0,
+	0x41, 0xe3, 0x72, 0x21, 0x54, 0x44, 0x1e, 0x66, 0x02, 0x73, 0x64, 0xef, 0x81, 0x19, 0x1d, 0x61,
+	0xe1, 0x2d, 0x7f, 0x2b, 0x76, 0xd9, 0x63, 0xd9, 0xad, 0xde, 0x9a, 0xf3, 0xe0, 0x44, 0x07, 0x1a,
+	0xb6, 0xd6, 0x27, 0xc1, 0x28, 0xd9, 0x4d, 0x69, 0xe5, 0xb4, 0xbf, 0xca, 0x7e, 0x2d, 0x7e, 0xa3,
+	0x06, 0x46, 0x08, 0x37, 0xfc, 0xfd, 0x12, 0x18, 0xa5, 0x6d, 0x36, 0xf0, 0x54, 0x87, 0xeb, 0xbb,
+	0x54, 0x67, 0x8f, 0x78, 0xba, 0x27, 0x5a, 0x6a, 0x85, 0xfc, 0x1a, 0xd7, 0x54, 0xbe, 0xc9, 0x89,
+	0xf3, 0x55, 0xe4, 0x07, 0xae, 0xe5, 0x49, 0xaa, 0x69, 0x4a, 0xa4, 0x99, 0x07, 0xf9, 0xc8, 0xf5,
+	0x24, 0x7b, 0x4d, 0xf2, 0x37, 0x90, 0xc4, 0x24, 0x49, 0x0d, 0x5b, 0x0f, 0x4c, 0x54, 0x96, 0x1b,
+	0x60, 0xfa, 0x92, 0x61, 0xe9, 0x92, 0x1d, 0xf8, 0x52, 0xc3, 0x76, 0x91, 0xa4, 0xd6, 0xf1, 0xbf,
+	0x98, 0xd4, 0xa1, 0x06, 0x7f, 0x62, 0xc3, 0xf7, 0x1d, 0x6f, 0xa9, 0x52, 0x89, 0x79, 0x3a, 0xa3,
+	0xb1, 0xab, 0x6e, 0xda, 0xf5, 0x4a, 0x43, 0x35, 0xac, 0xca, 0x73, 0xd1, 0x33, 0xcf, 0x41, 0x5a,
+	0x65, 0xe1, 0x63, 0x35, 0x2a, 0xa9, 0xdc, 0xd0, 0xbf, 0xf4, 0xc6, 0xdf, 0xbf, 0x54, 0x92, 0xe0,
+	0x74, 0x18, 0xaa, 0x74, 0x57, 0x18, 0x53, 0xf9, 0x03, 0x01, 0x90, 0xde, 0x12, 0x0f, 0x9e, 0xec,
+	0xec, 0x81, 0x58, 0x6f, 0x92, 0x78, 0xaa, 0x17, 0x52, 0xe6, 0xab, 0xf7, 0xf8, 0xa6, 0xf2, 0x97,
+	0xbc, 0x78, 0x3e, 0xf2, 0x95, 0x64, 0x1a, 0x9e, 0x8f, 0x7d, 0x84, 0xbd, 0x16, 0xfa, 0x88, 0xd4,
+	0x97, 0x12, 0x3e, 0xf9, 0x94, 0x5a, 0xe7, 0x53, 0x92, 0x8b, 0xbc, 0xc0, 0xf4, 0xcb, 0xf2, 0x26,
+	0x98, 0xcf, 0xf3, 0x1c, 0x39, 0xe9, 0x92, 0x54, 0x4b, 0x97, 0x90, 0xeb, 0xda, 0xae, 0xa4, 0xd9,
+	0x3a, 0xf2, 0xe0, 0x4a, 0x6f, 0x8e, 0xf4, 0x5d, 0x84, 0xa8, 0x23, 0x75, 0x5b, 0xf3, 0x2a, 0x57,
+	0xec, 0xad, 0xf9, 0x1b, 0x76, 0x45, 0x33, 0x8d, 0xfb, 0xc8, 0x1c, 0x1e, 0x7b, 0x89, 0x03, 0xfc,
+	0x83, 0x0b, 0x0b, 0xf0, 0x6b, 0x1c, 0x18, 0x5b, 0x56, 0x75, 0x29, 0x84, 0xea, 0xe7, 0xc1, 0x61,
+	0xd5, 0x71, 0x4c, 0x83, 0x56, 0xaf, 0x95, 0xcf, 0x79, 0xb6, 0x05, 0x37, 0x6e, 0xcb, 0x58, 0xb7,
+	0xbc, 0x74, 0x76, 0x4e, 0x6e, 0x20, 0xcf, 0x53, 0xd7, 0x91, 0xbc, 0x24, 0xbb, 0x8e, 0x46, 0x0d,
+	0x5b, 0x22, 0x96, 0x49, 0x8f, 0x48, 0xab, 0xd6, 0xa6, 0x6a, 0x1a, 0xba, 0xe2, 0xae, 0x07, 0x0d,
+	0x64, 0xf9, 0x92, 0x8e, 0x3c, 0x4d, 0x7a, 0x44, 0x32, 0xe8, 0x63, 0xe2, 0x08, 0x09, 0xaf, 0x25,
+	0xe9, 0xda, 0xe3, 0xca, 0xd5, 0xda, 0x8d, 0xa7, 0xae, 0xad, 0xc8, 0x73, 0xb2, 0x4e, 0xae, 0x45,
+	0x3d, 0x79, 0xe9, 0xd3, 0x9f, 0xd9, 0x79, 0xec, 0x8b, 0x1c, 0xe0, 0x1f, 0x5a, 0x58, 0x80, 0xdb,
+	0xe0, 0xd8, 0xaa, 0xe5, 0x23, 0xd7, 0x52, 0x4d, 0xe9, 0x3a, 0x72, 0x37, 0x91, 0x2b, 0xad, 0x60,
+	0x55, 0xf2, 0x67, 0x33, 0xcc, 0x7b, 0x3c, 0x34, 0xef, 0x4c, 0x57, 0xfb, 0x98, 0x48, 0x66, 0x18,
+	0x19, 0x4d, 0x99, 0x40, 0xb0, 0x35, 0x03, 0x3f, 0x9c, 0x8b, 0x2d, 0x02, 0xa8, 0x37, 0x47, 0x80,
+	0x80, 0xfd, 0x08, 0x67, 0xbb, 0xc2, 0x25, 0x04, 0xd6, 0xc9, 0x1e, 0x28, 0x19, 0xae, 0xfe, 0x53,
+	0x68, 0x2a, 0xdf, 0x15, 0xc4, 0x73, 0x21, 0xae, 0xe2, 0x2b, 0x8e, 0x3a, 0x71, 0x43, 0xf5, 0x25,
+	0xcd, 0x76, 0x5d, 0xc2, 0xa1, 0x7b, 0x92, 0x6f, 0xd3, 0xb5, 0x46, 0x6b, 0x85, 0xb2, 0x1c, 0x14,
+	0x45, 0xd5, 0xc5, 0x7e, 0x51, 0x85, 0x55, 0x3f, 0xf6, 0x65, 0x06, 0xaa, 0x9d, 0x24, 0xa6, 0xac,
+	0x8c, 0xa0, 0x3d, 0xdd, 0x1f, 0xa6, 0x50, 0xc3, 0xf1, 0xb7, 0x25, 0x97, 0x29, 0x48, 0xa1, 0xe8,
+	0x05, 0x62, 0xc6, 0x83, 0xf0, 0xf9, 0xa4, 0x19, 0x4e, 0x86, 0x19, 0xcf, 0x84, 0x66, 0x3c, 0xd4,
+	0xd9, 0x8c, 0xab, 0xb6, 0x7f, 0xc9, 0x0e, 0x2c, 0x3d, 0xd4, 0x4f, 0xc2, 0xc0, 0xdc, 0x2d, 0x59,
+	0xb6, 0x2f, 0xad, 0xe1, 0xd1, 0x21, 0x85, 0xf3, 0x49, 0xf8, 0x40, 0x47, 0x38, 0x57, 0x6e, 0xb3,
+	0x99, 0xec, 0xc0, 0x7f, 0xe5, 0xc1, 0xdd, 0xd1, 0xd5, 0xe3, 0x5c, 0x47, 0xc8, 0xa6, 0xee, 0xf2,
+	0xc4, 0xf9, 0x1e, 0xa9, 0x19, 0xc8, 0x5f, 0xe0, 0x9b, 0xca, 0xeb, 0x25, 0xf1, 0x93, 0xf1, 0x8d,
+	0x26, 0xbc, 0x3d, 0x95, 0x66, 0x3d, 0xd2, 0x2b, 0x47, 0x60, 0x4a, 0xef, 0x43, 0x25, 0x52, 0x4d,
+	0x9c, 0xcc, 0x85, 0x3e, 0xbb, 0xa2, 0xda, 0x2e, 0x0a, 0xfc, 0x2b, 0xfd, 0x02, 0x3f, 0xb4, 0x79,
+	0x48, 0xc0, 0x4f, 0x02, 0x7e, 0x1a, 0x9e, 0xcc, 0x0b, 0x78, 0x68, 0x6e, 0xe5, 0x36, 0xf5, 0xd8,
+	0x0e, 0xfc, 0x4d, 0x01, 0x1c, 0x4c, 0xf6, 0x0a, 0xc0, 0xc5, 0x8e, 0xa1, 0xcc, 0xec, 0x21, 0x14,
+	0xcf, 0x16, 0xe2, 0x61, 0x20, 0xf8, 0x1d, 0xbe, 0xa9, 0xfc, 0x73, 0x49, 0xac, 0xc7, 0x41, 0x40,
+	0x3b, 0x20, 0x5a, 0x58, 0x48, 0x45, 0x3c, 0x19, 0xf0, 0x39, 0xba, 0xb1, 0xfa, 0x1b, 0xc8, 0x70,
+	0xa5, 0x46, 0x60, 0xfa, 0x86, 0x63, 0x1a, 0xb8, 0x50, 0xc1, 0x71, 0x0e, 0x2c, 0x2c, 0x4b, 0x22,
+	0xdd, 0xd6, 0xf2, 0xf3, 0x45, 0x91, 0x71, 0xb5, 0x5f, 0x64, 0xd0, 0x89, 0x0c, 0x23, 0x3e, 0x16,
+	0xe1, 0x42, 0x1e, 0x3e, 0x52, 0xfd, 0x27, 0x2d, 0x98, 0xfc, 0x0f, 0x0f, 0x40, 0xab, 0x41, 0x11,
+	0x96, 0x3b, 0x86, 0xbb, 0xad, 0x53, 0x52, 0xac, 0xf4, 0x4c, 0xcf, 0xa0, 0xf1, 0x75, 0xbe, 0xa9,
+	0xfc, 0x75, 0x49, 0x7c, 0x32, 0x0e, 0x8d, 0x56, 0x3f, 0x63, 0x01, 0x54, 0x68, 0x76, 0xc3, 0x31,
+	0x11, 0x29, 0xba, 0x28, 0x12, 0x6e, 0x17, 0x45, 0xc2, 0x63, 0xfd, 0x22, 0xa1, 0x65, 0xf7, 0x30,
+	0xa1, 0x60, 0x1e, 0x9e, 0xce, 0x43, 0x41, 0xcb, 0xe0, 0x16, 0x00, 0xfe, 0x40, 0x00, 0x07, 0x93,
+	0x8d, 0x9d, 0x5d, 0xf2, 0x44, 0x66, 0xab, 0xa9, 0x78, 0xb6, 0x10, 0x0f, 0x03, 0xc3, 0x1f, 0xf2,
+	0x4d, 0xe5, 0xdf, 0x4b, 0xe2, 0xad, 0x8c, 0xcd, 0x22, 0xbe, 0x47, 0x84, 0x8f, 0x5c, 0xa4, 0xd9,
+	0xae, 0xee, 0x75, 0xd9, 0x2c, 0x12, 0x28, 0x21, 0x2f, 0xf9, 0x92, 0x61, 0xad, 0xd9, 0x6e, 0x83,
+	0xde, 0x20, 0xbf, 0xff, 0x09, 0x23, 0x5c, 0x74, 0x34, 0x52, 0x1f, 0x90, 0x84, 0xd1, 0x96, 0x29,
+	0x2a, 0x94, 0x1b, 0xfe, 0xb6, 0x00, 0x0e, 0x26, 0xdb, 0xa6, 0xba, 0xe0, 0x25, 0xb3, 0x4f, 0x56,
+	0x3c, 0x5b, 0x88, 0x87, 0xe1, 0xe5, 0xf7, 0xf8, 0xa6, 0xf2, 0x6e, 0x49, 0x44, 0x71, 0xbc, 0x24,
+	0x31, 0xd2, 0x3b, 0x38, 0x24, 0xf4, 0x9c, 0x43, 0x5e, 0xf8, 0x49, 0xfa, 0xc0, 0x4f, 0xb6, 0xa5,
+	0x3a, 0xd2, 0xec, 0x06, 0x92, 0x68, 0x95, 0x72, 0x07, 0x90, 0x42, 0xe7, 0xf2, 0x01, 0xdb, 0x5a,
+	0x52, 0x2d, 0x72, 0xad, 0xcc, 0xf2, 0xc7, 0x02, 0x38, 0x94, 0x6a, 0xad, 0x83, 0xbd, 0xa5, 0x89,
+	0x64, 0x93, 0xa0, 0xf8, 0x60, 0x31, 0x26, 0x06, 0x96, 0xef, 0xf0, 0x4d, 0xe5, 0xdf, 0x52, 0x60,
+	0x89, 0x50, 0x62, 0xaf, 0x31, 0x40, 0xe4, 0x03, 0xa6, 0xfd, 0x42, 0x64, 0x4e, 0x32, 0x2c, 0xcd,
+	0x0c, 0x70, 0x02, 0x4d, 0x97, 0x33, 0xf2, 0x17, 0xb9, 0xa2, 0x68, 0x79, 0x62, 0x50, 0x79, 0xa5,
+	0xbe, 0x4d, 0xac, 0x1b, 0x26, 0xb8, 0x3c, 0x0a, 0x7f, 0xb4, 0x5b, 0x62, 0xa9, 0xd5, 0xb7, 0xa9,
+	0x57, 0x2b, 0xb7, 0xdb, 0x3d, 0xbd, 0x03, 0xbf, 0x2b, 0x80, 0x63, 0xc9, 0x2c, 0x10, 0x42, 0xe8,
+	0x5c, 0x81, 0xcc, 0x91, 0x02, 0xd2, 0xd2, 0x6e, 0x58, 0x19, 0x9c, 0x5e, 0xe5, 0x9b, 0xca, 0x3b,
+	0xa9, 0xc2, 0x25, 0x9d, 0x7b, 0x76, 0x85, 0x2a, 0xf9, 0x85, 0xc2, 0xd0, 0xb9, 0x39, 0xd8, 0x44,
+	0x33, 0x84, 0x00, 0xba, 0x0c, 0x57, 0x7a, 0xcc, 0x37, 0x5d, 0x70, 0xf4, 0x73, 0x02, 0x18, 0x4f,
+	0x34, 0x96, 0xc2, 0x33, 0x1d, 0x41, 0x90, 0xd5, 0xcf, 0x2a, 0x2e, 0x16, 0x61, 0x61, 0x78, 0xf9,
+	0x05, 0xbe, 0xa9, 0x7c, 0xaf, 0x24, 0x2a, 0xd1, 0x69, 0x0f, 0xa6, 0xea, 0xbe, 0x4d, 0x65, 0xe0,
+	0xe3, 0x0b, 0x45, 0xe1, 0xf1, 0xc9, 0x7e, 0xe1, 0x41, 0x6c, 0x1d, 0xc6, 0x6d, 0xe8, 0x11, 0x78,
+	0x3e, 0x0f, 0x16, 0x89, 0xde, 0x21, 0x2f, 0x1b, 0x0c, 0x6f, 0xf2, 0x60, 0x5f, 0xd8, 0x2a, 0xd0,
+	0xf9, 0xf8, 0x3c, 0xd9, 0x03, 0x26, 0xce, 0xf5, 0x46, 0xcc, 0x42, 0xff, 0x6e, 0xa9, 0xa9, 0x7c,
+	0xa7, 0x24, 0x7e, 0x3c, 0x9e, 0x2a, 0xd8, 0x5d, 0x39, 0x3d, 0xef, 0xe8, 0x76, 0xdc, 0xf1, 0x5c,
+	0xd1, 0x88, 0x5f, 0xee, 0x37, 0xe2, 0xcc, 0xbc, 0x61, 0x8a, 0xf5, 0x29, 0x38, 0x9b, 0x17, 0x6b,
+	0x66, 0x6d, 0xab, 0xd4, 0xf8, 0xb6, 0x00, 0x26, 0xb3, 0xdb, 0x97, 0xe1, 0x52, 0x2f, 0xa1, 0xcb,
+	0xee, 0x98, 0x16, 0xcf, 0xef, 0x8a, 0x97, 0xa1, 0xe0, 0x57, 0xf9, 0xa6, 0xf2, 0x66, 0x49, 0xbc,
+	0x10, 0x3f, 0xee, 0x65, 0x77, 0x6d, 0x34, 0xfe, 0xf8, 0x41, 0x08, 0x8b, 0xd8, 0x05, 0x0c, 0x72,
+	0x25, 0xd5, 0x45, 0x52, 0xd8, 0x53, 0x6d, 0x49, 0xbe, 0x2d, 0x7f, 0xb5, 0xf0, 0x16, 0xf1, 0xa9,
+	0x01, 0x21, 0x22, 0x34, 0x83, 0x59, 0x3e, 0x4c, 0x00, 0x39, 0x0f, 0xcf, 0x75, 0x01, 0x48, 0x2d,
+	0xdd, 0xb0, 0xde, 0x42, 0xcc, 0x7f, 0xf3, 0x60, 0x22, 0xab, 0xf5, 0x1c, 0x7e, 0xbc, 0x63, 0xcc,
+	0x3b, 0x74, 0xb4, 0x8b, 0xe7, 0x76, 0xc1, 0xc9, 0xb0, 0xf2, 0x2f, 0xa5, 0xa6, 0xf2, 0x4a, 0x49,
+	0x94, 0xe3, 0x58, 0x61, 0x9d, 0x12, 0xec, 0x15, 0x36, 0x6c, 0x8a, 0x90, 0xbf, 0x52, 0x18, 0x0a,
+	0x37, 0xfa, 0x85, 0x02, 0xb3, 0x84, 0x18, 0x12, 0xda, 0x31, 0x4c, 0x40, 0x58, 0x80, 0xe5, 0x3c,
+	0x20, 0x64, 0x77, 0xac, 0xc0, 0x97, 0x05, 0x70, 0xa4, 0xad, 0x8d, 0x1c, 0x3e, 0xd4, 0x31, 0x80,
+	0x79, 0xdf, 0x09, 0x88, 0x1f, 0x2d, 0xca, 0xc6, 0x82, 0xfe, 0x4d, 0xbe, 0xa9, 0xbc, 0x51, 0x12,
+	0x57, 0xc2, 0xa0, 0xb7, 0xda, 0xe6, 0xa3, 0xb4, 0x50, 0xa0, 0x4a, 0xf8, 0x52, 0x61, 0x5c, 0x3c,
+	0xd9, 0x2f, 0x2e, 0x5a, 0x06, 0x0f, 0xe1, 0xf6, 0xa1, 0xc0, 0x1f, 0xcb, 0x03, 0x45, 0xfb, 0x27,
+	0x0f, 0xd9, 0xe5, 0xc2, 0xcf, 0x08, 0xe0, 0x40, 0x7c, 0xd9, 0xc2, 0x85, 0x9e, 0x57, 0x78, 0x88,
+	0x8d, 0x33, 0x05, 0x38, 0x18, 0x2c, 0x9a, 0x7c, 0x53, 0xf9, 0x8b, 0x92, 0x78, 0x31, 0x3f, 0x17,
+	0x14, 0x40, 0xc5, 0x4e, 0x51, 0x50, 0x3c, 0x3e, 0xc8, 0x64, 0x31, 0x4c, 0x78, 0x78, 0x18, 0x2e,
+	0xf5, 0x94, 0x24, 0xb2, 0xa1, 0xf0, 0xbb, 0x02, 0x80, 0xed, 0x5f, 0x2b, 0xc0, 0xce, 0x4b, 0x3f,
+	0xf7, 0x7b, 0x09, 0xf1, 0x63, 0x85, 0xf9, 0x18, 0x38, 0x7e, 0x83, 0x6f, 0x2a, 0x7f, 0x55, 0x12,
+	0x2f, 0x85, 0xe0, 0xb0, 0x5b, 0xa4, 0xbb, 0x49, 0x1a, 0x3f, 0x5d, 0x38, 0x69, 0x54, 0xfb, 0xc5,
+	0x47, 0xcc, 0xe2, 0x21, 0xcc, 0x1a, 0xcb, 0xf0, 0xd1, 0x3c, 0x94, 0xc4, 0x0c, 0xef, 0x9c, 0x36,
+	0x5e, 0x14, 0xc0, 0x78, 0xb2, 0x2d, 0xbe, 0x73, 0x16, 0xc8, 0xfa, 0xea, 0x44, 0x5c, 0x2c, 0xc2,
+	0xc2, 0xc0, 0xf1, 0xcb, 0x7c, 0x53, 0xf9, 0x87, 0x92, 0xf8, 0x4c, 0x08, 0x0e, 0xe4, 0xf9, 0x46,
+	0x43, 0xf5, 0xc9, 0x85, 0x2b, 0xa6, 0x8f, 0x10, 0xe2, 0x20, 0x57, 0x0a, 0x2c, 0x83, 0xf4, 0xb4,
+	0xb0, 0x19, 0x90, 0x17, 0x93, 0x39, 0xa9, 0xae, 0x7a, 0x48, 0x97, 0x6c, 0x2b, 0x91, 0x75, 0x54,
+	0x0d, 0x7f, 0xc6, 0x44, 0x3b, 0x5e, 0xee, 0xc0, 0xdb, 0x28, 0x35, 0x7e, 0x08, 0xc1, 0x32, 0x0b,
+	0xef, 0xcf, 0x03, 0x4b, 0xf2, 0x5b, 0x1e, 0xf8, 0xea, 0x08, 0x38, 0xd2, 0xde, 0x3c, 0xde, 0xb9,
+	0xde, 0xc8, 0xeb, 0xd9, 0x17, 0x3f, 0x5a, 0x94, 0x8d, 0xc1, 0xe3, 0xdb, 0x42, 0x53, 0xf9, 0x2f,
+	0x5e, 0xdc, 0x88, 0x6f, 0x2c, 0x21, 0x22, 0x5a, 0x9d, 0xf9, 0x34, 0x7d, 0x6c, 0x19, 0xa6, 0x29,
+	0x6d, 0xa8, 0x8e, 0x83, 0x2c, 0x89, 0xbd, 0xaa, 0x20, 0x1c, 0xf4, 0xb5, 0xf6, 0xed, 0x28, 0x7e,
+	0x2a, 0xca, 0xba, 0xe6, 0x19, 0x54, 0xee, 0x40, 0x49, 0xd2, 0x9a, 0x87, 0x43, 0x67, 0x3f, 0x2c,
+	0xcd, 0x2b, 0x43, 0xd2, 0x33, 0x32, 0x07, 0x4f, 0xe5, 0x42, 0x36, 0x72, 0x5d, 0x8d, 0xf9, 0x0e,
+	0x36, 0x05, 0xb0, 0x3f, 0xea, 0x3a, 0x87, 0x9d, 0x3b, 0x41, 0xd2, 0x7d, 0xeb, 0x62, 0xb9, 0x57,
+	0x72, 0x06, 0xcf, 0xdf, 0xe2, 0x9b, 0xca, 0x7b, 0x25, 0xd1, 0x8d, 0xc3, 0x93, 0x76, 0xb2, 0xb3,
+	0xb2, 0xc7, 0x0a, 0x1a, 0x75, 0x7c, 0x83, 0x83, 0x07, 0x4c, 0xd5, 0x8b, 0xaa, 0x21, 0xa3, 0x81,
+	0xe6, 0x92, 0xf9, 0xca, 0xd2, 0x25, 0xdc, 0xc1, 0x9d, 0x7a, 0x75, 0xa2, 0xdd, 0x03, 0x98, 0x30,
+	0x36, 0x88, 0xb9, 0xe5, 0x9f, 0x2c, 0x8a, 0xd3, 0xd5, 0x7e, 0x71, 0x4a, 0x94, 0xe3, 0x6b, 0xc9,
+	0x61, 0xca, 0x67, 0x3f, 0x02, 0xe5, 0x3c, 0x70, 0xd0, 0xf7, 0x27, 0x6c, 0x30, 0x7c, 0x65, 0x04,
+	0x8c, 0xc5, 0x9a, 0xda, 0x61, 0xa5, 0x6b, 0x07, 0x5c, 0xb2, 0x67, 0x5e, 0x5c, 0xe8, 0x9d, 0x81,
+	0x41, 0xe3, 0x4f, 0x84, 0xa6, 0xf2, 0x15, 0x41, 0xdc, 0x8a, 0x43, 0x83, 0x75, 0xae, 0x4b, 0x6b,
+	0x94, 0x36, 0xd9, 0x4d, 0x67, 0xdb, 0x26, 0xe9, 0xd7, 0x24, 0x89, 0x88, 0x82, 0x23, 0x6a, 0xf0,
+	0x95, 0x68, 0x17, 0x31, 0xd9, 0x04, 0x29, 0x06, 0x42, 0x58, 0x60, 0xe2, 0x07, 0x48, 0x4d, 0x95,
+	0x24, 0x90, 0x3f, 0x5f, 0x14, 0x1f, 0x9f, 0x18, 0x44, 0xdf, 0x1d, 0x9b, 0xd9, 0xb0, 0x64, 0xb0,
+	0x2f, 0xb3, 0xf6, 0xbb, 0x1d, 0xb0, 0xff, 0xaa, 0xed, 0x4b, 0xa4, 0x6f, 0xee, 0xfd, 0x6f, 0xbe,
+	0x23, 0x40, 0x3d, 0x03, 0x2b, 0x3d, 0x76, 0xbe, 0x55, 0x98, 0x13, 0xe1, 0xb7, 0x04, 0x30, 0x91,
+	0xd5, 0xa7, 0xdd, 0xe5, 0xbc, 0xa7, 0x43, 0xfb, 0xb7, 0x78, 0x6e, 0x17, 0x9c, 0x0c, 0xd0, 0xbf,
+	0xc6, 0x37, 0x95, 0xb7, 0x63, 0x97, 0x49, 0x18, 0x49, 0xb4, 0x2f, 0x9c, 0xec, 0xc4, 0x5b, 0x88,
+	0x74, 0xbe, 0xd4, 0xc3, 0xab, 0xe8, 0xb2, 0xa4, 0x58, 0xdb, 0x94, 0x40, 0xd2, 0x54, 0xab, 0x35,
+	0x20, 0x6d, 0x6d, 0x20, 0x4b, 0x32, 0x7c, 0xc9, 0xf0, 0x68, 0xf0, 0xee, 0xc4, 0xf1, 0x10, 0xb3,
+	0x98, 0x15, 0x92, 0x74, 0x1a, 0x1f, 0x90, 0xe3, 0x21, 0x66, 0x7a, 0xf4, 0xc9, 0x31, 0x35, 0x7e,
+	0xf9, 0xf2, 0x6b, 0x6f, 0x4f, 0x73, 0xdf, 0x7f, 0x7b, 0x9a, 0xfb, 0xbb, 0xb7, 0xa7, 0xb9, 0x17,
+	0xdf, 0x99, 0xbe, 0xeb, 0xfb, 0xef, 0x4c, 0xdf, 0xf5, 0x83, 0x77, 0xa6, 0xef, 0x7a, 0x7a, 0xbe,
+	0xb3, 0x93, 0x5a, 0xdd, 0xed, 0xe4, 0xe3, 0x82, 0xfa, 0x28, 0xf9, 0xc4, 0xe8, 0xec, 0xff, 0x0d,
+	0x00, 0xd4, 0xd1, 0x07, 0x4c, 0x2d, 0x55, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns parameters of the farming module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Plans returns all plans.
+	Plans(ctx context.Context, in *QueryPlansRequest, opts ...grpc.CallOption) (*QueryPlansResponse, error)
+	// Plan returns a specific plan.
+	Plan(ctx context.Context, in *QueryPlanRequest, opts ...grpc.CallOption) (*QueryPlanResponse, error)
+	// Stakings returns all stakings by a farmer.
+	Stakings(ctx context.Context, in *QueryStakingsRequest, opts ...grpc.CallOption) (*QueryStakingsResponse, error)
+	// LockedStakings returns all locked stakings by a farmer.
+	LockedStakings(ctx context.Context, in *QueryLockedStakingsRequest, opts ...grpc.CallOption) (*QueryLockedStakingsResponse, error)
+	// Unbondings returns all unbondings by a farmer.
+	Unbondings(ctx context.Context, in *QueryUnbondingsRequest, opts ...grpc.CallOption) (*QueryUnbondingsResponse, error)
+	// StakingsDetail returns all staking and queued staking records by a farmer, with their epoch information.
+	StakingsDetail(ctx context.Context, in *QueryStakingsDetailRequest, opts ...grpc.CallOption) (*QueryStakingsDetailResponse, error)
+	// QueuedStakings returns all queued stakings by a farmer, with the expected time they become staked.
+	QueuedStakings(ctx context.Context, in *QueryQueuedStakingsRequest, opts ...grpc.CallOption) (*QueryQueuedStakingsResponse, error)
+	// StakingsByDenom returns all stakings of farmers for a staking coin denom,
+	// including locked stakings.
+	StakingsByDenom(ctx context.Context, in *QueryStakingsByDenomRequest, opts ...grpc.CallOption) (*QueryStakingsByDenomResponse, error)
+	// QueuedStakingsByDenom returns all queued stakings of farmers for a staking coin denom
+	QueuedStakingsByDenom(ctx context.Context, in *QueryQueuedStakingsByDenomRequest, opts ...grpc.CallOption) (*QueryQueuedStakingsByDenomResponse, error)
+	// TotalStakings returns total staking amount for a staking coin denom
+	TotalStakings(ctx context.Context, in *QueryTotalStakingsRequest, opts ...grpc.CallOption) (*QueryTotalStakingsResponse, error)
+	// Rewards returns rewards for a farmer
+	Rewards(ctx context.Context, in *QueryRewardsRequest, opts ...grpc.CallOption) (*QueryRewardsResponse, error)
+	// RewardsWithdrawAddress returns the address that a farmer's rewards are withdrawn to.
+	RewardsWithdrawAddress(ctx context.Context, in *QueryRewardsWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryRewardsWithdrawAddressResponse, error)
+	// CurrentEpochDuration returns current epoch duration.
+	CurrentEpochDuration(ctx context.Context, in *QueryCurrentEpochDurationRequest, opts ...grpc.CallOption) (*QueryCurrentEpochDurationResponse, error)
+	// HistoricalRewards returns historical rewards for a staking coin denom
+	HistoricalRewards(ctx context.Context, in *QueryHistoricalRewardsRequest, opts ...grpc.CallOption) (*QueryHistoricalRewardsResponse, error)
+	// CurrentEpoch returns the current epoch for a staking coin denom
+	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// OutstandingRewards returns outstanding rewards for a staking coin denom
+	OutstandingRewards(ctx context.Context, in *QueryOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryOutstandingRewardsResponse, error)
+	// AnnualRewards returns estimated annual rewards per unit of staking coin
+	AnnualRewards(ctx context.Context, in *QueryAnnualRewardsRequest, opts ...grpc.CallOption) (*QueryAnnualRewardsResponse, error)
+	// AllocationPreview returns the rewards allocation that will happen at the end of the current epoch
+	AllocationPreview(ctx context.Context, in *QueryAllocationPreviewRequest, opts ...grpc.CallOption) (*QueryAllocationPreviewResponse, error)
+	// EpochInfo returns the global epoch number and the times of the last and next epochs
+	EpochInfo(ctx context.Context, in *QueryEpochInfoRequest, opts ...grpc.CallOption) (*QueryEpochInfoResponse, error)
+	// PlanFunding returns the budgets funding the farming pool of a plan with the projected inflow and outflow per epoch
+	PlanFunding(ctx context.Context, in *QueryPlanFundingRequest, opts ...grpc.CallOption) (*QueryPlanFundingResponse, error)
+	// AllowedStakingDenoms returns the denoms allowed to be staked
+	AllowedStakingDenoms(ctx context.Context, in *QueryAllowedStakingDenomsRequest, opts ...grpc.CallOption) (*QueryAllowedStakingDenomsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Plans(ctx context.Context, in *QueryPlansRequest, opts ...grpc.CallOption) (*QueryPlansResponse, error) {
+	out := new(QueryPlansResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Plans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Plan(ctx context.Context, in *QueryPlanRequest, opts ...grpc.CallOption) (*QueryPlanResponse, error) {
+	out := new(QueryPlanResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Plan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Stakings(ctx context.Context, in *QueryStakingsRequest, opts ...grpc.CallOption) (*QueryStakingsResponse, error) {
+	out := new(QueryStakingsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Stakings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LockedStakings(ctx context.Context, in *QueryLockedStakingsRequest, opts ...grpc.CallOption) (*QueryLockedStakingsResponse, error) {
+	out := new(QueryLockedStakingsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/LockedStakings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Unbondings(ctx context.Context, in *QueryUnbondingsRequest, opts ...grpc.CallOption) (*QueryUnbondingsResponse, error) {
+	out := new(QueryUnbondingsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Unbondings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StakingsDetail(ctx context.Context, in *QueryStakingsDetailRequest, opts ...grpc.CallOption) (*QueryStakingsDetailResponse, error) {
+	out := new(QueryStakingsDetailResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/StakingsDetail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedStakings(ctx context.Context, in *QueryQueuedStakingsRequest, opts ...grpc.CallOption) (*QueryQueuedStakingsResponse, error) {
+	out := new(QueryQueuedStakingsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/QueuedStakings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StakingsByDenom(ctx context.Context, in *QueryStakingsByDenomRequest, opts ...grpc.CallOption) (*QueryStakingsByDenomResponse, error) {
+	out := new(QueryStakingsByDenomResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/StakingsByDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedStakingsByDenom(ctx context.Context, in *QueryQueuedStakingsByDenomRequest, opts ...grpc.CallOption) (*QueryQueuedStakingsByDenomResponse, error) {
+	out := new(QueryQueuedStakingsByDenomResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/QueuedStakingsByDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalStakings(ctx context.Context, in *QueryTotalStakingsRequest, opts ...grpc.CallOption) (*QueryTotalStakingsResponse, error) {
+	out := new(QueryTotalStakingsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/TotalStakings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Rewards(ctx context.Context, in *QueryRewardsRequest, opts ...grpc.CallOption) (*QueryRewardsResponse, error) {
+	out := new(QueryRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/Rewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardsWithdrawAddress(ctx context.Context, in *QueryRewardsWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryRewardsWithdrawAddressResponse, error) {
+	out := new(QueryRewardsWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/RewardsWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CurrentEpochDuration(ctx context.Context, in *QueryCurrentEpochDurationRequest, opts ...grpc.CallOption) (*QueryCurrentEpochDurationResponse, error) {
+	out := new(QueryCurrentEpochDurationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/CurrentEpochDuration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HistoricalRewards(ctx context.Context, in *QueryHistoricalRewardsRequest, opts ...grpc.CallOption) (*QueryHistoricalRewardsResponse, error) {
+	out := new(QueryHistoricalRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/HistoricalRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error) {
+	out := new(QueryCurrentEpochResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/CurrentEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OutstandingRewards(ctx context.Context, in *QueryOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryOutstandingRewardsResponse, error) {
+	out := new(QueryOutstandingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/OutstandingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AnnualRewards(ctx context.Context, in *QueryAnnualRewardsRequest, opts ...grpc.CallOption) (*QueryAnnualRewardsResponse, error) {
+	out := new(QueryAnnualRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/AnnualRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllocationPreview(ctx context.Context, in *QueryAllocationPreviewRequest, opts ...grpc.CallOption) (*QueryAllocationPreviewResponse, error) {
+	out := new(QueryAllocationPreviewResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/AllocationPreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochInfo(ctx context.Context, in *QueryEpochInfoRequest, opts ...grpc.CallOption) (*QueryEpochInfoResponse, error) {
+	out := new(QueryEpochInfoResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/EpochInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PlanFunding(ctx context.Context, in *QueryPlanFundingRequest, opts ...grpc.CallOption) (*QueryPlanFundingResponse, error) {
+	out := new(QueryPlanFundingResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/PlanFunding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowedStakingDenoms(ctx context.Context, in *QueryAllowedStakingDenomsRequest, opts ...grpc.CallOption) (*QueryAllowedStakingDenomsResponse, error) {
+	out := new(QueryAllowedStakingDenomsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Query/AllowedStakingDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns parameters of the farming module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Plans returns all plans.
+	Plans(context.Context, *QueryPlansRequest) (*QueryPlansResponse, error)
+	// Plan returns a specific plan.
+	Plan(context.Context, *QueryPlanRequest) (*QueryPlanResponse, error)
+	// Stakings returns all stakings by a farmer.
+	Stakings(context.Context, *QueryStakingsRequest) (*QueryStakingsResponse, error)
+	// LockedStakings returns all locked stakings by a farmer.
+	LockedStakings(context.Context, *QueryLockedStakingsRequest) (*QueryLockedStakingsResponse, error)
+	// Unbondings returns all unbondings by a farmer.
+	Unbondings(context.Context, *QueryUnbondingsRequest) (*QueryUnbondingsResponse, error)
+	// StakingsDetail returns all staking and queued staking records by a farmer, with their epoch information.
+	StakingsDetail(context.Context, *QueryStakingsDetailRequest) (*QueryStakingsDetailResponse, error)
+	// QueuedStakings returns all queued stakings by a farmer, with the expected time they become staked.
+	QueuedStakings(context.Context, *QueryQueuedStakingsRequest) (*QueryQueuedStakingsResponse, error)
+	// StakingsByDenom returns all stakings of farmers for a staking coin denom,
+	// including locked stakings.
+	StakingsByDenom(context.Context, *QueryStakingsByDenomRequest) (*QueryStakingsByDenomResponse, error)
+	// QueuedStakingsByDenom returns all queued stakings of farmers for a staking coin denom
+	QueuedStakingsByDenom(context.Context, *QueryQueuedStakingsByDenomRequest) (*QueryQueuedStakingsByDenomResponse, error)
+	// TotalStakings returns total staking amount for a staking coin denom
+	TotalStakings(context.Context, *QueryTotalStakingsRequest) (*QueryTotalStakingsResponse, error)
+	// Rewards returns rewards for a farmer
+	Rewards(context.Context, *QueryRewardsRequest) (*QueryRewardsResponse, error)
+	// RewardsWithdrawAddress returns the address that a farmer's rewards are withdrawn to.
+	RewardsWithdrawAddress(context.Context, *QueryRewardsWithdrawAddressRequest) (*QueryRewardsWithdrawAddressResponse, error)
+	// CurrentEpochDuration returns current epoch duration.
+	CurrentEpochDuration(context.Context, *QueryCurrentEpochDurationRequest) (*QueryCurrentEpochDurationResponse, error)
+	// HistoricalRewards returns historical rewards for a staking coin denom
+	HistoricalRewards(context.Context, *QueryHistoricalRewardsRequest) (*QueryHistoricalRewardsResponse, error)
+	// CurrentEpoch returns the current epoch for a staking coin denom
+	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// OutstandingRewards returns outstanding rewards for a staking coin denom
+	OutstandingRewards(context.Context, *QueryOutstandingRewardsRequest) (*QueryOutstandingRewardsResponse, error)
+	// AnnualRewards returns estimated annual rewards per unit of staking coin
+	AnnualRewards(context.Context, *QueryAnnualRewardsRequest) (*QueryAnnualRewardsResponse, error)
+	// AllocationPreview returns the rewards allocation that will happen at the end of the current epoch
+	AllocationPreview(context.Context, *QueryAllocationPreviewRequest) (*QueryAllocationPreviewResponse, error)
+	// EpochInfo returns the global epoch number and the times of the last and next epochs
+	EpochInfo(context.Context, *QueryEpochInfoRequest) (*QueryEpochInfoResponse, error)
+	// PlanFunding returns the budgets funding the farming pool of a plan with the projected inflow and outflow per epoch
+	PlanFunding(context.Context, *QueryPlanFundingRequest) (*QueryPlanFundingResponse, error)
+	// AllowedStakingDenoms returns the denoms allowed to be staked
+	AllowedStakingDenoms(context.Context, *QueryAllowedStakingDenomsRequest) (*QueryAllowedStakingDenomsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Plans(ctx context.Context, req *QueryPlansRequest) (*QueryPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Plans not implemented")
+}
+func (*UnimplementedQueryServer) Plan(ctx context.Context, req *QueryPlanRequest) (*QueryPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Plan not implemented")
+}
+func (*UnimplementedQueryServer) Stakings(ctx context.Context, req *QueryStakingsRequest) (*QueryStakingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stakings not implemented")
+}
+func (*UnimplementedQueryServer) LockedStakings(ctx context.Context, req *QueryLockedStakingsRequest) (*QueryLockedStakingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockedStakings not implemented")
+}
+func (*UnimplementedQueryServer) Unbondings(ctx context.Context, req *QueryUnbondingsRequest) (*QueryUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unbondings not implemented")
+}
+func (*UnimplementedQueryServer) StakingsDetail(ctx context.Context, req *QueryStakingsDetailRequest) (*QueryStakingsDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakingsDetail not implemented")
+}
+func (*UnimplementedQueryServer) QueuedStakings(ctx context.Context, req *QueryQueuedStakingsRequest) (*QueryQueuedStakingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedStakings not implemented")
+}
+func (*UnimplementedQueryServer) StakingsByDenom(ctx context.Context, req *QueryStakingsByDenomRequest) (*QueryStakingsByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakingsByDenom not implemented")
+}
+func (*UnimplementedQueryServer) QueuedStakingsByDenom(ctx context.Context, req *QueryQueuedStakingsByDenomRequest) (*QueryQueuedStakingsByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedStakingsByDenom not implemented")
+}
+func (*UnimplementedQueryServer) TotalStakings(ctx context.Context, req *QueryTotalStakingsRequest) (*QueryTotalStakingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalStakings not implemented")
+}
+func (*UnimplementedQueryServer) Rewards(ctx context.Context, req *QueryRewardsRequest) (*QueryRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rewards not implemented")
+}
+func (*UnimplementedQueryServer) RewardsWithdrawAddress(ctx context.Context, req *QueryRewardsWithdrawAddressRequest) (*QueryRewardsWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsWithdrawAddress not implemented")
+}
+func (*UnimplementedQueryServer) CurrentEpochDuration(ctx context.Context, req *QueryCurrentEpochDurationRequest) (*QueryCurrentEpochDurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpochDuration not implemented")
+}
+func (*UnimplementedQueryServer) HistoricalRewards(ctx context.Context, req *QueryHistoricalRewardsRequest) (*QueryHistoricalRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalRewards not implemented")
+}
+func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
+}
+func (*UnimplementedQueryServer) OutstandingRewards(ctx context.Context, req *QueryOutstandingRewardsRequest) (*QueryOutstandingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutstandingRewards not implemented")
+}
+func (*UnimplementedQueryServer) AnnualRewards(ctx context.Context, req *QueryAnnualRewardsRequest) (*QueryAnnualRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnualRewards not implemented")
+}
+func (*UnimplementedQueryServer) AllocationPreview(ctx context.Context, req *QueryAllocationPreviewRequest) (*QueryAllocationPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllocationPreview not implemented")
+}
+func (*UnimplementedQueryServer) EpochInfo(ctx context.Context, req *QueryEpochInfoRequest) (*QueryEpochInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochInfo not implemented")
+}
+func (*UnimplementedQueryServer) PlanFunding(ctx context.Context, req *QueryPlanFundingRequest) (*QueryPlanFundingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanFunding not implemented")
+}
+func (*UnimplementedQueryServer) AllowedStakingDenoms(ctx context.Context, req *QueryAllowedStakingDenomsRequest) (*QueryAllowedStakingDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedStakingDenoms not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Plans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Plans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/Plans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Plans(ctx, req.(*QueryPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Plan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Plan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/Plan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Plan(ctx, req.(*QueryPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Stakings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Stakings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/Stakings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Stakings(ctx, req.(*QueryStakingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockedStakings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockedStakingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockedStakings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/LockedStakings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockedStakings(ctx, req.(*QueryLockedStakingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Unbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Unbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/Unbondings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Unbondings(ctx, req.(*QueryUnbondingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StakingsDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakingsDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StakingsDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/StakingsDetail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakingsDetail(ctx, req.(*QueryStakingsDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedStakings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedStakingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedStakings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/QueuedStakings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedStakings(ctx, req.(*QueryQueuedStakingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StakingsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakingsByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StakingsByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/StakingsByDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakingsByDenom(ctx, req.(*QueryStakingsByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedStakingsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedStakingsByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedStakingsByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/QueuedStakingsByDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedStakingsByDenom(ctx, req.(*QueryQueuedStakingsByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalStakings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalStakingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalStakings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/TotalStakings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalStakings(ctx, req.(*QueryTotalStakingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Rewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Rewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/Rewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Rewards(ctx, req.(*QueryRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardsWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsWithdrawAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/RewardsWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsWithdrawAddress(ctx, req.(*QueryRewardsWithdrawAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CurrentEpochDuration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentEpochDurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentEpochDuration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/CurrentEpochDuration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentEpochDuration(ctx, req.(*QueryCurrentEpochDurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HistoricalRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricalRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricalRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/HistoricalRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricalRewards(ctx, req.(*QueryHistoricalRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/CurrentEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentEpoch(ctx, req.(*QueryCurrentEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OutstandingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutstandingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutstandingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/OutstandingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutstandingRewards(ctx, req.(*QueryOutstandingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AnnualRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAnnualRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AnnualRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/AnnualRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AnnualRewards(ctx, req.(*QueryAnnualRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllocationPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllocationPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllocationPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/AllocationPreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllocationPreview(ctx, req.(*QueryAllocationPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/EpochInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochInfo(ctx, req.(*QueryEpochInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PlanFunding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlanFundingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PlanFunding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/PlanFunding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PlanFunding(ctx, req.(*QueryPlanFundingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedStakingDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedStakingDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedStakingDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Query/AllowedStakingDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedStakingDenoms(ctx, req.(*QueryAllowedStakingDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.farming.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Plans",
+			Handler:    _Query_Plans_Handler,
+		},
+		{
+			MethodName: "Plan",
+			Handler:    _Query_Plan_Handler,
+		},
+		{
+			MethodName: "Stakings",
+			Handler:    _Query_Stakings_Handler,
+		},
+		{
+			MethodName: "LockedStakings",
+			Handler:    _Query_LockedStakings_Handler,
+		},
+		{
+			MethodName: "Unbondings",
+			Handler:    _Query_Unbondings_Handler,
+		},
+		{
+			MethodName: "StakingsDetail",
+			Handler:    _Query_StakingsDetail_Handler,
+		},
+		{
+			MethodName: "QueuedStakings",
+			Handler:    _Query_QueuedStakings_Handler,
+		},
+		{
+			MethodName: "StakingsByDenom",
+			Handler:    _Query_StakingsByDenom_Handler,
+		},
+		{
+			MethodName: "QueuedStakingsByDenom",
+			Handler:    _Query_QueuedStakingsByDenom_Handler,
+		},
+		{
+			MethodName: "TotalStakings",
+			Handler:    _Query_TotalStakings_Handler,
+		},
+		{
+			MethodName: "Rewards",
+			Handler:    _Query_Rewards_Handler,
+		},
+		{
+			MethodName: "RewardsWithdrawAddress",
+			Handler:    _Query_RewardsWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "CurrentEpochDuration",
+			Handler:    _Query_CurrentEpochDuration_Handler,
+		},
+		{
+			MethodName: "HistoricalRewards",
+			Handler:    _Query_HistoricalRewards_Handler,
+		},
+		{
+			MethodName: "CurrentEpoch",
+			Handler:    _Query_CurrentEpoch_Handler,
+		},
+		{
+			MethodName: "OutstandingRewards",
+			Handler:    _Query_OutstandingRewards_Handler,
+		},
+		{
+			MethodName: "AnnualRewards",
+			Handler:    _Query_AnnualRewards_Handler,
+		},
+		{
+			MethodName: "AllocationPreview",
+			Handler:    _Query_AllocationPreview_Handler,
+		},
+		{
+			MethodName: "EpochInfo",
+			Handler:    _Query_EpochInfo_Handler,
+		},
+		{
+			MethodName: "PlanFunding",
+			Handler:    _Query_PlanFunding_Handler,
+		},
+		{
+			MethodName: "AllowedStakingDenoms",
+			Handler:    _Query_AllowedStakingDenoms_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tendermint/farming/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlansRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlansRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Terminated) > 0 {
+		i -= len(m.Terminated)
+		copy(dAtA[i:], m.Terminated)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Terminated)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TerminationAddress) > 0 {
+		i -= len(m.TerminationAddress)
+		copy(dAtA[i:], m.TerminationAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TerminationAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FarmingPoolAddress) > 0 {
+		i -= len(m.FarmingPoolAddress)
+		copy(dAtA[i:], m.FarmingPoolAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FarmingPoolAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Plans) > 0 {
+		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlanRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Plan != nil {
+		{
+			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueuedCoins) > 0 {
+		for iNdEx := len(m.QueuedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StakedCoins) > 0 {
+		for iNdEx := len(m.StakedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockedStakingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockedStakingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockedStakingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockedStakingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockedStakingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockedStakingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueuedLockedStakings) > 0 {
+		for iNdEx := len(m.QueuedLockedStakings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedLockedStakings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.LockedStakings) > 0 {
+		for iNdEx := len(m.LockedStakings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedStakings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingsDetailRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingsDetailRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingsDetailRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingsDetailResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingsDetailResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingsDetailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextEpochTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NextEpochTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextEpochTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintQuery(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.QueuedStakings) > 0 {
+		for iNdEx := len(m.QueuedStakings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedStakings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Stakings) > 0 {
+		for iNdEx := len(m.Stakings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stakings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedStakingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedStakingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedStakingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedStakingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedStakingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedStakingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextEpochTime != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NextEpochTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextEpochTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintQuery(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.QueuedStakings) > 0 {
+		for iNdEx := len(m.QueuedStakings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedStakings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakingDetail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakingDetail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakingDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartingEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartingEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedStakingDetail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedStakingDetail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedStakingDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingsByDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingsByDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingsByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueuedLockedStakings) > 0 {
+		for iNdEx := len(m.QueuedLockedStakings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedLockedStakings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LockedStakings) > 0 {
+		for iNdEx := len(m.LockedStakings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedStakings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stakings) > 0 {
+		for iNdEx := len(m.Stakings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stakings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FarmerStaking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FarmerStaking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FarmerStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartingEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartingEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedStakingsByDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedStakingsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedStakingsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedStakingsByDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedStakingsByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedStakingsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.QueuedStakings) > 0 {
+		for iNdEx := len(m.QueuedStakings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedStakings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FarmerQueuedStaking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FarmerQueuedStaking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FarmerQueuedStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalStakingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalStakingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalStakingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalStakingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalStakingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalStakingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsWithdrawAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsWithdrawAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsWithdrawAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentEpochDurationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentEpochDurationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentEpochDurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentEpochDurationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentEpochDurationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentEpochDurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CurrentEpochDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CurrentEpochDuration):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintQuery(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricalRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricalRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricalRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndingEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndingEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartingEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartingEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricalRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricalRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricalRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HistoricalRewards) > 0 {
+		for iNdEx := len(m.HistoricalRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoricalRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoricalRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReferenceCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReferenceCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CumulativeUnitRewards) > 0 {
+		for iNdEx := len(m.CumulativeUnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeUnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentEpochRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentEpochRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutstandingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutstandingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutstandingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutstandingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutstandingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutstandingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EpochsPerYear.Size()
+		i -= size
+		if _, err := m.EpochsPerYear.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.AnnualRewards) > 0 {
+		for iNdEx := len(m.AnnualRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AnnualRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakingCoinAnnualRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakingCoinAnnualRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakingCoinAnnualRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Plans) > 0 {
+		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AnnualUnitRewards) > 0 {
+		for iNdEx := len(m.AnnualUnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AnnualUnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EpochUnitRewards) > 0 {
+		for iNdEx := len(m.EpochUnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochUnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.TotalStakingAmount.Size()
+		i -= size
+		if _, err := m.TotalStakingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlanAnnualRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlanAnnualRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlanAnnualRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AnnualUnitRewards) > 0 {
+		for iNdEx := len(m.AnnualUnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AnnualUnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EpochUnitRewards) > 0 {
+		for iNdEx := len(m.EpochUnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochUnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.EpochRewards) > 0 {
+		for iNdEx := len(m.EpochRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllocationPreviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllocationPreviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllocationPreviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllocationPreviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllocationPreviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllocationPreviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SkippedPlans) > 0 {
+		for iNdEx := len(m.SkippedPlans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SkippedPlans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.UnitRewards) > 0 {
+		for iNdEx := len(m.UnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlanAllocationPreview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlanAllocationPreview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlanAllocationPreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FarmingPoolAddress) > 0 {
+		i -= len(m.FarmingPoolAddress)
+		copy(dAtA[i:], m.FarmingPoolAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FarmingPoolAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnitRewardsPreview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnitRewardsPreview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnitRewardsPreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnitRewards) > 0 {
+		for iNdEx := len(m.UnitRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnitRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StakingCoinDenom) > 0 {
+		i -= len(m.StakingCoinDenom)
+		copy(dAtA[i:], m.StakingCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SkippedPlanPreview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SkippedPlanPreview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SkippedPlanPreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FarmingPoolAddress) > 0 {
+		i -= len(m.FarmingPoolAddress)
+		copy(dAtA[i:], m.FarmingPoolAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FarmingPoolAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextEpochTime != nil {
+		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NextEpochTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextEpochTime):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintQuery(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.NextEpochDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.NextEpochDuration):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintQuery(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x22
+	n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CurrentEpochDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CurrentEpochDuration):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintQuery(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x1a
+	if m.LastEpochTime != nil {
+		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastEpochTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastEpochTime):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintQuery(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GlobalEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GlobalEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlanFundingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlanFundingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlanFundingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlanFundingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlanFundingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlanFundingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sufficient {
+		i--
+		if m.Sufficient {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.TotalEpochOutflow) > 0 {
+		for iNdEx := len(m.TotalEpochOutflow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalEpochOutflow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.EpochOutflow) > 0 {
+		for iNdEx := len(m.EpochOutflow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochOutflow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ProjectedInflow) > 0 {
+		for iNdEx := len(m.ProjectedInflow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProjectedInflow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Budgets) > 0 {
+		for iNdEx := len(m.Budgets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Budgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FarmingPoolAddress) > 0 {
+		i -= len(m.FarmingPoolAddress)
+		copy(dAtA[i:], m.FarmingPoolAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FarmingPoolAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundingBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProjectedInflow) > 0 {
+		for iNdEx := len(m.ProjectedInflow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProjectedInflow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TotalCollectedCoins) > 0 {
+		for iNdEx := len(m.TotalCollectedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalCollectedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SourceAddress) > 0 {
+		i -= len(m.SourceAddress)
+		copy(dAtA[i:], m.SourceAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedStakingDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedStakingDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedStakingDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedStakingDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedStakingDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedStakingDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPlansRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FarmingPoolAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TerminationAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Terminated)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Plans) > 0 {
+		for _, e := range m.Plans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovQuery(uint64(m.PlanId))
+	}
+	return n
+}
+
+func (m *QueryPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Plan != nil {
+		l = m.Plan.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StakedCoins) > 0 {
+		for _, e := range m.StakedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.QueuedCoins) > 0 {
+		for _, e := range m.QueuedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLockedStakingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockedStakingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LockedStakings) > 0 {
+		for _, e := range m.LockedStakings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.QueuedLockedStakings) > 0 {
+		for _, e := range m.QueuedLockedStakings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUnbondingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStakingsDetailRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakingsDetailResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stakings) > 0 {
+		for _, e := range m.Stakings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.QueuedStakings) > 0 {
+		for _, e := range m.QueuedStakings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextEpochTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextEpochTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueuedStakingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueuedStakingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QueuedStakings) > 0 {
+		for _, e := range m.QueuedStakings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextEpochTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextEpochTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *StakingDetail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.StartingEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.StartingEpoch))
+	}
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	}
+	return n
+}
+
+func (m *QueuedStakingDetail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStakingsByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakingsByDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stakings) > 0 {
+		for _, e := range m.Stakings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.LockedStakings) > 0 {
+		for _, e := range m.LockedStakings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.QueuedLockedStakings) > 0 {
+		for _, e := range m.QueuedLockedStakings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FarmerStaking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.StartingEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.StartingEpoch))
+	}
+	return n
+}
+
+func (m *QueryQueuedStakingsByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueuedStakingsByDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QueuedStakings) > 0 {
+		for _, e := range m.QueuedStakings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *FarmerQueuedStaking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalStakingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalStakingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRewardsWithdrawAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCurrentEpochDurationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentEpochDurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CurrentEpochDuration)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHistoricalRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartingEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.StartingEpoch))
+	}
+	if m.EndingEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.EndingEpoch))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHistoricalRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HistoricalRewards) > 0 {
+		for _, e := range m.HistoricalRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *HistoricalRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if len(m.CumulativeUnitRewards) > 0 {
+		for _, e := range m.CumulativeUnitRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ReferenceCount != 0 {
+		n += 1 + sovQuery(uint64(m.ReferenceCount))
+	}
+	return n
+}
+
+func (m *QueryCurrentEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCurrentEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	}
+	return n
+}
+
+func (m *QueryOutstandingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutstandingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAnnualRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PlanId != 0 {
+		n += 1 + sovQuery(uint64(m.PlanId))
+	}
+	return n
+}
+
+func (m *QueryAnnualRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AnnualRewards) > 0 {
+		for _, e := range m.AnnualRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.EpochsPerYear.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *StakingCoinAnnualRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TotalStakingAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.EpochUnitRewards) > 0 {
+		for _, e := range m.EpochUnitRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.AnnualUnitRewards) > 0 {
+		for _, e := range m.AnnualUnitRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Plans) > 0 {
+		for _, e := range m.Plans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PlanAnnualRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovQuery(uint64(m.PlanId))
+	}
+	if len(m.EpochRewards) > 0 {
+		for _, e := range m.EpochRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.EpochUnitRewards) > 0 {
+		for _, e := range m.EpochUnitRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.AnnualUnitRewards) > 0 {
+		for _, e := range m.AnnualUnitRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllocationPreviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllocationPreviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UnitRewards) > 0 {
+		for _, e := range m.UnitRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SkippedPlans) > 0 {
+		for _, e := range m.SkippedPlans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PlanAllocationPreview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovQuery(uint64(m.PlanId))
+	}
+	l = len(m.FarmingPoolAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnitRewardsPreview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.UnitRewards) > 0 {
+		for _, e := range m.UnitRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SkippedPlanPreview) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
-	if m.CurrentEpochDays != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentEpochDays))
+	if m.PlanId != 0 {
+		n += 1 + sovQuery(uint64(m.PlanId))
+	}
+	l = len(m.FarmingPoolAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEpochInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.GlobalEpoch))
+	}
+	if m.LastEpochTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastEpochTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CurrentEpochDuration)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.NextEpochDuration)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.NextEpochTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextEpochTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanFundingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovQuery(uint64(m.PlanId))
+	}
+	return n
+}
+
+func (m *QueryPlanFundingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovQuery(uint64(m.PlanId))
+	}
+	l = len(m.FarmingPoolAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Budgets) > 0 {
+		for _, e := range m.Budgets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ProjectedInflow) > 0 {
+		for _, e := range m.ProjectedInflow {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.EpochOutflow) > 0 {
+		for _, e := range m.EpochOutflow {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalEpochOutflow) > 0 {
+		for _, e := range m.TotalEpochOutflow {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Sufficient {
+		n += 2
+	}
+	return n
+}
+
+func (m *FundingBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.SourceAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.TotalCollectedCoins) > 0 {
+		for _, e := range m.TotalCollectedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ProjectedInflow) > 0 {
+		for _, e := range m.ProjectedInflow {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllowedStakingDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllowedStakingDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlansRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FarmingPoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TerminationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Terminated = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlansResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plans = append(m.Plans, &types.Any{})
+			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plan == nil {
+				m.Plan = &types.Any{}
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakedCoins = append(m.StakedCoins, types1.Coin{})
+			if err := m.StakedCoins[len(m.StakedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedCoins = append(m.QueuedCoins, types1.Coin{})
+			if err := m.QueuedCoins[len(m.QueuedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockedStakingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockedStakingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockedStakingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockedStakingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockedStakingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockedStakingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedStakings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedStakings = append(m.LockedStakings, LockedStaking{})
+			if err := m.LockedStakings[len(m.LockedStakings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedLockedStakings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedLockedStakings = append(m.QueuedLockedStakings, LockedStaking{})
+			if err := m.QueuedLockedStakings[len(m.QueuedLockedStakings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbondings = append(m.Unbondings, Unbonding{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingsDetailRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingsDetailRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingsDetailRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingsDetailResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingsDetailResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingsDetailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stakings = append(m.Stakings, StakingDetail{})
+			if err := m.Stakings[len(m.Stakings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedStakings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedStakings = append(m.QueuedStakings, QueuedStakingDetail{})
+			if err := m.QueuedStakings[len(m.QueuedStakings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextEpochTime == nil {
+				m.NextEpochTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.NextEpochTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedStakingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedStakingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedStakingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedStakingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedStakingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedStakingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedStakings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedStakings = append(m.QueuedStakings, QueuedStakingDetail{})
+			if err := m.QueuedStakings[len(m.QueuedStakings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextEpochTime == nil {
+				m.NextEpochTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.NextEpochTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakingDetail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakingDetail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakingDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingEpoch", wireType)
+			}
+			m.StartingEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartingEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedStakingDetail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedStakingDetail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedStakingDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingsByDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingsByDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingsByDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingsByDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stakings = append(m.Stakings, FarmerStaking{})
+			if err := m.Stakings[len(m.Stakings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedStakings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedStakings = append(m.LockedStakings, LockedStaking{})
+			if err := m.LockedStakings[len(m.LockedStakings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedLockedStakings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedLockedStakings = append(m.QueuedLockedStakings, LockedStaking{})
+			if err := m.QueuedLockedStakings[len(m.QueuedLockedStakings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FarmerStaking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FarmerStaking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FarmerStaking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingEpoch", wireType)
+			}
+			m.StartingEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartingEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedStakingsByDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedStakingsByDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedStakingsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedStakingsByDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedStakingsByDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedStakingsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedStakings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedStakings = append(m.QueuedStakings, FarmerQueuedStaking{})
+			if err := m.QueuedStakings[len(m.QueuedStakings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FarmerQueuedStaking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FarmerQueuedStaking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FarmerQueuedStaking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalStakingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalStakingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalStakingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalStakingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalStakingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalStakingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types1.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsWithdrawAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsWithdrawAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsWithdrawAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentEpochDurationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentEpochDurationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentEpochDurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentEpochDurationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentEpochDurationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentEpochDurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CurrentEpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoricalRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingEpoch", wireType)
+			}
+			m.StartingEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartingEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndingEpoch", wireType)
+			}
+			m.EndingEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndingEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoricalRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoricalRewards = append(m.HistoricalRewards, HistoricalRewardsResponse{})
+			if err := m.HistoricalRewards[len(m.HistoricalRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoricalRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeUnitRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CumulativeUnitRewards = append(m.CumulativeUnitRewards, types1.DecCoin{})
+			if err := m.CumulativeUnitRewards[len(m.CumulativeUnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
+			}
+			m.ReferenceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferenceCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentEpochRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutstandingRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutstandingRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types1.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnualRewards = append(m.AnnualRewards, StakingCoinAnnualRewards{})
+			if err := m.AnnualRewards[len(m.AnnualRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsPerYear", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochsPerYear.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakingCoinAnnualRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakingCoinAnnualRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakingCoinAnnualRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStakingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalStakingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochUnitRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochUnitRewards = append(m.EpochUnitRewards, types1.DecCoin{})
+			if err := m.EpochUnitRewards[len(m.EpochUnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualUnitRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnualUnitRewards = append(m.AnnualUnitRewards, types1.DecCoin{})
+			if err := m.AnnualUnitRewards[len(m.AnnualUnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plans = append(m.Plans, PlanAnnualRewards{})
+			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlanAnnualRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlanAnnualRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlanAnnualRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochRewards = append(m.EpochRewards, types1.Coin{})
+			if err := m.EpochRewards[len(m.EpochRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochUnitRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochUnitRewards = append(m.EpochUnitRewards, types1.DecCoin{})
+			if err := m.EpochUnitRewards[len(m.EpochUnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualUnitRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnualUnitRewards = append(m.AnnualUnitRewards, types1.DecCoin{})
+			if err := m.AnnualUnitRewards[len(m.AnnualUnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *QueryAllocationPreviewRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllocationPreviewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllocationPreviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryAllocationPreviewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllocationPreviewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllocationPreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, PlanAllocationPreview{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnitRewards = append(m.UnitRewards, UnitRewardsPreview{})
+			if err := m.UnitRewards[len(m.UnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedPlans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SkippedPlans = append(m.SkippedPlans, SkippedPlanPreview{})
+			if err := m.SkippedPlans[len(m.SkippedPlans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *PlanAllocationPreview) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1917,12 +12704,97 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlanAllocationPreview: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlanAllocationPreview: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FarmingPoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1944,7 +12816,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *UnitRewardsPreview) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1967,15 +12839,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnitRewardsPreview: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnitRewardsPreview: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2002,7 +12906,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnitRewards = append(m.UnitRewards, types1.DecCoin{})
+			if err := m.UnitRewards[len(m.UnitRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2027,7 +12932,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
+func (m *SkippedPlanPreview) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2050,15 +12955,34 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlansRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SkippedPlanPreview: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SkippedPlanPreview: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2086,13 +13010,13 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.FarmingPoolAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2102,27 +13026,29 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FarmingPoolAddress = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TerminationAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2150,13 +13076,113 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TerminationAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalEpoch", wireType)
 			}
-			var stringLen uint64
+			m.GlobalEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2166,29 +13192,16 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GlobalEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2198,27 +13211,31 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Terminated = string(dAtA[iNdEx:postIndex])
+			if m.LastEpochTime == nil {
+				m.LastEpochTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastEpochTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2245,66 +13262,13 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CurrentEpochDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlansResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2331,14 +13295,13 @@ func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Plans = append(m.Plans, &types.Any{})
-			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.NextEpochDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2365,10 +13328,10 @@ func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.NextEpochTime == nil {
+				m.NextEpochTime = new(time.Time)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.NextEpochTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2393,7 +13356,7 @@ func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPlanRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPlanFundingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2416,10 +13379,10 @@ func (m *QueryPlanRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlanRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPlanFundingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPlanFundingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2462,7 +13425,7 @@ func (m *QueryPlanRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPlanResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPlanFundingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2485,17 +13448,17 @@ func (m *QueryPlanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPlanFundingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPlanFundingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			var msglen int
+			m.PlanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2505,81 +13468,14 @@ func (m *QueryPlanResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PlanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Plan == nil {
-				m.Plan = &types.Any{}
-			}
-			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryStakingsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakingsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2607,13 +13503,13 @@ func (m *QueryStakingsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Farmer = string(dAtA[iNdEx:postIndex])
+			m.FarmingPoolAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Budgets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2623,77 +13519,29 @@ func (m *QueryStakingsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Budgets = append(m.Budgets, FundingBudget{})
+			if err := m.Budgets[len(m.Budgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryStakingsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakingsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedInflow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2720,14 +13568,14 @@ func (m *QueryStakingsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakedCoins = append(m.StakedCoins, types1.Coin{})
-			if err := m.StakedCoins[len(m.StakedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ProjectedInflow = append(m.ProjectedInflow, types1.Coin{})
+			if err := m.ProjectedInflow[len(m.ProjectedInflow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochOutflow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2754,66 +13602,16 @@ func (m *QueryStakingsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueuedCoins = append(m.QueuedCoins, types1.Coin{})
-			if err := m.QueuedCoins[len(m.QueuedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EpochOutflow = append(m.EpochOutflow, types1.Coin{})
+			if err := m.EpochOutflow[len(m.EpochOutflow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalStakingsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalStakingsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalStakingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEpochOutflow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2823,24 +13621,46 @@ func (m *QueryTotalStakingsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			m.TotalEpochOutflow = append(m.TotalEpochOutflow, types1.Coin{})
+			if err := m.TotalEpochOutflow[len(m.TotalEpochOutflow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sufficient", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sufficient = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2862,7 +13682,7 @@ func (m *QueryTotalStakingsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalStakingsResponse) Unmarshal(dAtA []byte) error {
+func (m *FundingBudget) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2885,15 +13705,15 @@ func (m *QueryTotalStakingsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalStakingsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FundingBudget: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalStakingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FundingBudget: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2921,63 +13741,11 @@ func (m *QueryTotalStakingsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3005,11 +13773,13 @@ func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Farmer = string(dAtA[iNdEx:postIndex])
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3037,61 +13807,45 @@ func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingCoinDenom = string(dAtA[iNdEx:postIndex])
+			m.SourceAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollectedCoins", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TotalCollectedCoins = append(m.TotalCollectedCoins, types1.Coin{})
+			if err := m.TotalCollectedCoins[len(m.TotalCollectedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedInflow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3118,8 +13872,8 @@ func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types1.Coin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ProjectedInflow = append(m.ProjectedInflow, types1.Coin{})
+			if err := m.ProjectedInflow[len(m.ProjectedInflow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3144,7 +13898,7 @@ func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentEpochDaysRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowedStakingDenomsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3167,10 +13921,10 @@ func (m *QueryCurrentEpochDaysRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentEpochDaysRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowedStakingDenomsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentEpochDaysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowedStakingDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3194,7 +13948,7 @@ func (m *QueryCurrentEpochDaysRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentEpochDaysResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowedStakingDenomsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3217,17 +13971,17 @@ func (m *QueryCurrentEpochDaysResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentEpochDaysResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowedStakingDenomsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentEpochDaysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowedStakingDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochDays", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
-			m.CurrentEpochDays = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3237,11 +13991,24 @@ func (m *QueryCurrentEpochDaysResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentEpochDays |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
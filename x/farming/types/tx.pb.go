@@ -16,6 +16,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -88,6 +89,10 @@ var xxx_messageInfo_MsgCreateFixedAmountPlan proto.InternalMessageInfo
 
 // MsgCreateFixedAmountPlanResponse defines the MsgCreateFixedAmountPlanResponse response type.
 type MsgCreateFixedAmountPlanResponse struct {
+	// plan_id specifies index of the created plan
+	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// farming_pool_address defines the bech32-encoded address of the farming pool of the created plan
+	FarmingPoolAddress string `protobuf:"bytes,2,opt,name=farming_pool_address,json=farmingPoolAddress,proto3" json:"farming_pool_address,omitempty"`
 }
 
 func (m *MsgCreateFixedAmountPlanResponse) Reset()         { *m = MsgCreateFixedAmountPlanResponse{} }
@@ -123,6 +128,20 @@ func (m *MsgCreateFixedAmountPlanResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateFixedAmountPlanResponse proto.InternalMessageInfo
 
+func (m *MsgCreateFixedAmountPlanResponse) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
+	}
+	return 0
+}
+
+func (m *MsgCreateFixedAmountPlanResponse) GetFarmingPoolAddress() string {
+	if m != nil {
+		return m.FarmingPoolAddress
+	}
+	return ""
+}
+
 // MsgCreateRatioPlan defines a SDK message for creating a new ratio farming
 // plan.
 type MsgCreateRatioPlan struct {
@@ -177,6 +196,10 @@ var xxx_messageInfo_MsgCreateRatioPlan proto.InternalMessageInfo
 // MsgCreateRatioPlanResponse  defines the Msg/MsgCreateRatioPlanResponse
 // response type.
 type MsgCreateRatioPlanResponse struct {
+	// plan_id specifies index of the created plan
+	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// farming_pool_address defines the bech32-encoded address of the farming pool of the created plan
+	FarmingPoolAddress string `protobuf:"bytes,2,opt,name=farming_pool_address,json=farmingPoolAddress,proto3" json:"farming_pool_address,omitempty"`
 }
 
 func (m *MsgCreateRatioPlanResponse) Reset()         { *m = MsgCreateRatioPlanResponse{} }
@@ -212,19 +235,153 @@ func (m *MsgCreateRatioPlanResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateRatioPlanResponse proto.InternalMessageInfo
 
+func (m *MsgCreateRatioPlanResponse) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
+	}
+	return 0
+}
+
+func (m *MsgCreateRatioPlanResponse) GetFarmingPoolAddress() string {
+	if m != nil {
+		return m.FarmingPoolAddress
+	}
+	return ""
+}
+
+// MsgCreateDecayingPlan defines a SDK message for creating a new decaying
+// farming plan.
+type MsgCreateDecayingPlan struct {
+	// name specifies the name for the plan
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// creator defines the bech32-encoded address of the creator for the private plan, termination address is also set to
+	// this creator.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// staking_coin_weights specifies coins weight for the plan
+	StakingCoinWeights github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=staking_coin_weights,json=stakingCoinWeights,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"staking_coin_weights" yaml:"staking_coin_weights"`
+	// start_time specifies the start time of the plan
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// end_time specifies the end time of the plan
+	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	// epoch_amount specifies the initial distributing amount for each epoch
+	EpochAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=epoch_amount,json=epochAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_amount" yaml:"epoch_amount"`
+	// decay_factor specifies the factor the epoch amount is multiplied by
+	// on each decay
+	DecayFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=decay_factor,json=decayFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_factor" yaml:"decay_factor"`
+	// decay_epochs specifies the number of epochs between decays
+	DecayEpochs uint32 `protobuf:"varint,8,opt,name=decay_epochs,json=decayEpochs,proto3" json:"decay_epochs,omitempty" yaml:"decay_epochs"`
+	// decay_times specifies the times at which the epoch amount decays
+	DecayTimes []time.Time `protobuf:"bytes,9,rep,name=decay_times,json=decayTimes,proto3,stdtime" json:"decay_times" yaml:"decay_times"`
+}
+
+func (m *MsgCreateDecayingPlan) Reset()         { *m = MsgCreateDecayingPlan{} }
+func (m *MsgCreateDecayingPlan) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDecayingPlan) ProtoMessage()    {}
+func (*MsgCreateDecayingPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{4}
+}
+func (m *MsgCreateDecayingPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDecayingPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDecayingPlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateDecayingPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDecayingPlan.Merge(m, src)
+}
+func (m *MsgCreateDecayingPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDecayingPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDecayingPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateDecayingPlan proto.InternalMessageInfo
+
+// MsgCreateDecayingPlanResponse defines the Msg/MsgCreateDecayingPlanResponse
+// response type.
+type MsgCreateDecayingPlanResponse struct {
+	// plan_id specifies index of the created plan
+	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// farming_pool_address defines the bech32-encoded address of the farming pool of the created plan
+	FarmingPoolAddress string `protobuf:"bytes,2,opt,name=farming_pool_address,json=farmingPoolAddress,proto3" json:"farming_pool_address,omitempty"`
+}
+
+func (m *MsgCreateDecayingPlanResponse) Reset()         { *m = MsgCreateDecayingPlanResponse{} }
+func (m *MsgCreateDecayingPlanResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDecayingPlanResponse) ProtoMessage()    {}
+func (*MsgCreateDecayingPlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{5}
+}
+func (m *MsgCreateDecayingPlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDecayingPlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDecayingPlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateDecayingPlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDecayingPlanResponse.Merge(m, src)
+}
+func (m *MsgCreateDecayingPlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDecayingPlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDecayingPlanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateDecayingPlanResponse proto.InternalMessageInfo
+
+func (m *MsgCreateDecayingPlanResponse) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
+	}
+	return 0
+}
+
+func (m *MsgCreateDecayingPlanResponse) GetFarmingPoolAddress() string {
+	if m != nil {
+		return m.FarmingPoolAddress
+	}
+	return ""
+}
+
 // MsgStake defines a SDK message for staking coins into the farming plan.
 type MsgStake struct {
 	// farmer defines the bech32-encoded address of the farmer
 	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
 	// staking_coins specifies coins to stake
 	StakingCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=staking_coins,json=stakingCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"staking_coins" yaml:"staking_coins"`
+	// lock_duration specifies the duration of one of the lock tiers to lock the staking coins for.
+	// Zero means the staking coins are not locked.
+	LockDuration time.Duration `protobuf:"bytes,3,opt,name=lock_duration,json=lockDuration,proto3,stdduration" json:"lock_duration" yaml:"lock_duration"`
+	// payer defines the bech32-encoded address of the account that pays the staking coins
+	// and signs the message on behalf of the farmer. Empty means the farmer pays.
+	Payer string `protobuf:"bytes,4,opt,name=payer,proto3" json:"payer,omitempty"`
 }
 
 func (m *MsgStake) Reset()         { *m = MsgStake{} }
 func (m *MsgStake) String() string { return proto.CompactTextString(m) }
 func (*MsgStake) ProtoMessage()    {}
 func (*MsgStake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{4}
+	return fileDescriptor_a33d9a3ff13f514a, []int{6}
 }
 func (m *MsgStake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -255,13 +412,15 @@ var xxx_messageInfo_MsgStake proto.InternalMessageInfo
 
 // MsgStakeResponse  defines the Msg/MsgStakeResponse response type.
 type MsgStakeResponse struct {
+	// locked_staking_ids are the ids of the locked stakings created, one for each staking coin denom
+	LockedStakingIds []uint64 `protobuf:"varint,1,rep,packed,name=locked_staking_ids,json=lockedStakingIds,proto3" json:"locked_staking_ids,omitempty"`
 }
 
 func (m *MsgStakeResponse) Reset()         { *m = MsgStakeResponse{} }
 func (m *MsgStakeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgStakeResponse) ProtoMessage()    {}
 func (*MsgStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{5}
+	return fileDescriptor_a33d9a3ff13f514a, []int{7}
 }
 func (m *MsgStakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -290,6 +449,13 @@ func (m *MsgStakeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgStakeResponse proto.InternalMessageInfo
 
+func (m *MsgStakeResponse) GetLockedStakingIds() []uint64 {
+	if m != nil {
+		return m.LockedStakingIds
+	}
+	return nil
+}
+
 // MsgUnstake defines a SDK message for performing unstaking of coins from the
 // farming plan.
 type MsgUnstake struct {
@@ -303,7 +469,7 @@ func (m *MsgUnstake) Reset()         { *m = MsgUnstake{} }
 func (m *MsgUnstake) String() string { return proto.CompactTextString(m) }
 func (*MsgUnstake) ProtoMessage()    {}
 func (*MsgUnstake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{6}
+	return fileDescriptor_a33d9a3ff13f514a, []int{8}
 }
 func (m *MsgUnstake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -334,13 +500,15 @@ var xxx_messageInfo_MsgUnstake proto.InternalMessageInfo
 
 // MsgUnstakeResponse defines the Msg/MsgUnstakeResponse response type.
 type MsgUnstakeResponse struct {
+	// withdrawn_rewards specifies rewards withdrawn to the farmer as a side effect of unstaking
+	WithdrawnRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=withdrawn_rewards,json=withdrawnRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn_rewards" yaml:"withdrawn_rewards"`
 }
 
 func (m *MsgUnstakeResponse) Reset()         { *m = MsgUnstakeResponse{} }
 func (m *MsgUnstakeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnstakeResponse) ProtoMessage()    {}
 func (*MsgUnstakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{7}
+	return fileDescriptor_a33d9a3ff13f514a, []int{9}
 }
 func (m *MsgUnstakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -369,6 +537,13 @@ func (m *MsgUnstakeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnstakeResponse proto.InternalMessageInfo
 
+func (m *MsgUnstakeResponse) GetWithdrawnRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WithdrawnRewards
+	}
+	return nil
+}
+
 // MsgHarvest defines a SDK message for claiming rewards from the farming plan.
 type MsgHarvest struct {
 	// farmer defines the bech32-encoded address of the farmer
@@ -376,13 +551,16 @@ type MsgHarvest struct {
 	// staking_coin_denoms is the set of denoms of staked coins as a source of the reward for
 	// harvesting
 	StakingCoinDenoms []string `protobuf:"bytes,2,rep,name=staking_coin_denoms,json=stakingCoinDenoms,proto3" json:"staking_coin_denoms,omitempty" yaml:"staking_coin_denoms"`
+	// stake_rewards specifies whether to stake the harvested rewards whose denoms are staking coin denoms
+	// of the plans, instead of sending them to the farmer
+	StakeRewards bool `protobuf:"varint,3,opt,name=stake_rewards,json=stakeRewards,proto3" json:"stake_rewards,omitempty" yaml:"stake_rewards"`
 }
 
 func (m *MsgHarvest) Reset()         { *m = MsgHarvest{} }
 func (m *MsgHarvest) String() string { return proto.CompactTextString(m) }
 func (*MsgHarvest) ProtoMessage()    {}
 func (*MsgHarvest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{8}
+	return fileDescriptor_a33d9a3ff13f514a, []int{10}
 }
 func (m *MsgHarvest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -413,13 +591,17 @@ var xxx_messageInfo_MsgHarvest proto.InternalMessageInfo
 
 // MsgHarvestResponse defines the Msg/MsgHarvestResponse response type.
 type MsgHarvestResponse struct {
+	// harvested_rewards specifies rewards withdrawn to the farmer
+	HarvestedRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=harvested_rewards,json=harvestedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"harvested_rewards" yaml:"harvested_rewards"`
+	// staked_rewards specifies the part of the harvested rewards that is staked as queued coins
+	StakedRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=staked_rewards,json=stakedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"staked_rewards" yaml:"staked_rewards"`
 }
 
 func (m *MsgHarvestResponse) Reset()         { *m = MsgHarvestResponse{} }
 func (m *MsgHarvestResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgHarvestResponse) ProtoMessage()    {}
 func (*MsgHarvestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{9}
+	return fileDescriptor_a33d9a3ff13f514a, []int{11}
 }
 func (m *MsgHarvestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -448,6 +630,20 @@ func (m *MsgHarvestResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgHarvestResponse proto.InternalMessageInfo
 
+func (m *MsgHarvestResponse) GetHarvestedRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.HarvestedRewards
+	}
+	return nil
+}
+
+func (m *MsgHarvestResponse) GetStakedRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.StakedRewards
+	}
+	return nil
+}
+
 // MsgAdvanceEpoch defines a message to advance epoch by one.
 type MsgAdvanceEpoch struct {
 	// requester defines the bech32-encoded address of the requester
@@ -458,7 +654,7 @@ func (m *MsgAdvanceEpoch) Reset()         { *m = MsgAdvanceEpoch{} }
 func (m *MsgAdvanceEpoch) String() string { return proto.CompactTextString(m) }
 func (*MsgAdvanceEpoch) ProtoMessage()    {}
 func (*MsgAdvanceEpoch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{10}
+	return fileDescriptor_a33d9a3ff13f514a, []int{12}
 }
 func (m *MsgAdvanceEpoch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -495,7 +691,7 @@ func (m *MsgAdvanceEpochResponse) Reset()         { *m = MsgAdvanceEpochResponse
 func (m *MsgAdvanceEpochResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAdvanceEpochResponse) ProtoMessage()    {}
 func (*MsgAdvanceEpochResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a33d9a3ff13f514a, []int{11}
+	return fileDescriptor_a33d9a3ff13f514a, []int{13}
 }
 func (m *MsgAdvanceEpochResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -524,1015 +720,5160 @@ func (m *MsgAdvanceEpochResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAdvanceEpochResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateFixedAmountPlan)(nil), "cosmos.farming.v1beta1.MsgCreateFixedAmountPlan")
-	proto.RegisterType((*MsgCreateFixedAmountPlanResponse)(nil), "cosmos.farming.v1beta1.MsgCreateFixedAmountPlanResponse")
-	proto.RegisterType((*MsgCreateRatioPlan)(nil), "cosmos.farming.v1beta1.MsgCreateRatioPlan")
-	proto.RegisterType((*MsgCreateRatioPlanResponse)(nil), "cosmos.farming.v1beta1.MsgCreateRatioPlanResponse")
-	proto.RegisterType((*MsgStake)(nil), "cosmos.farming.v1beta1.MsgStake")
-	proto.RegisterType((*MsgStakeResponse)(nil), "cosmos.farming.v1beta1.MsgStakeResponse")
-	proto.RegisterType((*MsgUnstake)(nil), "cosmos.farming.v1beta1.MsgUnstake")
-	proto.RegisterType((*MsgUnstakeResponse)(nil), "cosmos.farming.v1beta1.MsgUnstakeResponse")
-	proto.RegisterType((*MsgHarvest)(nil), "cosmos.farming.v1beta1.MsgHarvest")
-	proto.RegisterType((*MsgHarvestResponse)(nil), "cosmos.farming.v1beta1.MsgHarvestResponse")
-	proto.RegisterType((*MsgAdvanceEpoch)(nil), "cosmos.farming.v1beta1.MsgAdvanceEpoch")
-	proto.RegisterType((*MsgAdvanceEpochResponse)(nil), "cosmos.farming.v1beta1.MsgAdvanceEpochResponse")
+// MsgModifyPrivatePlan defines a SDK message for modifying an existing private
+// farming plan. Only non-empty fields are updated.
+type MsgModifyPrivatePlan struct {
+	// plan_id specifies index of the farming plan
+	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// termination_address defines the bech32-encoded termination address of the plan
+	TerminationAddress string `protobuf:"bytes,2,opt,name=termination_address,json=terminationAddress,proto3" json:"termination_address,omitempty"`
+	// name specifies the new name for the plan
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// staking_coin_weights specifies the new coins weight for the plan
+	StakingCoinWeights github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=staking_coin_weights,json=stakingCoinWeights,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"staking_coin_weights" yaml:"staking_coin_weights"`
+	// start_time specifies the new start time of the plan
+	StartTime *time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty" yaml:"start_time"`
+	// end_time specifies the new end time of the plan
+	EndTime *time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+	// epoch_amount specifies the new distributing amount for each epoch
+	EpochAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=epoch_amount,json=epochAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_amount" yaml:"epoch_amount"`
+	// epoch_ratio specifies the new distributing amount by ratio
+	EpochRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=epoch_ratio,json=epochRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"epoch_ratio" yaml:"epoch_ratio"`
+	// decay_factor specifies the factor the epoch amount of a decaying plan is
+	// multiplied by on each decay
+	DecayFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=decay_factor,json=decayFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_factor" yaml:"decay_factor"`
+	// decay_epochs specifies the number of epochs between decays
+	DecayEpochs uint32 `protobuf:"varint,10,opt,name=decay_epochs,json=decayEpochs,proto3" json:"decay_epochs,omitempty" yaml:"decay_epochs"`
+	// decay_times specifies the times at which the epoch amount decays
+	DecayTimes []time.Time `protobuf:"bytes,11,rep,name=decay_times,json=decayTimes,proto3,stdtime" json:"decay_times" yaml:"decay_times"`
 }
 
-func init() {
-	proto.RegisterFile("tendermint/farming/v1beta1/tx.proto", fileDescriptor_a33d9a3ff13f514a)
+func (m *MsgModifyPrivatePlan) Reset()         { *m = MsgModifyPrivatePlan{} }
+func (m *MsgModifyPrivatePlan) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyPrivatePlan) ProtoMessage()    {}
+func (*MsgModifyPrivatePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{14}
 }
-
-var fileDescriptor_a33d9a3ff13f514a = []byte{
-	// 842 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x4f, 0x1b, 0x47,
-	0x14, 0xf7, 0x82, 0x31, 0x78, 0xa0, 0x75, 0x19, 0x5c, 0xba, 0x2c, 0x74, 0xd7, 0xda, 0x4a, 0xad,
-	0x45, 0xc5, 0x6e, 0x71, 0x55, 0xa9, 0xe2, 0x86, 0xa1, 0x05, 0x55, 0x72, 0x55, 0x2d, 0xad, 0x9a,
-	0xe4, 0x62, 0xad, 0xed, 0x61, 0xbd, 0x82, 0xdd, 0x31, 0x3b, 0x63, 0x02, 0x39, 0x26, 0x8a, 0xc4,
-	0x29, 0xe2, 0x23, 0x44, 0xb9, 0x25, 0xd7, 0x1c, 0xf3, 0x05, 0x38, 0x72, 0x8c, 0x72, 0x30, 0x11,
-	0x7c, 0x03, 0xf2, 0x05, 0xa2, 0x9d, 0x99, 0xdd, 0x2c, 0xc6, 0xf8, 0xcf, 0x2d, 0x87, 0x9c, 0xbc,
-	0x33, 0xfb, 0x7b, 0xbf, 0x79, 0xef, 0xf7, 0x7e, 0x6f, 0xc7, 0xe0, 0x07, 0x8a, 0xfc, 0x06, 0x0a,
-	0x3c, 0xd7, 0xa7, 0xe6, 0xae, 0x1d, 0xfe, 0x3a, 0xe6, 0xe1, 0x6a, 0x0d, 0x51, 0x7b, 0xd5, 0xa4,
-	0x47, 0x46, 0x2b, 0xc0, 0x14, 0xc3, 0xf9, 0x3a, 0x26, 0x1e, 0x26, 0x86, 0x00, 0x18, 0x02, 0xa0,
-	0xe4, 0x1d, 0xec, 0x60, 0x06, 0x31, 0xc3, 0x27, 0x8e, 0x56, 0x16, 0x38, 0xba, 0xca, 0x5f, 0x88,
-	0x50, 0xfe, 0x4a, 0xe5, 0x2b, 0xb3, 0x66, 0x13, 0x14, 0x1f, 0x53, 0xc7, 0xae, 0x2f, 0xde, 0x6b,
-	0x0e, 0xc6, 0xce, 0x3e, 0x32, 0xd9, 0xaa, 0xd6, 0xde, 0x35, 0xa9, 0xeb, 0x21, 0x42, 0x6d, 0xaf,
-	0xc5, 0x01, 0xfa, 0xcb, 0x34, 0x90, 0x2b, 0xc4, 0xd9, 0x08, 0x90, 0x4d, 0xd1, 0x9f, 0xee, 0x11,
-	0x6a, 0xac, 0x7b, 0xb8, 0xed, 0xd3, 0x7f, 0xf6, 0x6d, 0x1f, 0x42, 0x90, 0xf6, 0x6d, 0x0f, 0xc9,
-	0x52, 0x41, 0x2a, 0x66, 0x2d, 0xf6, 0x0c, 0x65, 0x30, 0x59, 0x0f, 0xc1, 0x38, 0x90, 0xc7, 0xd8,
-	0x76, 0xb4, 0x84, 0x2f, 0x24, 0x90, 0x27, 0xd4, 0xde, 0x73, 0x7d, 0xa7, 0x1a, 0xa6, 0x50, 0x7d,
-	0x88, 0x5c, 0xa7, 0x49, 0x89, 0x3c, 0x5e, 0x18, 0x2f, 0x4e, 0x97, 0x96, 0x0c, 0x91, 0x79, 0x98,
-	0x6b, 0x54, 0xb1, 0xb1, 0x89, 0xea, 0x1b, 0xd8, 0xf5, 0xcb, 0xd6, 0x59, 0x47, 0x4b, 0x5d, 0x77,
-	0xb4, 0xc5, 0x63, 0xdb, 0xdb, 0x5f, 0xd3, 0x7b, 0xf1, 0xe8, 0xaf, 0x2e, 0xb4, 0x9f, 0x1d, 0x97,
-	0x36, 0xdb, 0x35, 0xa3, 0x8e, 0x3d, 0x21, 0x84, 0xf8, 0x59, 0x21, 0x8d, 0x3d, 0x93, 0x1e, 0xb7,
-	0x10, 0x89, 0x28, 0x89, 0x05, 0x05, 0x4b, 0xb8, 0xfa, 0x9f, 0x73, 0xc0, 0x7b, 0x00, 0x10, 0x6a,
-	0x07, 0xb4, 0x1a, 0x0a, 0x21, 0xa7, 0x0b, 0x52, 0x71, 0xba, 0xa4, 0x18, 0x5c, 0x25, 0x23, 0x52,
-	0xc9, 0xf8, 0x37, 0x52, 0xa9, 0xfc, 0xbd, 0xc8, 0x6b, 0x36, 0xce, 0x4b, 0xc4, 0xea, 0xa7, 0x17,
-	0x9a, 0x64, 0x65, 0xd9, 0x46, 0x08, 0x87, 0x16, 0x98, 0x42, 0x7e, 0x83, 0xf3, 0x4e, 0x0c, 0xe4,
-	0x5d, 0x14, 0xbc, 0x39, 0xce, 0x1b, 0x45, 0x72, 0xd6, 0x49, 0xe4, 0x37, 0x18, 0xe7, 0x53, 0x09,
-	0xcc, 0xa0, 0x16, 0xae, 0x37, 0xab, 0x36, 0xeb, 0x8a, 0x9c, 0x61, 0x52, 0x2e, 0xf4, 0x94, 0x92,
-	0xe9, 0xb8, 0x25, 0x78, 0xe7, 0x04, 0x6f, 0x22, 0x38, 0xd4, 0xaf, 0x38, 0x84, 0x7e, 0x5c, 0xbc,
-	0x69, 0x16, 0xca, 0xcd, 0xb0, 0x96, 0x3e, 0x79, 0xae, 0xa5, 0x74, 0x1d, 0x14, 0xee, 0xb2, 0x8a,
-	0x85, 0x48, 0x0b, 0xfb, 0x04, 0xe9, 0x8f, 0xd3, 0x00, 0xc6, 0x20, 0xcb, 0xa6, 0x2e, 0xfe, 0xe2,
-	0xa4, 0xcf, 0xc1, 0x49, 0x08, 0xf0, 0x86, 0x56, 0x83, 0xb0, 0x27, 0x72, 0x26, 0x14, 0xbc, 0xbc,
-	0x19, 0x86, 0xbe, 0xeb, 0x68, 0x3f, 0x0e, 0xa7, 0xc5, 0x75, 0x47, 0x83, 0x49, 0x5b, 0x31, 0x2a,
-	0xdd, 0x02, 0x6c, 0xc5, 0x7a, 0x2d, 0x8c, 0xb2, 0x04, 0x94, 0xdb, 0x1e, 0x88, 0x2d, 0xf2, 0x5a,
-	0x02, 0x53, 0x15, 0xe2, 0xec, 0x50, 0x7b, 0x0f, 0xc1, 0x79, 0x90, 0x09, 0x3f, 0x82, 0x28, 0x10,
-	0xd6, 0x10, 0x2b, 0x78, 0x22, 0x81, 0xaf, 0x92, 0xad, 0x23, 0xf2, 0xd8, 0x20, 0xeb, 0x6f, 0x0b,
-	0x21, 0xf2, 0xb7, 0x1b, 0x4f, 0x46, 0xf3, 0xfe, 0x4c, 0xa2, 0xdd, 0x44, 0xd4, 0x04, 0xc1, 0x37,
-	0x51, 0xd2, 0x71, 0x25, 0x6f, 0x24, 0x00, 0x2a, 0xc4, 0xf9, 0xcf, 0x27, 0x7d, 0x6b, 0x79, 0x26,
-	0x81, 0x5c, 0xdb, 0x1f, 0xb1, 0x9a, 0xbf, 0x44, 0x35, 0xf3, 0xbc, 0x9a, 0xae, 0xf8, 0xd1, 0xea,
-	0xf9, 0x3a, 0x8e, 0x4e, 0x56, 0x94, 0x67, 0x93, 0x2a, 0x92, 0x8f, 0x6b, 0x7a, 0xc4, 0x4a, 0xda,
-	0xb6, 0x83, 0x43, 0x44, 0xe8, 0x9d, 0x25, 0xfd, 0x0d, 0xe6, 0x6e, 0x0c, 0x56, 0x03, 0xf9, 0xd8,
-	0xe3, 0x55, 0x65, 0xcb, 0xea, 0x75, 0x47, 0x53, 0x7a, 0x4c, 0x1f, 0x07, 0xe9, 0xd6, 0x6c, 0x22,
-	0x99, 0x4d, 0xb6, 0x77, 0x23, 0x23, 0x71, 0x76, 0x9c, 0xd1, 0x6f, 0x20, 0x57, 0x21, 0xce, 0x7a,
-	0xe3, 0xd0, 0xf6, 0xeb, 0xe8, 0x8f, 0xd0, 0x6b, 0x70, 0x09, 0x64, 0x03, 0x74, 0xd0, 0x46, 0x84,
-	0xc6, 0x99, 0x7d, 0xda, 0x10, 0x64, 0x0b, 0xe0, 0xbb, 0xae, 0xb0, 0x88, 0xb1, 0xf4, 0x21, 0x0d,
-	0xc6, 0x2b, 0xc4, 0x81, 0x4f, 0x24, 0xf0, 0x6d, 0xef, 0x9b, 0xef, 0x17, 0xa3, 0xf7, 0x0d, 0x6d,
-	0xdc, 0xf5, 0x01, 0x54, 0x7e, 0x1f, 0x35, 0x22, 0xca, 0x06, 0x1e, 0x80, 0x5c, 0xf7, 0xe7, 0x72,
-	0x79, 0x20, 0x59, 0x8c, 0x55, 0x4a, 0xc3, 0x63, 0xe3, 0x23, 0x77, 0xc0, 0x04, 0x1f, 0xbf, 0x42,
-	0x9f, 0x60, 0x86, 0x50, 0x8a, 0x83, 0x10, 0x31, 0xe9, 0x7d, 0x30, 0x19, 0x4d, 0x82, 0xde, 0x27,
-	0x48, 0x60, 0x94, 0xe5, 0xc1, 0x98, 0x24, 0x75, 0xe4, 0xc8, 0x7e, 0xd4, 0x02, 0xd3, 0x97, 0xba,
-	0xcb, 0x5d, 0xb0, 0x09, 0x66, 0x6e, 0x58, 0xeb, 0xa7, 0x3e, 0xb1, 0x49, 0xa0, 0x62, 0x0e, 0x09,
-	0x8c, 0x4e, 0x2a, 0x6f, 0x9d, 0x5d, 0xaa, 0xd2, 0xf9, 0xa5, 0x2a, 0xbd, 0xbf, 0x54, 0xa5, 0xd3,
-	0x2b, 0x35, 0x75, 0x7e, 0xa5, 0xa6, 0xde, 0x5e, 0xa9, 0xa9, 0x07, 0x2b, 0x89, 0x49, 0xee, 0xf1,
-	0xf7, 0xf1, 0x28, 0x7e, 0x62, 0x43, 0x5d, 0xcb, 0xb0, 0x5b, 0xe0, 0xd7, 0x8f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x08, 0x76, 0x43, 0x79, 0x6b, 0x0a, 0x00, 0x00,
+func (m *MsgModifyPrivatePlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModifyPrivatePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModifyPrivatePlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgModifyPrivatePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyPrivatePlan.Merge(m, src)
+}
+func (m *MsgModifyPrivatePlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModifyPrivatePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyPrivatePlan.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgModifyPrivatePlan proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateFixedAmountPlan defines a method for creating a new fixed amount
-	// farming plan
-	CreateFixedAmountPlan(ctx context.Context, in *MsgCreateFixedAmountPlan, opts ...grpc.CallOption) (*MsgCreateFixedAmountPlanResponse, error)
-	// CreateRatioPlan defines a method for creating a new ratio farming plan
-	CreateRatioPlan(ctx context.Context, in *MsgCreateRatioPlan, opts ...grpc.CallOption) (*MsgCreateRatioPlanResponse, error)
-	// Stake defines a method for staking coins into the farming plan
-	Stake(ctx context.Context, in *MsgStake, opts ...grpc.CallOption) (*MsgStakeResponse, error)
-	// Unstake defines a method for unstaking coins from the farming plan
-	Unstake(ctx context.Context, in *MsgUnstake, opts ...grpc.CallOption) (*MsgUnstakeResponse, error)
-	// Harvest defines a method for claiming farming rewards
-	Harvest(ctx context.Context, in *MsgHarvest, opts ...grpc.CallOption) (*MsgHarvestResponse, error)
-	// AdvanceEpoch defines a method for advancing epoch by one, just for testing purpose
-	// and shouldn't be used in real world
-	AdvanceEpoch(ctx context.Context, in *MsgAdvanceEpoch, opts ...grpc.CallOption) (*MsgAdvanceEpochResponse, error)
+// MsgModifyPrivatePlanResponse defines the Msg/ModifyPrivatePlan response type.
+type MsgModifyPrivatePlanResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgModifyPrivatePlanResponse) Reset()         { *m = MsgModifyPrivatePlanResponse{} }
+func (m *MsgModifyPrivatePlanResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyPrivatePlanResponse) ProtoMessage()    {}
+func (*MsgModifyPrivatePlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{15}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgModifyPrivatePlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) CreateFixedAmountPlan(ctx context.Context, in *MsgCreateFixedAmountPlan, opts ...grpc.CallOption) (*MsgCreateFixedAmountPlanResponse, error) {
-	out := new(MsgCreateFixedAmountPlanResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/CreateFixedAmountPlan", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgModifyPrivatePlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModifyPrivatePlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgModifyPrivatePlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyPrivatePlanResponse.Merge(m, src)
+}
+func (m *MsgModifyPrivatePlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModifyPrivatePlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyPrivatePlanResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateRatioPlan(ctx context.Context, in *MsgCreateRatioPlan, opts ...grpc.CallOption) (*MsgCreateRatioPlanResponse, error) {
-	out := new(MsgCreateRatioPlanResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/CreateRatioPlan", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgModifyPrivatePlanResponse proto.InternalMessageInfo
+
+// MsgTerminatePrivatePlan defines a SDK message for terminating a private farming plan.
+type MsgTerminatePrivatePlan struct {
+	// plan_id specifies index of the farming plan
+	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// termination_address defines the bech32-encoded termination address of the plan
+	TerminationAddress string `protobuf:"bytes,2,opt,name=termination_address,json=terminationAddress,proto3" json:"termination_address,omitempty"`
 }
 
-func (c *msgClient) Stake(ctx context.Context, in *MsgStake, opts ...grpc.CallOption) (*MsgStakeResponse, error) {
-	out := new(MsgStakeResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/Stake", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTerminatePrivatePlan) Reset()         { *m = MsgTerminatePrivatePlan{} }
+func (m *MsgTerminatePrivatePlan) String() string { return proto.CompactTextString(m) }
+func (*MsgTerminatePrivatePlan) ProtoMessage()    {}
+func (*MsgTerminatePrivatePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{16}
+}
+func (m *MsgTerminatePrivatePlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTerminatePrivatePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTerminatePrivatePlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgTerminatePrivatePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTerminatePrivatePlan.Merge(m, src)
+}
+func (m *MsgTerminatePrivatePlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTerminatePrivatePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTerminatePrivatePlan.DiscardUnknown(m)
 }
 
-func (c *msgClient) Unstake(ctx context.Context, in *MsgUnstake, opts ...grpc.CallOption) (*MsgUnstakeResponse, error) {
-	out := new(MsgUnstakeResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/Unstake", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgTerminatePrivatePlan proto.InternalMessageInfo
+
+// MsgTerminatePrivatePlanResponse defines the Msg/TerminatePrivatePlan response type.
+type MsgTerminatePrivatePlanResponse struct {
 }
 
-func (c *msgClient) Harvest(ctx context.Context, in *MsgHarvest, opts ...grpc.CallOption) (*MsgHarvestResponse, error) {
-	out := new(MsgHarvestResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/Harvest", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTerminatePrivatePlanResponse) Reset()         { *m = MsgTerminatePrivatePlanResponse{} }
+func (m *MsgTerminatePrivatePlanResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTerminatePrivatePlanResponse) ProtoMessage()    {}
+func (*MsgTerminatePrivatePlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{17}
+}
+func (m *MsgTerminatePrivatePlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTerminatePrivatePlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTerminatePrivatePlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgTerminatePrivatePlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTerminatePrivatePlanResponse.Merge(m, src)
+}
+func (m *MsgTerminatePrivatePlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTerminatePrivatePlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTerminatePrivatePlanResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) AdvanceEpoch(ctx context.Context, in *MsgAdvanceEpoch, opts ...grpc.CallOption) (*MsgAdvanceEpochResponse, error) {
-	out := new(MsgAdvanceEpochResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/AdvanceEpoch", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgTerminatePrivatePlanResponse proto.InternalMessageInfo
+
+// MsgFundPrivatePlan defines a SDK message for sending coins from the termination address
+// to the farming pool of a private farming plan.
+type MsgFundPrivatePlan struct {
+	// plan_id specifies index of the farming plan
+	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// termination_address defines the bech32-encoded termination address of the plan
+	TerminationAddress string `protobuf:"bytes,2,opt,name=termination_address,json=terminationAddress,proto3" json:"termination_address,omitempty"`
+	// amount specifies coins to send to the farming pool
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateFixedAmountPlan defines a method for creating a new fixed amount
-	// farming plan
-	CreateFixedAmountPlan(context.Context, *MsgCreateFixedAmountPlan) (*MsgCreateFixedAmountPlanResponse, error)
-	// CreateRatioPlan defines a method for creating a new ratio farming plan
-	CreateRatioPlan(context.Context, *MsgCreateRatioPlan) (*MsgCreateRatioPlanResponse, error)
-	// Stake defines a method for staking coins into the farming plan
-	Stake(context.Context, *MsgStake) (*MsgStakeResponse, error)
-	// Unstake defines a method for unstaking coins from the farming plan
-	Unstake(context.Context, *MsgUnstake) (*MsgUnstakeResponse, error)
-	// Harvest defines a method for claiming farming rewards
-	Harvest(context.Context, *MsgHarvest) (*MsgHarvestResponse, error)
-	// AdvanceEpoch defines a method for advancing epoch by one, just for testing purpose
-	// and shouldn't be used in real world
-	AdvanceEpoch(context.Context, *MsgAdvanceEpoch) (*MsgAdvanceEpochResponse, error)
+func (m *MsgFundPrivatePlan) Reset()         { *m = MsgFundPrivatePlan{} }
+func (m *MsgFundPrivatePlan) String() string { return proto.CompactTextString(m) }
+func (*MsgFundPrivatePlan) ProtoMessage()    {}
+func (*MsgFundPrivatePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{18}
+}
+func (m *MsgFundPrivatePlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundPrivatePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundPrivatePlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundPrivatePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundPrivatePlan.Merge(m, src)
+}
+func (m *MsgFundPrivatePlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundPrivatePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundPrivatePlan.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgFundPrivatePlan proto.InternalMessageInfo
+
+// MsgFundPrivatePlanResponse defines the Msg/FundPrivatePlan response type.
+type MsgFundPrivatePlanResponse struct {
 }
 
-func (*UnimplementedMsgServer) CreateFixedAmountPlan(ctx context.Context, req *MsgCreateFixedAmountPlan) (*MsgCreateFixedAmountPlanResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateFixedAmountPlan not implemented")
+func (m *MsgFundPrivatePlanResponse) Reset()         { *m = MsgFundPrivatePlanResponse{} }
+func (m *MsgFundPrivatePlanResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundPrivatePlanResponse) ProtoMessage()    {}
+func (*MsgFundPrivatePlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{19}
 }
-func (*UnimplementedMsgServer) CreateRatioPlan(ctx context.Context, req *MsgCreateRatioPlan) (*MsgCreateRatioPlanResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRatioPlan not implemented")
+func (m *MsgFundPrivatePlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) Stake(ctx context.Context, req *MsgStake) (*MsgStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Stake not implemented")
+func (m *MsgFundPrivatePlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundPrivatePlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Unstake(ctx context.Context, req *MsgUnstake) (*MsgUnstakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Unstake not implemented")
+func (m *MsgFundPrivatePlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundPrivatePlanResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) Harvest(ctx context.Context, req *MsgHarvest) (*MsgHarvestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Harvest not implemented")
+func (m *MsgFundPrivatePlanResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) AdvanceEpoch(ctx context.Context, req *MsgAdvanceEpoch) (*MsgAdvanceEpochResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AdvanceEpoch not implemented")
+func (m *MsgFundPrivatePlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundPrivatePlanResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgFundPrivatePlanResponse proto.InternalMessageInfo
 
-func _Msg_CreateFixedAmountPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateFixedAmountPlan)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateFixedAmountPlan(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Msg/CreateFixedAmountPlan",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateFixedAmountPlan(ctx, req.(*MsgCreateFixedAmountPlan))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgCancelUnbonding defines a SDK message for canceling an unbonding of a farmer.
+// The unbonding coins are queued for staking again.
+type MsgCancelUnbonding struct {
+	// farmer defines the bech32-encoded address of the farmer
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	// unbonding_id specifies the id of the unbonding to cancel
+	UnbondingId uint64 `protobuf:"varint,2,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty"`
 }
 
-func _Msg_CreateRatioPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateRatioPlan)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateRatioPlan(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Msg/CreateRatioPlan",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateRatioPlan(ctx, req.(*MsgCreateRatioPlan))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgCancelUnbonding) Reset()         { *m = MsgCancelUnbonding{} }
+func (m *MsgCancelUnbonding) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUnbonding) ProtoMessage()    {}
+func (*MsgCancelUnbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{20}
 }
-
-func _Msg_Stake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Stake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Msg/Stake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Stake(ctx, req.(*MsgStake))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgCancelUnbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Msg_Unstake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUnstake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Unstake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Msg/Unstake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Unstake(ctx, req.(*MsgUnstake))
+func (m *MsgCancelUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUnbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCancelUnbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUnbonding.Merge(m, src)
+}
+func (m *MsgCancelUnbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUnbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUnbonding.DiscardUnknown(m)
 }
 
-func _Msg_Harvest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgHarvest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Harvest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Msg/Harvest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Harvest(ctx, req.(*MsgHarvest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgCancelUnbonding proto.InternalMessageInfo
+
+// MsgCancelUnbondingResponse defines the Msg/CancelUnbonding response type.
+type MsgCancelUnbondingResponse struct {
 }
 
-func _Msg_AdvanceEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAdvanceEpoch)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AdvanceEpoch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.farming.v1beta1.Msg/AdvanceEpoch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AdvanceEpoch(ctx, req.(*MsgAdvanceEpoch))
+func (m *MsgCancelUnbondingResponse) Reset()         { *m = MsgCancelUnbondingResponse{} }
+func (m *MsgCancelUnbondingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUnbondingResponse) ProtoMessage()    {}
+func (*MsgCancelUnbondingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{21}
+}
+func (m *MsgCancelUnbondingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUnbondingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUnbondingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCancelUnbondingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUnbondingResponse.Merge(m, src)
+}
+func (m *MsgCancelUnbondingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUnbondingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUnbondingResponse.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.farming.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateFixedAmountPlan",
-			Handler:    _Msg_CreateFixedAmountPlan_Handler,
-		},
-		{
-			MethodName: "CreateRatioPlan",
-			Handler:    _Msg_CreateRatioPlan_Handler,
-		},
-		{
-			MethodName: "Stake",
-			Handler:    _Msg_Stake_Handler,
-		},
-		{
-			MethodName: "Unstake",
-			Handler:    _Msg_Unstake_Handler,
-		},
-		{
-			MethodName: "Harvest",
-			Handler:    _Msg_Harvest_Handler,
-		},
-		{
-			MethodName: "AdvanceEpoch",
-			Handler:    _Msg_AdvanceEpoch_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "tendermint/farming/v1beta1/tx.proto",
+var xxx_messageInfo_MsgCancelUnbondingResponse proto.InternalMessageInfo
+
+// MsgSetRewardsWithdrawAddress defines a SDK message for setting the address
+// that the rewards of a farmer are withdrawn to.
+type MsgSetRewardsWithdrawAddress struct {
+	// farmer defines the bech32-encoded address of the farmer
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	// withdraw_address defines the bech32-encoded address that the rewards are withdrawn to
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
 }
 
-func (m *MsgCreateFixedAmountPlan) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetRewardsWithdrawAddress) Reset()         { *m = MsgSetRewardsWithdrawAddress{} }
+func (m *MsgSetRewardsWithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardsWithdrawAddress) ProtoMessage()    {}
+func (*MsgSetRewardsWithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{22}
+}
+func (m *MsgSetRewardsWithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardsWithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardsWithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetRewardsWithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardsWithdrawAddress.Merge(m, src)
+}
+func (m *MsgSetRewardsWithdrawAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRewardsWithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardsWithdrawAddress.DiscardUnknown(m)
 }
 
-func (m *MsgCreateFixedAmountPlan) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetRewardsWithdrawAddress proto.InternalMessageInfo
+
+// MsgSetRewardsWithdrawAddressResponse defines the Msg/SetRewardsWithdrawAddress response type.
+type MsgSetRewardsWithdrawAddressResponse struct {
 }
 
-func (m *MsgCreateFixedAmountPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EpochAmount) > 0 {
-		for iNdEx := len(m.EpochAmount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EpochAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintTx(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x2a
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintTx(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x22
-	if len(m.StakingCoinWeights) > 0 {
-		for iNdEx := len(m.StakingCoinWeights) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StakingCoinWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgSetRewardsWithdrawAddressResponse) Reset()         { *m = MsgSetRewardsWithdrawAddressResponse{} }
+func (m *MsgSetRewardsWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardsWithdrawAddressResponse) ProtoMessage()    {}
+func (*MsgSetRewardsWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{23}
+}
+func (m *MsgSetRewardsWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardsWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardsWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateFixedAmountPlanResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetRewardsWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardsWithdrawAddressResponse.Merge(m, src)
 }
-
-func (m *MsgCreateFixedAmountPlanResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetRewardsWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgCreateFixedAmountPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetRewardsWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardsWithdrawAddressResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateRatioPlan) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgSetRewardsWithdrawAddressResponse proto.InternalMessageInfo
 
-func (m *MsgCreateRatioPlan) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgDepositAndStake defines a SDK message for depositing coins to a liquidity pool
+// and staking the pool coins minted by the deposit once the deposit batch is executed.
+type MsgDepositAndStake struct {
+	// farmer defines the bech32-encoded address of the farmer
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	// pool_id specifies the id of the liquidity pool to deposit to
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// deposit_coins specifies the reserve coins to deposit to the liquidity pool
+	DepositCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=deposit_coins,json=depositCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit_coins" yaml:"deposit_coins"`
 }
 
-func (m *MsgCreateRatioPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.EpochRatio.Size()
-		i -= size
-		if _, err := m.EpochRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintTx(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x2a
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintTx(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x22
-	if len(m.StakingCoinWeights) > 0 {
-		for iNdEx := len(m.StakingCoinWeights) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StakingCoinWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgDepositAndStake) Reset()         { *m = MsgDepositAndStake{} }
+func (m *MsgDepositAndStake) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositAndStake) ProtoMessage()    {}
+func (*MsgDepositAndStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{24}
+}
+func (m *MsgDepositAndStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositAndStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositAndStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateRatioPlanResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgDepositAndStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositAndStake.Merge(m, src)
 }
-
-func (m *MsgCreateRatioPlanResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDepositAndStake) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgCreateRatioPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgDepositAndStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositAndStake.DiscardUnknown(m)
 }
 
-func (m *MsgStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgDepositAndStake proto.InternalMessageInfo
 
-func (m *MsgStake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgDepositAndStakeResponse defines the Msg/DepositAndStake response type.
+type MsgDepositAndStakeResponse struct {
+	// deposit_request_id specifies the id of the deposit request created
+	DepositRequestId uint64 `protobuf:"varint,1,opt,name=deposit_request_id,json=depositRequestId,proto3" json:"deposit_request_id,omitempty"`
 }
 
-func (m *MsgStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StakingCoins) > 0 {
-		for iNdEx := len(m.StakingCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StakingCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgDepositAndStakeResponse) Reset()         { *m = MsgDepositAndStakeResponse{} }
+func (m *MsgDepositAndStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositAndStakeResponse) ProtoMessage()    {}
+func (*MsgDepositAndStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{25}
+}
+func (m *MsgDepositAndStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositAndStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositAndStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Farmer) > 0 {
-		i -= len(m.Farmer)
-		copy(dAtA[i:], m.Farmer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgDepositAndStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositAndStakeResponse.Merge(m, src)
+}
+func (m *MsgDepositAndStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositAndStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositAndStakeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDepositAndStakeResponse proto.InternalMessageInfo
+
+func (m *MsgDepositAndStakeResponse) GetDepositRequestId() uint64 {
+	if m != nil {
+		return m.DepositRequestId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUnstakeAndWithdraw defines a SDK message for unstaking pool coins and
+// withdrawing them from the liquidity pool.
+type MsgUnstakeAndWithdraw struct {
+	// farmer defines the bech32-encoded address of the farmer
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	// pool_id specifies the id of the liquidity pool to withdraw from
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// pool_coin specifies the pool coin to unstake and withdraw
+	PoolCoin types.Coin `protobuf:"bytes,3,opt,name=pool_coin,json=poolCoin,proto3" json:"pool_coin" yaml:"pool_coin"`
 }
 
-func (m *MsgStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUnstakeAndWithdraw) Reset()         { *m = MsgUnstakeAndWithdraw{} }
+func (m *MsgUnstakeAndWithdraw) String() string { return proto.CompactTextString(m) }
+func (*MsgUnstakeAndWithdraw) ProtoMessage()    {}
+func (*MsgUnstakeAndWithdraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{26}
 }
-
-func (m *MsgUnstake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnstakeAndWithdraw) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnstakeAndWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnstakeAndWithdraw.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUnstakeAndWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnstakeAndWithdraw.Merge(m, src)
+}
+func (m *MsgUnstakeAndWithdraw) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnstakeAndWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnstakeAndWithdraw.DiscardUnknown(m)
 }
 
-func (m *MsgUnstake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUnstakeAndWithdraw proto.InternalMessageInfo
+
+// MsgUnstakeAndWithdrawResponse defines the Msg/UnstakeAndWithdraw response type.
+type MsgUnstakeAndWithdrawResponse struct {
+	// withdrawn_rewards specifies rewards withdrawn to the farmer as a side effect of unstaking
+	WithdrawnRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=withdrawn_rewards,json=withdrawnRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn_rewards" yaml:"withdrawn_rewards"`
 }
 
-func (m *MsgUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UnstakingCoins) > 0 {
-		for iNdEx := len(m.UnstakingCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UnstakingCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgUnstakeAndWithdrawResponse) Reset()         { *m = MsgUnstakeAndWithdrawResponse{} }
+func (m *MsgUnstakeAndWithdrawResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnstakeAndWithdrawResponse) ProtoMessage()    {}
+func (*MsgUnstakeAndWithdrawResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33d9a3ff13f514a, []int{27}
+}
+func (m *MsgUnstakeAndWithdrawResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnstakeAndWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnstakeAndWithdrawResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Farmer) > 0 {
-		i -= len(m.Farmer)
-		copy(dAtA[i:], m.Farmer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUnstakeAndWithdrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnstakeAndWithdrawResponse.Merge(m, src)
+}
+func (m *MsgUnstakeAndWithdrawResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnstakeAndWithdrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnstakeAndWithdrawResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUnstakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUnstakeAndWithdrawResponse proto.InternalMessageInfo
+
+func (m *MsgUnstakeAndWithdrawResponse) GetWithdrawnRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WithdrawnRewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUnstakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*MsgCreateFixedAmountPlan)(nil), "cosmos.farming.v1beta1.MsgCreateFixedAmountPlan")
+	proto.RegisterType((*MsgCreateFixedAmountPlanResponse)(nil), "cosmos.farming.v1beta1.MsgCreateFixedAmountPlanResponse")
+	proto.RegisterType((*MsgCreateRatioPlan)(nil), "cosmos.farming.v1beta1.MsgCreateRatioPlan")
+	proto.RegisterType((*MsgCreateRatioPlanResponse)(nil), "cosmos.farming.v1beta1.MsgCreateRatioPlanResponse")
+	proto.RegisterType((*MsgCreateDecayingPlan)(nil), "cosmos.farming.v1beta1.MsgCreateDecayingPlan")
+	proto.RegisterType((*MsgCreateDecayingPlanResponse)(nil), "cosmos.farming.v1beta1.MsgCreateDecayingPlanResponse")
+	proto.RegisterType((*MsgStake)(nil), "cosmos.farming.v1beta1.MsgStake")
+	proto.RegisterType((*MsgStakeResponse)(nil), "cosmos.farming.v1beta1.MsgStakeResponse")
+	proto.RegisterType((*MsgUnstake)(nil), "cosmos.farming.v1beta1.MsgUnstake")
+	proto.RegisterType((*MsgUnstakeResponse)(nil), "cosmos.farming.v1beta1.MsgUnstakeResponse")
+	proto.RegisterType((*MsgHarvest)(nil), "cosmos.farming.v1beta1.MsgHarvest")
+	proto.RegisterType((*MsgHarvestResponse)(nil), "cosmos.farming.v1beta1.MsgHarvestResponse")
+	proto.RegisterType((*MsgAdvanceEpoch)(nil), "cosmos.farming.v1beta1.MsgAdvanceEpoch")
+	proto.RegisterType((*MsgAdvanceEpochResponse)(nil), "cosmos.farming.v1beta1.MsgAdvanceEpochResponse")
+	proto.RegisterType((*MsgModifyPrivatePlan)(nil), "cosmos.farming.v1beta1.MsgModifyPrivatePlan")
+	proto.RegisterType((*MsgModifyPrivatePlanResponse)(nil), "cosmos.farming.v1beta1.MsgModifyPrivatePlanResponse")
+	proto.RegisterType((*MsgTerminatePrivatePlan)(nil), "cosmos.farming.v1beta1.MsgTerminatePrivatePlan")
+	proto.RegisterType((*MsgTerminatePrivatePlanResponse)(nil), "cosmos.farming.v1beta1.MsgTerminatePrivatePlanResponse")
+	proto.RegisterType((*MsgFundPrivatePlan)(nil), "cosmos.farming.v1beta1.MsgFundPrivatePlan")
+	proto.RegisterType((*MsgFundPrivatePlanResponse)(nil), "cosmos.farming.v1beta1.MsgFundPrivatePlanResponse")
+	proto.RegisterType((*MsgCancelUnbonding)(nil), "cosmos.farming.v1beta1.MsgCancelUnbonding")
+	proto.RegisterType((*MsgCancelUnbondingResponse)(nil), "cosmos.farming.v1beta1.MsgCancelUnbondingResponse")
+	proto.RegisterType((*MsgSetRewardsWithdrawAddress)(nil), "cosmos.farming.v1beta1.MsgSetRewardsWithdrawAddress")
+	proto.RegisterType((*MsgSetRewardsWithdrawAddressResponse)(nil), "cosmos.farming.v1beta1.MsgSetRewardsWithdrawAddressResponse")
+	proto.RegisterType((*MsgDepositAndStake)(nil), "cosmos.farming.v1beta1.MsgDepositAndStake")
+	proto.RegisterType((*MsgDepositAndStakeResponse)(nil), "cosmos.farming.v1beta1.MsgDepositAndStakeResponse")
+	proto.RegisterType((*MsgUnstakeAndWithdraw)(nil), "cosmos.farming.v1beta1.MsgUnstakeAndWithdraw")
+	proto.RegisterType((*MsgUnstakeAndWithdrawResponse)(nil), "cosmos.farming.v1beta1.MsgUnstakeAndWithdrawResponse")
 }
 
-func (m *MsgUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("tendermint/farming/v1beta1/tx.proto", fileDescriptor_a33d9a3ff13f514a)
 }
 
-func (m *MsgHarvest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_a33d9a3ff13f514a = []byte{
+	// 1667 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xce, 0xc6, 0x8e, 0x1d, 0xbf, 0x49, 0x9b, 0x64, 0x9a, 0xb4, 0x9b, 0x6d, 0x6a, 0xbb, 0xfb,
+	0xfb, 0xa9, 0x84, 0xb6, 0xb1, 0xdb, 0x40, 0x05, 0xaa, 0x40, 0x22, 0x6e, 0xfa, 0x91, 0x8a, 0xa0,
+	0x68, 0xd3, 0xaa, 0x7c, 0x1c, 0xcc, 0xc6, 0x3b, 0x59, 0x2f, 0xb1, 0x77, 0xdd, 0xdd, 0x75, 0xd2,
+	0x70, 0x42, 0x20, 0xa4, 0x4a, 0x48, 0xd0, 0x03, 0x07, 0x8e, 0x88, 0x5b, 0xe9, 0x15, 0x21, 0x55,
+	0x1c, 0xb8, 0x96, 0x5b, 0xc5, 0x09, 0x71, 0x48, 0x51, 0x7b, 0xe3, 0x98, 0xbf, 0x00, 0xcd, 0xc7,
+	0x4e, 0xd6, 0xdf, 0xde, 0x40, 0xa0, 0x87, 0x9e, 0xe2, 0xd9, 0x79, 0xde, 0x8f, 0x79, 0xe6, 0x7d,
+	0x67, 0x9e, 0xdd, 0xc0, 0xff, 0x7c, 0x6c, 0x1b, 0xd8, 0xad, 0x5a, 0xb6, 0x9f, 0x5f, 0xd7, 0xc9,
+	0x5f, 0x33, 0xbf, 0x79, 0x7e, 0x0d, 0xfb, 0xfa, 0xf9, 0xbc, 0x7f, 0x27, 0x57, 0x73, 0x1d, 0xdf,
+	0x41, 0x47, 0x4b, 0x8e, 0x57, 0x75, 0xbc, 0x1c, 0x07, 0xe4, 0x38, 0x40, 0x99, 0x34, 0x1d, 0xd3,
+	0xa1, 0x90, 0x3c, 0xf9, 0xc5, 0xd0, 0xca, 0x34, 0x43, 0x17, 0xd9, 0x04, 0x37, 0x65, 0x53, 0x69,
+	0x36, 0xca, 0xaf, 0xe9, 0x1e, 0x16, 0x61, 0x4a, 0x8e, 0x65, 0xf3, 0xf9, 0x8c, 0xe9, 0x38, 0x66,
+	0x05, 0xe7, 0xe9, 0x68, 0xad, 0xbe, 0x9e, 0xf7, 0xad, 0x2a, 0xf6, 0x7c, 0xbd, 0x5a, 0x0b, 0x1c,
+	0x34, 0x03, 0x8c, 0xba, 0xab, 0xfb, 0x96, 0xc3, 0x1d, 0xa8, 0xf7, 0xe3, 0x20, 0x2f, 0x7b, 0xe6,
+	0x25, 0x17, 0xeb, 0x3e, 0xbe, 0x62, 0xdd, 0xc1, 0xc6, 0x42, 0xd5, 0xa9, 0xdb, 0xfe, 0x4a, 0x45,
+	0xb7, 0x11, 0x82, 0xb8, 0xad, 0x57, 0xb1, 0x2c, 0x65, 0xa5, 0xd9, 0x94, 0x46, 0x7f, 0x23, 0x19,
+	0x92, 0x25, 0x02, 0x76, 0x5c, 0x79, 0x90, 0x3e, 0x0e, 0x86, 0xe8, 0x3b, 0x09, 0x26, 0x3d, 0x5f,
+	0xdf, 0xb0, 0x6c, 0xb3, 0x48, 0x52, 0x2c, 0x6e, 0x61, 0xcb, 0x2c, 0xfb, 0x9e, 0x1c, 0xcb, 0xc6,
+	0x66, 0x47, 0xe6, 0x67, 0x72, 0x7c, 0x65, 0x64, 0x2d, 0x01, 0x23, 0xb9, 0x45, 0x5c, 0xba, 0xe4,
+	0x58, 0x76, 0x41, 0x7b, 0xb4, 0x93, 0x19, 0xd8, 0xdd, 0xc9, 0x1c, 0xdf, 0xd6, 0xab, 0x95, 0x8b,
+	0x6a, 0x3b, 0x3f, 0xea, 0xf7, 0x4f, 0x32, 0x67, 0x4c, 0xcb, 0x2f, 0xd7, 0xd7, 0x72, 0x25, 0xa7,
+	0xca, 0x89, 0xe2, 0x7f, 0xe6, 0x3c, 0x63, 0x23, 0xef, 0x6f, 0xd7, 0xb0, 0x17, 0xb8, 0xf4, 0x34,
+	0xc4, 0xbd, 0x90, 0xd1, 0x2d, 0xe6, 0x03, 0xbd, 0x0b, 0xe0, 0xf9, 0xba, 0xeb, 0x17, 0x09, 0x51,
+	0x72, 0x3c, 0x2b, 0xcd, 0x8e, 0xcc, 0x2b, 0x39, 0x46, 0x52, 0x2e, 0x20, 0x29, 0x77, 0x23, 0x60,
+	0xb1, 0x70, 0x82, 0xe7, 0x35, 0x21, 0xf2, 0xe2, 0xb6, 0xea, 0xbd, 0x27, 0x19, 0x49, 0x4b, 0xd1,
+	0x07, 0x04, 0x8e, 0x34, 0x18, 0xc6, 0xb6, 0xc1, 0xfc, 0x0e, 0xf5, 0xf4, 0x7b, 0x9c, 0xfb, 0x1d,
+	0x63, 0x7e, 0x03, 0x4b, 0xe6, 0x35, 0x89, 0x6d, 0x83, 0xfa, 0xfc, 0x5c, 0x82, 0x51, 0x5c, 0x73,
+	0x4a, 0xe5, 0xa2, 0x4e, 0x77, 0x45, 0x4e, 0x50, 0x2a, 0xa7, 0xdb, 0x52, 0x49, 0x79, 0xbc, 0xca,
+	0xfd, 0x1e, 0xe1, 0x7e, 0x43, 0xc6, 0x84, 0xbf, 0xd9, 0x3e, 0xf8, 0x63, 0xe4, 0x8d, 0x50, 0x53,
+	0x56, 0x0c, 0x17, 0xe3, 0x77, 0xbf, 0xcd, 0x0c, 0xa8, 0x55, 0xc8, 0x76, 0x2a, 0x15, 0x0d, 0x7b,
+	0x35, 0xc7, 0xf6, 0x30, 0x3a, 0x06, 0xc9, 0x5a, 0x45, 0xb7, 0x8b, 0x96, 0x41, 0xab, 0x26, 0xae,
+	0x25, 0xc8, 0x70, 0xc9, 0x40, 0xe7, 0x60, 0x92, 0x77, 0x43, 0xb1, 0xe6, 0x38, 0x95, 0xa2, 0x6e,
+	0x18, 0x2e, 0xf6, 0x3c, 0x5e, 0x44, 0x88, 0xcf, 0xad, 0x38, 0x4e, 0x65, 0x81, 0xcd, 0xa8, 0x9f,
+	0xc6, 0x01, 0x89, 0x78, 0x1a, 0x29, 0xda, 0x17, 0x45, 0xf9, 0x3c, 0x14, 0x25, 0x06, 0x56, 0x1b,
+	0x45, 0x7a, 0x90, 0xc8, 0x09, 0x42, 0x78, 0x61, 0x91, 0x98, 0xfe, 0xbe, 0x93, 0x39, 0xd5, 0x1f,
+	0x17, 0xbb, 0x3b, 0x19, 0x14, 0xae, 0x50, 0xea, 0x4a, 0xd5, 0x80, 0x8e, 0xe8, 0x5e, 0xf3, 0x9a,
+	0x33, 0x41, 0x69, 0xad, 0x81, 0x83, 0xa8, 0xb6, 0xfb, 0x09, 0x98, 0x12, 0x91, 0x16, 0x71, 0x49,
+	0xdf, 0x26, 0x80, 0x17, 0x05, 0xf7, 0xe2, 0x14, 0x0c, 0x4e, 0x41, 0x54, 0x86, 0x51, 0x83, 0x14,
+	0x46, 0x71, 0x5d, 0x2f, 0x91, 0x9d, 0x4f, 0xd2, 0xca, 0xbf, 0x1c, 0xb9, 0xf2, 0x79, 0x56, 0x61,
+	0x5f, 0xaa, 0x36, 0x42, 0x87, 0x57, 0xe8, 0x08, 0x5d, 0x0c, 0x22, 0xd1, 0xf0, 0x9e, 0x3c, 0x9c,
+	0x95, 0x66, 0x0f, 0x15, 0x8e, 0x35, 0xdb, 0xb2, 0xd9, 0xc0, 0xf6, 0x32, 0x1d, 0xa1, 0x0f, 0x80,
+	0x0d, 0x29, 0x93, 0x9e, 0x9c, 0xca, 0xc6, 0x7a, 0x6c, 0x42, 0x9a, 0x93, 0x85, 0xc2, 0xae, 0xa9,
+	0x31, 0xdb, 0x07, 0xa0, 0x4f, 0x28, 0x9e, 0x37, 0xe5, 0x47, 0x70, 0xa2, 0x6d, 0xab, 0x1c, 0x44,
+	0x5f, 0x3e, 0x18, 0x84, 0xe1, 0x65, 0xcf, 0x5c, 0xf5, 0xf5, 0x0d, 0x8c, 0x8e, 0x42, 0x82, 0x40,
+	0xb0, 0xcb, 0x9b, 0x91, 0x8f, 0xd0, 0x5d, 0x09, 0x0e, 0x85, 0x9b, 0x85, 0x38, 0xec, 0x51, 0x22,
+	0xd7, 0xf8, 0xaa, 0x27, 0x5b, 0x5b, 0xcd, 0x8b, 0x56, 0x23, 0xa3, 0xa1, 0x06, 0xf3, 0xd0, 0x87,
+	0x70, 0xa8, 0xe2, 0x94, 0x36, 0x8a, 0x81, 0xce, 0x92, 0x63, 0xb4, 0x0b, 0xa6, 0x5b, 0x36, 0x60,
+	0x91, 0x03, 0x0a, 0xd9, 0xc6, 0x4c, 0x1a, 0xac, 0xd5, 0x6f, 0xc8, 0x0e, 0x8c, 0x92, 0x67, 0x01,
+	0x1e, 0x4d, 0xc2, 0x50, 0x4d, 0xdf, 0xc6, 0x2e, 0xed, 0xdb, 0x94, 0xc6, 0x06, 0x7c, 0x67, 0xde,
+	0x82, 0xf1, 0x80, 0x2c, 0xb1, 0x19, 0x67, 0x01, 0x11, 0x7b, 0x6c, 0x14, 0x83, 0x45, 0x5a, 0x86,
+	0x27, 0x4b, 0xd9, 0xd8, 0x6c, 0x5c, 0x1b, 0x67, 0x33, 0xab, 0x6c, 0x62, 0xc9, 0xf0, 0xd4, 0x9f,
+	0x24, 0x80, 0x65, 0xcf, 0xbc, 0x69, 0x7b, 0x5d, 0x19, 0xff, 0x52, 0x82, 0xb1, 0xba, 0x1d, 0x91,
+	0xf3, 0xeb, 0x7c, 0xa5, 0x47, 0xd9, 0x4a, 0xeb, 0xf6, 0xdf, 0x60, 0xfd, 0xb0, 0xb0, 0xa6, 0x63,
+	0xbe, 0xfe, 0x07, 0x12, 0xa0, 0xbd, 0xec, 0x05, 0x05, 0x5f, 0x4b, 0x30, 0xb1, 0x65, 0xf9, 0x65,
+	0xc3, 0xd5, 0xb7, 0xec, 0xa2, 0x8b, 0xb7, 0x74, 0x97, 0x53, 0xd0, 0x35, 0xdf, 0xb7, 0x79, 0xbe,
+	0x32, 0xcb, 0xb7, 0xc5, 0x43, 0xb4, 0x8c, 0xc7, 0x85, 0xbd, 0xc6, 0xcd, 0x1f, 0x32, 0xae, 0xaf,
+	0xe9, 0xee, 0x26, 0xf6, 0xfc, 0x8e, 0x5c, 0xbf, 0x03, 0x47, 0x1a, 0x6e, 0x02, 0x03, 0xdb, 0x4e,
+	0x95, 0xd1, 0x9d, 0x2a, 0xa4, 0x77, 0x77, 0x32, 0x4a, 0x9b, 0xeb, 0x82, 0x81, 0x54, 0x6d, 0x22,
+	0xc4, 0xd2, 0x22, 0x7d, 0x86, 0xde, 0x64, 0xcd, 0x82, 0x05, 0x11, 0xa4, 0x44, 0x87, 0x0b, 0x72,
+	0x63, 0x37, 0x88, 0x69, 0x95, 0x55, 0x38, 0xe6, 0x59, 0x73, 0xa6, 0x1f, 0x0e, 0x02, 0xda, 0xcb,
+	0xbd, 0x81, 0xe9, 0x32, 0x7b, 0x86, 0x8d, 0x7d, 0x33, 0xdd, 0xe2, 0x21, 0x22, 0xd3, 0xc2, 0x9e,
+	0xe7, 0x8c, 0xbe, 0x90, 0xe0, 0x30, 0x5d, 0xc4, 0x5e, 0x4e, 0x3d, 0xab, 0x75, 0x89, 0xe7, 0x34,
+	0x15, 0xe2, 0x64, 0x9f, 0x09, 0x31, 0xbe, 0x83, 0x6c, 0xd4, 0x0b, 0x30, 0xb6, 0xec, 0x99, 0x0b,
+	0xc6, 0xa6, 0x6e, 0x97, 0x30, 0x3d, 0xb6, 0xd1, 0x0c, 0xa4, 0x5c, 0x7c, 0xbb, 0x4e, 0x92, 0x0e,
+	0xb6, 0x7f, 0xef, 0x01, 0xa7, 0x7c, 0x1a, 0x8e, 0x35, 0x99, 0x05, 0xb4, 0xab, 0x3f, 0x27, 0x61,
+	0x72, 0xd9, 0x33, 0x97, 0x1d, 0xc3, 0x5a, 0xdf, 0x5e, 0x71, 0xad, 0x4d, 0xdd, 0xc7, 0x54, 0xbc,
+	0x74, 0x3c, 0x89, 0xf3, 0x70, 0xc4, 0xa7, 0xaf, 0xb1, 0xf4, 0x50, 0x69, 0x3e, 0x88, 0x43, 0x53,
+	0xfc, 0x20, 0x16, 0x32, 0x28, 0x16, 0x92, 0x41, 0x1d, 0xc5, 0x4e, 0xfc, 0xb9, 0x15, 0x3b, 0x43,
+	0x7d, 0x89, 0x1d, 0x29, 0xb2, 0xd8, 0x49, 0xf4, 0x25, 0x76, 0xa4, 0xe8, 0x62, 0x27, 0xf9, 0xdf,
+	0x88, 0x9d, 0x26, 0x95, 0x3f, 0x7c, 0x30, 0x2a, 0xbf, 0x45, 0x53, 0xa5, 0xfe, 0x35, 0x4d, 0x05,
+	0xfb, 0xd7, 0x54, 0x23, 0x07, 0xa0, 0xa9, 0xd2, 0x30, 0xd3, 0xae, 0x81, 0x45, 0x87, 0x5b, 0xb4,
+	0xf9, 0x6f, 0xf0, 0xbe, 0xc4, 0x07, 0xd2, 0xe3, 0x3c, 0x95, 0x93, 0x90, 0xe9, 0x10, 0x4a, 0x64,
+	0xf3, 0x0b, 0xbb, 0x67, 0xaf, 0xd4, 0x6d, 0xe3, 0x60, 0x4e, 0x9b, 0x12, 0x24, 0x78, 0xfd, 0xc7,
+	0x7a, 0xd5, 0xff, 0x39, 0xc2, 0x75, 0xa4, 0x42, 0xe7, 0xae, 0xf9, 0x72, 0x67, 0x40, 0x69, 0x5d,
+	0x8a, 0x58, 0xe9, 0x4d, 0xf6, 0x11, 0x82, 0x1c, 0xb9, 0x95, 0x9b, 0xf6, 0x9a, 0x63, 0x1b, 0x96,
+	0x6d, 0x76, 0xbc, 0xaa, 0x4f, 0xc2, 0x68, 0x3d, 0x00, 0x11, 0x16, 0x06, 0x29, 0x0b, 0x23, 0xe2,
+	0xd9, 0x92, 0xd1, 0x10, 0xb4, 0xc9, 0xad, 0x08, 0x6a, 0xd2, 0x62, 0x58, 0xc5, 0x3e, 0xbf, 0x31,
+	0x6e, 0x71, 0xe5, 0x10, 0xb0, 0xd3, 0x29, 0xfc, 0xcb, 0x20, 0x44, 0x46, 0x13, 0xc7, 0x63, 0x5b,
+	0x8d, 0x2e, 0x78, 0x1a, 0xa7, 0xe0, 0xff, 0xdd, 0x02, 0x89, 0x84, 0xfe, 0x64, 0xfb, 0xbd, 0x88,
+	0x6b, 0x8e, 0x67, 0xf9, 0x0b, 0xb6, 0xd1, 0x5d, 0x8f, 0x9f, 0x81, 0x24, 0x95, 0xf7, 0x01, 0x03,
+	0x05, 0xb4, 0xbb, 0x93, 0x39, 0xcc, 0x7a, 0x81, 0x4f, 0xa8, 0x5a, 0x82, 0xfc, 0x5a, 0x32, 0xa8,
+	0x78, 0x37, 0x98, 0x63, 0x2e, 0x24, 0x63, 0x11, 0xc5, 0x7b, 0x83, 0x75, 0x44, 0xf1, 0xce, 0x6d,
+	0xc3, 0x22, 0xf2, 0x3a, 0x28, 0xad, 0x6b, 0x0d, 0xcb, 0xe9, 0x20, 0x1e, 0xbf, 0xa0, 0xf7, 0xca,
+	0x7d, 0x9c, 0xcf, 0x68, 0x6c, 0x62, 0xc9, 0x50, 0x7f, 0x90, 0x60, 0x6a, 0x4f, 0x90, 0x2e, 0xd8,
+	0x46, 0xc0, 0xf0, 0x3f, 0xc3, 0xdd, 0x0a, 0xa4, 0xe8, 0x33, 0xb2, 0x72, 0xf1, 0xa6, 0xd1, 0x91,
+	0x36, 0x99, 0xd3, 0x36, 0x1e, 0xf2, 0x46, 0x2c, 0x55, 0x6d, 0x98, 0xfc, 0x26, 0x18, 0x4e, 0xc1,
+	0x8f, 0x12, 0x9c, 0x68, 0x9b, 0xf6, 0x73, 0x2e, 0xa9, 0xe7, 0x7f, 0x1d, 0x85, 0xd8, 0xb2, 0x67,
+	0xa2, 0xcf, 0x24, 0x98, 0x6a, 0xff, 0x51, 0xfb, 0x5c, 0xae, 0xfd, 0xc7, 0xf9, 0x5c, 0xa7, 0x6f,
+	0x9b, 0xca, 0xeb, 0x51, 0x2d, 0x04, 0x49, 0xb7, 0x61, 0xac, 0xf9, 0xf3, 0xe5, 0xe9, 0x9e, 0xce,
+	0x04, 0x56, 0x99, 0xef, 0x1f, 0x2b, 0x42, 0x7e, 0x0c, 0xa8, 0xcd, 0x37, 0xac, 0xb9, 0x9e, 0x9e,
+	0xc2, 0x70, 0xe5, 0x42, 0x24, 0xb8, 0x88, 0xbd, 0x0a, 0x43, 0xec, 0x5c, 0xc8, 0x76, 0xb1, 0xa7,
+	0x08, 0x65, 0xb6, 0x17, 0x42, 0x38, 0x7d, 0x0f, 0x92, 0xc1, 0xcb, 0xa8, 0xda, 0xc5, 0x88, 0x63,
+	0x94, 0xd3, 0xbd, 0x31, 0x61, 0xd7, 0xc1, 0xbb, 0x57, 0x37, 0xd7, 0x1c, 0xa3, 0x9c, 0xee, 0x8d,
+	0x11, 0xae, 0xcb, 0x30, 0xda, 0xa0, 0xef, 0x5f, 0xea, 0x62, 0x1b, 0x06, 0x2a, 0xf9, 0x3e, 0x81,
+	0x22, 0xd2, 0x16, 0x4c, 0xb4, 0xca, 0xfe, 0xb3, 0x5d, 0xbc, 0xb4, 0xa0, 0x95, 0x57, 0xa3, 0xa0,
+	0x45, 0xe0, 0x4f, 0x24, 0x98, 0x6c, 0xab, 0x47, 0xba, 0x2d, 0xa1, 0x9d, 0x81, 0xf2, 0x5a, 0x44,
+	0x83, 0x70, 0x7f, 0x35, 0x4b, 0x90, 0x6e, 0x9b, 0xd4, 0x84, 0x55, 0xe6, 0xfb, 0xc7, 0x36, 0xb4,
+	0x74, 0x93, 0x18, 0xe8, 0xda, 0xd2, 0x8d, 0x58, 0x65, 0xbe, 0x7f, 0xac, 0x08, 0xf9, 0x95, 0x04,
+	0xd3, 0x9d, 0xb5, 0x40, 0xb7, 0xcd, 0xeb, 0x68, 0xa5, 0xbc, 0xb1, 0x1f, 0xab, 0x30, 0x09, 0xcd,
+	0x52, 0xa0, 0x1b, 0x09, 0x4d, 0x58, 0x65, 0xbe, 0x7f, 0x6c, 0xf8, 0x5c, 0x6b, 0x73, 0x89, 0xce,
+	0xf5, 0xee, 0xf6, 0x10, 0x5c, 0xb9, 0x10, 0x09, 0x1e, 0xc4, 0x2e, 0x5c, 0x7d, 0xf4, 0x34, 0x2d,
+	0x3d, 0x7e, 0x9a, 0x96, 0xfe, 0x78, 0x9a, 0x96, 0xee, 0x3d, 0x4b, 0x0f, 0x3c, 0x7e, 0x96, 0x1e,
+	0xf8, 0xed, 0x59, 0x7a, 0xe0, 0xfd, 0xb9, 0xd0, 0x55, 0xd5, 0xe6, 0x1f, 0xc3, 0x77, 0xc4, 0x2f,
+	0x7a, 0x6b, 0xad, 0x25, 0xe8, 0xab, 0xc2, 0x2b, 0x7f, 0x0d, 0x00, 0x0d, 0xb8, 0xfb, 0xa1, 0x45,
+	0x1e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateFixedAmountPlan defines a method for creating a new fixed amount
+	// farming plan
+	CreateFixedAmountPlan(ctx context.Context, in *MsgCreateFixedAmountPlan, opts ...grpc.CallOption) (*MsgCreateFixedAmountPlanResponse, error)
+	// CreateRatioPlan defines a method for creating a new ratio farming plan
+	CreateRatioPlan(ctx context.Context, in *MsgCreateRatioPlan, opts ...grpc.CallOption) (*MsgCreateRatioPlanResponse, error)
+	// CreateDecayingPlan defines a method for creating a new decaying farming plan
+	CreateDecayingPlan(ctx context.Context, in *MsgCreateDecayingPlan, opts ...grpc.CallOption) (*MsgCreateDecayingPlanResponse, error)
+	// Stake defines a method for staking coins into the farming plan
+	Stake(ctx context.Context, in *MsgStake, opts ...grpc.CallOption) (*MsgStakeResponse, error)
+	// Unstake defines a method for unstaking coins from the farming plan
+	Unstake(ctx context.Context, in *MsgUnstake, opts ...grpc.CallOption) (*MsgUnstakeResponse, error)
+	// Harvest defines a method for claiming farming rewards
+	Harvest(ctx context.Context, in *MsgHarvest, opts ...grpc.CallOption) (*MsgHarvestResponse, error)
+	// AdvanceEpoch defines a method for advancing epoch by one, just for testing purpose
+	// and shouldn't be used in real world
+	AdvanceEpoch(ctx context.Context, in *MsgAdvanceEpoch, opts ...grpc.CallOption) (*MsgAdvanceEpochResponse, error)
+	// ModifyPrivatePlan defines a method for modifying an existing private farming plan
+	ModifyPrivatePlan(ctx context.Context, in *MsgModifyPrivatePlan, opts ...grpc.CallOption) (*MsgModifyPrivatePlanResponse, error)
+	// TerminatePrivatePlan defines a method for terminating a private farming plan
+	TerminatePrivatePlan(ctx context.Context, in *MsgTerminatePrivatePlan, opts ...grpc.CallOption) (*MsgTerminatePrivatePlanResponse, error)
+	// FundPrivatePlan defines a method for funding the farming pool of a private farming plan
+	FundPrivatePlan(ctx context.Context, in *MsgFundPrivatePlan, opts ...grpc.CallOption) (*MsgFundPrivatePlanResponse, error)
+	// CancelUnbonding defines a method for canceling an unbonding and staking the coins again
+	CancelUnbonding(ctx context.Context, in *MsgCancelUnbonding, opts ...grpc.CallOption) (*MsgCancelUnbondingResponse, error)
+	// SetRewardsWithdrawAddress defines a method for setting the address that the farmer's rewards are withdrawn to
+	SetRewardsWithdrawAddress(ctx context.Context, in *MsgSetRewardsWithdrawAddress, opts ...grpc.CallOption) (*MsgSetRewardsWithdrawAddressResponse, error)
+	// DepositAndStake defines a method for depositing coins to a liquidity pool and staking the minted pool coins
+	DepositAndStake(ctx context.Context, in *MsgDepositAndStake, opts ...grpc.CallOption) (*MsgDepositAndStakeResponse, error)
+	// UnstakeAndWithdraw defines a method for unstaking pool coins and withdrawing them from the liquidity pool
+	UnstakeAndWithdraw(ctx context.Context, in *MsgUnstakeAndWithdraw, opts ...grpc.CallOption) (*MsgUnstakeAndWithdrawResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateFixedAmountPlan(ctx context.Context, in *MsgCreateFixedAmountPlan, opts ...grpc.CallOption) (*MsgCreateFixedAmountPlanResponse, error) {
+	out := new(MsgCreateFixedAmountPlanResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/CreateFixedAmountPlan", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgHarvest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateRatioPlan(ctx context.Context, in *MsgCreateRatioPlan, opts ...grpc.CallOption) (*MsgCreateRatioPlanResponse, error) {
+	out := new(MsgCreateRatioPlanResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/CreateRatioPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgHarvest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StakingCoinDenoms) > 0 {
-		for iNdEx := len(m.StakingCoinDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.StakingCoinDenoms[iNdEx])
-			copy(dAtA[i:], m.StakingCoinDenoms[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.StakingCoinDenoms[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Farmer) > 0 {
-		i -= len(m.Farmer)
-		copy(dAtA[i:], m.Farmer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CreateDecayingPlan(ctx context.Context, in *MsgCreateDecayingPlan, opts ...grpc.CallOption) (*MsgCreateDecayingPlanResponse, error) {
+	out := new(MsgCreateDecayingPlanResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/CreateDecayingPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgHarvestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Stake(ctx context.Context, in *MsgStake, opts ...grpc.CallOption) (*MsgStakeResponse, error) {
+	out := new(MsgStakeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/Stake", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgHarvestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Unstake(ctx context.Context, in *MsgUnstake, opts ...grpc.CallOption) (*MsgUnstakeResponse, error) {
+	out := new(MsgUnstakeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/Unstake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgHarvestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) Harvest(ctx context.Context, in *MsgHarvest, opts ...grpc.CallOption) (*MsgHarvestResponse, error) {
+	out := new(MsgHarvestResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/Harvest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAdvanceEpoch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AdvanceEpoch(ctx context.Context, in *MsgAdvanceEpoch, opts ...grpc.CallOption) (*MsgAdvanceEpochResponse, error) {
+	out := new(MsgAdvanceEpochResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/AdvanceEpoch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAdvanceEpoch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ModifyPrivatePlan(ctx context.Context, in *MsgModifyPrivatePlan, opts ...grpc.CallOption) (*MsgModifyPrivatePlanResponse, error) {
+	out := new(MsgModifyPrivatePlanResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/ModifyPrivatePlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAdvanceEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Requester) > 0 {
-		i -= len(m.Requester)
-		copy(dAtA[i:], m.Requester)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Requester)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) TerminatePrivatePlan(ctx context.Context, in *MsgTerminatePrivatePlan, opts ...grpc.CallOption) (*MsgTerminatePrivatePlanResponse, error) {
+	out := new(MsgTerminatePrivatePlanResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/TerminatePrivatePlan", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAdvanceEpochResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) FundPrivatePlan(ctx context.Context, in *MsgFundPrivatePlan, opts ...grpc.CallOption) (*MsgFundPrivatePlanResponse, error) {
+	out := new(MsgFundPrivatePlanResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/FundPrivatePlan", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAdvanceEpochResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelUnbonding(ctx context.Context, in *MsgCancelUnbonding, opts ...grpc.CallOption) (*MsgCancelUnbondingResponse, error) {
+	out := new(MsgCancelUnbondingResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/CancelUnbonding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAdvanceEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) SetRewardsWithdrawAddress(ctx context.Context, in *MsgSetRewardsWithdrawAddress, opts ...grpc.CallOption) (*MsgSetRewardsWithdrawAddressResponse, error) {
+	out := new(MsgSetRewardsWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/SetRewardsWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) DepositAndStake(ctx context.Context, in *MsgDepositAndStake, opts ...grpc.CallOption) (*MsgDepositAndStakeResponse, error) {
+	out := new(MsgDepositAndStakeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/DepositAndStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgCreateFixedAmountPlan) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *msgClient) UnstakeAndWithdraw(ctx context.Context, in *MsgUnstakeAndWithdraw, opts ...grpc.CallOption) (*MsgUnstakeAndWithdrawResponse, error) {
+	out := new(MsgUnstakeAndWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.farming.v1beta1.Msg/UnstakeAndWithdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateFixedAmountPlan defines a method for creating a new fixed amount
+	// farming plan
+	CreateFixedAmountPlan(context.Context, *MsgCreateFixedAmountPlan) (*MsgCreateFixedAmountPlanResponse, error)
+	// CreateRatioPlan defines a method for creating a new ratio farming plan
+	CreateRatioPlan(context.Context, *MsgCreateRatioPlan) (*MsgCreateRatioPlanResponse, error)
+	// CreateDecayingPlan defines a method for creating a new decaying farming plan
+	CreateDecayingPlan(context.Context, *MsgCreateDecayingPlan) (*MsgCreateDecayingPlanResponse, error)
+	// Stake defines a method for staking coins into the farming plan
+	Stake(context.Context, *MsgStake) (*MsgStakeResponse, error)
+	// Unstake defines a method for unstaking coins from the farming plan
+	Unstake(context.Context, *MsgUnstake) (*MsgUnstakeResponse, error)
+	// Harvest defines a method for claiming farming rewards
+	Harvest(context.Context, *MsgHarvest) (*MsgHarvestResponse, error)
+	// AdvanceEpoch defines a method for advancing epoch by one, just for testing purpose
+	// and shouldn't be used in real world
+	AdvanceEpoch(context.Context, *MsgAdvanceEpoch) (*MsgAdvanceEpochResponse, error)
+	// ModifyPrivatePlan defines a method for modifying an existing private farming plan
+	ModifyPrivatePlan(context.Context, *MsgModifyPrivatePlan) (*MsgModifyPrivatePlanResponse, error)
+	// TerminatePrivatePlan defines a method for terminating a private farming plan
+	TerminatePrivatePlan(context.Context, *MsgTerminatePrivatePlan) (*MsgTerminatePrivatePlanResponse, error)
+	// FundPrivatePlan defines a method for funding the farming pool of a private farming plan
+	FundPrivatePlan(context.Context, *MsgFundPrivatePlan) (*MsgFundPrivatePlanResponse, error)
+	// CancelUnbonding defines a method for canceling an unbonding and staking the coins again
+	CancelUnbonding(context.Context, *MsgCancelUnbonding) (*MsgCancelUnbondingResponse, error)
+	// SetRewardsWithdrawAddress defines a method for setting the address that the farmer's rewards are withdrawn to
+	SetRewardsWithdrawAddress(context.Context, *MsgSetRewardsWithdrawAddress) (*MsgSetRewardsWithdrawAddressResponse, error)
+	// DepositAndStake defines a method for depositing coins to a liquidity pool and staking the minted pool coins
+	DepositAndStake(context.Context, *MsgDepositAndStake) (*MsgDepositAndStakeResponse, error)
+	// UnstakeAndWithdraw defines a method for unstaking pool coins and withdrawing them from the liquidity pool
+	UnstakeAndWithdraw(context.Context, *MsgUnstakeAndWithdraw) (*MsgUnstakeAndWithdrawResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateFixedAmountPlan(ctx context.Context, req *MsgCreateFixedAmountPlan) (*MsgCreateFixedAmountPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFixedAmountPlan not implemented")
+}
+func (*UnimplementedMsgServer) CreateRatioPlan(ctx context.Context, req *MsgCreateRatioPlan) (*MsgCreateRatioPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRatioPlan not implemented")
+}
+func (*UnimplementedMsgServer) CreateDecayingPlan(ctx context.Context, req *MsgCreateDecayingPlan) (*MsgCreateDecayingPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDecayingPlan not implemented")
+}
+func (*UnimplementedMsgServer) Stake(ctx context.Context, req *MsgStake) (*MsgStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stake not implemented")
+}
+func (*UnimplementedMsgServer) Unstake(ctx context.Context, req *MsgUnstake) (*MsgUnstakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unstake not implemented")
+}
+func (*UnimplementedMsgServer) Harvest(ctx context.Context, req *MsgHarvest) (*MsgHarvestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Harvest not implemented")
+}
+func (*UnimplementedMsgServer) AdvanceEpoch(ctx context.Context, req *MsgAdvanceEpoch) (*MsgAdvanceEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdvanceEpoch not implemented")
+}
+func (*UnimplementedMsgServer) ModifyPrivatePlan(ctx context.Context, req *MsgModifyPrivatePlan) (*MsgModifyPrivatePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyPrivatePlan not implemented")
+}
+func (*UnimplementedMsgServer) TerminatePrivatePlan(ctx context.Context, req *MsgTerminatePrivatePlan) (*MsgTerminatePrivatePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminatePrivatePlan not implemented")
+}
+func (*UnimplementedMsgServer) FundPrivatePlan(ctx context.Context, req *MsgFundPrivatePlan) (*MsgFundPrivatePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundPrivatePlan not implemented")
+}
+func (*UnimplementedMsgServer) CancelUnbonding(ctx context.Context, req *MsgCancelUnbonding) (*MsgCancelUnbondingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUnbonding not implemented")
+}
+func (*UnimplementedMsgServer) SetRewardsWithdrawAddress(ctx context.Context, req *MsgSetRewardsWithdrawAddress) (*MsgSetRewardsWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRewardsWithdrawAddress not implemented")
+}
+func (*UnimplementedMsgServer) DepositAndStake(ctx context.Context, req *MsgDepositAndStake) (*MsgDepositAndStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositAndStake not implemented")
+}
+func (*UnimplementedMsgServer) UnstakeAndWithdraw(ctx context.Context, req *MsgUnstakeAndWithdraw) (*MsgUnstakeAndWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnstakeAndWithdraw not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateFixedAmountPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateFixedAmountPlan)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateFixedAmountPlan(ctx, in)
 	}
-	if len(m.StakingCoinWeights) > 0 {
-		for _, e := range m.StakingCoinWeights {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/CreateFixedAmountPlan",
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.EpochAmount) > 0 {
-		for _, e := range m.EpochAmount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateFixedAmountPlan(ctx, req.(*MsgCreateFixedAmountPlan))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateFixedAmountPlanResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateRatioPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRatioPlan)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRatioPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/CreateRatioPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRatioPlan(ctx, req.(*MsgCreateRatioPlan))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateRatioPlan) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateDecayingPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDecayingPlan)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDecayingPlan(ctx, in)
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/CreateDecayingPlan",
 	}
-	if len(m.StakingCoinWeights) > 0 {
-		for _, e := range m.StakingCoinWeights {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDecayingPlan(ctx, req.(*MsgCreateDecayingPlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Stake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Stake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/Stake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Stake(ctx, req.(*MsgStake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unstake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnstake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unstake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/Unstake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unstake(ctx, req.(*MsgUnstake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Harvest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgHarvest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Harvest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/Harvest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Harvest(ctx, req.(*MsgHarvest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AdvanceEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAdvanceEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AdvanceEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/AdvanceEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AdvanceEpoch(ctx, req.(*MsgAdvanceEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ModifyPrivatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModifyPrivatePlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ModifyPrivatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/ModifyPrivatePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModifyPrivatePlan(ctx, req.(*MsgModifyPrivatePlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TerminatePrivatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTerminatePrivatePlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TerminatePrivatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/TerminatePrivatePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TerminatePrivatePlan(ctx, req.(*MsgTerminatePrivatePlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundPrivatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundPrivatePlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundPrivatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/FundPrivatePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundPrivatePlan(ctx, req.(*MsgFundPrivatePlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelUnbonding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelUnbonding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelUnbonding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/CancelUnbonding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelUnbonding(ctx, req.(*MsgCancelUnbonding))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRewardsWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRewardsWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRewardsWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/SetRewardsWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRewardsWithdrawAddress(ctx, req.(*MsgSetRewardsWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositAndStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositAndStake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositAndStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/DepositAndStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositAndStake(ctx, req.(*MsgDepositAndStake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnstakeAndWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnstakeAndWithdraw)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnstakeAndWithdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.farming.v1beta1.Msg/UnstakeAndWithdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnstakeAndWithdraw(ctx, req.(*MsgUnstakeAndWithdraw))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.farming.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFixedAmountPlan",
+			Handler:    _Msg_CreateFixedAmountPlan_Handler,
+		},
+		{
+			MethodName: "CreateRatioPlan",
+			Handler:    _Msg_CreateRatioPlan_Handler,
+		},
+		{
+			MethodName: "CreateDecayingPlan",
+			Handler:    _Msg_CreateDecayingPlan_Handler,
+		},
+		{
+			MethodName: "Stake",
+			Handler:    _Msg_Stake_Handler,
+		},
+		{
+			MethodName: "Unstake",
+			Handler:    _Msg_Unstake_Handler,
+		},
+		{
+			MethodName: "Harvest",
+			Handler:    _Msg_Harvest_Handler,
+		},
+		{
+			MethodName: "AdvanceEpoch",
+			Handler:    _Msg_AdvanceEpoch_Handler,
+		},
+		{
+			MethodName: "ModifyPrivatePlan",
+			Handler:    _Msg_ModifyPrivatePlan_Handler,
+		},
+		{
+			MethodName: "TerminatePrivatePlan",
+			Handler:    _Msg_TerminatePrivatePlan_Handler,
+		},
+		{
+			MethodName: "FundPrivatePlan",
+			Handler:    _Msg_FundPrivatePlan_Handler,
+		},
+		{
+			MethodName: "CancelUnbonding",
+			Handler:    _Msg_CancelUnbonding_Handler,
+		},
+		{
+			MethodName: "SetRewardsWithdrawAddress",
+			Handler:    _Msg_SetRewardsWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "DepositAndStake",
+			Handler:    _Msg_DepositAndStake_Handler,
+		},
+		{
+			MethodName: "UnstakeAndWithdraw",
+			Handler:    _Msg_UnstakeAndWithdraw_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tendermint/farming/v1beta1/tx.proto",
+}
+
+func (m *MsgCreateFixedAmountPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFixedAmountPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFixedAmountPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochAmount) > 0 {
+		for iNdEx := len(m.EpochAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.StakingCoinWeights) > 0 {
+		for iNdEx := len(m.StakingCoinWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingCoinWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateFixedAmountPlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFixedAmountPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFixedAmountPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FarmingPoolAddress) > 0 {
+		i -= len(m.FarmingPoolAddress)
+		copy(dAtA[i:], m.FarmingPoolAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FarmingPoolAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRatioPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRatioPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRatioPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EpochRatio.Size()
+		i -= size
+		if _, err := m.EpochRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if len(m.StakingCoinWeights) > 0 {
+		for iNdEx := len(m.StakingCoinWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingCoinWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRatioPlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRatioPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRatioPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FarmingPoolAddress) > 0 {
+		i -= len(m.FarmingPoolAddress)
+		copy(dAtA[i:], m.FarmingPoolAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FarmingPoolAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDecayingPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDecayingPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDecayingPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DecayTimes) > 0 {
+		for iNdEx := len(m.DecayTimes) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DecayTimes[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DecayTimes[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintTx(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.DecayEpochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DecayEpochs))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.DecayFactor.Size()
+		i -= size
+		if _, err := m.DecayFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.EpochAmount) > 0 {
+		for iNdEx := len(m.EpochAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if len(m.StakingCoinWeights) > 0 {
+		for iNdEx := len(m.StakingCoinWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingCoinWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDecayingPlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDecayingPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDecayingPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FarmingPoolAddress) > 0 {
+		i -= len(m.FarmingPoolAddress)
+		copy(dAtA[i:], m.FarmingPoolAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FarmingPoolAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LockDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTx(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.StakingCoins) > 0 {
+		for iNdEx := len(m.StakingCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockedStakingIds) > 0 {
+		dAtA9 := make([]byte, len(m.LockedStakingIds)*10)
+		var j8 int
+		for _, num := range m.LockedStakingIds {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintTx(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnstake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnstakingCoins) > 0 {
+		for iNdEx := len(m.UnstakingCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnstakingCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnstakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawnRewards) > 0 {
+		for iNdEx := len(m.WithdrawnRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawnRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgHarvest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgHarvest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgHarvest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StakeRewards {
+		i--
+		if m.StakeRewards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.StakingCoinDenoms) > 0 {
+		for iNdEx := len(m.StakingCoinDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StakingCoinDenoms[iNdEx])
+			copy(dAtA[i:], m.StakingCoinDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.StakingCoinDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgHarvestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgHarvestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgHarvestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakedRewards) > 0 {
+		for iNdEx := len(m.StakedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.HarvestedRewards) > 0 {
+		for iNdEx := len(m.HarvestedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HarvestedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAdvanceEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAdvanceEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAdvanceEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAdvanceEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAdvanceEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAdvanceEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModifyPrivatePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyPrivatePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyPrivatePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DecayTimes) > 0 {
+		for iNdEx := len(m.DecayTimes) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DecayTimes[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DecayTimes[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintTx(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.DecayEpochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DecayEpochs))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.DecayFactor.Size()
+		i -= size
+		if _, err := m.DecayFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.EpochRatio.Size()
+		i -= size
+		if _, err := m.EpochRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.EpochAmount) > 0 {
+		for iNdEx := len(m.EpochAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.EndTime != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintTx(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StartTime != nil {
+		n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintTx(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StakingCoinWeights) > 0 {
+		for iNdEx := len(m.StakingCoinWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingCoinWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TerminationAddress) > 0 {
+		i -= len(m.TerminationAddress)
+		copy(dAtA[i:], m.TerminationAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TerminationAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModifyPrivatePlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyPrivatePlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyPrivatePlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTerminatePrivatePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTerminatePrivatePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTerminatePrivatePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TerminationAddress) > 0 {
+		i -= len(m.TerminationAddress)
+		copy(dAtA[i:], m.TerminationAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TerminationAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTerminatePrivatePlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTerminatePrivatePlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTerminatePrivatePlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundPrivatePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundPrivatePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundPrivatePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TerminationAddress) > 0 {
+		i -= len(m.TerminationAddress)
+		copy(dAtA[i:], m.TerminationAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TerminationAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundPrivatePlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundPrivatePlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundPrivatePlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUnbondingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUnbondingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUnbondingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardsWithdrawAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardsWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardsWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardsWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardsWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardsWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositAndStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositAndStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositAndStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DepositCoins) > 0 {
+		for iNdEx := len(m.DepositCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositAndStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositAndStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositAndStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DepositRequestId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DepositRequestId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnstakeAndWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstakeAndWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstakeAndWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PoolCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnstakeAndWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstakeAndWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstakeAndWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawnRewards) > 0 {
+		for iNdEx := len(m.WithdrawnRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawnRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateFixedAmountPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingCoinWeights) > 0 {
+		for _, e := range m.StakingCoinWeights {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.EpochAmount) > 0 {
+		for _, e := range m.EpochAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateFixedAmountPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovTx(uint64(m.PlanId))
+	}
+	l = len(m.FarmingPoolAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateRatioPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingCoinWeights) > 0 {
+		for _, e := range m.StakingCoinWeights {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.EpochRatio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateRatioPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovTx(uint64(m.PlanId))
+	}
+	l = len(m.FarmingPoolAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateDecayingPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingCoinWeights) > 0 {
+		for _, e := range m.StakingCoinWeights {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.EpochAmount) > 0 {
+		for _, e := range m.EpochAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.DecayFactor.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DecayEpochs != 0 {
+		n += 1 + sovTx(uint64(m.DecayEpochs))
+	}
+	if len(m.DecayTimes) > 0 {
+		for _, e := range m.DecayTimes {
+			l = github_com_gogo_protobuf_types.SizeOfStdTime(e)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateDecayingPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovTx(uint64(m.PlanId))
+	}
+	l = len(m.FarmingPoolAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingCoins) > 0 {
+		for _, e := range m.StakingCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration)
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LockedStakingIds) > 0 {
+		l = 0
+		for _, e := range m.LockedStakingIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.UnstakingCoins) > 0 {
+		for _, e := range m.UnstakingCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUnstakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WithdrawnRewards) > 0 {
+		for _, e := range m.WithdrawnRewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgHarvest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingCoinDenoms) > 0 {
+		for _, s := range m.StakingCoinDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.StakeRewards {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgHarvestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HarvestedRewards) > 0 {
+		for _, e := range m.HarvestedRewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.StakedRewards) > 0 {
+		for _, e := range m.StakedRewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAdvanceEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAdvanceEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgModifyPrivatePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovTx(uint64(m.PlanId))
+	}
+	l = len(m.TerminationAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingCoinWeights) > 0 {
+		for _, e := range m.StakingCoinWeights {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.StartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.EpochAmount) > 0 {
+		for _, e := range m.EpochAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.EpochRatio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.DecayFactor.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DecayEpochs != 0 {
+		n += 1 + sovTx(uint64(m.DecayEpochs))
+	}
+	if len(m.DecayTimes) > 0 {
+		for _, e := range m.DecayTimes {
+			l = github_com_gogo_protobuf_types.SizeOfStdTime(e)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgModifyPrivatePlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTerminatePrivatePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovTx(uint64(m.PlanId))
+	}
+	l = len(m.TerminationAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTerminatePrivatePlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundPrivatePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovTx(uint64(m.PlanId))
+	}
+	l = len(m.TerminationAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundPrivatePlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingId != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingId))
+	}
+	return n
+}
+
+func (m *MsgCancelUnbondingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRewardsWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetRewardsWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositAndStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if len(m.DepositCoins) > 0 {
+		for _, e := range m.DepositCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDepositAndStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DepositRequestId != 0 {
+		n += 1 + sovTx(uint64(m.DepositRequestId))
+	}
+	return n
+}
+
+func (m *MsgUnstakeAndWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.PoolCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUnstakeAndWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WithdrawnRewards) > 0 {
+		for _, e := range m.WithdrawnRewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFixedAmountPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFixedAmountPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinWeights = append(m.StakingCoinWeights, types.DecCoin{})
+			if err := m.StakingCoinWeights[len(m.StakingCoinWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochAmount = append(m.EpochAmount, types.Coin{})
+			if err := m.EpochAmount[len(m.EpochAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateFixedAmountPlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFixedAmountPlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFixedAmountPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FarmingPoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRatioPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRatioPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinWeights = append(m.StakingCoinWeights, types.DecCoin{})
+			if err := m.StakingCoinWeights[len(m.StakingCoinWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRatioPlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRatioPlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRatioPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FarmingPoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDecayingPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDecayingPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDecayingPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinWeights = append(m.StakingCoinWeights, types.DecCoin{})
+			if err := m.StakingCoinWeights[len(m.StakingCoinWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochAmount = append(m.EpochAmount, types.Coin{})
+			if err := m.EpochAmount[len(m.EpochAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayEpochs", wireType)
+			}
+			m.DecayEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecayEpochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecayTimes = append(m.DecayTimes, time.Time{})
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&(m.DecayTimes[len(m.DecayTimes)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDecayingPlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDecayingPlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDecayingPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingPoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FarmingPoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoins = append(m.StakingCoins, types.Coin{})
+			if err := m.StakingCoins[len(m.StakingCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LockedStakingIds = append(m.LockedStakingIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LockedStakingIds) == 0 {
+					m.LockedStakingIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LockedStakingIds = append(m.LockedStakingIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedStakingIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnstake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakingCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnstakingCoins = append(m.UnstakingCoins, types.Coin{})
+			if err := m.UnstakingCoins[len(m.UnstakingCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnstakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnstakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawnRewards = append(m.WithdrawnRewards, types.Coin{})
+			if err := m.WithdrawnRewards[len(m.WithdrawnRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgHarvest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgHarvest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingCoinDenoms = append(m.StakingCoinDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeRewards", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StakeRewards = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgHarvestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgHarvestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgHarvestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HarvestedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HarvestedRewards = append(m.HarvestedRewards, types.Coin{})
+			if err := m.HarvestedRewards[len(m.HarvestedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakedRewards = append(m.StakedRewards, types.Coin{})
+			if err := m.StakedRewards[len(m.StakedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovTx(uint64(l))
-	l = m.EpochRatio.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgCreateRatioPlanResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgStake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Farmer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.StakingCoins) > 0 {
-		for _, e := range m.StakingCoins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgAdvanceEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	return n
-}
-
-func (m *MsgStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgUnstake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Farmer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.UnstakingCoins) > 0 {
-		for _, e := range m.UnstakingCoins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAdvanceEpoch: wiretype end group for non-group")
 		}
-	}
-	return n
-}
-
-func (m *MsgUnstakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgHarvest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Farmer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.StakingCoinDenoms) > 0 {
-		for _, s := range m.StakingCoinDenoms {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAdvanceEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgHarvestResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgAdvanceEpoch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Requester)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgAdvanceEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAdvanceEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAdvanceEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgAdvanceEpochResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
+func (m *MsgModifyPrivatePlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1555,15 +5896,34 @@ func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFixedAmountPlan: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgModifyPrivatePlan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFixedAmountPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgModifyPrivatePlan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminationAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1591,11 +5951,11 @@ func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.TerminationAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1623,9 +5983,9 @@ func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinWeights", wireType)
 			}
@@ -1659,7 +6019,7 @@ func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
@@ -1688,11 +6048,14 @@ func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
@@ -1721,11 +6084,14 @@ func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EpochAmount", wireType)
 			}
@@ -1759,6 +6125,127 @@ func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayEpochs", wireType)
+			}
+			m.DecayEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecayEpochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecayTimes = append(m.DecayTimes, time.Time{})
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&(m.DecayTimes[len(m.DecayTimes)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1780,7 +6267,7 @@ func (m *MsgCreateFixedAmountPlan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateFixedAmountPlanResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgModifyPrivatePlanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1803,10 +6290,10 @@ func (m *MsgCreateFixedAmountPlanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFixedAmountPlanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgModifyPrivatePlanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFixedAmountPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgModifyPrivatePlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1830,7 +6317,7 @@ func (m *MsgCreateFixedAmountPlanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
+func (m *MsgTerminatePrivatePlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1853,17 +6340,17 @@ func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRatioPlan: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTerminatePrivatePlan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRatioPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTerminatePrivatePlan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			var stringLen uint64
+			m.PlanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1873,27 +6360,14 @@ func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PlanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminationAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1921,47 +6395,113 @@ func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.TerminationAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinWeights", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTerminatePrivatePlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTerminatePrivatePlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTerminatePrivatePlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundPrivatePlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingCoinWeights = append(m.StakingCoinWeights, types.DecCoin{})
-			if err := m.StakingCoinWeights[len(m.StakingCoinWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundPrivatePlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundPrivatePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			var msglen int
+			m.PlanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1971,30 +6511,16 @@ func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PlanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminationAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2004,30 +6530,29 @@ func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TerminationAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2037,23 +6562,23 @@ func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EpochRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2078,7 +6603,7 @@ func (m *MsgCreateRatioPlan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateRatioPlanResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundPrivatePlanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2101,10 +6626,10 @@ func (m *MsgCreateRatioPlanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRatioPlanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundPrivatePlanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRatioPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundPrivatePlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2128,7 +6653,7 @@ func (m *MsgCreateRatioPlanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStake) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUnbonding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2151,10 +6676,10 @@ func (m *MsgStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUnbonding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2190,10 +6715,10 @@ func (m *MsgStake) Unmarshal(dAtA []byte) error {
 			m.Farmer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoins", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
 			}
-			var msglen int
+			m.UnbondingId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2203,26 +6728,11 @@ func (m *MsgStake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UnbondingId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakingCoins = append(m.StakingCoins, types.Coin{})
-			if err := m.StakingCoins[len(m.StakingCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2244,7 +6754,7 @@ func (m *MsgStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUnbondingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2267,10 +6777,10 @@ func (m *MsgStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUnbondingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUnbondingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2294,7 +6804,7 @@ func (m *MsgStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRewardsWithdrawAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2317,10 +6827,10 @@ func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRewardsWithdrawAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRewardsWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2357,9 +6867,9 @@ func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnstakingCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2369,25 +6879,23 @@ func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnstakingCoins = append(m.UnstakingCoins, types.Coin{})
-			if err := m.UnstakingCoins[len(m.UnstakingCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2410,7 +6918,7 @@ func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRewardsWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2433,10 +6941,10 @@ func (m *MsgUnstakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRewardsWithdrawAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRewardsWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2460,7 +6968,7 @@ func (m *MsgUnstakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositAndStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2483,10 +6991,10 @@ func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgHarvest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositAndStake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgHarvest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositAndStake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2522,10 +7030,29 @@ func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
 			m.Farmer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingCoinDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCoins", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2535,23 +7062,25 @@ func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingCoinDenoms = append(m.StakingCoinDenoms, string(dAtA[iNdEx:postIndex]))
+			m.DepositCoins = append(m.DepositCoins, types.Coin{})
+			if err := m.DepositCoins[len(m.DepositCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2574,7 +7103,7 @@ func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgHarvestResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositAndStakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2597,12 +7126,31 @@ func (m *MsgHarvestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgHarvestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositAndStakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgHarvestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositAndStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRequestId", wireType)
+			}
+			m.DepositRequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositRequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2624,7 +7172,7 @@ func (m *MsgHarvestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAdvanceEpoch) Unmarshal(dAtA []byte) error {
+func (m *MsgUnstakeAndWithdraw) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2647,15 +7195,15 @@ func (m *MsgAdvanceEpoch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAdvanceEpoch: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnstakeAndWithdraw: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAdvanceEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnstakeAndWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2683,7 +7231,59 @@ func (m *MsgAdvanceEpoch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requester = string(dAtA[iNdEx:postIndex])
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2706,7 +7306,7 @@ func (m *MsgAdvanceEpoch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAdvanceEpochResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnstakeAndWithdrawResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2729,12 +7329,46 @@ func (m *MsgAdvanceEpochResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAdvanceEpochResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnstakeAndWithdrawResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAdvanceEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnstakeAndWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawnRewards = append(m.WithdrawnRewards, types.Coin{})
+			if err := m.WithdrawnRewards[len(m.WithdrawnRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
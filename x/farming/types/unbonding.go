@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetFarmer returns the farmer address of the unbonding.
+func (unbonding Unbonding) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(unbonding.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// Validate validates Unbonding.
+func (unbonding Unbonding) Validate() error {
+	if unbonding.Id == 0 {
+		return fmt.Errorf("unbonding id must not be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(unbonding.Farmer); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(unbonding.StakingCoinDenom); err != nil {
+		return err
+	}
+	if !unbonding.Amount.IsPositive() {
+		return fmt.Errorf("unbonding amount must be positive: %s", unbonding.Amount)
+	}
+	return nil
+}